@@ -31,13 +31,14 @@ func TestInjections(t *testing.T) {
 	Expect(ok).To(Equal(true))
 	_, ok = bootList[2].(*server.MetricsServer)
 	Expect(ok).To(Equal(true))
-	_, ok = bootList[3].(*server.HealthCheckServer)
+	healthCheckServer, ok := bootList[3].(*server.HealthCheckServer)
 	Expect(ok).To(Equal(true))
+	Expect(healthCheckServer.Registry().Names()).To(ContainElements("maintenance", "database", "leader_leases", "worker_reconciles", "worker_cluster"))
 	_, ok = bootList[4].(*workers.LeaderElectionManager)
 	Expect(ok).To(Equal(true))
 
 	var workerList []workers.Worker
 	env.MustResolve(&workerList)
-	Expect(workerList).To(HaveLen(8))
+	Expect(workerList).To(HaveLen(14))
 
 }
@@ -0,0 +1,25 @@
+/*
+ * Connector Service Fleet Manager Admin APIs
+ *
+ * Connector Service Fleet Manager Admin is a Rest API to manage connector clusters.
+ *
+ * API version: 0.0.3
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ConnectorDeprecation A connector that uses a deprecated connector type or channel
+type ConnectorDeprecation struct {
+	ConnectorId     string `json:"connector_id,omitempty"`
+	ConnectorTypeId string `json:"connector_type_id,omitempty"`
+	Channel         string `json:"channel,omitempty"`
+	Owner           string `json:"owner,omitempty"`
+	OrganisationId  string `json:"organisation_id,omitempty"`
+	// Whether the connector type of the connector is deprecated
+	TypeDeprecated bool `json:"type_deprecated,omitempty"`
+	// Whether the channel of the connector is deprecated
+	ChannelDeprecated bool `json:"channel_deprecated,omitempty"`
+	// The id of the connector type the connector will be migrated to
+	SuccessorId string `json:"successor_id,omitempty"`
+}
@@ -1,6 +1,10 @@
 package dbapi
 
-import "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+)
 
 type ConnectorStatusPhase = string
 
@@ -77,6 +81,49 @@ type ConnectorStatus struct {
 
 type ConnectorList []*Connector
 
+// ConnectorRevision Holds a previous configuration of a connector, keyed by the connector version it was created for
+type ConnectorRevision struct {
+	ConnectorID     string `gorm:"primaryKey"`
+	Version         int64  `gorm:"primaryKey;autoIncrement:false"`
+	CreatedAt       time.Time
+	ConnectorTypeId string
+	Channel         string
+	DesiredState    string
+	ConnectorSpec   api.JSON `gorm:"type:jsonb"`
+}
+
+type ConnectorRevisionList []ConnectorRevision
+
+// ConnectorLogEntry Holds a log line of a connector reported by the agent running its deployment
+type ConnectorLogEntry struct {
+	ID           int64  `gorm:"primaryKey"`
+	ConnectorID  string `gorm:"index"`
+	DeploymentID string
+	Timestamp    time.Time
+	Message      string
+}
+
+type ConnectorLogEntryList []ConnectorLogEntry
+
+// ConnectorMetricSample Holds a metric value of a connector reported by the agent running its deployment
+type ConnectorMetricSample struct {
+	ID           int64  `gorm:"primaryKey"`
+	ConnectorID  string `gorm:"index"`
+	DeploymentID string
+	Timestamp    time.Time
+	Name         string
+	Value        float64
+}
+
+type ConnectorMetricSampleList []ConnectorMetricSample
+
+// ConnectorOrphanedSecret Remembers since when a connector secret in the vault is no longer referenced by its connector
+type ConnectorOrphanedSecret struct {
+	Name           string `gorm:"primaryKey"`
+	OwningResource string
+	OrphanedSince  time.Time
+}
+
 // ConnectorDeployment Holds the deployment configuration of a connector
 type ConnectorDeployment struct {
 	api.Meta
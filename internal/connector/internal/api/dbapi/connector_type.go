@@ -2,6 +2,7 @@ package dbapi
 
 import (
 	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
@@ -25,6 +26,13 @@ type ConnectorType struct {
 	Labels []ConnectorTypeLabel `gorm:"foreignKey:ConnectorTypeID"`
 	// connector capabilities used to understand what features a connector support
 	Capabilities []ConnectorTypeCapability `gorm:"foreignKey:ConnectorTypeID"`
+	// new connectors can't be created with a deprecated connector type
+	Deprecated bool
+	// id of the connector type that connectors of this deprecated type are migrated to
+	SuccessorId string
+	// channels that new connectors can't be created on, channels are shared by connector types
+	// so their deprecation is tracked per connector type.
+	DeprecatedChannels []ConnectorTypeDeprecatedChannel `gorm:"foreignKey:ConnectorTypeID"`
 }
 
 type ConnectorTypeList []*ConnectorType
@@ -47,6 +55,25 @@ type ConnectorTypeCapability struct {
 	Capability      string `gorm:"primaryKey"`
 }
 
+type ConnectorTypeDeprecatedChannel struct {
+	ConnectorTypeID string `gorm:"primaryKey"`
+	Channel         string `gorm:"primaryKey"`
+}
+
+// ConnectorDeprecation is a connector that uses a deprecated connector type or channel
+type ConnectorDeprecation struct {
+	ConnectorID       string
+	ConnectorTypeId   string
+	Channel           string
+	Owner             string
+	OrganisationId    string
+	TypeDeprecated    bool
+	ChannelDeprecated bool
+	SuccessorId       string
+}
+
+type ConnectorDeprecationList []ConnectorDeprecation
+
 type ConnectorShardMetadata struct {
 	ID              int64    `gorm:"primaryKey:autoIncrement"`
 	ConnectorTypeId string   `gorm:"primaryKey"`
@@ -105,6 +132,47 @@ func (ct *ConnectorType) SetLabels(labels []string) {
 	}
 }
 
+func (ct *ConnectorType) DeprecatedChannelNames() []string {
+	channels := make([]string, len(ct.DeprecatedChannels))
+	for i, channel := range ct.DeprecatedChannels {
+		channels[i] = channel.Channel
+	}
+	return channels
+}
+
+func (ct *ConnectorType) SetDeprecatedChannels(channels []string) {
+	id := ct.ID
+	ct.DeprecatedChannels = make([]ConnectorTypeDeprecatedChannel, len(channels))
+	for i, channel := range channels {
+		ct.DeprecatedChannels[i] = ConnectorTypeDeprecatedChannel{ConnectorTypeID: id, Channel: channel}
+	}
+}
+
+func (ct *ConnectorType) IsChannelDeprecated(channel string) bool {
+	for _, c := range ct.DeprecatedChannels {
+		if c.Channel == channel {
+			return true
+		}
+	}
+	return false
+}
+
+// DeprecationWarnings returns the warnings to show for a connector of this type on the given channel
+func (ct *ConnectorType) DeprecationWarnings(channel string) []string {
+	var warnings []string
+	if ct.Deprecated {
+		if ct.SuccessorId != "" {
+			warnings = append(warnings, fmt.Sprintf("connector type %s is deprecated, the connector will be migrated to connector type %s", ct.ID, ct.SuccessorId))
+		} else {
+			warnings = append(warnings, fmt.Sprintf("connector type %s is deprecated", ct.ID))
+		}
+	}
+	if ct.IsChannelDeprecated(channel) {
+		warnings = append(warnings, fmt.Sprintf("channel %s of connector type %s is deprecated", channel, ct.ID))
+	}
+	return warnings
+}
+
 func (ct *ConnectorType) JsonSchemaAsMap() (map[string]interface{}, *errors.ServiceError) {
 	schema, err := ct.JsonSchema.Object()
 	if err != nil {
@@ -0,0 +1,15 @@
+/*
+ * Connector Service Fleet Manager Private APIs
+ *
+ * Connector Service Fleet Manager apis that are used by internal services.
+ *
+ * API version: 0.0.3
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ConnectorDeploymentLogs Recent log lines of a connector deployment
+type ConnectorDeploymentLogs struct {
+	Items []ConnectorLogEntry `json:"items,omitempty"`
+}
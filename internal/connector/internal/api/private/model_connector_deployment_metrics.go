@@ -0,0 +1,21 @@
+/*
+ * Connector Service Fleet Manager Private APIs
+ *
+ * Connector Service Fleet Manager apis that are used by internal services.
+ *
+ * API version: 0.0.3
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// ConnectorDeploymentMetrics A sample of the throughput and lag metrics of a connector deployment
+type ConnectorDeploymentMetrics struct {
+	Timestamp time.Time `json:"timestamp,omitempty"`
+	// The value of each metric, keyed by metric name
+	Values map[string]float64 `json:"values,omitempty"`
+}
@@ -0,0 +1,17 @@
+/*
+ * Connector Service Fleet Manager
+ *
+ * Connector Service Fleet Manager is a Rest API to manage connectors.
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// ConnectorLogList struct for ConnectorLogList
+type ConnectorLogList struct {
+	Kind  string              `json:"kind,omitempty"`
+	Id    string              `json:"id,omitempty"`
+	Items []ConnectorLogEntry `json:"items,omitempty"`
+}
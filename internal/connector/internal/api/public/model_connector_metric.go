@@ -0,0 +1,16 @@
+/*
+ * Connector Service Fleet Manager
+ *
+ * Connector Service Fleet Manager is a Rest API to manage connectors.
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// ConnectorMetric The samples of a connector metric reported by the data plane agent.
+type ConnectorMetric struct {
+	Name   string                 `json:"name,omitempty"`
+	Values []ConnectorMetricValue `json:"values,omitempty"`
+}
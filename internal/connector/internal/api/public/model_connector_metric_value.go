@@ -0,0 +1,16 @@
+/*
+ * Connector Service Fleet Manager
+ *
+ * Connector Service Fleet Manager is a Rest API to manage connectors.
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// ConnectorMetricValue struct for ConnectorMetricValue
+type ConnectorMetricValue struct {
+	Timestamp int64   `json:"timestamp,omitempty"`
+	Value     float64 `json:"value"`
+}
@@ -0,0 +1,25 @@
+/*
+ * Connector Service Fleet Manager
+ *
+ * Connector Service Fleet Manager is a Rest API to manage connectors.
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+import (
+	"time"
+)
+
+// ConnectorRevision A previous configuration of a connector. Secrets are never returned.
+type ConnectorRevision struct {
+	// The resource_version of the connector this revision was created for.
+	Revision        int64                  `json:"revision,omitempty"`
+	CreatedAt       time.Time              `json:"created_at,omitempty"`
+	ConnectorTypeId string                 `json:"connector_type_id,omitempty"`
+	Channel         Channel                `json:"channel,omitempty"`
+	DesiredState    ConnectorDesiredState  `json:"desired_state,omitempty"`
+	Connector       map[string]interface{} `json:"connector,omitempty"`
+}
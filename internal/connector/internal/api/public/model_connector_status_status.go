@@ -13,4 +13,6 @@ package public
 type ConnectorStatusStatus struct {
 	State ConnectorState `json:"state,omitempty"`
 	Error string         `json:"error,omitempty"`
+	// Warnings about the connector, e.g. the deprecation of its connector type or channel.
+	Warnings []string `json:"warnings,omitempty"`
 }
@@ -28,6 +28,12 @@ type ConnectorType struct {
 	Labels []string `json:"labels,omitempty"`
 	// The capabilities supported by the conenctor
 	Capabilities []string `json:"capabilities,omitempty"`
+	// Whether the connector type is deprecated. New connectors can't be created with a deprecated connector type.
+	Deprecated bool `json:"deprecated,omitempty"`
+	// Channels of the connector type that are deprecated. New connectors can't be created on a deprecated channel.
+	DeprecatedChannels []Channel `json:"deprecated_channels,omitempty"`
+	// The id of the connector type that connectors of this deprecated connector type are migrated to.
+	SuccessorId string `json:"successor_id,omitempty"`
 	// A json schema that can be used to validate a ConnectorRequest connector field.
 	Schema map[string]interface{} `json:"schema,omitempty"`
 	// A json schema that can be used to validate a ConnectorRequest connector field.
@@ -0,0 +1,32 @@
+/*
+ * Connector Service Fleet Manager
+ *
+ * Connector Service Fleet Manager is a Rest API to manage connectors.
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+import (
+	"time"
+)
+
+// RoleBinding Grants a user a role on a connector, or on all the resources of an organisation
+type RoleBinding struct {
+	Id   string `json:"id,omitempty"`
+	Kind string `json:"kind,omitempty"`
+	Href string `json:"href,omitempty"`
+	// type of the resource the role is granted on, one of kafka, connector or organisation
+	ResourceType string `json:"resource_type,omitempty"`
+	// id of the resource the role is granted on
+	ResourceId string `json:"resource_id,omitempty"`
+	// username of the user the role is granted to
+	Subject string `json:"subject,omitempty"`
+	// the granted role
+	Role string `json:"role,omitempty"`
+	// username of the user that granted the role
+	CreatedBy string    `json:"created_by,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
@@ -0,0 +1,14 @@
+package vault
+
+const (
+	// FlagDryRun is a flag representing whether changes should only be reported and not applied
+	FlagDryRun = "dry-run"
+	// FlagTargetKind is a flag representing the kind of vault secrets are rotated to
+	FlagTargetKind = "target-vault-kind"
+	// FlagTargetAccessKeyFile is a flag representing the file containing the target vault access key
+	FlagTargetAccessKeyFile = "target-vault-access-key-file"
+	// FlagTargetSecretAccessKeyFile is a flag representing the file containing the target vault secret access key
+	FlagTargetSecretAccessKeyFile = "target-vault-secret-access-key-file"
+	// FlagTargetRegion is a flag representing the region of the target vault
+	FlagTargetRegion = "target-vault-region"
+)
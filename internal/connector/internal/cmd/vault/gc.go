@@ -0,0 +1,69 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/vault"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/flags"
+	"github.com/golang/glog"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+func NewGCCommand(env *environments.Env) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gc",
+		Short: "Delete orphaned connector secrets",
+		Long: `Delete vault secrets owned by connectors that no longer exist or no longer reference them. Like the connector
+secrets worker, secrets are only deleted once they have stayed orphaned for the vault-orphaned-secrets-grace-period.`,
+
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			err := env.CreateServices()
+			if err != nil {
+				glog.Fatalf("Unable to initialize environment: %s", err.Error())
+			}
+		},
+
+		Run: func(cmd *cobra.Command, args []string) {
+			dryRun := flags.MustGetBool(FlagDryRun, cmd.Flags())
+			env.MustInvoke(func(connectorSecretsService services.ConnectorSecretsService, vaultService vault.VaultService, vaultConfig *vault.Config) {
+				runGC(connectorSecretsService, vaultService, vaultConfig, dryRun)
+			})
+		},
+	}
+	cmd.Flags().Bool(FlagDryRun, false, "Only list the orphaned secrets, don't delete them")
+	return cmd
+}
+
+func runGC(connectorSecretsService services.ConnectorSecretsService, vaultService vault.VaultService, vaultConfig *vault.Config, dryRun bool) {
+	now := time.Now()
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Secret Key", "Owning Resource", "Result"})
+	failed := 0
+	err := connectorSecretsService.ForEachOrphanedSecret(func(key string, owner string, orphanedSince time.Time) bool {
+		result := "orphaned"
+		if now.Sub(orphanedSince) < vaultConfig.OrphanedSecretsGracePeriod {
+			result = fmt.Sprintf("orphaned since %s, within grace period", orphanedSince.Format(time.RFC3339))
+		} else if !dryRun {
+			if err := vaultService.DeleteSecretString(key); err != nil {
+				result = fmt.Sprintf("delete failed: %v", err)
+				failed++
+			} else {
+				result = "deleted"
+			}
+		}
+		table.Append([]string{key, owner, result})
+		return true
+	})
+	table.Render()
+	if err != nil {
+		glog.Fatalf("Unable to list orphaned connector secrets: %s", err.Error())
+	}
+	if failed > 0 {
+		glog.Fatalf("Unable to delete %d orphaned connector secrets", failed)
+	}
+}
@@ -0,0 +1,78 @@
+package vault
+
+import (
+	"fmt"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/vault"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/flags"
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+)
+
+func NewRotateCommand(env *environments.Env) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate",
+		Short: "Rotate connector secrets",
+		Long: `Re-key all connector secrets by copying them to new keys in the target vault and updating the connectors
+to reference the new keys. When no target vault kind is given, the secrets are re-keyed within the configured vault.
+When rotating to a different vault, the service must be reconfigured to use that vault once the rotation completes.
+The old secrets are not deleted, the connectors keep using them until they pick up the new references, they are
+deleted as orphaned secrets once the vault-orphaned-secrets-grace-period is over.`,
+
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			err := env.CreateServices()
+			if err != nil {
+				glog.Fatalf("Unable to initialize environment: %s", err.Error())
+			}
+		},
+
+		Run: func(cmd *cobra.Command, args []string) {
+			dryRun := flags.MustGetBool(FlagDryRun, cmd.Flags())
+			targetConfig := &vault.Config{
+				Kind:                flags.MustGetString(FlagTargetKind, cmd.Flags()),
+				AccessKeyFile:       flags.MustGetString(FlagTargetAccessKeyFile, cmd.Flags()),
+				SecretAccessKeyFile: flags.MustGetString(FlagTargetSecretAccessKeyFile, cmd.Flags()),
+				Region:              flags.MustGetString(FlagTargetRegion, cmd.Flags()),
+			}
+			env.MustInvoke(func(connectorSecretsService services.ConnectorSecretsService, vaultService vault.VaultService) {
+				runRotate(connectorSecretsService, vaultService, targetConfig, dryRun)
+			})
+		},
+	}
+	defaults := vault.NewConfig()
+	cmd.Flags().Bool(FlagDryRun, false, "Only count the secrets that would be rotated")
+	cmd.Flags().String(FlagTargetKind, "", "The kind of vault to rotate the secrets to: aws|tmp, defaults to the configured vault")
+	cmd.Flags().String(FlagTargetAccessKeyFile, defaults.AccessKeyFile, "File containing the target vault access key")
+	cmd.Flags().String(FlagTargetSecretAccessKeyFile, defaults.SecretAccessKeyFile, "File containing the target vault secret access key")
+	cmd.Flags().String(FlagTargetRegion, defaults.Region, "The region of the target vault")
+	return cmd
+}
+
+func runRotate(connectorSecretsService services.ConnectorSecretsService, vaultService vault.VaultService, targetConfig *vault.Config, dryRun bool) {
+	target := vaultService
+	if targetConfig.Kind != "" {
+		if err := targetConfig.ReadFiles(); err != nil {
+			glog.Fatalf("Unable to read target vault configuration: %s", err.Error())
+		}
+		var err error
+		target, err = vault.NewVaultService(targetConfig)
+		if err != nil {
+			glog.Fatalf("Unable to create target vault: %s", err.Error())
+		}
+	}
+
+	count, err := connectorSecretsService.RotateSecrets(target, dryRun)
+	if dryRun {
+		fmt.Printf("%d secrets would be rotated to the %s vault\n", count, target.Kind())
+	} else {
+		fmt.Printf("%d secrets rotated to the %s vault\n", count, target.Kind())
+	}
+	if err != nil {
+		glog.Fatalf("Unable to rotate connector secrets: %s", err.Error())
+	}
+	if !dryRun && targetConfig.Kind != "" {
+		fmt.Printf("the previous secrets are left in the %s vault, run `vault gc` against it after the grace period to delete them\n", vaultService.Kind())
+	}
+}
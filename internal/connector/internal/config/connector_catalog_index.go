@@ -0,0 +1,256 @@
+package config
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// ConnectorCatalogIndex is the document served at a remote connector catalog index URL
+type ConnectorCatalogIndex struct {
+	Entries []ConnectorCatalogIndexEntry `json:"entries"`
+}
+
+// ConnectorCatalogIndexEntry points to a single connector catalog entry, URLs may be relative to the index URL.
+type ConnectorCatalogIndexEntry struct {
+	URL string `json:"url"`
+	// Checksum of the catalog entry document in the form "sha256:<hex digest>"
+	Checksum string `json:"checksum"`
+}
+
+const (
+	ociScheme            = "oci://"
+	ociManifestMediaType = "application/vnd.oci.image.manifest.v1+json"
+)
+
+type ociDescriptor struct {
+	MediaType string `json:"mediaType"`
+	Digest    string `json:"digest"`
+}
+
+type ociManifest struct {
+	Layers []ociDescriptor `json:"layers"`
+}
+
+// connectorCatalogLoader fetches connector catalog entries from HTTP(S) catalog indexes or OCI artifacts
+type connectorCatalogLoader struct {
+	client *http.Client
+}
+
+// Load returns the catalog entries listed by an index URL. OCI references have the form
+// oci://registry/repository:tag or oci://registry/repository@sha256:digest, every layer of the
+// referenced artifact is a connector catalog entry.
+func (l *connectorCatalogLoader) Load(indexURL string) ([]ConnectorCatalogEntry, error) {
+	if strings.HasPrefix(indexURL, ociScheme) {
+		return l.loadOCIArtifact(strings.TrimPrefix(indexURL, ociScheme))
+	}
+	return l.loadIndex(indexURL)
+}
+
+func (l *connectorCatalogLoader) loadIndex(indexURL string) ([]ConnectorCatalogEntry, error) {
+	base, err := url.Parse(indexURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid connector catalog index url '%s': %v", indexURL, err)
+	}
+	buf, err := l.get(indexURL, "application/json", "")
+	if err != nil {
+		return nil, err
+	}
+	index := ConnectorCatalogIndex{}
+	if err := json.Unmarshal(buf, &index); err != nil {
+		return nil, fmt.Errorf("invalid connector catalog index '%s': %v", indexURL, err)
+	}
+
+	var result []ConnectorCatalogEntry
+	for _, ie := range index.Entries {
+		if ie.Checksum == "" {
+			return nil, fmt.Errorf("connector catalog entry '%s' in index '%s' has no checksum", ie.URL, indexURL)
+		}
+		ref, err := url.Parse(ie.URL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid connector catalog entry url '%s' in index '%s': %v", ie.URL, indexURL, err)
+		}
+		entryURL := base.ResolveReference(ref).String()
+		buf, err := l.get(entryURL, "application/json", "")
+		if err != nil {
+			return nil, err
+		}
+		entry, err := parseCatalogEntry(entryURL, buf, ie.Checksum)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, entry)
+	}
+	return result, nil
+}
+
+var ociReferenceRegexp = regexp.MustCompile(`^([^/]+)/([^:@]+)(?::([^@]+))?(?:@(sha256:[a-f0-9]{64}))?$`)
+
+func (l *connectorCatalogLoader) loadOCIArtifact(ref string) ([]ConnectorCatalogEntry, error) {
+	m := ociReferenceRegexp.FindStringSubmatch(ref)
+	if m == nil {
+		return nil, fmt.Errorf("invalid connector catalog OCI reference '%s'", ref)
+	}
+	registry, repository, tag, digest := m[1], m[2], m[3], m[4]
+	reference := digest
+	if reference == "" {
+		reference = tag
+	}
+	if reference == "" {
+		reference = "latest"
+	}
+
+	base := fmt.Sprintf("https://%s/v2/%s", registry, repository)
+	buf, err := l.get(fmt.Sprintf("%s/manifests/%s", base, reference), ociManifestMediaType, digest)
+	if err != nil {
+		return nil, err
+	}
+	manifest := ociManifest{}
+	if err := json.Unmarshal(buf, &manifest); err != nil {
+		return nil, fmt.Errorf("invalid connector catalog OCI manifest '%s': %v", ref, err)
+	}
+
+	var result []ConnectorCatalogEntry
+	for _, layer := range manifest.Layers {
+		blobURL := fmt.Sprintf("%s/blobs/%s", base, layer.Digest)
+		buf, err := l.get(blobURL, layer.MediaType, "")
+		if err != nil {
+			return nil, err
+		}
+		entry, err := parseCatalogEntry(blobURL, buf, layer.Digest)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, entry)
+	}
+	return result, nil
+}
+
+// get fetches a document, verifying it against the checksum when one is given. Anonymous bearer tokens are
+// requested when an OCI registry challenges the request.
+func (l *connectorCatalogLoader) get(u string, accept string, checksum string) ([]byte, error) {
+	resp, err := l.do(u, accept, "")
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		_ = resp.Body.Close()
+		token, err := l.anonymousToken(challenge)
+		if err != nil {
+			return nil, fmt.Errorf("failed to authenticate to '%s': %v", u, err)
+		}
+		resp, err = l.do(u, accept, token)
+		if err != nil {
+			return nil, err
+		}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch '%s': %s", u, resp.Status)
+	}
+	buf, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read '%s': %v", u, err)
+	}
+	if checksum != "" {
+		if err := verifyChecksum(buf, checksum); err != nil {
+			return nil, fmt.Errorf("failed to verify '%s': %v", u, err)
+		}
+	}
+	return buf, nil
+}
+
+func (l *connectorCatalogLoader) do(u string, accept string, token string) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := l.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch '%s': %v", u, err)
+	}
+	return resp, nil
+}
+
+var challengeParamRegexp = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+func (l *connectorCatalogLoader) anonymousToken(challenge string) (string, error) {
+	if !strings.HasPrefix(challenge, "Bearer ") {
+		return "", fmt.Errorf("unsupported authentication challenge '%s'", challenge)
+	}
+	params := map[string]string{}
+	for _, m := range challengeParamRegexp.FindAllStringSubmatch(challenge, -1) {
+		params[m[1]] = m[2]
+	}
+	realm, err := url.Parse(params["realm"])
+	if err != nil || params["realm"] == "" {
+		return "", fmt.Errorf("invalid authentication realm in challenge '%s'", challenge)
+	}
+	query := realm.Query()
+	for _, key := range []string{"service", "scope"} {
+		if params[key] != "" {
+			query.Set(key, params[key])
+		}
+	}
+	realm.RawQuery = query.Encode()
+
+	resp, err := l.do(realm.String(), "application/json", "")
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("token request failed: %s", resp.Status)
+	}
+	body := struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", err
+	}
+	if body.Token != "" {
+		return body.Token, nil
+	}
+	return body.AccessToken, nil
+}
+
+func parseCatalogEntry(source string, buf []byte, checksum string) (ConnectorCatalogEntry, error) {
+	entry := ConnectorCatalogEntry{}
+	if err := verifyChecksum(buf, checksum); err != nil {
+		return entry, fmt.Errorf("failed to verify connector catalog entry '%s': %v", source, err)
+	}
+	if err := json.Unmarshal(buf, &entry); err != nil {
+		return entry, fmt.Errorf("invalid connector catalog entry '%s': %v", source, err)
+	}
+	if entry.ConnectorType.Id == "" {
+		return entry, fmt.Errorf("connector catalog entry '%s' has no connector type id", source)
+	}
+	return entry, nil
+}
+
+func verifyChecksum(buf []byte, checksum string) error {
+	parts := strings.SplitN(checksum, ":", 2)
+	if len(parts) != 2 || parts[0] != "sha256" {
+		return fmt.Errorf("unsupported checksum '%s', expected sha256:<hex digest>", checksum)
+	}
+	sum := sha256.Sum256(buf)
+	if actual := hex.EncodeToString(sum[:]); actual != strings.ToLower(parts[1]) {
+		return fmt.Errorf("checksum mismatch, expected %s but was sha256:%s", checksum, actual)
+	}
+	return nil
+}
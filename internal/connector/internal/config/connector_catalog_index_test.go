@@ -0,0 +1,113 @@
+package config
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+const testCatalogEntry = `{"connector_type": {"id": "log_sink_0.1", "name": "Log Sink"}, "channels": {"stable": {"shard_metadata": {"connector_image": "quay.io/log-sink:0.1"}}}}`
+
+func checksum(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+func TestConnectorsConfig_RefreshCatalog(t *testing.T) {
+	tests := []struct {
+		name          string
+		entryChecksum string
+		wantErr       bool
+		wantEntries   int
+	}{
+		{
+			name:          "loads entries with a valid checksum",
+			entryChecksum: checksum(testCatalogEntry),
+			wantEntries:   1,
+		},
+		{
+			name:          "rejects entries with a checksum mismatch",
+			entryChecksum: checksum("something else"),
+			wantErr:       true,
+		},
+		{
+			name:    "rejects entries without a checksum",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			mux := http.NewServeMux()
+			mux.HandleFunc("/catalog/index.json", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = fmt.Fprintf(w, `{"entries": [{"url": "log_sink_0.1.json", "checksum": "%s"}]}`, tt.entryChecksum)
+			})
+			mux.HandleFunc("/catalog/log_sink_0.1.json", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(testCatalogEntry))
+			})
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			c := NewConnectorsConfig()
+			c.CatalogIndexURLs = []string{server.URL + "/catalog/index.json"}
+			changed, err := c.RefreshCatalog()
+			Expect(err != nil).To(Equal(tt.wantErr))
+			Expect(changed).To(Equal(tt.wantEntries > 0))
+			Expect(c.CatalogEntries).To(HaveLen(tt.wantEntries))
+			if tt.wantEntries > 0 {
+				Expect(c.CatalogEntries[0].ConnectorType.Id).To(Equal("log_sink_0.1"))
+
+				// refreshing an unchanged catalog reports no change
+				changed, err = c.RefreshCatalog()
+				Expect(err).To(BeNil())
+				Expect(changed).To(BeFalse())
+			}
+		})
+	}
+}
+
+func TestConnectorsConfig_ReadFiles_unreachableIndex(t *testing.T) {
+	RegisterTestingT(t)
+	available := false
+	mux := http.NewServeMux()
+	mux.HandleFunc("/catalog/index.json", func(w http.ResponseWriter, r *http.Request) {
+		if !available {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"entries": [{"url": "log_sink_0.1.json", "checksum": "%s"}]}`, checksum(testCatalogEntry))
+	})
+	mux.HandleFunc("/catalog/log_sink_0.1.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testCatalogEntry))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c := NewConnectorsConfig()
+	c.CatalogIndexURLs = []string{server.URL + "/catalog/index.json"}
+
+	// the service starts without the remote entries
+	Expect(c.ReadFiles()).To(BeNil())
+	Expect(c.CatalogEntries).To(BeEmpty())
+	Expect(c.IsCatalogComplete()).To(BeFalse())
+
+	// and picks them up once the index is reachable
+	available = true
+	changed, err := c.RefreshCatalog()
+	Expect(err).To(BeNil())
+	Expect(changed).To(BeTrue())
+	Expect(c.CatalogEntries).To(HaveLen(1))
+	Expect(c.IsCatalogComplete()).To(BeTrue())
+
+	// failing refreshes keep the last known entries
+	available = false
+	changed, err = c.RefreshCatalog()
+	Expect(err).ToNot(BeNil())
+	Expect(changed).To(BeFalse())
+	Expect(c.CatalogEntries).To(HaveLen(1))
+}
@@ -4,9 +4,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"path/filepath"
+	"reflect"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/public"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
@@ -16,8 +19,21 @@ import (
 )
 
 type ConnectorsConfig struct {
-	ConnectorCatalogDirs []string                `json:"connector_types"`
-	CatalogEntries       []ConnectorCatalogEntry `json:"connector_type_urls"`
+	ConnectorCatalogDirs   []string                `json:"connector_types"`
+	CatalogIndexURLs       []string                `json:"connector_catalog_indexes"`
+	CatalogRefreshInterval time.Duration           `json:"connector_catalog_refresh_interval"`
+	CatalogEntries         []ConnectorCatalogEntry `json:"connector_type_urls"`
+	RevisionHistoryLimit   int                     `json:"connector_revision_history_limit"`
+	LogsRetention          time.Duration           `json:"connector_logs_retention"`
+	LogsLimit              int                     `json:"connector_logs_limit"`
+	MetricsRetention       time.Duration           `json:"connector_metrics_retention"`
+	MetricsLimit           int                     `json:"connector_metrics_limit"`
+	TelemetryPruneInterval time.Duration           `json:"connector_telemetry_prune_interval"`
+
+	localCatalogEntries []ConnectorCatalogEntry
+	catalogLoader       *connectorCatalogLoader
+	// remoteCatalogLoaded is set once the entries of every remote catalog index have been loaded
+	remoteCatalogLoaded bool
 }
 
 var _ environments.ConfigModule = &ConnectorsConfig{}
@@ -33,11 +49,30 @@ type ConnectorCatalogEntry struct {
 }
 
 func NewConnectorsConfig() *ConnectorsConfig {
-	return &ConnectorsConfig{}
+	return &ConnectorsConfig{
+		CatalogRefreshInterval: 5 * time.Minute,
+		RevisionHistoryLimit:   10,
+		LogsRetention:          24 * time.Hour,
+		LogsLimit:              1000,
+		MetricsRetention:       24 * time.Hour,
+		MetricsLimit:           10000,
+		TelemetryPruneInterval: 10 * time.Minute,
+		catalogLoader: &connectorCatalogLoader{
+			client: &http.Client{Timeout: 30 * time.Second},
+		},
+	}
 }
 
 func (c *ConnectorsConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringArrayVar(&c.ConnectorCatalogDirs, "connector-catalog", c.ConnectorCatalogDirs, "Directory containing connector catalog entries")
+	fs.StringArrayVar(&c.CatalogIndexURLs, "connector-catalog-index", c.CatalogIndexURLs, "HTTP(S) URL of a connector catalog index, or oci:// reference of a connector catalog artifact")
+	fs.DurationVar(&c.CatalogRefreshInterval, "connector-catalog-refresh-interval", c.CatalogRefreshInterval, "How often remote connector catalog indexes are refreshed")
+	fs.IntVar(&c.RevisionHistoryLimit, "connector-revision-history-limit", c.RevisionHistoryLimit, "Number of previous revisions kept for each connector")
+	fs.DurationVar(&c.LogsRetention, "connector-logs-retention", c.LogsRetention, "How long the log lines reported by connector agents are kept")
+	fs.IntVar(&c.LogsLimit, "connector-logs-limit", c.LogsLimit, "Maximum number of log lines kept for each connector")
+	fs.DurationVar(&c.MetricsRetention, "connector-metrics-retention", c.MetricsRetention, "How long the metrics reported by connector agents are kept")
+	fs.IntVar(&c.MetricsLimit, "connector-metrics-limit", c.MetricsLimit, "Maximum number of metric samples kept for each connector")
+	fs.DurationVar(&c.TelemetryPruneInterval, "connector-telemetry-prune-interval", c.TelemetryPruneInterval, "How often the logs and metrics past their retention or of deleted connectors are pruned")
 }
 
 func (c *ConnectorsConfig) ReadFiles() error {
@@ -78,10 +113,105 @@ func (c *ConnectorsConfig) ReadFiles() error {
 			values = append(values, entry)
 		}
 	}
-	sort.Slice(values, func(i, j int) bool {
-		return values[i].ConnectorType.Id < values[j].ConnectorType.Id
-	})
-	glog.Infof("loaded %d connector types", len(values))
+	c.localCatalogEntries = values
+
+	if _, err := c.RefreshCatalog(); err != nil {
+		if len(c.CatalogIndexURLs) == 0 {
+			return err
+		}
+		// don't fail the startup because a remote index is unreachable, the connector types loaded from it
+		// before are still in the database, and the connector manager retries to load it periodically
+		glog.Warningf("starting with the local connector catalog only, failed to load the remote connector catalog: %v", err)
+		if err := validateDeprecations(values); err != nil {
+			return err
+		}
+		c.CatalogEntries = sortCatalogEntries(values)
+	}
+	glog.Infof("loaded %d connector types", len(c.CatalogEntries))
+	return nil
+}
+
+// IsCatalogComplete reports whether the catalog entries include the entries of every remote catalog index,
+// i.e. whether connector types that are not in the catalog entries were removed from the catalog
+func (c *ConnectorsConfig) IsCatalogComplete() bool {
+	return len(c.CatalogIndexURLs) == 0 || c.remoteCatalogLoaded
+}
+
+// RefreshCatalog reloads the entries of the remote connector catalog indexes and merges them with the
+// entries read from the local catalog directories. It reports whether the catalog entries changed.
+// On error the current catalog entries are kept.
+func (c *ConnectorsConfig) RefreshCatalog() (bool, error) {
+	typesLoaded := map[string]string{}
+	var values []ConnectorCatalogEntry
+	for _, entry := range c.localCatalogEntries {
+		typesLoaded[entry.ConnectorType.Id] = "local catalog"
+		values = append(values, entry)
+	}
+
+	for _, indexURL := range c.CatalogIndexURLs {
+		entries, err := c.catalogLoader.Load(indexURL)
+		if err != nil {
+			return false, err
+		}
+		for _, entry := range entries {
+			if prev, found := typesLoaded[entry.ConnectorType.Id]; found {
+				return false, fmt.Errorf("connector type '%s' defined in '%s' and '%s'", entry.ConnectorType.Id, indexURL, prev)
+			}
+			typesLoaded[entry.ConnectorType.Id] = indexURL
+			values = append(values, entry)
+		}
+	}
+
+	if err := validateDeprecations(values); err != nil {
+		return false, err
+	}
+
+	values = sortCatalogEntries(values)
+	c.remoteCatalogLoaded = true
+	if reflect.DeepEqual(values, c.CatalogEntries) {
+		return false, nil
+	}
 	c.CatalogEntries = values
+	return true, nil
+}
+
+func sortCatalogEntries(entries []ConnectorCatalogEntry) []ConnectorCatalogEntry {
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].ConnectorType.Id < entries[j].ConnectorType.Id
+	})
+	return entries
+}
+
+// validateDeprecations checks that deprecated channels and successors of deprecated connector types are defined in the catalog
+func validateDeprecations(entries []ConnectorCatalogEntry) error {
+	types := make(map[string]*public.ConnectorType, len(entries))
+	for i := range entries {
+		types[entries[i].ConnectorType.Id] = &entries[i].ConnectorType
+	}
+	for _, entry := range entries {
+		ct := entry.ConnectorType
+		for _, deprecated := range ct.DeprecatedChannels {
+			found := false
+			for _, channel := range ct.Channels {
+				found = found || channel == deprecated
+			}
+			if !found {
+				return fmt.Errorf("connector type '%s' deprecates unknown channel '%s'", ct.Id, deprecated)
+			}
+		}
+		if ct.SuccessorId == "" {
+			continue
+		}
+		if !ct.Deprecated {
+			return fmt.Errorf("connector type '%s' has successor '%s' but is not deprecated", ct.Id, ct.SuccessorId)
+		}
+		successor, found := types[ct.SuccessorId]
+		if !found {
+			return fmt.Errorf("successor '%s' of connector type '%s' is not in the connector catalog", ct.SuccessorId, ct.Id)
+		}
+		if successor.Deprecated {
+			return fmt.Errorf("successor '%s' of connector type '%s' is deprecated", ct.SuccessorId, ct.Id)
+		}
+	}
 	return nil
 }
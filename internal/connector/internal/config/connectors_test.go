@@ -0,0 +1,48 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/public"
+	. "github.com/onsi/gomega"
+)
+
+func TestValidateDeprecations(t *testing.T) {
+	successor := ConnectorCatalogEntry{ConnectorType: public.ConnectorType{Id: "log_sink_0.2", Channels: []public.Channel{"stable"}}}
+	tests := []struct {
+		name    string
+		entry   public.ConnectorType
+		wantErr bool
+	}{
+		{
+			name:  "deprecated type with successor",
+			entry: public.ConnectorType{Id: "log_sink_0.1", Deprecated: true, SuccessorId: "log_sink_0.2"},
+		},
+		{
+			name:  "deprecated channel",
+			entry: public.ConnectorType{Id: "log_sink_0.1", Channels: []public.Channel{"stable", "beta"}, DeprecatedChannels: []public.Channel{"beta"}},
+		},
+		{
+			name:    "unknown deprecated channel",
+			entry:   public.ConnectorType{Id: "log_sink_0.1", Channels: []public.Channel{"stable"}, DeprecatedChannels: []public.Channel{"beta"}},
+			wantErr: true,
+		},
+		{
+			name:    "successor of a type that is not deprecated",
+			entry:   public.ConnectorType{Id: "log_sink_0.1", SuccessorId: "log_sink_0.2"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown successor",
+			entry:   public.ConnectorType{Id: "log_sink_0.1", Deprecated: true, SuccessorId: "log_sink_0.3"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			err := validateDeprecations([]ConnectorCatalogEntry{{ConnectorType: tt.entry}, successor})
+			Expect(err != nil).To(Equal(tt.wantErr))
+		})
+	}
+}
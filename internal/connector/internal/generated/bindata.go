@@ -78,7 +78,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _connector_mgmtYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\xff\x73\xdb\x38\xae\xf8\xef\xf9\x2b\xf8\x71\x3f\x37\xb9\x7b\x2f\x76\x6c\xc7\xf9\xe6\x79\xbd\x99\x34\x49\xbb\xd9\x4d\xd3\x6e\x92\x6e\xb7\x77\x73\xe3\xd0\x12\x6c\xb3\x91\x48\x85\xa4\x92\xba\x77\xef\x7f\x7f\x43\x52\xb2\x28\x89\xb2\x65\x27\xfd\xb6\x6b\xcf\xdc\x6d\x23\x81\x20\x08\x02\x20\x00\x82\x14\x8b\x80\xe2\x88\xf4\xd1\x4e\xab\xdd\x6a\xa3\x67\x88\x02\xf8\x48\x4e\x88\x40\x58\xa0\x11\xe1\x42\xa2\x80\x50\x40\x92\x21\x1c\x04\xec\x01\x09\x16\x02\x3a\x3b\x39\x15\xea\xd1\x2d\x65\x0f\x06\x5a\x35\xa0\x28\x41\x87\x7c\xe6\xc5\x21\x50\xd9\xda\x78\x86\x8e\x82\x00\x01\xf5\x23\x46\xa8\x14\xc8\x87\x11\xa1\xe0\xa3\x09\x70\x40\x0f\x24\x08\xd0\x10\x90\x4f\x84\xc7\xee\x81\xe3\x61\x00\x68\x38\x55\x3d\xa1\x58\x00\x17\x2d\x74\x36\x42\x52\xc3\xaa\x0e\x12\xea\x18\xba\x05\x88\x0c\x25\x33\xcc\x1b\xcf\x50\x23\xe2\xe4\x1e\x4b\x68\x6c\x21\xec\xab\x51\x40\xa8\x80\xe5\x04\x50\xc3\x63\x94\x82\x27\x19\x1f\x84\xe3\x50\x36\x13\xc8\xd6\x14\x87\x41\x03\x8d\x48\x00\x1b\x84\x8e\x58\x7f\x03\x21\x49\x64\x00\x7d\x74\x9c\x36\x40\x57\xc0\xef\x89\x07\xe8\x65\x00\x20\xd1\x6b\x4c\xf1\x18\xf8\x06\x42\xf7\xc0\x05\x61\xb4\x8f\xda\xad\x4e\xab\xbd\x81\x90\x0f\xc2\xe3\x24\x92\xfa\xe1\x82\xf6\x66\x3c\x97\x20\x24\x3a\x7a\x7b\xa6\xc8\x0c\xf5\x0b\x34\x23\x54\xb4\x36\x04\x70\xd5\x89\xa2\xaa\x89\x62\x1e\xf4\xd1\x44\xca\x48\xf4\xb7\xb7\x71\x44\x5a\x8a\xd9\x62\x42\x46\xb2\xe5\xb1\x70\x03\xa1\x02\x01\xaf\x31\xa1\xe8\xaf\x11\x67\x7e\xec\xa9\x27\x7f\x43\x06\x9d\x1b\x99\x90\x78\x0c\x8b\x50\x5e\x49\x3c\x26\x74\xec\x44\xd4\xdf\xde\x0e\x98\x87\x83\x09\x13\xb2\x7f\xd0\x6e\xb7\xcb\xcd\x67\xef\xb3\x96\xdb\x65\x28\x2f\xe6\x1c\xa8\x44\x3e\x0b\x31\xa1\x1b\x12\x8f\x13\x06\x50\x1c\xe6\xe6\xe5\x7a\x1a\x81\x28\xb7\x6f\x34\x5c\xd0\xb5\x01\xd1\x71\x10\x0b\x09\x4b\x34\x48\xe6\xd7\x09\xbf\x11\x61\x39\xd1\xf4\x3f\x53\xff\x43\xce\x66\xcf\x36\x36\x10\x6a\xa8\x69\xd8\xce\x8b\xe9\xf6\x7d\xa7\xd1\xd7\x78\xc7\x20\xcd\x3f\x10\x4a\x19\x62\x7e\xcd\x0a\x42\x90\xd2\x45\x8e\x15\x21\x67\x7e\x5f\xb5\xff\xcd\x88\xeb\x6b\x90\xd8\xc7\x12\x27\x50\x22\x0e\x43\xcc\xa7\x7d\x74\x09\x32\xe6\x54\x68\x6d\x49\x24\x1b\x85\x79\xd8\xdc\xe0\xea\x34\xe0\x20\x22\x46\x05\x58\xf4\x36\xba\xed\x76\x23\xfb\x13\x29\x79\x97\x40\xa5\xfd\x08\x21\x1c\x45\x01\xf1\x34\xf5\xdb\x1f\x05\xa3\xf9\xb7\x08\x09\x6f\x02\x21\x2e\x3e\x45\xe8\xff\x73\x18\xf5\xd1\xe6\xb3\x6d\x8f\x85\x11\xa3\x40\xa5\xd8\x36\xb0\x62\xbb\x30\xfe\x4d\xab\x71\x6e\x60\xbf\x15\xc7\x32\x9b\xbc\xb2\xe8\xcd\x9b\xb9\xed\x5b\x3c\xba\xc5\x83\xec\xb9\x54\x8d\xb6\xff\x9d\x7f\x30\x20\xfe\xff\x26\xfc\x88\x30\xc7\x21\xc8\x44\xe1\xcd\xe4\x1a\x59\x2b\x35\xd9\x70\x52\x7e\x3d\x01\x44\x7c\xc4\xb4\xc9\xcc\x1a\x21\xd5\x68\xa3\x9a\x75\xea\x75\x1f\x09\xc9\x09\x1d\xcf\x1e\x13\xda\x47\x4a\x76\x67\x0f\x38\xdc\xc5\x84\x83\xdf\x47\x92\xc7\x50\x5f\x28\x33\x2d\x45\x48\x80\x17\x73\x22\xa7\x36\xe4\x0b\xc0\x1c\x78\x1f\xfd\x13\xfd\xab\x42\x70\x67\xb8\x14\xaa\x17\xd3\xb3\x93\xa2\xe8\xbe\x02\x89\x70\x61\xbc\x6a\x19\x99\xf1\x29\x2f\xb8\x0b\xc1\xbf\x91\xd8\x36\x9c\x62\x9b\x1b\x7d\xa3\xd0\x14\x3e\xe1\x30\x0a\x6c\x42\xd3\x5f\xae\xd9\xa9\x01\x2b\x43\xb9\xbb\x4e\xb1\x6e\xbb\x90\x34\xaa\xf4\xe6\xba\x24\x73\x28\xc4\xd2\x9b\xa8\x05\x43\xc9\xa3\x12\x20\xd0\xb6\x3f\x61\x69\xaf\xdd\xf9\x36\x2c\x3d\xe5\x9c\xf1\xfa\xac\xec\xb5\x3b\xab\x32\x30\x6b\x5a\xc9\xb6\xa3\x58\x4e\x90\x64\xb7\x40\x95\x4b\x40\xe8\x3d\x0e\x2c\xfd\x6e\xf4\xda\xbd\x1f\x84\x49\xbd\xd5\x99\xd4\x5b\xc4\xa4\x0b\x96\xc9\x52\x41\xc6\xe0\x13\x11\x52\x64\x0c\xdb\xfd\x56\x8a\xba\x24\xc3\x76\xdb\xed\x55\x19\x96\x35\xad\x64\xd8\x3b\x0a\x9f\x22\xf0\x24\xf8\x08\x14\x5d\x88\x79\xda\xaf\xf2\x97\x5e\xb0\x96\x71\x40\x9e\xd8\xd6\x8b\x2a\x1f\x05\xa3\x80\x08\xa9\x16\xba\xbc\x30\x08\x97\xbd\xaf\xdb\xa8\xbc\xfc\x2a\x92\x5d\x13\x91\x41\x6e\x47\x78\x6c\x4d\xc2\x42\x70\x41\x3e\x2f\x03\xce\xb8\x0f\xfc\xc5\x74\x99\x0e\x00\x73\x6f\xd2\xf8\xee\x17\xb2\x73\x22\x64\xb5\x49\x5c\x30\x53\xeb\xb5\xa3\xde\xda\xb1\x36\x85\x0b\x4d\x61\xc1\xb1\x5f\xd2\xa5\x4f\x8d\x63\xa4\x62\xde\x45\xd6\xf1\x11\x86\xd1\xe3\x80\x25\xd8\x54\x22\xdb\x2c\x1e\xeb\xd7\x3a\x3d\xf2\x90\xa9\x8c\xcb\x16\xce\x85\x74\x1b\x40\x15\x08\xdc\xc5\xc0\xa7\x16\x7f\x4d\x54\x82\xc5\x94\x7a\x55\x5c\x7f\x0b\x7c\xc4\x78\xa8\x3d\x3f\xac\xf3\x0f\x88\x50\x84\xa9\x69\x35\xe1\x8c\xb2\x58\xa0\x10\x53\x0a\x7c\x63\xbe\xb4\x99\xf8\x64\xc8\x58\x00\x98\x5a\x6f\x1c\x11\x09\x4a\xbd\xcc\x17\xcc\xb7\x18\x5c\x91\x98\xb1\x22\x55\xa7\x72\xcc\x57\x0d\xb7\x62\xd4\xb2\x80\x97\x86\xc8\xbc\x86\x54\xe9\xc7\xac\x95\x99\xbc\x4a\x4d\xa9\xe7\xc9\xe7\x90\x34\xe6\x45\x77\x55\xcb\x47\xf7\x1b\x2f\x1f\xd5\xd6\xd0\xf3\x20\x92\x90\x73\x9e\x7f\x0c\x03\xd8\x6b\xb7\xf5\xbc\x10\x46\x57\x5f\x2d\x8a\x28\x2a\xf9\xf4\x9b\x5a\x25\x34\xa4\x31\x88\x22\xb3\x88\x16\xe7\xd6\xeb\xeb\x3a\x36\xab\x15\x9b\x5d\x67\xb1\x3d\xf8\xca\x66\xb0\x98\x7b\x80\x7c\x06\x82\x6e\x4a\x13\x9f\xad\x7d\x92\x82\x60\x51\x14\x57\xb9\x25\x66\xb5\x4f\xb3\x26\xf9\x45\xba\x4e\x14\xf6\x08\x3f\x43\xb9\xdd\x65\x3c\x7f\xb0\xe8\xeb\xbb\x8e\x8d\x96\x8d\x8b\xd6\x21\xd1\x3a\x24\xfa\x36\xd9\x21\xb1\xfd\xef\xf9\x5b\x17\x0b\x94\x91\xf8\x8d\xaf\x61\xd2\xec\x9c\x52\xd1\xa0\x15\x36\x02\x5c\xe6\xcb\x0d\xf2\x7d\xda\x8e\x9a\x99\xf9\x75\x52\x7e\xed\xf8\xd5\x61\xd2\x3a\x29\xbf\x14\xc3\x1e\x65\x76\x0d\x68\x00\x12\xbe\xa4\x2d\x34\x3d\x54\x9a\xc3\x13\xfd\x7a\x91\x45\xac\x84\x72\x1b\xc5\xef\x45\x51\x1c\x63\x58\x87\xbb\x7f\x58\xab\x67\x26\xf8\x11\xb6\x2f\x87\x60\x9e\x05\xd4\x5e\x51\xba\x8c\xa2\x07\x22\x27\x48\x44\xe0\x91\x11\x01\x1f\x9d\x9d\xfc\xc8\x96\xf0\x71\x4c\x2c\x22\x58\xd1\x2a\x46\x6a\x85\xf9\x92\x46\x51\x77\x50\x69\x13\xdf\xaa\xb7\x8b\x4c\x62\x15\xd0\xe2\x5c\xf4\x09\x96\x18\x49\x66\x88\x28\x54\xed\x28\x59\xda\x98\x23\x26\xb6\x90\x84\xc0\xc7\xd0\xd4\x58\xfe\xbb\x6e\xa6\xda\xa4\xd5\xd9\xf0\x23\x78\xb2\x02\xad\x42\xb5\x24\xd6\x42\xc0\xfa\xf3\xd5\x9b\x0b\xc3\x9f\x2d\x74\xf9\xf2\x18\xed\x1d\xb6\xbb\xa8\x39\xab\x3c\x94\x8c\x05\xa2\x45\x40\x8e\x5a\x8c\x8f\xb7\x27\x32\x0c\xb6\xf9\xc8\x53\x50\xab\x51\xfb\x25\x52\xf4\xb3\xe6\x7f\x84\x24\xf9\x3a\x16\xf8\xf3\xae\x8a\xeb\x58\xe0\x47\x88\x05\x1c\xe5\xa6\x49\x49\xf2\xb2\x05\xa7\x5e\x52\xc9\xbc\xcc\x2e\x75\xbe\xfc\x79\xfe\x3e\x74\x46\x16\xaa\xbd\xf6\x2e\xd8\xb4\x46\x5e\x0e\x67\x8d\xcd\xeb\x42\x8b\x3f\xdd\x26\x76\x32\xfc\x6f\xb7\x99\x9d\x48\xc1\x8a\x7b\xda\xa6\xf1\xd3\x6c\x6d\x3b\x70\xfd\x90\x3b\xdc\xc9\x40\xd6\x1b\xdd\xeb\x8d\x6e\x8b\x73\x6b\x1f\xa7\x06\x93\xd6\x1b\xdd\xdf\x97\x9b\xb3\xc2\x46\x77\x6e\x41\xaf\x55\x76\x5c\x70\x59\x1e\xbb\xf1\x5d\x44\x57\x67\xff\xdb\xcb\xb7\xa9\xbd\x05\x5e\x68\xf7\xb5\x6b\x90\xbf\xcf\x8d\xac\x64\x02\x96\xae\x11\x2e\x30\x73\x6d\xdd\xd7\x7b\xe2\x5f\xf9\xc4\x44\x2a\x81\xf6\x29\xbf\xe4\xd9\x92\x07\xfd\xb2\x56\xee\x00\xa0\xea\xac\x5f\x3e\x1a\xfa\xfa\xc7\xfd\x1e\x6f\x8b\xed\x1d\xfb\x42\x84\x59\x75\xe0\x6f\x4e\xd0\x38\x1f\xf4\xbb\xb6\x7f\x35\x73\x78\x69\x00\xb8\xce\xe5\xad\xfd\xdc\x3a\x4c\x5a\x31\x97\x97\x8a\xd9\x3a\xa7\xb7\xea\x4e\x56\xfc\x55\xcc\x67\x1c\xf9\x8e\x1c\xdd\x8b\xe9\x99\x5f\xb4\xa2\xb1\x1f\xe1\xfc\x4e\xfe\x3c\x43\xba\x10\xba\xfe\x6e\x97\x21\xd1\x5f\x71\xaf\xeb\xab\x24\xaf\x96\xc8\x16\xe5\x4d\x46\x3e\x4b\x97\xe8\x8c\x90\x58\xc6\xfa\x8e\x94\x64\xe8\x6b\xbb\xbc\xb6\xcb\x4f\x6c\x97\xd7\x26\xd9\xc5\xb3\x27\x29\xb9\x7a\x02\xab\x5c\x28\xbd\xaa\xf0\x6b\xcb\xb5\x55\xf3\x2c\xf2\x42\xe8\x75\x45\xd6\xda\x2e\x7e\x27\x4c\xfa\x8a\x15\x59\xb3\xc4\xec\xba\x18\xeb\x29\x8b\xb1\x9e\x2e\x0b\xb2\x8d\x7d\x9f\xd1\x41\x96\x05\x59\xa7\x45\x56\x4b\x8b\x1c\x29\x3e\xbe\x9d\x71\xad\xb8\x9a\x54\xa4\x3e\x36\x05\xd2\x13\x60\xf1\xdb\xb5\xba\x2c\xdd\xfa\xbb\xca\xa5\xe4\x59\x33\x37\x93\xac\x44\x26\x1b\x0c\x92\x13\x2c\x91\x98\xb0\x38\xf0\xd1\x10\x50\x2c\xcc\x8d\x83\x1e\xa3\x23\x32\x8e\x39\x68\xc1\x32\x77\xf5\xd9\x11\x8c\x61\x0a\xa3\x46\xee\x0c\xaf\x5a\xeb\xe5\xec\x8f\xba\x9c\xad\xd3\x2f\x3f\x92\xaf\xbf\x91\x61\x54\x1d\x27\xd4\xf7\xcd\x51\xd0\x67\xe6\xff\xd1\x31\x0b\x43\x46\x93\x47\xfa\x3f\xca\x6c\xf4\x67\xd6\x2d\x31\xfc\x96\xc5\xbe\x25\xd4\xb7\xfe\x8c\xf0\x18\xac\x3f\x05\xf9\x6c\xff\x29\x99\xc4\x81\xf5\x37\x91\x10\xa6\x53\xe8\x28\x6e\x8d\xb8\xb2\xfe\x92\xd8\x6c\x54\xfd\x2d\x5c\xb2\x14\x15\x65\x20\x42\x25\x8c\xed\xf5\x8f\x7c\xae\x01\xa5\x69\xae\x06\xd3\x2f\xb4\x08\xa4\x30\x38\x08\xde\x8c\x6c\x16\xcd\x13\x9e\x37\x7a\xbc\x97\x30\x02\x0e\xd4\xcb\x6d\x61\x56\x54\xfb\xba\x98\x82\xb4\xbc\xfb\x25\x89\x72\x32\x07\xe9\x99\xc4\x0e\xe9\xaf\x04\x9f\x2d\xc2\x03\xe2\xcf\x6d\xa4\xdf\x15\xc6\xd4\x5f\x6e\x82\xc9\xe2\xe9\xad\x25\x03\x13\xc5\xf5\x2a\x20\x8b\xce\xd7\x20\xf1\x92\x24\xb2\x07\x0a\x7c\x21\x01\xa6\x50\xd0\x1f\xe0\x9c\x0d\x1a\x31\x1e\x62\xd9\x47\x3e\x96\xd0\x94\x24\x84\x45\x68\x42\xe6\x6b\xdf\x7d\x55\x3c\xfa\x79\x72\x2b\x6a\xe2\x3c\x11\x46\xaf\x40\x4a\x42\x33\x4f\xcd\xa5\xda\xc4\x56\xec\x98\x07\x8f\x9b\xb4\x98\x3b\xb4\xc8\x41\xe3\x91\xe7\xb1\x98\xce\xb5\x39\x5e\x40\x80\xca\x41\x8e\xbe\xe4\x99\x00\x8f\xc3\xbc\xb9\x9b\xb5\x5d\x3c\x7f\x36\xc6\xf9\xa4\x9f\x40\x14\xb0\x69\x08\x54\x9e\x33\xb3\xba\xa4\xf0\x3e\x51\xc6\x39\x24\x14\x4b\x66\x89\x4c\x42\xd9\xf4\x42\xbb\xf6\x39\x1b\x1a\xe2\x28\x22\x74\x6c\x77\x58\xf4\x79\xeb\x66\x74\xaf\x31\x1f\xc3\xcc\xe9\x63\x14\xea\xdb\xa5\x2a\x54\x1b\x2e\x7a\xcc\xcb\xbe\xcb\x83\x6e\x98\x77\x02\x3d\x30\x7e\x1b\x30\xec\xeb\x2b\xb3\x31\x4d\x7c\x45\x2f\xbf\xcb\xe7\xd0\xbf\x05\x6b\xce\xca\x4b\x44\x16\x44\xcd\x9f\xda\xc2\x8d\xb9\x5f\xc9\xc8\x83\xcb\x43\xd0\xe3\x42\x8d\xa3\xb7\x67\x09\x51\x79\xa7\x83\xa8\x97\xf7\x9d\xfc\xc3\x89\x21\xab\xe2\x5e\xe5\xc2\x02\x12\x04\xc6\x38\x94\xbc\x96\xa6\x41\xae\x63\x5c\x51\x74\x75\x16\x74\x52\xbe\x2f\xac\xd4\x3e\x19\x58\xe5\x05\x10\xd5\x4b\x5e\x25\xc5\x86\xaf\x98\x73\x3c\x2d\xbc\xd1\x3e\x47\xd9\xf5\x2a\x4c\xa8\x3d\xf6\xa5\xa6\x36\xe7\x4e\x25\x26\x4d\xd8\x0e\xd5\x2f\x8a\x1d\xd5\x86\x38\xa7\x3d\x3f\xb1\xc0\x17\x69\x14\xaf\x43\x2f\x53\xc9\x69\x62\x31\x85\x41\x6b\x93\xc1\x89\xce\xa8\x90\x98\x7a\xd0\x5a\x45\x46\x2b\x57\x88\x6c\x22\x9e\x25\x07\xfd\x92\x74\x92\x67\xcd\x4b\x06\x53\x21\xd2\xcf\xf2\xb3\x68\x0c\xbe\xee\xfa\x12\xc6\x44\x48\x3e\x7d\x62\x96\x68\xe4\x28\x45\xfe\x15\x78\x63\x80\x11\x4f\x7b\x7c\x2a\x2e\xa5\xb2\xa4\xa3\xf9\x9c\x24\xe5\xe3\x7b\xb7\xf9\x3d\x2a\x66\x2a\xe6\x98\xda\x15\x17\xf6\x7b\x1c\xc4\x0e\x3f\xda\x36\xa2\xe5\x4c\x44\x15\xb5\x69\x41\x5b\x31\xbf\x92\x27\xdb\xd6\xeb\x82\x3e\xd7\x4f\x88\x34\x8a\xa1\x4f\xf9\xa4\xc9\x8c\xd5\xc5\x15\xef\x4a\x62\x59\x70\x6c\x73\x5c\x01\x1a\x87\xb6\x74\x29\x37\xc0\xa0\x00\xdb\x69\xe1\x80\xfd\xa9\xbb\x87\x64\x37\xd6\xf6\x4e\x5d\xf3\xa3\x73\x83\x73\x79\x5f\x81\xd8\x3d\x01\x46\x25\x95\x73\x69\x57\xd0\x64\xfb\xd5\x08\xeb\x33\x16\x28\x0a\x30\x05\x2b\x1d\x66\x36\x77\x1b\xab\x28\xd7\x9c\x81\x57\xb8\x1b\x36\x4f\x56\x58\x87\x0d\xe6\x2f\x45\xdc\x95\xe6\xc4\xbc\x29\x13\x39\x88\x0a\x5d\xac\x6a\x9c\x22\x28\x85\x7a\xcb\x8c\x42\x4b\x6f\xe1\x90\x8a\x1d\xc1\xd6\x17\xa6\xa7\x76\x87\x96\x19\xc5\x63\xe6\xf1\x2a\x91\x57\xe7\xa0\x6c\xfb\xb4\xd4\xc0\xf2\x7e\xcb\xd2\x11\xbc\xd3\x33\x59\xda\x91\x59\xae\xba\xce\x6d\x02\xad\xa7\xc7\x13\x4c\x29\x04\x73\x6c\x9d\x0f\x23\x1c\x07\x52\x3d\xc5\xc3\x00\x2a\x2c\x60\xf2\x32\xcf\xf0\x13\x10\xca\xb7\x5f\xd6\x9a\x1a\xb3\x69\xe3\x66\x51\x94\x33\xac\x7e\xb2\x95\x9a\xef\x6e\xd9\x7e\xb0\x10\x64\x4c\xed\xb5\x2e\x7d\x96\xeb\x4c\x9b\xc6\x3c\xd4\x62\x0a\x47\x98\x04\x65\x92\xf3\x58\xfc\xc2\x86\x70\x53\x89\xce\x3d\x51\xae\x7f\x11\x30\xf7\xa2\x20\xd5\xb6\x9f\x34\x37\x95\xa7\xbc\x3b\x9b\x68\xe3\xf6\x0c\xb0\x89\xc8\xad\x37\xa5\x5b\x73\x5d\x51\x98\xc2\x66\x8b\xe7\x3c\xc1\xac\x70\x8a\x33\x65\x2a\xd0\x52\xc6\xeb\xfe\xde\x49\x3e\xa7\x90\x7d\xee\xc4\xbc\x1f\xa4\xce\x5a\x5d\x32\x17\x79\xac\x19\xbd\x33\x0e\xd9\xa8\x9f\x59\xb9\xec\x79\xee\xa1\x82\xd4\xcb\xac\x98\xe0\x08\x72\x8f\x23\xce\x3c\x10\xc2\xbe\xf4\x4e\x3d\x36\xb9\xde\x09\xa6\x7e\x90\xcf\xdd\xe5\x4c\x50\x5e\x2e\x1c\x1e\x86\x4b\x2a\x94\x87\xe1\x9a\xfa\xd2\x67\x58\xb4\x18\x26\x69\x90\x41\x90\xe4\x41\x72\x6f\xb5\xb2\x0f\xf4\xf2\xb5\xaa\x4b\x53\xe2\x6f\x4a\xc6\xe2\x16\x79\x43\xb6\x68\xaa\x13\xbb\x97\xcd\xa8\x63\x70\x75\x71\x95\xd3\x43\x36\x5a\x8b\x2b\xb5\x89\x73\x19\xd0\xe2\x6a\x56\x70\xf4\x56\x73\xca\x72\x0e\xcf\x92\x6d\x73\x86\xa7\x48\xdd\xb7\x70\xe2\x2a\x06\xb3\xe4\x32\x9d\xd6\x56\x0c\xd2\x6f\x9f\x39\x57\xec\xe2\x36\x82\xf9\xa5\x59\x5b\x42\xe5\x5e\xcf\xb1\x3a\x7d\xb7\x9e\xe3\x13\xb8\x8c\xdf\xc4\x57\x7c\x0a\xc1\x5d\xb2\xb5\xdb\xb7\xfc\x13\x38\x95\x0d\xb7\x33\x69\x7d\x11\xa5\x18\x4d\xab\x37\xce\x40\xf4\xef\xcd\x19\x09\x97\x10\x71\x10\xaa\xc7\xf2\x07\xab\x44\x1c\x45\x8c\x4b\xf0\xd1\x70\xaa\x03\xd6\xa3\xb7\x67\x49\xc3\x52\xb6\xbb\xbc\xb6\xa1\xf2\xfa\x66\x1e\x25\x8a\x5d\x78\x6a\xc6\xfb\x94\x18\x3f\x0a\x46\x07\x39\xb4\xdf\x68\xef\xb0\xb8\xe4\x96\xe6\xe3\x02\x87\xe0\xfe\xa6\x5a\x6b\x9e\x01\xb0\x5f\x54\x58\x4b\xe7\x67\xe7\x1e\xd7\x53\xb2\xd2\x97\xc4\x38\x5f\x96\x9e\x00\x2d\xd3\xd7\x53\x29\x4c\xd1\xb5\x28\x12\x37\x8f\xee\x23\xfb\xcf\x12\xf1\xb5\x79\x44\x3c\x46\x07\xc5\x2d\xd2\x52\x67\xef\x2e\xcf\x93\xed\x1a\x05\xbf\x7a\x6f\x01\x1e\x2e\x9a\x8f\x73\x0d\x92\x95\x1a\x61\x09\x63\xc6\xc9\x67\x70\xdc\x00\xfe\x88\x79\xa9\x16\x1a\x1c\xe1\x21\x09\x48\x59\x39\x5c\x07\xcf\x2c\xe0\xb2\x11\xf2\xd4\x7c\x7f\x51\x62\x6b\x5c\x3b\x66\x59\xd0\xf4\x77\xa4\x0d\x4e\x9a\xa8\xd6\x35\x5e\x1e\xa6\x76\x81\xd7\xbd\xb9\x2c\x02\x10\x2e\xb9\x91\x25\x6c\x99\xc2\x8c\x08\x04\xbe\x5b\x16\x4a\x16\x08\xd9\x46\xef\xc7\x19\x40\x79\xd9\xfa\x13\xac\xe7\xe6\x63\x8b\x1b\xe5\xa2\xd4\x2c\xda\x32\xa5\xa9\xee\x6f\x4c\x2a\x45\x39\x3b\x51\x46\x83\x83\xc7\xf8\xec\xe8\x55\x61\xea\x1d\x42\x5e\xa8\x38\x75\xd4\x9b\xda\x05\x3e\x86\x06\xab\xf0\xa8\x78\xd1\x51\xe1\x9e\xc2\x31\x20\x42\x7d\xf8\x54\xc2\x3e\xc2\x81\x80\xfa\x54\x96\x4b\xbc\x8a\x65\x47\x66\x67\x04\x35\x92\x8d\x56\xbb\xde\xc8\x10\x6d\x95\x47\xcd\x25\xfa\x22\x0e\x87\xc0\x15\x2b\xf5\x7c\x22\x42\x11\x60\x6f\x62\x0f\xfa\x09\x87\x51\xac\x8b\x9a\x0d\xa3\xdd\x36\x03\x49\x3e\xc9\xe6\xf4\xdc\xfe\x93\xa9\xed\x55\x52\x76\x6e\xb6\xeb\x74\x23\x65\x22\x3d\x4e\x24\x70\x82\x5b\x5a\x42\xc4\x94\x4a\xfc\xc9\x2c\x2d\x44\x64\xa2\x86\x88\xb0\x08\x0a\x49\x80\x79\xfa\x1d\x6b\xbb\x09\xa0\x9b\x14\xf1\x0d\xf2\x02\x1c\x0b\xed\xa7\x60\x8a\xae\x7e\x3d\x37\xf1\x8e\xf9\x06\x77\x8a\xeb\x54\xf1\x4d\x33\x3a\xb5\x1d\xba\xbd\xb1\xde\x98\x4e\x67\x68\x73\x6a\x70\x63\x6c\x84\xc8\xf0\xbc\x64\x3c\x65\xdd\x96\x22\x8c\xeb\x0b\x2e\xf4\x57\xbb\x8f\x73\xae\x84\xb0\x3b\x90\x13\x20\x5c\x4f\xfe\x96\xb2\x59\xba\xa7\x11\x0b\x02\xf6\xa0\x3f\x29\xad\x07\xd6\xdf\x98\x75\x72\x73\x73\x23\xee\xb2\x82\x39\xd5\x0e\x61\xe1\xd9\xef\x33\xe0\xeb\xe5\x89\x40\x03\x4c\xfd\x41\xea\x9a\x3d\x86\xa4\xad\xd9\xe7\x8f\x2b\xe9\x33\x5f\x31\xcf\xcd\x30\xdd\x94\x26\xa5\xe9\x83\xbf\x85\x18\x47\xc4\xc0\x68\x89\x43\x44\x20\x08\x23\x39\xdd\x52\xcf\x32\xdf\xd9\x6c\x4c\x89\x38\x50\x11\x01\xcf\xcd\x9f\xa2\xa6\x35\x93\xeb\x28\x60\x3e\xe4\x0e\x2f\x96\x65\xbd\x20\xca\xb6\xb8\xa7\x43\x6b\x54\x68\xa8\x51\xe1\x04\xc1\x63\xb5\x50\xc8\x69\x00\x7d\x9d\x1f\x30\xb6\x42\x7f\xc3\xd0\xad\x61\x99\x82\x69\xa0\x4c\xa1\x2c\x59\x98\xaf\x59\x0b\x34\xea\x61\x02\x1c\x72\xea\x94\x75\x99\xd3\x2a\x74\xa4\xe4\x04\xfc\x44\x3b\x94\x5d\xd2\xe8\x0c\x5d\x6a\x72\x6e\x14\x97\x6e\xb6\xd0\x8d\x35\x04\xf5\x67\x22\x2d\xea\x9f\xda\x39\xbc\xd9\x42\x98\xfa\xe8\x26\xf1\xdd\x6f\x32\x45\x4b\xbb\x30\x35\x88\x8c\x9b\x49\xbf\xf9\x9f\xbf\xab\xb6\xcf\x6f\xb4\xd8\xdc\x9c\x9f\xfd\x72\xea\x68\xe3\x31\xfa\x31\xa6\x9e\x24\xf7\x50\x6c\x7f\x74\x71\x72\x63\xba\x7c\x73\x79\xd3\x42\x3f\xb1\x07\xb8\x07\xbe\x85\xa6\x2c\xd6\x86\x41\x8d\x1c\xa3\x10\x7f\x22\x61\x1c\x2a\x1e\x74\xda\x19\x3a\x46\xf5\x58\x71\x3a\x52\x2d\x16\x16\xfb\x4f\x67\x72\xe6\xd2\xce\x42\x68\x6c\xce\xe8\x28\xbe\x69\x89\xbb\xc1\x0f\xa2\x29\xee\x44\xd3\x64\x99\x0c\x91\xda\xad\x34\xac\x41\x37\x66\x2b\xe5\xa6\xae\xba\xe6\x75\xf5\x39\xca\xe3\xd7\xe8\x53\xd4\xcf\xf3\x7b\x38\xba\xf9\x3f\xa3\xe6\xbf\xdc\xc3\x30\x55\x27\x24\xa9\xac\x30\xc3\xc0\xa6\x17\x73\x80\x40\x62\x2e\x85\x79\xae\x46\xb5\x22\xc5\x01\xb9\x05\x45\xf4\x5f\xba\xbb\x5f\xc4\xb0\x68\x73\xa9\x5e\xe6\xa7\xc5\xb2\x37\x58\xea\xf7\xb1\x00\x8e\x26\x58\xa0\x08\x78\x48\x84\x48\xca\x4e\x04\x80\x16\x29\xc3\x17\xf0\x2d\x39\xb8\x60\x12\x5a\x29\x7d\x66\xd1\xc9\x6a\xfe\x95\xc4\x27\x89\x7b\x22\xac\xd6\xd5\xe6\x2b\x71\x1a\xb4\xcc\x55\x18\x25\xb7\x01\x72\xac\xf1\x39\xfb\x82\x8a\x66\xaf\x96\x94\x34\x56\x33\x6f\x1b\xd9\xd1\x1f\xbd\x9f\x92\x92\x95\x9c\xfd\xb1\x91\x42\x1f\x0d\xf5\xd3\xe4\xa1\xf9\xe3\x65\x92\x44\xfd\xf9\xfd\xf5\x86\xdd\xe3\x44\xca\x48\x61\xcf\x8f\xb6\xd6\x35\x8b\x85\x1a\x16\xc3\xe8\xc6\xeb\x69\xee\x16\x92\x79\x9f\x13\x2f\x20\x20\x7e\x1f\x05\x6c\x3c\x10\x84\xde\x0e\xda\xad\xce\xec\x85\x29\x75\xcb\x61\x9a\xbd\x5b\xaa\x8c\x2e\xf9\x92\xbe\xdd\x49\xa3\x40\xff\x39\x1b\xa3\x2b\x42\x6f\x67\x8f\xd3\x14\x0c\x6a\xe4\xa0\x5d\xf9\x92\x66\xd1\x12\xe4\x83\xf5\x22\xe6\x2c\x9d\xb0\x22\xfd\xad\x88\x8e\x33\x8a\xca\xf9\x82\x26\x12\x76\x7f\x55\xd1\x7a\x53\xef\x9b\x0d\x8a\xfb\x66\x4d\xd7\xbe\x59\x39\x06\xad\xae\x33\x0c\xc3\x72\x5a\x26\x53\xb5\x7f\xfe\xab\x18\x8f\x11\x19\x98\x09\xa8\x1b\x15\x57\x77\xae\x7e\x61\x1c\x48\x32\x08\x08\x75\x9e\x19\x99\x6d\xc0\xdb\x2a\x9f\x07\xb0\xe6\xee\xb5\xc2\x85\xce\x09\x75\x41\x26\x84\xcf\x87\xa9\xb8\xc5\x35\xfd\x7d\x6a\x8e\x39\x8b\xa3\x3e\x6a\x00\xf5\x23\x46\xa8\x2c\x57\x7c\x8a\x09\x7b\x18\xe0\x20\x78\xfc\x70\xae\x26\xec\x41\xad\xf7\xd5\x83\x99\x07\xf1\xc8\xa1\x48\x16\x11\x6f\x41\x9e\x91\x85\xa1\xf2\x13\xd4\xea\x24\xc1\x9f\x15\xb8\x99\xc5\x53\x23\xd0\xea\x2a\xdc\x22\x74\x5d\x0d\x50\x95\x1c\xb2\xc9\xd6\x4a\x97\xa7\x59\x48\x88\x1e\x9f\x40\x28\xa4\xd7\xb3\x5f\x73\xae\x20\x27\x38\xa9\x00\x2e\x07\xda\x69\xac\x82\xa9\x0e\x2b\xcb\xbf\x23\xdf\xd7\x9b\x03\xb1\x90\x2c\x34\xbe\x68\xea\x8d\x78\x4c\xbb\x27\x32\x59\xf9\x13\x7f\x37\x04\x21\x4c\x1e\x00\x49\x8e\xa9\x20\xb2\x98\xfd\xc9\x7e\x8b\x87\xa3\x7e\x0b\xc6\x52\x1a\xcf\x75\xea\xee\x25\x3e\xb7\x21\x5a\x32\x15\x90\x62\xdf\xb7\x8a\x3e\x5c\xbf\x44\x38\x5e\xaa\x46\xf3\x01\xab\x85\xc4\xfe\x95\x4a\x38\x6b\x50\x6f\x18\x6a\x93\x5f\x87\xe4\xdf\x54\xab\xc7\x93\xec\xde\x7a\xc9\xff\x9a\x0b\xa9\x6a\x9a\x41\x54\x42\x24\x34\x9f\x69\x71\x35\xdc\x46\x47\x9e\x2c\x6e\xe4\x94\xa9\x77\x1a\xf8\x7a\x94\x37\x73\xda\xe1\x04\x5a\xd0\x47\x1d\x0d\x84\x4f\x92\x63\x6f\x39\x15\x3c\x35\x6d\x10\x4e\x84\x75\xc4\x99\xb9\x15\x7c\xc8\xfc\xa2\xd5\xc8\x7e\x7f\x7c\xf5\x79\x0a\x59\x4c\x28\x4a\x59\xfc\xb5\x44\x2d\x27\x06\x5f\x4a\xd6\x26\x58\x0c\x26\x80\x7d\xe0\x83\x11\x09\x24\x94\x6a\x06\xb2\x5f\x6e\x8e\x5f\x6a\x60\x34\xc4\x42\x45\xff\x26\xb3\x60\xb6\x82\x3d\x3d\xef\x8c\x02\x32\x78\x1f\x29\x7c\xae\xdd\xcf\x39\x74\x29\xd9\x33\xfd\x26\xb1\x2e\x43\xa0\xec\x48\x56\xd5\xe4\xfe\xa5\x67\x7a\x92\xc6\x17\xc5\x5d\xe2\xe2\x2f\x91\x89\x9f\x4c\x57\x8b\xc1\x9f\x4e\x56\x4b\x1b\xd8\x2e\xb2\xb0\x48\x49\x4b\x26\xea\xcb\x8b\x6b\x49\x92\xea\x89\xec\xfc\x8f\xc7\x3b\x43\xbf\xd7\xd3\x73\x36\xb6\xcb\x77\x16\x54\x7f\x15\x4e\x30\x39\x2e\xaf\xb4\xce\x9b\xa1\xc6\xe1\x50\xdc\xb7\xc5\xbe\xa4\xb0\x3f\x6e\x77\xc7\x93\xdd\x71\xcf\x8a\x7e\x4a\x95\x93\x56\x9b\xbd\x21\x1f\xf1\x76\xbb\x1b\x8d\xe8\xed\xa4\x9d\xef\x20\x3d\xd8\x88\x1a\x82\xdf\x7b\x4d\xec\x79\xb2\xd9\xd9\xeb\xc2\xa8\xeb\x1f\x34\xdb\xdd\xf6\x61\xb3\xd7\xe9\xec\x37\x0f\x7a\x7b\xdd\xa6\x3f\xda\xdb\xf1\xba\xed\xee\xae\xd7\xdd\x73\x60\x49\x0e\x3d\xa2\xc6\xb0\xd3\xeb\xf9\x87\x87\x9d\x66\xfb\x00\x86\xcd\x5e\x6f\xbf\xdb\x3c\x00\xaf\xd3\x84\x61\x7b\xa7\xe7\xed\x1d\x76\x77\x3a\x43\xbb\x7d\xcc\x83\x3e\x6a\x8c\x18\x6b\xba\xe8\x6d\xdd\x62\xd1\xc2\x5e\x08\x2d\x8f\x85\xfd\x5e\x6f\xa7\x51\x88\xc6\x9c\x15\x99\xd6\xf0\xdb\xb7\x07\x01\x1d\xb7\x77\x3a\x02\x0e\xef\x6a\x0c\x1f\xda\xdd\xdd\xee\xde\x2e\x34\xf1\xc1\x01\x6e\xf6\x7a\xa3\x61\xf3\xa0\xb7\xdb\x6e\x82\xdf\xee\xb4\x61\xb8\x37\xf4\x76\xbd\x79\xc3\xf7\xbd\x5d\x7c\xd0\x3d\x3c\x68\x0e\xc1\xdf\x6f\xf6\xba\x5d\x68\x1e\x1c\xf6\xf6\x9b\xa3\xbd\x91\x8f\xf7\x0e\xbb\x87\xdd\xd1\xa8\x3c\xfc\x21\xe6\xc9\xf0\xbb\xe1\xc8\xc3\xed\x76\x57\x1e\xde\xed\x8b\x71\x4b\xf0\xaa\xe1\xa7\xd5\x89\xc5\xb0\xbb\x5c\xe7\x88\x1a\xee\x98\xdf\x59\x71\xea\x8a\x5c\x67\xb1\x97\x9d\x5a\x32\xbf\x2c\xce\x14\xa5\xb7\x49\xac\xa3\x27\x77\x6b\x88\xf3\xd7\x58\xcd\x82\xee\x7c\x57\xb7\x30\x2d\x6a\x73\x5a\x01\xd7\xb8\xba\xbe\x3c\xbb\x78\x95\x8f\x4d\x9c\x7e\xe8\xac\xc5\xcf\x57\x6f\x2e\x0a\xc7\x02\x93\x98\xbe\x58\x81\x33\x3f\xc0\x48\xb2\x3b\xfa\xad\x32\xab\xe5\xf0\x34\xcd\x85\x69\x10\xed\xb2\x56\x15\x6c\x16\x2a\xbd\x75\x3a\x6f\x90\xd6\xe1\xda\x5d\xfb\x80\xfd\x41\x00\x52\xd9\x80\xbb\x18\x8a\xc3\xd4\xdc\x55\x02\x17\xdc\x99\xae\xaa\xbf\x12\xe1\x48\x35\x35\x3a\x6d\x4b\x96\x12\x63\x54\xb8\x97\x62\x7e\x76\xc6\x7c\x1b\x62\x3b\x87\x47\xdf\x28\x80\x1a\xc7\x6f\x2e\x2e\x4e\x8f\xaf\xdf\x5c\x36\x5f\xbf\x7a\x7d\xdd\xcc\x81\x24\xf7\x08\xa0\xc6\x95\xf5\x2d\x98\xf4\x2b\x31\x02\x51\x26\xb3\xf2\x08\x93\xfd\xd5\x5f\x8d\x79\xae\x64\xab\x7c\x26\xad\x70\xd1\x00\x6a\x74\xc8\xfb\x33\x12\xde\xbd\xf2\xf8\x49\x7c\xbe\xd7\xc1\xef\x3e\x9d\xfd\xe3\xee\xc5\xf5\xdd\xc5\x25\x9e\x71\xe9\xcc\x64\x53\x7f\x8d\x81\x4f\x6b\x70\xaa\xfb\x44\x9c\xea\x2e\x64\x54\xd7\xc1\xa7\xff\x58\x93\xfe\x52\x9f\x00\x30\x5f\xbe\xe3\x02\x72\x7b\x09\x7d\xf4\x8e\x2a\x3b\xa0\xde\xea\x8c\xc1\x2f\xf6\x27\x16\x85\x3e\xa0\x85\x23\x32\x30\x49\xb5\xa4\x38\xbe\x8f\x4a\x14\xf4\x97\xe8\x2f\xab\x63\xf1\x58\x10\x87\xd4\x78\x37\xaa\xa7\x24\x59\x8c\x36\x89\xbf\xd9\x42\x57\x2e\x38\xbd\xab\x62\xf7\xa6\x0c\x39\xa3\x5b\xc9\x5e\xa7\x17\xb0\xd8\x1f\x24\x19\x79\x9e\x3e\x35\xf5\xac\x2d\xf4\xab\xc9\x8c\x9b\x89\xec\x23\xe2\xa3\xe7\xa8\xd3\xdd\xa9\x94\x8a\xe0\xfd\xc9\xab\x78\x3a\x3c\xe3\xa7\xf4\x13\x3f\x82\x70\xbf\xdb\x1b\xdf\xdd\xde\x92\x93\xfb\x54\x2a\x8a\x77\xd3\xb8\x24\xa1\xd7\xee\x3d\x89\x24\xec\x2f\x12\x84\x7d\x87\xbe\xd4\xf9\x8e\xc6\x6c\x30\xce\x7b\xcf\x5c\x43\xda\xff\x76\x03\x3a\xce\xdd\x63\x8b\x88\xff\x7c\xb3\x43\x7e\xd9\xf1\xe3\xdf\x3e\x9c\xdd\xdf\xef\x7e\xb8\x3f\x0f\xa6\x9f\x3b\xe1\xab\xcb\x9d\x9f\xa7\x77\x17\x9b\xda\x34\x8c\x58\x4c\xfd\x39\xca\xff\xe1\xcd\xfe\xb8\x3b\xde\xfb\xe9\xda\x7f\xf7\xcb\x3b\xdc\xbd\x15\x3f\x1d\x74\x6f\x7f\x3d\xd9\x99\xa6\x9c\x29\xde\xd3\xe4\x34\x8d\x9d\xa7\xb1\x8c\x9d\x85\x86\xb1\xe3\x60\x4b\xa6\xc6\xf7\xc0\xc9\x68\x8a\x7e\x7e\x7f\x6d\x6e\x7f\xea\xa3\xcb\xc4\xe1\x45\x38\x96\x13\xc6\xc9\xe7\xf4\x24\xf3\x2d\xd0\x7a\xfc\xd9\x79\x37\x39\x9d\x3c\x84\xbf\xbf\x88\xde\xbf\x1d\x9d\x75\x83\x0b\xb8\x8d\xfc\xde\x3f\x4e\x52\xfe\x1c\xaa\xe5\xed\x98\xd1\x51\x40\x3c\x59\x83\x57\x3b\x7b\x4f\xc2\x2b\x1b\x8d\x9b\x57\x36\x84\x2d\x42\xa6\x6e\xce\x58\x1e\x22\x10\x0e\xf4\xf2\xaa\xcb\xbb\x2a\xf9\xb0\x77\xfb\xa1\xfd\x8e\x9c\xde\x7e\xbe\xfd\xfd\xf8\xf3\xfb\xb7\x70\xd6\x65\x1f\x60\xe2\xef\x9c\x26\x6c\x28\x5f\xb8\xe4\x1a\xfa\xe1\x93\x8c\xfc\x70\xd1\xc0\x0f\x9d\x32\x92\x5d\xd0\x08\xf9\x4e\x4b\x53\x0e\xa7\xe7\xf7\x2f\x0f\x3f\xbe\xfe\xf5\xc3\xde\x87\xf1\x64\xf4\xfa\x70\xfc\xea\x52\xfc\x74\x7f\xfa\x7e\x36\xd6\xda\xc6\xe2\xdb\x8d\xd8\x5e\x05\x75\x9f\xb3\xc3\x6f\x48\x79\x07\x42\xb9\xde\x6f\x8e\x5f\x37\x4f\x7f\x6f\x1e\xf6\x93\x93\x72\x4a\x85\xcc\x79\xb8\x0c\x06\x3e\xc9\x66\xb2\xf6\xe1\x88\x34\x3b\xe4\x53\x7b\x27\xa0\x7e\x10\xde\xb5\xef\x46\xde\xbe\x20\x12\xef\x8a\xe0\xe3\xfd\x81\xed\xc7\x8e\xac\x8b\xc4\x14\x1f\x3a\xe3\x5d\xff\xe0\xe0\xae\x1d\x70\xcf\xbf\xef\x8d\xf7\x71\x30\xdc\x17\xc1\x68\x4c\x3f\xee\xf8\x93\xa1\xf8\xf8\x97\xff\xf7\xd7\xd3\xdf\xaf\x2f\x8f\xd0\x7f\x99\x11\xb7\x34\xc5\xcf\x89\x0f\x54\xaa\x39\xb3\x83\x50\x22\xd0\x66\xaf\xdd\xdb\xdc\xd2\xbc\xd0\x7f\x1e\x9f\xbf\xbb\xba\x3e\xbd\xbc\x32\xcc\x50\x2f\xf5\x5e\xea\x6c\x62\x51\x86\x48\xc3\x77\xc6\xbb\x8c\xef\xb6\xef\x49\xdc\xde\x67\xa0\xa6\x6d\xc2\x6f\xbd\xee\x9e\x3f\x1e\xc9\x8f\x1d\xec\x6d\xda\x8b\x6c\x7a\xb7\xf6\xe6\xa2\x41\x58\xf6\xf6\x6f\x73\xec\xc9\xb5\x78\xcf\xa7\x7b\x54\xdc\x0d\xbb\xe2\x22\x7c\xf9\x71\x77\xf8\x7b\x74\xb2\x7f\x8c\x1b\x1b\xff\x17\x00\x00\xff\xff\x48\xdc\x50\x6f\x24\xa1\x00\x00")
+var _connector_mgmtYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x7d\x7b\x73\x1b\x37\xf2\xe0\xff\xfc\x14\x7d\xf4\x6d\x79\xf7\x4e\xa4\x48\xea\xcd\xba\x6c\x95\x6c\xcb\x89\x12\x59\x76\x24\x39\x8e\x77\x6b\x8b\x02\x67\x40\x12\xd6\x0c\x30\x02\x40\xc9\x4c\x6e\xbf\xfb\xaf\x00\xcc\x03\x98\x07\x67\x28\xc9\x96\xec\x50\x55\xbb\x31\x67\x80\x46\x77\xa3\xd1\x2f\x00\x3d\x2c\xc2\x14\x45\x64\x08\x5b\xdd\x5e\xb7\x07\xcf\x80\x62\xec\x83\x9c\x11\x01\x48\xc0\x84\x70\x21\x21\x20\x14\x83\x64\x80\x82\x80\xdd\x82\x60\x21\x86\xe3\x57\x47\x42\x3d\xba\xa2\xec\xd6\xb4\x56\x1d\x28\xc4\xe0\xc0\x67\xde\x3c\xc4\x54\x76\x5b\xcf\xe0\x30\x08\x00\x53\x3f\x62\x84\x4a\x01\x3e\x9e\x10\x8a\x7d\x98\x61\x8e\xe1\x96\x04\x01\x8c\x31\xf8\x44\x78\xec\x06\x73\x34\x0e\x30\x8c\x17\x6a\x24\x98\x0b\xcc\x45\x17\x8e\x27\x20\x75\x5b\x35\x40\x8c\x1d\x83\x2b\x8c\x23\x83\x49\x0a\xb9\xf5\x0c\xda\x11\x27\x37\x48\xe2\xf6\x06\x20\x5f\x51\x81\x43\xd5\x58\xce\x30\xb4\x3d\x46\x29\xf6\x24\xe3\xa3\x70\x1a\xca\x4e\xdc\xb2\xbb\x40\x61\xd0\x86\x09\x09\x70\x8b\xd0\x09\x1b\xb6\x00\x24\x91\x01\x1e\xc2\xcb\xa4\x03\x9c\x63\x7e\x43\x3c\x0c\xaf\x03\x8c\x25\xbc\x41\x14\x4d\x31\x6f\x01\xdc\x60\x2e\x08\xa3\x43\xe8\x75\xfb\xdd\x5e\x0b\xc0\xc7\xc2\xe3\x24\x92\xfa\x61\x4d\x7f\x43\xcf\x19\x16\x12\x0e\xdf\x1d\x83\x64\x10\xea\x17\x90\x22\x2a\xba\x2d\x81\xb9\x1a\x44\x61\xd5\x81\x39\x0f\x86\x30\x93\x32\x12\xc3\xcd\x4d\x14\x91\xae\x62\xb6\x98\x91\x89\xec\x7a\x2c\x6c\x01\xe4\x10\x78\x83\x08\x85\xbf\x47\x9c\xf9\x73\x4f\x3d\xf9\x07\x18\x70\xe5\xc0\x84\x44\x53\x5c\x07\xf2\x5c\xa2\x29\xa1\xd3\x52\x40\xc3\xcd\xcd\x80\x79\x28\x98\x31\x21\x87\xfb\xbd\x5e\xaf\xd8\x3d\x7d\x9f\xf5\xdc\x2c\xb6\xf2\xe6\x9c\x63\x2a\xc1\x67\x21\x22\xb4\x25\xd1\x34\x66\x00\x45\xa1\x33\x2f\x17\x8b\x08\x8b\x62\xff\x76\xbb\xac\x75\xe3\x86\xf0\x32\x98\x0b\x89\x57\xe8\x10\xcf\x6f\x69\xfb\x56\x84\xe4\x4c\xe3\xff\x4c\xfd\x0f\x4a\xbb\x3d\x6b\xb5\x00\xda\x6a\x1a\x36\x5d\x31\xdd\xbc\xe9\xb7\x87\x1a\xee\x14\x4b\xf3\x0f\x80\x84\x21\xe6\xaf\x53\x81\x08\x00\x8b\x30\x47\x0a\x91\x63\x7f\xa8\xfa\xff\x66\xc4\xf5\x0d\x96\xc8\x47\x12\xc5\xad\xc4\x3c\x0c\x11\x5f\x0c\xe1\x0c\xcb\x39\xa7\x42\xaf\x96\x58\xb2\x21\x74\xdb\x3a\xc4\x35\xe9\xc0\xb1\x88\x18\x15\xd8\xc2\xb7\x3d\xe8\xf5\xda\xd9\x4f\x00\x8f\x51\x89\xa9\xb4\x1f\x01\xa0\x28\x0a\x88\xa7\xb1\xdf\xfc\x24\x18\x75\xdf\x02\x08\x6f\x86\x43\x94\x7f\x0a\xf0\xbf\x39\x9e\x0c\xe1\xf9\xb3\x4d\x8f\x85\x11\xa3\x98\x4a\xb1\x69\xda\x8a\xcd\x1c\xfd\xcf\xad\xce\x0e\x61\xbf\xe5\x69\x49\x27\xaf\x28\x7a\xcb\x66\x6e\xf3\x0a\x4d\xae\xd0\x28\x7b\x2e\x55\xa7\xcd\x3f\xdd\x07\x23\xe2\xff\x37\xe6\x47\x84\x38\x0a\xb1\x8c\x17\x3c\x40\x26\x6b\x85\x2e\xad\x52\xcc\x2f\x66\x18\x88\x0f\x4c\xab\xcc\xac\x13\xa8\x4e\xad\x6a\xd6\xa9\xd7\x43\x10\x92\x13\x3a\x4d\x1f\x13\x3a\x04\x25\xbb\xe9\x03\x8e\xaf\xe7\x84\x63\x7f\x08\x92\xcf\x71\x73\xa1\xcc\x56\x29\x80\xc0\xde\x9c\x13\xb9\xb0\x5b\xbe\xc0\x88\x63\x3e\x84\x7f\xc3\x7f\x2a\x04\x37\x85\xa5\x40\xbd\x58\x1c\xbf\xca\x8b\xee\x8f\x58\x02\xca\xd1\xab\xcc\x48\xca\x27\x57\x70\x6b\x9b\x3f\x92\xd8\xb6\x4b\xc5\xd6\xa1\xbe\x9d\xeb\x8a\x3f\xa3\x30\x0a\x6c\x44\x93\x3f\xa7\xdb\x91\x69\x56\x6c\x55\x3e\x74\x02\x75\xb3\x0c\x48\xbb\x6a\xdd\x5c\x14\x64\x0e\x42\x24\xbd\x99\x32\x18\x4a\x1e\x95\x00\x61\xad\xfb\xcd\x5f\x7b\xbb\xd7\x7f\x1c\x96\x1e\x71\xce\x78\x73\x56\x6e\xf7\xfa\x77\x65\x60\xd6\xb5\x92\x6d\x87\x73\x39\x03\xc9\xae\x30\x05\x22\x80\xd0\x1b\x14\x58\xeb\xbb\xbd\xdd\xdb\xfe\x46\x98\xb4\x7d\x77\x26\x6d\xd7\x31\xe9\x94\x65\xb2\x94\x93\x31\xfc\x99\x08\x29\x32\x86\xed\xf4\x7a\xdf\x04\xc3\x76\x7a\xbd\xbb\x32\x2c\xeb\x5a\xc9\xb0\xf7\x14\x7f\x8e\xb0\x27\xb1\x0f\x58\xe1\x05\xcc\xd3\x7e\x95\xbf\xb2\xc1\x5a\xc5\x01\x79\x60\x5d\x2f\xaa\x7c\x14\x04\x01\x11\x12\xd8\x24\x27\x0c\xa2\x4c\xdf\x37\xed\x54\x34\xbf\x0a\xe5\xb2\x89\xc8\x5a\x6e\x46\x68\x8a\xdb\xcd\x9b\x0b\xf2\xc7\x2a\xcd\x19\xf7\x31\x7f\xb1\x58\x65\x00\x8c\xb8\x37\x6b\x3f\x79\x43\x76\x42\x84\xac\x56\x89\x35\x33\xb5\xb6\x1d\xcd\x6c\xc7\x5a\x15\xd6\xaa\xc2\x9c\x63\xbf\xa2\x4b\x9f\x28\xc7\x88\x89\x7a\xed\x78\x0f\xc5\xe8\x71\x8c\x24\xb6\xb1\x74\xd4\xe2\x4b\xfd\x1a\x10\x50\x7c\x0b\x5e\xae\x95\x9b\x94\x58\xd6\xb2\x5c\x01\x12\x3a\x84\xeb\x39\xe6\x8b\xf4\x19\xc4\x51\x09\x12\x0b\xea\x55\x71\xfd\x1d\xe6\x13\xc6\x43\xed\xf9\x21\x9d\x7f\x00\x42\x01\x51\xd3\x6b\xc6\x19\x65\x73\x01\x21\xa2\x14\xf3\xd6\x72\x69\x33\xf1\xc9\x98\xb1\x00\x23\x6a\xbd\x29\x89\x48\x20\xf1\x32\x5f\x30\xdf\x62\x70\x45\x62\xc6\x8a\x54\x4b\x17\xc7\xf2\xa5\x51\xbe\x30\x1a\x69\xc0\x33\x83\xa4\xbb\x42\xaa\xd6\x47\xda\xcb\x4c\x5e\xe5\x4a\x69\xe6\xc9\x3b\x40\xda\xad\x1a\x5e\x96\x99\x8f\xc1\x23\x9b\x8f\x6a\x6d\xe8\x79\x38\x92\xd8\x71\x9e\x7b\xdf\x88\x95\xe8\xe9\x79\x21\x8c\xde\xdd\x5a\xe4\x41\x54\xf2\xe9\x37\x65\x25\x74\x4b\xa3\x10\x45\xa6\x11\xd7\xf6\x75\x1d\x9b\xad\x1a\x9b\x5d\x64\xb1\x3d\xf6\x81\x63\xc1\xe6\xdc\xc3\xe0\x33\x2c\xe8\x73\x69\xe2\xb3\xb5\x4f\x92\x13\x2c\x0a\xf3\x2a\xb7\xc4\x58\xfb\x24\x6b\xe2\x1a\xe9\x29\xfe\xa2\x7e\x86\x72\xbb\x8b\x70\xbe\xb3\xe8\xeb\x49\xc7\x46\xab\xc6\x45\xeb\x90\x68\x1d\x12\x3d\x4e\x76\x48\x6c\xfe\xb9\x7c\xeb\xa2\x66\x31\x12\xbf\xfd\x35\x54\x9a\x9d\x53\xaa\xd9\x37\x28\x53\x5f\xe5\x4d\x9e\xa6\xee\x68\x98\x99\x5f\x27\xe5\xd7\x8e\x1f\xc0\x3a\x29\xff\x94\xd4\xae\x69\x1a\x60\x89\xbf\xa4\x2e\x34\x23\x54\xaa\xc3\x57\xfa\x75\x9d\x46\xac\x6c\x55\xae\x14\x9f\xca\x42\x29\xa1\x61\x1d\xee\x7e\xb7\x5a\xcf\x4c\xf0\x3d\x74\x9f\x03\x60\x99\x06\xd4\x5e\x51\x62\x46\xe1\x96\xc8\x19\x88\x08\x7b\x64\x42\xb0\x0f\xc7\xaf\xbe\x65\x4d\x78\x3f\x26\xe6\x01\xdc\x51\x2b\x46\xca\xc2\x7c\x49\xa5\xa8\x07\xa8\xd4\x89\xef\xd4\xdb\x3a\x95\x58\xd5\xa8\x3e\x17\xfd\x0a\x49\x04\x92\x19\x24\xdc\x78\x5f\xcb\x52\xd3\xec\x74\x88\xf9\x14\x77\x34\x94\xff\xdb\x34\x53\x6d\xd2\xea\x6c\xfc\x09\x7b\x72\x49\xd2\x7b\x45\xa8\xb9\x80\xf5\xe7\xf3\xb7\xa7\x86\x3f\x1b\x70\xf6\xfa\x25\xec\x1e\xf4\x06\xd0\x49\x4f\x1e\x4a\xc6\x02\xd1\x25\x58\x4e\xba\x8c\x4f\x37\x67\x32\x0c\x36\xf9\xc4\x53\xad\xee\x86\xed\x97\x48\xd1\x7f\x57\x49\xf2\x75\x2c\xb0\x8e\x05\xd6\xb1\xc0\xf7\x94\x82\xd9\xe4\xf8\x86\x08\xc2\xa8\x78\xf2\xc9\x18\x27\xbf\x7c\x96\xa0\xbd\xec\x24\x72\x4a\x1b\xb0\x49\x9d\x19\xfe\x67\x27\xc5\xa4\x09\x88\x0d\xb5\x09\x8e\x85\x34\xb7\x1c\xba\xf0\x96\x06\x0b\xdd\x21\x64\x42\x02\xc7\x1e\xa6\xd2\x52\xfd\xe5\x40\x00\x71\x0c\x57\x38\x92\xdd\x47\x3a\x45\xf4\x34\x53\x51\xc9\xdc\x2e\x4d\x67\x5f\xe4\x27\xa7\xb8\xdf\xb1\x36\x43\x6b\x33\x74\x7f\x33\xb4\xb6\x40\x5f\xcb\x02\x6d\xfe\x99\xfc\xf3\xbf\x9b\x9c\x05\xc1\x18\x79\x57\xf7\xb7\x4a\xd9\x45\x88\x04\x7a\xab\x56\xa1\x80\x64\xa0\x30\xc8\x05\x55\x0a\x21\x90\xac\xf6\x36\x04\xa1\x12\x4f\x9d\x93\x49\xea\x34\x13\x92\xfa\xcd\xee\xf6\x4a\xd7\x24\xbe\xf4\xe9\xb0\x84\xd1\x95\x41\xec\x99\xe2\x03\x2a\x72\x01\x10\x44\x8a\x5f\x6c\x2e\xf2\x9c\xad\x36\xac\x1d\xbd\x30\xb0\xc8\x31\xd6\x63\x74\x42\xa6\x73\x83\xd5\x06\x78\x33\x44\x29\x0e\x00\x51\x5f\xc1\x52\x1c\x01\x21\x91\xc4\xda\x7e\xa6\xe0\x0a\xc3\x6f\x00\xa1\x5e\x30\xf7\x93\x98\x44\x60\x8f\x63\x99\x5a\x87\xa4\x59\x17\x2e\xb2\x97\x29\x34\x05\x3a\x6d\x92\x5a\x66\x98\x53\x49\x02\xa7\x3b\x10\x01\x11\x9f\x53\xec\xc3\x84\x33\x73\x48\x6d\x46\x84\x64\x7c\xd1\xfd\x76\xc3\x3a\x25\x07\xd8\x37\x93\x5b\x6a\x46\x7b\x4f\x31\x17\xeb\x2c\x5a\x0f\xa9\x23\x23\x63\x6c\xf0\x30\x77\x3d\xd7\x5e\xc1\xda\x2b\xf8\x12\x5e\x01\xe3\x99\xdc\xad\x3d\x84\x2f\xec\x21\x04\x6c\x2a\xbe\xa9\xb3\x02\x27\x6c\x5a\x13\x98\x7a\x98\x4a\x50\x74\xdd\x3d\x34\x0d\xd8\x14\xf0\x67\x0f\xf3\x48\x0a\xe0\x38\x62\x5c\xf1\x7a\x6c\xc2\x50\x75\x3a\x19\xa2\x00\x51\x0c\x68\xaa\xc6\x9a\x30\x0e\xce\x79\xe5\x24\x8e\x65\x81\x9f\x8f\x63\x6d\xf4\x1e\x24\x44\xf5\x63\xd3\xfe\xb4\xe3\xce\x13\x36\x6d\x10\x72\x3a\x33\xb7\x36\x2f\x6b\xf3\xb2\x0e\x3a\xbf\x41\x93\x12\x62\xc9\x89\xf7\x6d\x59\x95\x37\x06\xe7\x06\x86\x25\xa6\xee\xee\xb6\x45\xce\x38\x9b\x4f\x67\xd1\x5c\xea\x20\x2c\x40\xd3\x14\x66\x9d\xa5\x69\x59\x31\xaf\x3d\xba\x6b\x5b\x12\x68\x5f\xc4\xbc\x34\xe8\x32\x21\x81\xfa\xef\xd3\x36\x48\xf1\x84\x37\x35\x4a\xd6\xac\xaf\xed\xd2\xda\x2e\xad\xed\xd2\x37\x68\x97\x38\x0b\xf0\x68\x4c\xa8\xca\x61\x7d\x5b\xd6\xe9\x8c\x05\xf8\x45\x8c\xf8\x52\x13\xc5\x54\x99\xae\xb8\x21\x4c\x39\xa2\xfa\xba\x8a\xae\xd8\x05\xc8\xf3\xb0\x10\x26\xbb\xf8\xa4\xcf\x4d\x5b\xd4\xd6\xab\x67\x87\xe2\xb5\x76\xbe\xa7\x76\xde\x7a\x8a\x89\xc8\xf7\xc2\x54\x65\xa3\x4c\x9a\x52\x77\x26\xfd\x28\x30\x06\xb9\x92\x08\xac\x6d\xcf\xda\xf6\x3c\xdc\xb1\xf0\xaf\x5c\x5b\xc0\xd2\x8a\x85\xeb\x32\x4a\xd1\x0b\x40\x5a\xd1\x03\x32\x2b\x82\xd1\x15\xe2\x93\x14\x42\x88\xc3\x31\xe6\xc9\x2a\x62\x7c\x8a\x28\x11\x1a\x25\x60\xb7\xb4\x70\xf7\x51\xff\xba\x21\xf8\x16\xf3\x0d\xc0\x3e\xc9\x46\x02\x60\x1c\x90\x1f\x12\x9a\xa2\x43\x64\x17\x0e\xcd\xaf\x64\x7b\x29\x58\x18\x2b\x95\x6d\x28\xcc\xe3\xc5\xce\x71\x14\x20\x0f\xfb\xdd\x14\x9e\x0e\x71\x34\xc4\xe2\x2a\xd7\xb1\x8e\xa5\x1b\x34\x50\x3d\x94\xe8\x36\x3e\xf3\x79\x66\x29\x92\xaf\x5e\x82\xc0\x9a\xde\x42\x11\x82\x15\x8e\x37\xf6\x1f\xdd\x64\xd7\x9b\xeb\x5b\x24\x92\x59\x5f\x57\x04\x58\x57\x04\x58\xbb\x36\x05\xd7\xc6\x52\x5f\xc0\xe8\xda\xa1\x59\x3b\x34\x4f\x3b\x98\xde\xfc\x33\xfe\xd7\xe8\x41\x6e\x1e\x37\x68\x9a\x8d\xd7\x7e\x9c\x3b\x7a\x4b\xdc\xb1\x33\x7c\xc3\xae\xb0\x48\x1c\xb1\xc4\xc1\x29\x75\xc8\xbe\xa1\xbb\x79\x8e\xfd\xe6\x9a\xc6\xb5\x15\xfa\x9e\xad\x90\x99\xe3\xef\xc9\x0c\x7d\xbd\xdb\x87\x15\xc7\x5a\xec\x08\x63\x6d\xa2\xee\x5b\x14\x30\xa9\x08\xbf\x6a\xbd\x6f\xcf\x74\x5b\xa9\x48\xa0\x5b\x7d\x7e\x79\xa8\x9e\xa1\xd5\xdc\xd6\xd4\xd4\x0c\x04\xcf\x81\xd9\xa0\x76\x60\xae\xc7\x5f\xae\x86\x60\x4c\xfe\xe3\xd5\x12\x8c\xa5\xe0\x8e\x25\x05\x4d\xe7\x87\xa9\x2c\x58\x02\xeb\x9b\x2c\x30\x18\x13\xb2\xae\x33\xb8\xce\x2a\x3c\x15\x7f\x6e\x1d\x81\xaf\xeb\x0c\x7e\xa5\x3a\x83\x8e\x41\x9f\xe2\xd5\x5d\x96\xfb\xde\x0b\xcd\x83\x6b\x52\x7e\xd0\x73\xfb\x34\xae\x40\x98\xeb\xb7\xbe\xbc\x69\x4d\xc0\xca\x25\xda\x73\xcc\x5c\x6b\xf7\x75\x49\xc2\xaf\xfc\xc1\x8a\x44\x02\x37\xff\x2c\x3c\x5b\xf1\x3b\x4b\x59\xaf\xd5\x3e\xb5\xe4\x46\x43\x5f\xff\x6b\x4b\xf7\xd7\xc5\xf6\x81\xa0\x5c\x84\x59\xf5\xbd\xa5\x25\x41\xe3\xf2\xa6\x4f\x5a\xff\x35\x2c\xa1\x12\x53\xb4\x2e\xa5\xb2\xf6\x73\x1f\xc6\xcf\x2d\x4f\xeb\x25\x62\xb6\x2e\xa9\x72\xd7\x73\x34\xf3\xaf\xa2\x3e\xe7\x91\x5f\x92\xa3\x7b\xb1\x38\xf6\xf3\x5a\x74\xee\x47\xc8\x2d\xa4\xb8\x4c\x91\xd6\xb6\x6e\x5e\x6c\xcc\xa0\xe8\xdf\xb1\xd4\xd8\x57\x49\x5e\xad\x90\x2d\x72\x55\x86\x9b\xa5\x33\xd0\x41\x48\x24\xe7\x02\x88\x48\x48\x5f\xeb\xe5\xb5\x5e\x7e\x60\xbd\xbc\x56\xc9\xab\xab\xe4\x86\xbb\xe9\x0f\xa0\x95\x73\xbb\xea\x15\x7e\x6d\xb1\xb4\xed\x32\x8d\x5c\xdb\x7a\x5d\x10\x77\xad\x17\xff\x7a\x5b\xd2\x69\x62\x76\x5d\x0b\xf7\x21\x6b\xe1\x3e\x5c\x16\x64\x13\xf9\x3e\xa3\xa3\x2c\x0b\xb2\x4e\x8b\xdc\x2d\x2d\x72\xa8\xf8\xf8\x2e\xe5\x5a\xc3\x2c\xc9\x73\x01\x7a\x02\x20\xca\xf7\x6c\x92\x38\xa9\xee\xfd\xa4\x72\x29\x2e\x6b\x6a\xaf\x57\x65\xc4\x80\x9c\x21\x09\x62\xc6\xe6\x81\x0f\x63\x7d\x4e\xdf\x07\xc9\xd2\xf2\x52\xe6\x2e\x4e\x88\x28\x9a\x3a\x11\x8c\x61\x4a\x72\x7a\xc8\xf0\xaa\xbb\x36\x67\x6b\x37\x7f\x9d\x7e\x79\x7c\x5f\xbf\x95\x41\x54\x03\xc7\xd8\x0f\x5b\x1a\xd2\x33\xf3\xff\xf0\x92\x85\x61\x5c\x7e\xee\x99\x79\xa3\xd4\xc6\xb0\x95\x53\xfc\x96\xc6\xbe\x22\xd4\xb7\x7e\xaa\x7d\x38\xeb\xa7\xda\x67\xb3\x7e\x4a\x26\x51\x60\xfd\x26\x12\x87\xc9\x14\x96\xd4\x16\x8f\xb8\xd2\xfe\x92\xd8\x6c\x54\xe3\xd5\x9a\x2c\x85\x45\x7d\x39\x41\x85\x5c\x7d\x2b\x8d\x73\x75\x33\xfd\x42\x8b\x40\xd2\x06\x05\xc1\xdb\x49\xdd\xd6\x65\x22\x3c\x6f\x35\xbd\x67\x78\x82\x39\xa6\x9e\xb3\x85\x59\x51\x6c\xbd\x8c\x29\x46\xde\x7d\x5c\x5e\x5d\x3e\xc7\x1c\x33\x93\xa8\x44\xfa\x2b\x9b\xa7\x46\x78\x44\xfc\xa5\x9d\xf4\xbb\x1c\x4d\xc3\xd5\x26\x98\xd4\x4f\x6f\x23\x19\x98\x29\xae\xb7\xea\xf1\x7c\x83\x25\x5a\x11\x45\x76\x4b\x31\xaf\x45\xc0\x1c\x14\xf4\x47\xc8\xd1\x41\x49\x05\x4b\x95\x02\xeb\x48\x12\xe2\x3a\x30\x21\xf3\xb5\xef\x7e\x57\x38\xfa\xf9\x39\xe6\x37\xc4\x4b\x62\x6f\xc2\xe8\x39\x96\xea\xd4\x81\x58\xb6\xb4\x89\xbd\xb0\xe7\x3c\xb8\xdf\xa4\xcd\x79\x30\x6c\x82\xe3\xa1\xe7\xb1\x39\x5d\xaa\x73\xbc\x80\x60\x2a\x47\xc4\x2f\x3e\x33\xd5\x28\x97\x60\x9a\xf6\xad\x9f\x3f\x1b\xe2\x72\xd4\x5f\xe1\x28\x60\x8b\x10\x53\x79\xc2\x8c\x75\x49\xda\xfb\x44\x29\xe7\x90\x50\x24\x99\x25\x32\x31\x66\x8b\x53\xed\xda\x3b\x3a\x34\x44\x51\x44\xe8\xd4\x1e\x30\xef\xf3\x36\xcd\xe8\x5e\x20\x3e\xc5\xa9\xd3\xc7\x28\x6e\xae\x97\xaa\x40\xb5\xca\xf0\x31\x2f\x87\x65\x1e\x74\xdb\xbc\x13\x70\xcb\xf8\x55\xc0\x90\x6f\x8a\x12\xd0\xd8\x57\xf4\xdc\x5d\xbe\x92\xf5\x57\x63\x73\xee\x6c\x22\xb2\x20\x6a\xf9\xd4\xfe\x86\xb9\x20\x8c\x2a\x2d\xa1\x4e\x97\x7e\x25\x25\x8f\xcb\x3c\x04\x4d\x17\xb4\x0f\xdf\x1d\xc7\x48\xb9\x4e\x07\x51\x2f\x6f\xfa\xee\xc3\x99\x41\xab\x3c\x68\x6d\xe7\x0c\x48\x10\x18\xe5\x50\xf0\x5a\x3a\x06\xb8\x8e\x71\x45\x3b\xf7\xb2\x66\x90\xe2\xe7\xda\x0b\xfd\x63\xc2\x2a\xbf\xbf\x59\x6d\xf2\x2a\x31\x36\x7c\x45\x9c\xa3\x45\xee\x8d\xf6\x39\x86\x05\x1c\x72\x13\x0a\x70\xc7\xa9\x75\xdc\xa9\x58\xa5\x09\xdb\xa1\xfa\x45\xb1\xa3\x5a\x11\x3b\xab\xe7\x27\x16\xf8\x69\x8d\xdf\xac\xb2\x6f\x1c\x8b\x29\x08\xea\x9f\xc8\xc0\x84\x63\x2a\x24\xa2\x1e\xee\xde\x45\x46\x2b\x2d\x44\x36\x11\xcf\xe2\xef\x2c\xc5\xe9\x24\xcf\x9a\x97\xac\x4d\x85\x48\x3f\x73\x67\xd1\x28\x7c\x3d\xf4\x19\x9e\x12\x21\xf9\xe2\x81\x59\xa2\x81\x43\x02\xfc\x2b\xf0\xc6\x34\x06\x9e\x8c\xf8\x50\x5c\x4a\x64\x49\x47\xf3\x8e\x24\xb9\xf1\x7d\xb9\xfa\x3d\xcc\x67\x2a\xda\x0f\xee\x8d\xdd\xa0\x60\x8e\x97\x2b\xd1\x62\x26\xa2\x0a\xdb\xe4\x40\x5b\x0e\x6b\xd1\x6e\x55\xad\xeb\xdc\x7a\x6e\x9e\x10\x69\xe7\x43\x9f\xe2\x4d\x93\x94\xd5\x79\x8b\x77\x2e\x91\xcc\x39\xb6\x0e\x57\x30\x9d\x87\xb6\x74\x29\x37\xc0\x80\xc0\xb6\xd3\xc2\x31\xf2\x17\xe5\x23\xc4\xbb\xb1\xb6\x77\x5a\x36\x3f\x3a\x37\xb8\x94\xf7\x15\x80\xcb\x27\xc0\x2c\x49\xe5\x5c\xda\x27\x68\xb2\xfd\x6a\x40\x76\x9d\xba\x2c\x1d\x66\x36\x77\xdb\x77\x59\x5c\x4b\x08\xaf\x70\x37\x6c\x9e\xdc\xc1\x0e\x1b\xc8\x5f\x0a\xb9\x73\xcd\x89\x65\x53\x26\x9c\x16\x50\xfd\x59\xad\x2a\xb3\x27\x6c\xe1\x6b\x22\xf7\xa5\xd2\x9b\xbb\xa4\x62\x47\xb0\xcd\x85\xe9\xa1\xdd\xa1\x55\xa8\xb8\xcf\x3c\x9e\xc7\xf2\x5a\x4a\x94\xad\x9f\x56\x22\xcc\xf5\x5b\x56\x8e\xe0\x4b\x3d\x93\x95\x1d\x99\xd5\x4e\xd7\x95\xab\x40\xeb\xe9\x4b\xf3\x0d\x81\x25\xba\xce\xc7\x13\x34\x0f\xa4\x7a\x8a\xc6\x01\xae\xd0\x80\xf1\x4b\x97\xe1\xaf\xcc\x47\x09\x56\xd5\xa6\x46\x6d\xda\xb0\x59\x14\x39\x8a\xd5\x8f\xb7\x52\xdd\xe1\x56\x1d\x07\x09\x41\xa6\x34\x7b\x9f\x3d\x73\x06\xd3\xaa\xd1\x6d\x55\x8f\xe1\x04\x91\xa0\x88\xb2\x0b\xc5\xcf\x6d\x08\x77\x94\xe8\x98\x62\xe9\xf9\x86\xce\x8b\x9c\x54\xdb\x7e\xd2\xd2\x54\x9e\xf2\xee\x6c\xa4\x8d\xdb\x33\x42\x26\x22\xb7\xde\xe4\x6f\x06\x97\x46\x61\x0a\xda\xb0\xd5\x4c\x30\x2b\x9c\xe2\x6c\x31\xe5\x70\x29\xc2\x7d\xbe\xcc\x73\x8b\x73\x0a\xcf\x73\x5b\x5f\xa3\xc4\x59\x6b\x8a\x66\x9d\xc7\xda\x2e\x16\x06\xb7\x41\x3f\xcb\x1e\x2f\x75\x0f\x55\x4b\x6d\x66\xc5\x0c\x45\xd8\x79\x1c\x71\xe6\x61\x21\x18\x77\x5b\x6b\xf5\x0d\x33\x44\xfd\xc0\xcd\xdd\x39\x2a\xc8\x95\x8b\x12\x0f\xa3\x4c\x2a\x94\x87\x51\x36\xf5\x23\x05\xda\xcd\xc1\xf8\x69\x1a\x64\x14\xc4\x79\x10\xe7\xad\x5e\xec\x23\x6d\xbe\xee\xea\xd2\x14\xf8\x9b\xa0\x51\xdf\xc3\x55\x64\xb5\xaa\xd2\x34\x6f\x5b\x5b\xac\x05\xe2\x9a\xc2\x2a\xa6\x87\x6c\xb0\x16\x57\x1a\x23\x57\xa6\x40\xdb\xe5\xf3\x3b\xbc\x97\x53\xe6\x38\x3c\xab\xda\x5a\x5b\xf1\xe4\xb1\x7b\x0c\x27\xae\x82\x98\x15\xcd\x74\x72\xb6\x62\x74\x63\xb2\x30\xe5\x16\xbb\xf8\xed\x22\x28\x7c\xbf\xa8\x68\x9d\x9e\xac\xe7\x78\x27\x97\xd1\x7e\x71\x8b\x38\xb5\x83\x7a\x80\x92\xf8\xc3\xaa\x52\x97\xfc\x7d\x88\xfb\x01\x1a\xb3\xb9\x74\x4f\x34\x6c\x00\xee\x4e\xbb\xfa\x99\xb2\x80\xd8\x8b\x8b\xd7\x4d\x80\x48\x51\x30\x52\x2e\x92\xc0\x78\xa2\x12\xba\xf7\x75\xb9\x96\xf8\xc9\x8f\xe2\x20\x3f\xc4\x6a\x5d\xb1\x77\xb9\x43\xfd\x17\xf0\xa4\xdb\x79\xa5\x6b\xdc\xb0\x61\x4d\x11\xc6\xc3\xec\xab\x5b\x6e\x1e\xcb\x2d\x35\xdf\x85\xf3\xf8\xdb\x5b\x88\x63\xa0\xf8\x06\x73\xe0\xfa\x2a\x65\x56\x2d\xb1\x61\x26\x87\xe7\x30\x83\xf2\xab\xbd\xae\x6e\x2b\x1e\x22\x92\x33\x92\x7d\x28\x4c\x97\x07\x8a\xf7\xb9\x94\x6e\xeb\xde\xf9\x13\x6e\x0f\xb4\x59\xf6\x18\x6e\xc1\xc3\xd9\xef\xa5\xce\xe3\x52\x4f\x2e\xfb\xd0\xe7\x77\xbe\xdc\x12\x52\x0b\x9a\x86\x4d\x8f\xa8\xe5\xc9\xe7\xef\xc9\xb2\x29\x04\x84\xe2\xdc\xf2\xaa\xfd\x08\xc3\x8a\x6b\x4c\x89\xa8\x90\x28\x8c\xee\xb7\xe3\x8b\x85\x28\x3d\xc0\x50\x6a\x61\xe2\xaf\xed\x0c\xbf\xc0\x31\x8a\x06\xcb\xa7\x30\x97\xe5\xd3\x4e\x8a\x53\xde\xf4\x53\x42\x7a\x5a\x4b\xbc\x47\x4e\xbc\xdf\xec\xcc\xf3\x7d\xa6\x68\x35\x5d\x55\x91\xf0\xa6\x73\x55\x4e\xb7\x6c\xe2\xd9\x3c\xcb\x8b\x94\x85\x58\x1a\x60\x29\x85\xc3\x56\x85\xa2\x16\xe6\x48\x50\x5e\x9e\xcd\xa7\x2b\x1e\x5a\xac\x1b\x45\x64\x9a\x88\x2f\x28\x0a\xd6\x94\x97\x4b\x83\xf8\x7e\x97\x81\x21\x30\x26\xdb\xaa\x46\x58\x2a\x1e\x0d\x2a\x44\x6f\x00\xe3\xfa\x51\x90\x7c\x92\xd3\x98\x7d\x23\x4f\xd4\xa9\x05\xfd\xb8\xa7\x9a\x52\x87\x44\xf7\x5b\x16\x3b\x48\xed\xd4\x4f\x1c\x7a\xb2\x9a\xf1\x44\x58\xb5\x19\x37\x80\x19\x53\xa0\x73\x54\x1b\x6e\xdd\xb6\x12\xda\x6b\x83\x9b\x14\x4b\xe2\x2f\xc5\x31\x3b\x95\x5d\x83\x61\xd3\x81\xc5\x5c\x33\x72\xe9\xa0\x4a\x0c\xd4\x0a\x4e\x86\x56\xbf\x4b\x87\xb5\xbe\xc8\x5b\x47\x2f\x0b\x6a\x26\x63\x96\xd5\xc2\x54\x8d\x9b\x02\xce\xe7\x60\x2d\x1d\xa9\x0b\x8f\x97\xbc\xc8\x55\x22\xcf\x5e\xe8\xf2\xe1\xce\xf3\xc4\xc9\x1c\x2f\xee\xc2\x30\x24\x33\x4e\xc5\xdc\x6b\x4a\x56\xb9\x77\x5b\xe7\x1c\x94\x1b\xfe\x62\xf1\xf0\x52\x2d\x50\xbd\x93\xa7\x89\x70\xd5\xc3\x6a\xc7\x6f\x62\xa1\xb3\x9e\x58\x30\x4a\xf3\x16\x45\x29\xbd\xab\x84\x6e\x64\x0d\xc2\xb9\x30\x5f\xa9\x6d\x58\xc8\x3e\x59\x72\x75\xba\x3c\x2f\xdc\x0e\xaa\x46\x0c\x05\x78\x88\x66\x1f\xa5\x88\x01\x27\x55\xf1\xcd\x5b\x14\x88\x74\xd7\x94\x98\xcf\x7f\xc5\x05\xed\xd3\xb7\x26\xbf\x9f\xbc\xcd\x15\x82\x26\xb2\x55\x2b\x59\xc5\xc5\x52\xba\x50\x4a\x17\x49\xb2\x40\xf2\x42\xf5\xfd\x06\x11\x4e\xe1\xfa\x8a\xbd\xff\x8b\x45\xe4\x1e\xd7\x49\x5f\x5d\x58\x06\xa8\xfa\xd3\x6f\x11\xc7\x02\x1b\xf3\x6b\xc5\xcc\xca\x34\x89\x79\xe4\x3a\x65\x87\xef\x8e\xab\x4e\xe6\x15\x57\x1d\x14\x73\xf1\xf1\x74\x9b\x40\x3d\xf7\xd4\x90\xfc\x90\x10\xd5\x69\xf9\x91\x03\xf6\x91\x3c\x82\xbc\x33\x5a\x98\x8f\x53\x4b\x93\xb8\x73\xd0\x6d\xaa\xaf\x2b\x32\xbb\x6e\x61\xbd\xca\x0c\xc9\x2a\x23\xc5\xe9\x87\xe5\x69\xd1\x38\xe9\x20\x56\x19\xeb\xa1\x02\xef\x7c\xbe\x23\x8f\xdc\x32\xbc\x0f\xed\x9f\x05\xe4\x1b\xf3\x88\x78\x8c\x8e\xf2\xc7\xb9\x0b\x83\xbd\x3f\x3b\x89\x8f\x96\x12\xef\x3e\xa3\x05\x68\x5c\x37\x1f\x27\xba\x49\x76\x2d\x0a\x49\x3c\x65\x9c\xfc\x81\x2b\xca\x26\xdf\x71\x5e\xaa\x85\x06\x45\x68\x4c\x02\x52\x5c\x1c\x65\x45\x72\xac\xc6\x45\x25\xe4\xa9\xf9\xfe\xa2\xc8\x26\x99\x79\xec\xaf\x9c\xfa\x9f\x61\x39\xc3\xdc\x65\xaa\x1e\x08\x88\xb0\xe0\x76\xe1\xd4\x2e\x86\x2b\xd2\x0f\xd8\x17\x20\x26\xa9\x4a\x7d\x2b\x16\x59\x20\x1a\x2d\xa9\x62\xe1\x5a\x9b\xba\x51\xa3\xa5\x5c\x42\xe6\xf2\xd5\x6d\x1c\x4f\xc4\xf1\x12\x82\x8b\x74\xc6\x0c\x48\x09\x66\x34\x47\xee\x03\xed\x7c\xac\xac\x3a\xc4\xdc\x33\xfb\xd7\x75\xa1\x52\x09\xa3\xaa\xee\xb5\x66\x6c\xf2\x32\x19\xd0\xad\x1c\x31\x29\x72\xc9\x85\xa0\x78\x1c\x92\x29\x47\xc6\xd3\x6c\xac\x30\xca\xef\x8c\xd5\x11\x73\xa8\x2d\x6a\xdc\x39\xc6\x1e\x51\xfb\xb6\xe5\x8d\xa9\xdc\x8a\x01\x15\xf6\x74\x97\x90\x32\x21\x38\xf0\xcb\x71\x2f\x98\x58\xb0\xad\xfa\xb7\x43\x40\xd1\x2f\xfb\x0b\x24\xbe\x15\x99\xda\x6b\xcd\xdf\x10\xcf\xd6\x91\xf6\x8c\xb2\x5b\x3b\x05\x4b\x70\xfc\x0a\xd8\x04\x38\xf6\x18\x4f\xda\xe4\xa7\xbe\x44\xc8\x73\xd7\xbf\x4b\x2e\x7f\x67\xdf\xe7\x70\x31\xc9\x9e\x2f\xc7\x28\xff\x61\xbf\x87\xc4\xcd\xbe\x09\x68\xb0\xb2\x6e\x28\xe6\x2b\xa2\xbb\x75\xcf\xd1\x14\x03\xa1\x3e\xfe\x5c\x80\x3e\x41\x81\xc0\xcd\xb1\x2c\xde\x05\xcd\xdf\x4f\x34\x19\x65\x68\xc7\x37\x32\xec\x8b\x89\x06\x69\xeb\x1e\xe5\x52\xa4\x4f\xe7\x49\x24\xac\x65\x0d\x08\x05\x8c\xbc\x99\x4d\xf4\x03\x92\x91\xbf\x40\x99\x92\xd1\xeb\x19\x42\xfc\xdc\xa9\x33\x43\x4c\xf2\xb4\x09\x41\x4a\x4a\x02\x4c\xa7\x72\xa6\x25\x85\x84\x6a\x4e\x20\x24\x74\x2e\xb1\xd0\xd9\x8d\xdb\x19\xf1\x66\xe6\x13\x16\x6a\x5f\x54\x4b\x53\xc0\xa6\x02\xd2\x74\xb8\xa8\x16\x8f\x2a\xc2\xf3\x5b\x01\xe5\x1b\x01\xe9\xc9\xc7\x9d\xf4\x51\x48\x28\x09\xe7\xe1\x10\xfa\xd9\x23\xf4\xd9\x3c\xda\xde\x1a\xf4\x2a\x79\x99\x67\x95\xc5\x4f\x03\x3d\xfe\xd2\xb6\xcb\xca\xf8\x61\x13\x4e\x9e\xc4\x27\xeb\x63\x9e\x80\x64\x30\xc1\xd2\x9b\x75\xe1\xb5\xfa\x8f\xd2\x9b\xe9\xbb\xdb\x19\xa6\x80\xc3\x48\x2e\xba\x4b\xd9\xe4\x2a\xb9\x8a\x24\x78\xce\x64\xa6\x4c\x4b\x6a\x3c\x30\xee\xab\xda\x6c\xa5\xb1\xf5\xff\xcf\xec\xce\x79\x5c\xc4\x44\xc4\x49\x1e\x1f\x73\xe5\xc4\x7a\x9c\x48\xcc\x09\xea\x9a\x6d\x91\x05\x95\xe8\x73\x6a\xfc\x53\x5d\x09\x44\x58\x52\x1b\x92\x00\xf1\xe4\x23\x84\x76\x17\x0c\x97\x09\xe0\x4b\xf0\x02\x34\x17\x38\xce\x88\x9f\xff\x7a\x62\x4e\xcf\x84\xd6\xf6\x09\xc0\x91\x5a\x5c\x9a\xe5\x89\xf1\xd3\xfd\x8d\x7f\x8d\xe8\x22\x05\xeb\xe8\xf1\x4b\x63\xe4\x44\x06\xe7\x35\xe3\x89\x4c\x6c\x58\xb2\xac\xe6\xe4\xa5\xe3\xa5\x08\x7b\x00\x39\xc3\x84\x6b\x49\xd8\x50\x46\x57\xfd\x86\x09\x53\x1f\x75\x51\x39\x2f\x43\xd8\xb0\x95\x0e\x72\x79\x79\x29\xae\x03\x67\x57\x07\x90\xf0\xec\xf7\x59\xe3\x8b\xd5\x91\x80\x11\xa2\xfe\x28\x09\x9e\xef\x83\xd2\x46\x02\xa4\x1a\xbf\x63\xc3\x58\x7b\x86\x95\xcf\xab\xcf\xc1\xfa\xd8\xd7\x3b\x1c\xc4\xb4\xd1\xcb\x08\x88\x30\x12\xad\x33\x88\x99\x13\x1f\x27\xf0\xe6\x41\x7c\xc6\xc2\xa2\x4c\x61\xd3\x4d\x17\x6c\x14\x30\x1f\x3b\xaa\xa3\xb8\x88\x73\xa2\x6c\xeb\xc4\x84\xb4\x76\xc5\x5a\x35\xeb\x39\x06\x70\x5f\x55\x2d\xe4\x22\x50\xda\x81\xf1\x50\x3f\x11\x18\x71\x6f\x56\xbe\xc2\xb2\x05\xa6\x1b\x65\x0b\xca\x92\x85\xe5\x2b\xab\x66\x45\xdd\xce\x30\xc7\xce\x72\xca\x86\x74\x56\x15\x1c\xc6\xdf\x23\x32\xab\x03\x88\x51\xe6\x06\x79\x3d\x39\x97\x8a\x4b\x97\x1b\x70\x69\x91\xa0\x7e\xc6\xd2\xa2\xfe\xa9\xc3\xf7\xcb\x0d\x9d\x51\xbd\x8c\xc3\x9c\xcb\x6c\xa1\x25\x43\x98\x1b\xed\x8c\x9b\x49\xbf\xfc\x7f\xff\x54\x7d\x7f\xb8\xd4\x62\x73\x79\x72\xfc\xcb\x51\x49\x1f\x8f\xd1\x4f\x73\xea\x49\x72\x83\xf3\xfd\x0f\x4f\x5f\x5d\x9a\x21\xdf\x9e\x5d\x76\xe1\x27\x76\xab\x8e\xea\x6c\xc0\x82\xcd\xb5\x62\x50\x94\xa3\xc4\x12\x28\x1e\xf4\x7b\x19\x38\x46\x35\xad\x28\xa1\x54\x8b\x85\xc5\xfe\xa3\x54\xce\xca\x56\x67\x2e\x79\x69\x62\x5b\xc5\x37\x2d\x71\x97\xe8\x56\x74\xc4\xb5\xe8\x98\x14\xb5\x41\x52\xbd\x8d\x59\x03\x97\xe6\x60\xfe\x65\xd3\xe5\xea\xae\xd5\x1f\xc0\x85\xaf\xc1\x27\xa0\x7f\x70\x6f\x04\x00\x5c\x5e\x5e\xfe\x3b\xea\xfc\xa7\x9c\x0c\x73\x87\x91\xc4\xf7\xf4\x92\x10\x5d\x8f\x62\xca\xd1\x48\xc4\xa5\x30\xcf\x15\x55\x77\xc4\x38\x20\x57\x58\x21\xfd\xb7\xc1\xce\x17\x51\x2c\xe9\x9e\xaa\x3b\x2d\x96\xbe\x41\x32\xdb\xc3\x98\x21\x01\x11\xe6\x21\x11\x22\xbe\xc4\x28\xb0\x89\x44\xb3\x53\x5e\x69\xd7\x53\x26\x71\x37\xc1\xcf\x18\x9d\xac\x82\x8c\x92\xf8\xf8\x18\x38\x11\x56\xef\x6a\xf5\x15\x7b\x96\x5a\xe6\x2a\x94\x52\xb9\x02\x2a\x71\x04\x1d\xfd\x52\x50\x7b\x8d\xa4\xa4\x7d\x37\xf5\xd6\xca\x0a\x49\xe9\x2d\xaf\x04\xad\xb8\x92\x94\x0d\x54\x85\x24\xfa\x69\xfc\xd0\xfc\x78\x1d\xbb\x76\x3f\x7f\xb8\x70\xb6\xc1\x66\x52\x46\xad\x56\x9e\xda\x46\x1f\xed\xc9\x1d\x10\x31\x8c\x6e\xbf\x59\x38\x35\xad\x1d\x8f\x60\x39\x00\xe2\x0f\x95\x4b\x3b\x12\x84\x5e\x8d\x7a\xdd\xbe\x7b\x7c\xc1\x85\xd4\xba\xd3\xa5\x6c\xbd\xbd\x2e\x36\xed\x41\xda\x39\xfc\x4f\xd8\x14\xce\x09\xbd\x4a\x1f\x27\x49\x72\x68\x3b\xad\xcb\xd2\x60\x9d\xbc\x26\x70\x3d\xd3\x3c\xe4\x2c\xe1\x7b\x47\xfc\xbb\x91\xfd\x6d\xe2\x62\x46\xb7\x03\xc2\x1e\xaf\x2a\x9f\xda\xd1\x07\x67\x46\xf9\x5b\x18\x9d\xb2\x5b\x18\xc5\x24\x4a\xf5\xad\xf5\x30\x2c\x26\xce\xb3\xa5\xf6\xef\xff\xe4\x5e\x49\x22\x03\x33\x01\x4d\xd3\x3a\xd5\x83\xab\xbf\x70\x1e\x48\x32\x0a\x08\x2d\xad\x40\x94\xfa\xe7\xf6\x92\xaf\x4c\x0c\xbd\x51\xb0\xe0\x84\xd0\xb2\x96\x31\xe2\xcb\xdb\x54\xa6\x56\xcd\xdf\xe7\xce\x94\xb3\x79\x34\x84\x36\xa6\x7e\xc4\x08\x95\xc5\xfa\x01\x62\xc6\x6e\x47\x28\x08\xee\x4f\xce\xf9\x8c\xdd\x2a\x7b\x5f\x4d\xcc\xb2\x16\xf7\x24\x45\xb2\x88\x78\x35\x3b\x41\x2c\x0c\x11\x08\x1c\x21\x93\xa6\x4c\xae\x4b\x1b\xe3\xa9\x01\xe8\xe5\x2a\xca\x45\xe8\xa2\xba\x41\xf5\x89\x8c\x0c\x6d\xbd\xe8\x5c\x9c\x85\xc4\xd1\xfd\x33\x60\xb9\x0d\xd0\xdc\x52\xab\x14\x64\xf3\x47\xa8\xc0\x5c\x8e\xb4\xd3\x58\xd5\xa6\x3a\xac\x2c\xfe\x1d\xfa\xbe\x00\x04\xde\x5c\x48\x16\x1a\x5f\x34\xf1\x46\x3c\xa6\xdd\x13\x19\x5b\xfe\xd8\xdf\x8d\xcf\x6b\x02\xa1\x20\x39\xa2\x82\xc8\x6e\x25\xf8\x7a\x72\x4c\xac\xbf\x94\x96\xd2\x2c\x89\x7d\x80\xc2\x20\x2d\x99\x3e\x1e\xe1\xfb\x25\xb9\xef\x12\xe1\x78\xad\x3a\x2d\x6f\x58\x2d\x24\xf6\x5f\xe1\x7c\x64\x03\xec\x75\x1f\x07\xfd\x26\x28\xeb\xd3\x80\xf7\x47\xb9\x7c\x73\x3c\x2f\x89\x75\x58\x25\xe7\x38\x6b\x70\x3e\xd6\xe2\x6a\xb8\x0d\x87\x5e\xc9\x31\xb3\x46\x0a\xbe\x19\xe6\x1d\x67\x75\xb4\xee\x30\x46\x93\x15\x88\x3f\x4b\x8e\xbc\xd5\x96\xe0\x91\xe9\x03\x28\x16\xd6\x09\x67\xe6\x1b\x93\x63\xe6\x2f\xfe\xc2\xcb\xe7\x21\x64\x31\xc6\x28\x61\xf1\xd7\x12\x35\x47\x0c\xbe\x94\xac\xcd\x90\x18\xcd\x30\xf2\x31\x1f\x99\xe4\x67\x43\x79\x7b\xad\x1b\xc3\x18\x09\xb3\x1d\xaa\x63\x3c\x7d\x58\xc7\xd3\xf3\xce\x28\x06\x03\xf7\x9e\xc2\x57\x76\x3e\xa5\x46\xf6\xcc\xb8\xba\x27\x48\x06\x58\xe9\x91\xec\x8e\x6c\xd5\x9a\x33\x11\x43\xdc\xf9\x14\x85\xb8\x89\x94\xfe\x64\x86\xaa\x6f\xfe\x70\xb2\x4a\x97\x8d\x95\xa0\x85\x44\x82\x5a\x3c\x51\x5f\x5e\x5c\x0b\x92\xd4\x4c\x64\xb3\x08\xb0\x71\xe8\xf7\x66\x71\xc2\xa6\xf6\x76\x42\xcd\x5d\xe2\x5c\x3d\xac\x92\x4f\x21\x59\xd5\xcb\xa0\x7d\x30\x16\x37\x3d\xb1\x27\x29\xde\x9b\xf6\x06\xd3\xd9\xce\x74\xdb\x8a\x7e\x0a\xf7\xf0\xad\x3e\xbb\x63\x3e\xe1\xbd\xde\x20\x9a\xd0\xab\x59\xcf\x1d\x20\x29\x93\x07\x6d\xc1\x6f\xbc\x0e\xf2\x3c\xd9\xe9\xef\x0e\xf0\x64\xe0\xef\x77\x7a\x83\xde\x41\x67\xbb\xdf\xdf\xeb\xec\x6f\xef\x0e\x3a\xfe\x64\x77\xcb\x1b\xf4\x06\x3b\xde\x60\xb7\x04\x4a\x5c\x42\x0f\xda\xe3\xfe\xf6\xb6\x7f\x70\xd0\xef\xf4\xf6\xf1\xb8\xb3\xbd\xbd\x37\xe8\xec\x63\xaf\xdf\xc1\xe3\xde\xd6\xb6\xb7\x7b\x30\xd8\xea\x8f\xed\xfe\xaa\x66\x20\xb4\x27\x8c\x75\xca\xf0\xed\x5e\x21\xd1\x45\x5e\x88\xbb\x1e\x0b\x87\xdb\xdb\x5b\xed\x26\xf7\xfb\x2d\xf2\x7b\x57\xfb\x01\x9d\xf6\xb6\xfa\x02\x1f\x5c\x37\x20\x1f\xf7\x06\x3b\x83\xdd\x1d\xdc\x41\xfb\xfb\xa8\xb3\xbd\x3d\x19\x77\xf6\xb7\x77\x7a\x1d\xec\xf7\xfa\x3d\x3c\xde\x1d\x7b\x3b\xde\x32\xf2\x7d\x6f\x07\xed\x0f\x0e\xf6\x3b\x63\xec\xef\x75\xb6\x07\x03\xdc\xd9\x3f\xd8\xde\xeb\x4c\x76\x27\x3e\xda\x3d\x18\x1c\x0c\x26\x93\x22\xf9\x63\xc4\x63\xf2\x07\xe1\xc4\x43\xbd\xde\x40\x1e\x5c\xef\x89\x69\x57\xf0\x2a\xf2\x93\x4b\x6d\xf9\xb0\xbb\x78\x3d\x0e\xda\xe5\x31\x7f\xe9\x15\xb4\xb2\xc8\x35\x8d\xbd\xec\xd4\x52\x3e\xce\x14\x85\xb7\x71\xac\xa3\x27\x77\x63\x8c\xdc\x8f\x22\xa4\x41\x77\xae\xfe\x1d\x5e\x54\x1d\x9f\x6e\x9f\x5f\x9c\x1d\x9f\xfe\xd8\x76\x5e\x97\xfa\xa1\x69\x8f\x9f\xcf\xdf\x9e\xe6\x8a\xcc\xc5\x31\xfd\xb0\x55\xed\x42\xe5\xc1\xa5\xd9\x1d\xfd\x56\xa9\xd5\x62\x78\x9a\xe4\xc2\x74\x93\xf8\x02\x8b\x65\x0b\xac\xeb\x83\xb9\xba\x21\x3a\x9d\x37\x4a\xaa\x3a\xb8\x67\x92\x91\x3f\x0a\xb0\x54\x3a\xe0\x7a\x8e\xf3\x64\x6a\xee\x2a\x81\x0b\xae\xcd\x50\xd5\xdf\x1c\x2e\x49\x35\xb5\xfb\x3d\x4b\x96\x62\x65\x94\xab\x72\xbc\x3c\x3b\xa3\x11\x17\x9b\x0e\x1c\x5d\x9f\x16\xda\x2f\xdf\x9e\x9e\x1e\xbd\xbc\x78\x7b\xd6\x79\xf3\xe3\x9b\x8b\x8e\xd3\x24\xae\x4a\x0b\xed\x73\xeb\xcb\xe2\xc9\x37\xc7\x05\x50\x26\xb3\x03\x6c\x26\xfb\xab\xbf\x41\xfe\x83\x92\xad\x62\x85\xb3\x5c\xd9\x5a\x68\xf7\xc9\x87\x63\x12\x5e\xff\xe8\xf1\x57\xf3\x93\xdd\x3e\x7a\xff\xf9\xf8\x5f\xd7\x2f\x2e\xae\x4f\xcf\x50\xca\xa5\x63\x93\x4d\xfd\x55\x25\x41\x1b\x70\x6a\xf0\x40\x9c\x1a\xd4\x32\x6a\x50\xc2\xa7\x6c\xef\x06\xe0\xb5\xae\x27\x03\x92\x29\x46\x08\xec\xec\x25\xa8\xb2\xd0\x4a\x0f\xa8\xb7\x3a\x63\x60\xd2\x05\xf1\x9d\x00\xb3\x8d\x8e\x22\x32\x32\x49\xb5\xb8\xd4\xca\x10\x0a\x18\x0c\x57\x18\x2f\x9d\x28\xf0\x58\x30\x0f\xa9\x5e\x27\x7a\x24\xd3\x72\x08\xcf\x89\xff\xbc\x0b\xe7\x65\xed\xf4\xae\x8a\x3d\x9a\x52\xe4\x6a\x4b\xd1\xec\x75\x7a\x01\x9b\xfb\xa3\x38\x23\xcf\x93\xa7\xa6\x3a\x42\x17\x7e\x35\x99\x71\x33\x91\xfa\xce\xcd\x0f\xd0\x1f\x6c\x55\x4a\x45\xf0\xe1\xd5\x8f\xf3\xc5\xf8\x98\x1f\xd1\xcf\xfc\x10\x87\x7b\x83\xed\xe9\xf5\xd5\x15\x79\x75\x93\x48\xc5\x76\x03\x49\x50\x75\xdb\x1f\x42\x12\xf6\xea\x04\x61\xaf\x64\xbd\x34\xf9\x2a\x73\x4a\x4c\xe9\x57\x34\xca\x48\xda\x7b\x3c\x82\x5e\x3a\x5f\x45\x03\xe2\xff\xf0\xbc\x4f\x7e\xd9\xf2\xe7\xbf\x7d\x3c\xbe\xb9\xd9\xf9\x78\x73\x12\x2c\xfe\xe8\x87\x3f\x9e\x6d\xfd\xbc\xb8\x3e\x7d\x0e\x94\x49\x98\xb0\x39\xf5\x97\x2c\xfe\x8f\x6f\xf7\xa6\x83\xe9\xee\x4f\x17\xfe\xfb\x5f\xde\xa3\xc1\x95\xf8\x69\x7f\x70\xf5\xeb\xab\xad\x45\xc2\x99\x7e\x13\xd5\xd8\x7f\x18\xcd\xd8\xaf\x55\x8c\xfd\x12\xb6\x64\xcb\xf8\x06\x73\x32\x59\xa8\x4d\x0b\xf3\x2d\x01\xf5\x85\x65\xe3\xf0\x02\x9a\xcb\x99\x3a\x8d\x9c\xd4\xc5\xbc\xc2\xb4\x19\x7f\xb6\xde\xcf\x8e\x66\xb7\xe1\xef\x2f\xa2\x0f\xef\x26\xc7\x83\xe0\x14\x5f\x45\xfe\xf6\xbf\x5e\x25\xfc\x39\x50\xe6\x4d\x95\x89\x08\x88\x27\x1b\xf0\x6a\x6b\xf7\x41\x78\xb5\xb5\x5b\xc7\xab\xad\xdd\x12\x5e\xbd\x4c\x4e\x36\x1b\xcd\x43\x04\xa0\x40\x9b\x57\x7d\x3e\xb1\x92\x0f\xbb\x57\x1f\x7b\xef\xc9\xd1\xd5\x1f\x57\xbf\xbf\xfc\xe3\xc3\x3b\x7c\x3c\x60\x1f\xf1\xcc\xdf\x3a\x8a\xd9\x50\x2c\xdf\x5f\x46\xfa\xc1\x83\x50\x7e\x50\x47\xf8\x41\xa9\x8c\x64\x9f\xfb\xc1\xee\xa0\x85\x29\xc7\x47\x27\x37\xaf\x0f\x3e\xbd\xf9\xf5\xe3\xee\xc7\xe9\x6c\xf2\xe6\x60\xfa\xe3\x99\xf8\xe9\xe6\xe8\x43\x4a\x6b\x63\x65\xf1\x78\x14\xdb\x56\x50\x8f\x99\x96\x52\x03\xe5\x1d\x08\x2c\x87\xf0\xf6\xe5\x9b\xce\xd1\xef\x9d\x83\x61\x5c\x77\x0d\x64\x7a\xfb\x2a\x6d\x83\x3f\xcb\x4e\x6c\xfb\x50\x44\x3a\x7d\xf2\xb9\xb7\x15\x50\x3f\x08\xaf\x7b\xd7\x13\x6f\x4f\x10\x89\x76\x44\xf0\xe9\x66\x1f\xbb\x77\xbc\x93\x60\x4c\xf3\xa1\x3f\xdd\xf1\xf7\xf7\xaf\x7b\x01\xf7\xfc\x9b\xed\xe9\x1e\x0a\xc6\x7b\x22\x98\x4c\xe9\xa7\x2d\x7f\x36\x16\x9f\xfe\xf6\xbf\xfe\x7e\xf4\xfb\xc5\xd9\x21\xfc\x1f\x43\x71\x57\x63\xfc\x03\xf1\x31\x95\x6a\xce\xec\x20\x94\x08\x78\xbe\xdd\xdb\x7e\xbe\xa1\x79\xa1\x7f\xbe\x3c\x79\x7f\x7e\x71\x74\x76\x6e\x98\xa1\x5e\xea\xbd\xd4\x74\x62\x21\x03\xa4\xdb\xf7\xa7\x3b\x8c\xef\xf4\x6e\xc8\xbc\xb7\xc7\xb0\x9a\xb6\x19\xbf\xf2\x06\xbb\xfe\x74\x22\x3f\xf5\x91\xf7\xdc\x29\x33\x1e\xd3\xf1\xbc\x8e\x08\x4b\xdf\xfe\xa3\x5a\xb8\x3e\x5e\x88\x0f\x7c\xb1\x4b\xc5\xf5\x78\x20\x4e\xc3\xd7\x9f\x76\xc6\xbf\x47\xaf\xf6\x5e\xa2\x76\xeb\x7f\x06\x00\x06\x8b\x30\xc2\xf1\xe8\x00\x00")
 
 func connector_mgmtYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -93,7 +93,7 @@ func connector_mgmtYaml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "connector_mgmt.yaml", size: 41252, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "connector_mgmt.yaml", size: 59633, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
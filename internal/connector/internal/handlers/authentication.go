@@ -3,6 +3,7 @@ package handlers
 import (
 	"fmt"
 
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/keycloak"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/server"
@@ -12,7 +13,7 @@ import (
 	pkgErrors "github.com/pkg/errors"
 )
 
-func NewAuthenticationBuilder(ServerConfig *server.ServerConfig, KeycloakConfig *keycloak.KeycloakConfig) (*authentication.HandlerBuilder, error) {
+func NewAuthenticationBuilder(ServerConfig *server.ServerConfig, KeycloakConfig *keycloak.KeycloakConfig, OIDCConfig *auth.OIDCConfig) (*auth.AuthenticationBuilder, error) {
 
 	authnLogger, err := sdk.NewGlogLoggerBuilder().
 		InfoV(glog.Level(1)).
@@ -23,16 +24,27 @@ func NewAuthenticationBuilder(ServerConfig *server.ServerConfig, KeycloakConfig
 		return nil, pkgErrors.Wrap(err, "unable to create authentication logger")
 	}
 
-	return authentication.NewHandler().
+	newBuilder := func() *authentication.HandlerBuilder {
+		return authentication.NewHandler().
 			Logger(authnLogger).
-			KeysURL(ServerConfig.JwksURL).                      //ocm JWK JSON web token signing certificates URL
-			KeysFile(ServerConfig.JwksFile).                    //ocm JWK backup JSON web token signing certificates
-			KeysURL(KeycloakConfig.KafkaRealm.JwksEndpointURI). // mas-sso JWK Cert URL
 			Error(fmt.Sprint(errors.ErrorUnauthenticated)).
 			Service(errors.CONNECTOR_MGMT_ERROR_CODE_PREFIX).
 			Public("^/api/connector_mgmt/?$").
 			Public("^/api/connector_mgmt/v1/?$").
 			Public("^/api/connector_mgmt/v1/openapi/?$").
-			Public("^/api/connector_mgmt/v1/graphiql/?$"),
-		nil
+			Public("^/api/connector_mgmt/v1/graphiql/?$")
+	}
+
+	builder := &auth.AuthenticationBuilder{
+		Default: newBuilder().
+			KeysURL(ServerConfig.JwksURL).                      //ocm JWK JSON web token signing certificates URL
+			KeysFile(ServerConfig.JwksFile).                    //ocm JWK backup JSON web token signing certificates
+			KeysURL(KeycloakConfig.KafkaRealm.JwksEndpointURI), // mas-sso JWK Cert URL
+		Issuers: map[string]*authentication.HandlerBuilder{},
+	}
+	// the OpenID Connect providers configured with --oidc-issuer-url only verify the tokens of their own issuer
+	for _, provider := range OIDCConfig.Providers {
+		builder.Issuers[provider.Issuer] = newBuilder().KeysURL(provider.JwksURI)
+	}
+	return builder, nil
 }
@@ -69,6 +69,37 @@ func (h *ConnectorAdminHandler) ListConnectorClusters(w http.ResponseWriter, r *
 	handlers.HandleList(w, r, cfg)
 }
 
+func (h *ConnectorAdminHandler) ListDeprecatedConnectors(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			if err := isAdmin(r); err != nil {
+				return nil, err
+			}
+
+			listArgs := coreservices.NewListArguments(r.URL.Query())
+			deprecations, paging, err := h.ConnectorTypes.ListDeprecatedConnectors(listArgs)
+			if err != nil {
+				return nil, err
+			}
+
+			resourceList := private.ConnectorDeprecationList{
+				Kind:  "ConnectorDeprecationList",
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+				Items: make([]private.ConnectorDeprecation, len(deprecations)),
+			}
+			for i := range deprecations {
+				resourceList.Items[i] = presenters.PresentConnectorDeprecation(&deprecations[i])
+			}
+
+			return resourceList, nil
+		},
+	}
+
+	handlers.HandleList(w, r, cfg)
+}
+
 func (h *ConnectorAdminHandler) GetConnectorUpgradesByType(writer http.ResponseWriter, request *http.Request) {
 	id := mux.Vars(request)["connector_cluster_id"]
 	listArgs := coreservices.NewListArguments(request.URL.Query())
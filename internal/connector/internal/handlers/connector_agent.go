@@ -271,3 +271,60 @@ func (h *ConnectorClusterHandler) UpdateDeploymentStatus(w http.ResponseWriter,
 	}
 	handlers.Handle(w, r, cfg, http.StatusNoContent)
 }
+
+func (h *ConnectorClusterHandler) PushDeploymentLogs(w http.ResponseWriter, r *http.Request) {
+	connectorClusterId := mux.Vars(r)["connector_cluster_id"]
+	deploymentId := mux.Vars(r)["deployment_id"]
+	var resource private.ConnectorDeploymentLogs
+
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &resource,
+		Validate: []handlers.Validate{
+			handlers.Validation("connector_cluster_id", &connectorClusterId, handlers.MinLen(1), handlers.MaxLen(maxConnectorClusterIdLength)),
+			handlers.Validation("deployment_id", &deploymentId, handlers.MinLen(1), handlers.MaxLen(maxConnectorIdLength)),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			deployment, err := h.getClusterDeployment(r, connectorClusterId, deploymentId)
+			if err != nil {
+				return nil, err
+			}
+			return nil, h.Telemetry.SaveLogs(&deployment, presenters.ConvertConnectorDeploymentLogs(resource))
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusNoContent)
+}
+
+func (h *ConnectorClusterHandler) PushDeploymentMetrics(w http.ResponseWriter, r *http.Request) {
+	connectorClusterId := mux.Vars(r)["connector_cluster_id"]
+	deploymentId := mux.Vars(r)["deployment_id"]
+	var resource private.ConnectorDeploymentMetrics
+
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &resource,
+		Validate: []handlers.Validate{
+			handlers.Validation("connector_cluster_id", &connectorClusterId, handlers.MinLen(1), handlers.MaxLen(maxConnectorClusterIdLength)),
+			handlers.Validation("deployment_id", &deploymentId, handlers.MinLen(1), handlers.MaxLen(maxConnectorIdLength)),
+			validateConnectorDeploymentMetrics(&resource),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			deployment, err := h.getClusterDeployment(r, connectorClusterId, deploymentId)
+			if err != nil {
+				return nil, err
+			}
+			return nil, h.Telemetry.SaveMetrics(&deployment, presenters.ConvertConnectorDeploymentMetrics(resource))
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusNoContent)
+}
+
+// getClusterDeployment returns a deployment, if it's assigned to the connector cluster
+func (h *ConnectorClusterHandler) getClusterDeployment(r *http.Request, connectorClusterId string, deploymentId string) (dbapi.ConnectorDeployment, *errors.ServiceError) {
+	resource, err := h.Service.GetDeployment(r.Context(), deploymentId)
+	if err != nil {
+		return resource, err
+	}
+	if resource.ClusterID != connectorClusterId {
+		return resource, errors.NotFound("Connector deployment not found")
+	}
+	return resource, nil
+}
@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/gorilla/mux"
+	. "github.com/onsi/gomega"
+)
+
+// connectorClusterServiceStub finds the deployments of the connector clusters
+type connectorClusterServiceStub struct {
+	services.ConnectorClusterService
+	deployments map[string]dbapi.ConnectorDeployment
+}
+
+func (s *connectorClusterServiceStub) GetDeployment(ctx context.Context, id string) (dbapi.ConnectorDeployment, *errors.ServiceError) {
+	if deployment, found := s.deployments[id]; found {
+		return deployment, nil
+	}
+	return dbapi.ConnectorDeployment{}, errors.NotFound("Connector deployment not found")
+}
+
+func newTestConnectorClusterHandler(telemetryService services.ConnectorTelemetryService) *ConnectorClusterHandler {
+	return NewConnectorClusterHandler(ConnectorClusterHandler{
+		Service: &connectorClusterServiceStub{
+			deployments: map[string]dbapi.ConnectorDeployment{
+				"deployment-1": {Meta: api.Meta{ID: "deployment-1"}, ConnectorID: "connector-1", ClusterID: "cluster-1"},
+			},
+		},
+		Telemetry: telemetryService,
+	})
+}
+
+func TestConnectorClusterHandler_PushDeploymentLogs(t *testing.T) {
+	tests := []struct {
+		name      string
+		clusterId string
+		wantCode  int
+		wantSaved int
+	}{
+		{
+			name:      "should save the logs of a deployment of the cluster",
+			clusterId: "cluster-1",
+			wantCode:  http.StatusNoContent,
+			wantSaved: 2,
+		},
+		{
+			name:      "should not save the logs of deployments of other clusters",
+			clusterId: "cluster-2",
+			wantCode:  http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			telemetryService := &services.ConnectorTelemetryServiceMock{
+				SaveLogsFunc: func(deployment *dbapi.ConnectorDeployment, entries dbapi.ConnectorLogEntryList) *errors.ServiceError {
+					return nil
+				},
+			}
+			handler := newTestConnectorClusterHandler(telemetryService)
+
+			body := `{"items": [{"timestamp": "2022-03-01T12:00:00Z", "message": "started"}, {"message": "running"}]}`
+			recorder := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodPost, "/api/connector_mgmt/v1/agent/kafka_connector_clusters/"+tt.clusterId+"/deployments/deployment-1/logs", strings.NewReader(body))
+			handler.PushDeploymentLogs(recorder, mux.SetURLVars(r, map[string]string{"connector_cluster_id": tt.clusterId, "deployment_id": "deployment-1"}))
+
+			Expect(recorder.Code).To(Equal(tt.wantCode))
+			if tt.wantSaved == 0 {
+				Expect(telemetryService.SaveLogsCalls()).To(BeEmpty())
+				return
+			}
+			Expect(telemetryService.SaveLogsCalls()).To(HaveLen(1))
+			Expect(telemetryService.SaveLogsCalls()[0].Deployment.ConnectorID).To(Equal("connector-1"))
+			Expect(telemetryService.SaveLogsCalls()[0].Entries).To(HaveLen(tt.wantSaved))
+		})
+	}
+}
+
+func TestConnectorClusterHandler_PushDeploymentMetrics(t *testing.T) {
+	tooMany := make([]string, 0, maxConnectorMetricsPerSample+1)
+	for i := 0; i <= maxConnectorMetricsPerSample; i++ {
+		tooMany = append(tooMany, fmt.Sprintf(`"metric_%d": %d`, i, i))
+	}
+	tests := []struct {
+		name      string
+		body      string
+		wantCode  int
+		wantSaved int
+	}{
+		{
+			name:      "should save a metric sample for each value",
+			body:      `{"timestamp": "2022-03-01T12:00:00Z", "values": {"records_in": 10, "records_out": 5}}`,
+			wantCode:  http.StatusNoContent,
+			wantSaved: 2,
+		},
+		{
+			name:     "should reject too many metrics at once",
+			body:     `{"values": {` + strings.Join(tooMany, ",") + `}}`,
+			wantCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			telemetryService := &services.ConnectorTelemetryServiceMock{
+				SaveMetricsFunc: func(deployment *dbapi.ConnectorDeployment, samples dbapi.ConnectorMetricSampleList) *errors.ServiceError {
+					return nil
+				},
+			}
+			handler := newTestConnectorClusterHandler(telemetryService)
+
+			recorder := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodPost, "/api/connector_mgmt/v1/agent/kafka_connector_clusters/cluster-1/deployments/deployment-1/metrics", strings.NewReader(tt.body))
+			handler.PushDeploymentMetrics(recorder, mux.SetURLVars(r, map[string]string{"connector_cluster_id": "cluster-1", "deployment_id": "deployment-1"}))
+
+			Expect(recorder.Code).To(Equal(tt.wantCode))
+			if tt.wantSaved == 0 {
+				Expect(telemetryService.SaveMetricsCalls()).To(BeEmpty())
+				return
+			}
+			Expect(telemetryService.SaveMetricsCalls()).To(HaveLen(1))
+			Expect(telemetryService.SaveMetricsCalls()[0].Samples).To(HaveLen(tt.wantSaved))
+		})
+	}
+}
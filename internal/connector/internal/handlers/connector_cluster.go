@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/url"
 
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/audit"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
@@ -27,7 +28,8 @@ import (
 )
 
 var (
-	maxConnectorClusterIdLength = 32
+	maxConnectorClusterIdLength  = 32
+	maxConnectorMetricsPerSample = 100
 )
 
 type ConnectorClusterHandler struct {
@@ -37,6 +39,7 @@ type ConnectorClusterHandler struct {
 	Keycloak       coreservices.KafkaKeycloakService
 	ConnectorTypes services.ConnectorTypesService
 	Vault          vault.VaultService
+	Telemetry      services.ConnectorTelemetryService
 	KeycloakConfig *keycloak.KeycloakConfig
 	ServerConfig   *server.ServerConfig
 }
@@ -108,10 +111,16 @@ func (h *ConnectorClusterHandler) Update(w http.ResponseWriter, r *http.Request)
 				return nil, err
 			}
 
+			audit.SetPreviousState(r.Context(), presenters.PresentConnectorCluster(existing))
+
 			// Copy over the fields that support being updated...
 			existing.Name = resource.Name
 
-			return nil, h.Service.Update(r.Context(), &existing)
+			if err := h.Service.Update(r.Context(), &existing); err != nil {
+				return nil, err
+			}
+			audit.SetNewState(r.Context(), presenters.PresentConnectorCluster(existing))
+			return nil, nil
 		},
 	}
 	handlers.Handle(w, r, cfg, http.StatusNoContent)
@@ -123,6 +132,11 @@ func (h *ConnectorClusterHandler) Delete(w http.ResponseWriter, r *http.Request)
 			handlers.Validation("connector_cluster_id", &connectorClusterId, handlers.MinLen(1), handlers.MaxLen(maxConnectorClusterIdLength)),
 		},
 		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			if audit.IsAudited(r.Context()) {
+				if existing, err := h.Service.Get(r.Context(), connectorClusterId); err == nil {
+					audit.SetPreviousState(r.Context(), presenters.PresentConnectorCluster(existing))
+				}
+			}
 			err := h.Service.Delete(r.Context(), connectorClusterId)
 			return nil, err
 		},
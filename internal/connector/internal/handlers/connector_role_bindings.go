@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/audit"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/rbac"
+	"github.com/gorilla/mux"
+)
+
+type ConnectorRoleBindingsHandler struct {
+	connectorsService  services.ConnectorsService
+	roleBindingService rbac.RoleBindingService
+}
+
+func NewConnectorRoleBindingsHandler(connectorsService services.ConnectorsService, roleBindingService rbac.RoleBindingService) *ConnectorRoleBindingsHandler {
+	return &ConnectorRoleBindingsHandler{
+		connectorsService:  connectorsService,
+		roleBindingService: roleBindingService,
+	}
+}
+
+func (h ConnectorRoleBindingsHandler) List(w http.ResponseWriter, r *http.Request) {
+	connectorId := mux.Vars(r)["connector_id"]
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.Validation("connector_id", &connectorId, handlers.MinLen(1), handlers.MaxLen(maxConnectorIdLength)),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			resource, err := h.connectorsService.Get(r.Context(), connectorId, "")
+			if err != nil {
+				return nil, err
+			}
+			bindings, err := h.roleBindingService.List(r.Context(), services.ConnectorResource(resource))
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentRoleBindingList(bindings), nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+func (h ConnectorRoleBindingsHandler) Create(w http.ResponseWriter, r *http.Request) {
+	connectorId := mux.Vars(r)["connector_id"]
+	var request public.RoleBindingRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &request,
+		Validate: []handlers.Validate{
+			handlers.Validation("connector_id", &connectorId, handlers.MinLen(1), handlers.MaxLen(maxConnectorIdLength)),
+			handlers.Validation("subject", &request.Subject, handlers.MinLen(1)),
+			handlers.Validation("role", &request.Role, handlers.IsOneOf(string(rbac.RoleViewer), string(rbac.RoleEditor), string(rbac.RoleAdmin))),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			resource, err := h.connectorsService.Get(r.Context(), connectorId, "")
+			if err != nil {
+				return nil, err
+			}
+			binding, err := h.roleBindingService.Grant(r.Context(), services.ConnectorResource(resource), request.Subject, rbac.Role(request.Role))
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentRoleBinding(binding), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusCreated)
+}
+
+func (h ConnectorRoleBindingsHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	connectorId := mux.Vars(r)["connector_id"]
+	bindingId := mux.Vars(r)["binding_id"]
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.Validation("connector_id", &connectorId, handlers.MinLen(1), handlers.MaxLen(maxConnectorIdLength)),
+			handlers.Validation("binding_id", &bindingId, handlers.MinLen(1)),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			resource, err := h.connectorsService.Get(r.Context(), connectorId, "")
+			if err != nil {
+				return nil, err
+			}
+			if audit.IsAudited(r.Context()) {
+				if bindings, err := h.roleBindingService.List(r.Context(), services.ConnectorResource(resource)); err == nil {
+					for _, binding := range bindings {
+						if binding.ID == bindingId {
+							audit.SetPreviousState(r.Context(), presenters.PresentRoleBinding(binding))
+						}
+					}
+				}
+			}
+			return nil, h.roleBindingService.Revoke(r.Context(), services.ConnectorResource(resource), bindingId)
+		},
+	}
+	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
+}
@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/rbac"
+	"github.com/gorilla/mux"
+	. "github.com/onsi/gomega"
+)
+
+func newTestConnectorRoleBindingsHandler(roleBindingService rbac.RoleBindingService) *ConnectorRoleBindingsHandler {
+	return NewConnectorRoleBindingsHandler(&connectorsServiceStub{
+		connectors: map[string]*dbapi.Connector{
+			"connector-1": {Meta: api.Meta{ID: "connector-1"}, Owner: "owner", OrganisationId: "test-org"},
+		},
+	}, roleBindingService)
+}
+
+func TestConnectorRoleBindingsHandler_Create(t *testing.T) {
+	tests := []struct {
+		name        string
+		connectorId string
+		body        string
+		wantCode    int
+		wantGrants  int
+	}{
+		{
+			name:        "should grant the role on the connector",
+			connectorId: "connector-1",
+			body:        `{"subject": "other-user", "role": "viewer"}`,
+			wantCode:    http.StatusCreated,
+			wantGrants:  1,
+		},
+		{
+			name:        "should not grant a role on a connector the user can't see",
+			connectorId: "connector-2",
+			body:        `{"subject": "other-user", "role": "viewer"}`,
+			wantCode:    http.StatusNotFound,
+		},
+		{
+			name:        "should reject unknown roles",
+			connectorId: "connector-1",
+			body:        `{"subject": "other-user", "role": "owner"}`,
+			wantCode:    http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			roleBindingService := &rbac.RoleBindingServiceMock{
+				GrantFunc: func(ctx context.Context, resource rbac.Resource, subject string, role rbac.Role) (*api.RoleBinding, *errors.ServiceError) {
+					return &api.RoleBinding{Meta: api.Meta{ID: "binding-1"}, ResourceType: string(resource.Type), ResourceId: resource.ID, Subject: subject, Role: string(role)}, nil
+				},
+			}
+			handler := newTestConnectorRoleBindingsHandler(roleBindingService)
+
+			recorder := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodPost, "/api/connector_mgmt/v1/kafka_connectors/"+tt.connectorId+"/role_bindings", strings.NewReader(tt.body))
+			handler.Create(recorder, mux.SetURLVars(r, map[string]string{"connector_id": tt.connectorId}))
+
+			Expect(recorder.Code).To(Equal(tt.wantCode))
+			Expect(roleBindingService.GrantCalls()).To(HaveLen(tt.wantGrants))
+			if tt.wantGrants == 0 {
+				return
+			}
+			Expect(roleBindingService.GrantCalls()[0].Resource).To(Equal(rbac.Resource{Type: rbac.ResourceTypeConnector, ID: "connector-1", Owner: "owner", OrganisationId: "test-org"}))
+			var binding public.RoleBinding
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &binding)).To(Succeed())
+			Expect(binding.Id).To(Equal("binding-1"))
+			Expect(binding.Role).To(Equal("viewer"))
+		})
+	}
+}
+
+func TestConnectorRoleBindingsHandler_Delete(t *testing.T) {
+	RegisterTestingT(t)
+	roleBindingService := &rbac.RoleBindingServiceMock{
+		RevokeFunc: func(ctx context.Context, resource rbac.Resource, id string) *errors.ServiceError {
+			return errors.Forbidden("admin role is required")
+		},
+	}
+	handler := newTestConnectorRoleBindingsHandler(roleBindingService)
+
+	recorder := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodDelete, "/api/connector_mgmt/v1/kafka_connectors/connector-1/role_bindings/binding-1", nil)
+	handler.Delete(recorder, mux.SetURLVars(r, map[string]string{"connector_id": "connector-1", "binding_id": "binding-1"}))
+
+	Expect(recorder.Code).To(Equal(http.StatusForbidden))
+	Expect(roleBindingService.RevokeCalls()).To(HaveLen(1))
+	Expect(roleBindingService.RevokeCalls()[0].ID).To(Equal("binding-1"))
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/vault"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
@@ -43,7 +44,7 @@ func moveSecretsToVault(resource *dbapi.Connector, ct *dbapi.ConnectorType, vaul
 	// move secrets to a vault.
 	if resource.ServiceAccount.ClientSecret != "" {
 		keyId := api.NewID()
-		if err := vault.SetSecretString(keyId, resource.ServiceAccount.ClientSecret, "/v1/connector/"+resource.ID); err != nil {
+		if err := vault.SetSecretString(keyId, resource.ServiceAccount.ClientSecret, services.ConnectorOwningResource(resource.ID)); err != nil {
 			return errors.GeneralError("could not store kafka client secret in the vault: %v", err.Error())
 		}
 		resource.ServiceAccount.ClientSecret = ""
@@ -58,7 +59,7 @@ func moveSecretsToVault(resource *dbapi.Connector, ct *dbapi.ConnectorType, vaul
 				if err != nil {
 					return err
 				}
-				err = vault.SetSecretString(keyId, s, "/v1/connector/"+resource.ID)
+				err = vault.SetSecretString(keyId, s, services.ConnectorOwningResource(resource.ID))
 				if err != nil {
 					return err
 				}
@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/gorilla/mux"
+)
+
+const (
+	defaultTelemetryDuration = 5 * time.Minute
+	maxTelemetryDuration     = 4320 * time.Minute
+)
+
+type ConnectorTelemetryHandler struct {
+	connectorsService services.ConnectorsService
+	telemetryService  services.ConnectorTelemetryService
+}
+
+func NewConnectorTelemetryHandler(connectorsService services.ConnectorsService, telemetryService services.ConnectorTelemetryService) *ConnectorTelemetryHandler {
+	return &ConnectorTelemetryHandler{
+		connectorsService: connectorsService,
+		telemetryService:  telemetryService,
+	}
+}
+
+func (h ConnectorTelemetryHandler) GetLogs(w http.ResponseWriter, r *http.Request) {
+	connectorId := mux.Vars(r)["connector_id"]
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.Validation("connector_id", &connectorId, handlers.MinLen(1), handlers.MaxLen(maxConnectorIdLength)),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			// only the owner of a connector can see its logs
+			if _, err := h.connectorsService.Get(r.Context(), connectorId, ""); err != nil {
+				return nil, err
+			}
+
+			entries, err := h.telemetryService.GetLogs(connectorId, time.Now().Add(-extractTelemetryDuration(r)))
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentConnectorLogs(connectorId, entries), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+func (h ConnectorTelemetryHandler) GetMetrics(w http.ResponseWriter, r *http.Request) {
+	connectorId := mux.Vars(r)["connector_id"]
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.Validation("connector_id", &connectorId, handlers.MinLen(1), handlers.MaxLen(maxConnectorIdLength)),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			if _, err := h.connectorsService.Get(r.Context(), connectorId, ""); err != nil {
+				return nil, err
+			}
+
+			samples, err := h.telemetryService.GetMetrics(connectorId, time.Now().Add(-extractTelemetryDuration(r)), r.URL.Query()["filters"])
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentConnectorMetrics(connectorId, samples), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+// extractTelemetryDuration returns the duration query parameter in minutes, or the default if it isn't valid.
+func extractTelemetryDuration(r *http.Request) time.Duration {
+	if dur := r.URL.Query().Get("duration"); dur != "" {
+		if num, err := strconv.ParseInt(dur, 10, 64); err == nil {
+			duration := time.Duration(num) * time.Minute
+			if duration > 0 && duration <= maxTelemetryDuration {
+				return duration
+			}
+		}
+	}
+	return defaultTelemetryDuration
+}
@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/gorilla/mux"
+	. "github.com/onsi/gomega"
+)
+
+// connectorsServiceStub finds the connectors owned by the caller
+type connectorsServiceStub struct {
+	services.ConnectorsService
+	connectors map[string]*dbapi.Connector
+}
+
+func (s *connectorsServiceStub) Get(ctx context.Context, id string, tid string) (*dbapi.Connector, *errors.ServiceError) {
+	if connector, found := s.connectors[id]; found {
+		return connector, nil
+	}
+	return nil, errors.NotFound("Connector with id='%v' not found", id)
+}
+
+func Test_extractTelemetryDuration(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  time.Duration
+	}{
+		{
+			name: "should default when the duration is missing",
+			want: defaultTelemetryDuration,
+		},
+		{
+			name:  "should parse the duration in minutes",
+			query: "?duration=60",
+			want:  time.Hour,
+		},
+		{
+			name:  "should default when the duration is invalid",
+			query: "?duration=an-hour",
+			want:  defaultTelemetryDuration,
+		},
+		{
+			name:  "should default when the duration is past the maximum",
+			query: "?duration=4321",
+			want:  defaultTelemetryDuration,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			r := httptest.NewRequest(http.MethodGet, "/api/connector_mgmt/v1/kafka_connectors/connector-1/logs"+tt.query, nil)
+			Expect(extractTelemetryDuration(r)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestConnectorTelemetryHandler_GetLogs(t *testing.T) {
+	timestamp := time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		connectorId string
+		wantCode    int
+		wantItems   int
+	}{
+		{
+			name:        "should return the logs of an owned connector",
+			connectorId: "connector-1",
+			wantCode:    http.StatusOK,
+			wantItems:   1,
+		},
+		{
+			name:        "should not return the logs of other connectors",
+			connectorId: "connector-2",
+			wantCode:    http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			telemetryService := &services.ConnectorTelemetryServiceMock{
+				GetLogsFunc: func(connectorId string, since time.Time) (dbapi.ConnectorLogEntryList, *errors.ServiceError) {
+					return dbapi.ConnectorLogEntryList{{ConnectorID: connectorId, Timestamp: timestamp, Message: "started"}}, nil
+				},
+			}
+			handler := NewConnectorTelemetryHandler(&connectorsServiceStub{
+				connectors: map[string]*dbapi.Connector{"connector-1": {}},
+			}, telemetryService)
+
+			recorder := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/api/connector_mgmt/v1/kafka_connectors/"+tt.connectorId+"/logs?duration=60", nil)
+			handler.GetLogs(recorder, mux.SetURLVars(r, map[string]string{"connector_id": tt.connectorId}))
+
+			Expect(recorder.Code).To(Equal(tt.wantCode))
+			if tt.wantCode != http.StatusOK {
+				Expect(telemetryService.GetLogsCalls()).To(BeEmpty())
+				return
+			}
+			Expect(telemetryService.GetLogsCalls()).To(HaveLen(1))
+			Expect(telemetryService.GetLogsCalls()[0].Since).To(BeTemporally("~", time.Now().Add(-time.Hour), time.Minute))
+			var logs public.ConnectorLogList
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &logs)).To(Succeed())
+			Expect(logs.Id).To(Equal(tt.connectorId))
+			Expect(logs.Items).To(HaveLen(tt.wantItems))
+		})
+	}
+}
+
+func TestConnectorTelemetryHandler_GetMetrics(t *testing.T) {
+	RegisterTestingT(t)
+	telemetryService := &services.ConnectorTelemetryServiceMock{
+		GetMetricsFunc: func(connectorId string, since time.Time, names []string) (dbapi.ConnectorMetricSampleList, *errors.ServiceError) {
+			return dbapi.ConnectorMetricSampleList{{ConnectorID: connectorId, Timestamp: time.Now(), Name: "records_in", Value: 10}}, nil
+		},
+	}
+	handler := NewConnectorTelemetryHandler(&connectorsServiceStub{
+		connectors: map[string]*dbapi.Connector{"connector-1": {}},
+	}, telemetryService)
+
+	recorder := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/api/connector_mgmt/v1/kafka_connectors/connector-1/metrics?filters=records_in&filters=records_out", nil)
+	handler.GetMetrics(recorder, mux.SetURLVars(r, map[string]string{"connector_id": "connector-1"}))
+
+	Expect(recorder.Code).To(Equal(http.StatusOK))
+	Expect(telemetryService.GetMetricsCalls()).To(HaveLen(1))
+	Expect(telemetryService.GetMetricsCalls()[0].Names).To(Equal([]string{"records_in", "records_out"}))
+	Expect(telemetryService.GetMetricsCalls()[0].Since).To(BeTemporally("~", time.Now().Add(-defaultTelemetryDuration), time.Minute))
+}
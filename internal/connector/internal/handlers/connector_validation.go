@@ -3,6 +3,7 @@ package handlers
 import (
 	"strings"
 
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/private"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/public"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
@@ -18,6 +19,36 @@ func validateConnector(connectorTypesService services.ConnectorTypesService, res
 	return connectorValidationFunction(connectorTypesService, &resource.ConnectorTypeId, &resource.Channel, &resource.Connector, tid)
 }
 
+// validateConnectorDeploymentMetrics limits the number of metrics an agent can push at once
+func validateConnectorDeploymentMetrics(resource *private.ConnectorDeploymentMetrics) handlers.Validate {
+	return func() *errors.ServiceError {
+		if len(resource.Values) > maxConnectorMetricsPerSample {
+			return errors.BadRequest("at most %d metrics can be pushed at once", maxConnectorMetricsPerSample)
+		}
+		return nil
+	}
+}
+
+// validateConnectorNotDeprecated rejects new connectors of deprecated connector types or on deprecated channels
+func validateConnectorNotDeprecated(connectorTypesService services.ConnectorTypesService, connectorTypeId *string, channel *public.Channel) handlers.Validate {
+	return func() *errors.ServiceError {
+		ct, err := connectorTypesService.Get(*connectorTypeId)
+		if err != nil {
+			return errors.BadRequest("invalid connector type id %s : %s", *connectorTypeId, err)
+		}
+		if ct.Deprecated {
+			if ct.SuccessorId != "" {
+				return errors.BadRequest("connector type %s is deprecated, use connector type %s instead", ct.ID, ct.SuccessorId)
+			}
+			return errors.BadRequest("connector type %s is deprecated", ct.ID)
+		}
+		if ct.IsChannelDeprecated(string(*channel)) {
+			return errors.BadRequest("channel %s of connector type %s is deprecated", *channel, ct.ID)
+		}
+		return nil
+	}
+}
+
 func connectorValidationFunction(connectorTypesService services.ConnectorTypesService, connectorTypeId *string, channel *public.Channel, connectorConfiguration *map[string]interface{}, tid string) handlers.Validate {
 	return func() *errors.ServiceError {
 
@@ -1,20 +1,23 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strconv"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/public"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/presenters"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/vault"
-	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
-	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/audit"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/quota"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/tracing"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/secrets"
 	"github.com/spyzhov/ajson"
 
@@ -36,13 +39,15 @@ type ConnectorsHandler struct {
 	connectorsService     services.ConnectorsService
 	connectorTypesService services.ConnectorTypesService
 	vaultService          vault.VaultService
+	quotaService          quota.OrganisationQuotaService
 }
 
-func NewConnectorsHandler(connectorsService services.ConnectorsService, connectorTypesService services.ConnectorTypesService, vaultService vault.VaultService) *ConnectorsHandler {
+func NewConnectorsHandler(connectorsService services.ConnectorsService, connectorTypesService services.ConnectorTypesService, vaultService vault.VaultService, quotaService quota.OrganisationQuotaService) *ConnectorsHandler {
 	return &ConnectorsHandler{
 		connectorsService:     connectorsService,
 		connectorTypesService: connectorTypesService,
 		vaultService:          vaultService,
+		quotaService:          quotaService,
 	}
 }
 
@@ -65,6 +70,7 @@ func (h ConnectorsHandler) Create(w http.ResponseWriter, r *http.Request) {
 			handlers.Validation("desired_state", (*string)(&resource.DesiredState), handlers.WithDefault("ready"), handlers.IsOneOf(dbapi.ValidDesiredStates...)),
 			handlers.Validation("deployment_location.kind", &resource.DeploymentLocation.Kind, handlers.IsOneOf("addon")),
 			validateConnectorRequest(h.connectorTypesService, &resource, tid),
+			validateConnectorNotDeprecated(h.connectorTypesService, &resource.ConnectorTypeId, &resource.Channel),
 		},
 
 		Action: func() (interface{}, *errors.ServiceError) {
@@ -86,9 +92,15 @@ func (h ConnectorsHandler) Create(w http.ResponseWriter, r *http.Request) {
 			}
 
 			convResource.ID = api.NewID()
+			tracing.SetAttributes(r.Context(), tracing.ConnectorIDKey.String(convResource.ID))
 			convResource.Owner = auth.GetUsernameFromClaims(claims)
 			convResource.OrganisationId = auth.GetOrgIdFromClaims(claims)
 
+			// connectors are only budgeted for the organisations with a connectors quota
+			if err := h.quotaService.Reserve(convResource.OrganisationId, services.ConnectorsQuotaResource, 1); err != nil {
+				return nil, err
+			}
+
 			ct, err := h.connectorTypesService.Get(resource.ConnectorTypeId)
 			if err != nil {
 				return nil, errors.BadRequest("invalid connector type id: %s", resource.ConnectorTypeId)
@@ -121,6 +133,8 @@ func (h ConnectorsHandler) Patch(w http.ResponseWriter, r *http.Request) {
 	connectorTypeId := mux.Vars(r)["connector_type_id"]
 	contentType := r.Header.Get("Content-Type")
 
+	// version of the connector the If-Match precondition was checked against
+	var version int64
 	cfg := &handlers.HandlerConfig{
 		Validate: []handlers.Validate{
 			handlers.Validation("connector_id", &connectorId, handlers.MinLen(1), handlers.MaxLen(maxConnectorIdLength)),
@@ -137,6 +151,9 @@ func (h ConnectorsHandler) Patch(w http.ResponseWriter, r *http.Request) {
 			if serr != nil {
 				return nil, serr
 			}
+			if version != 0 && dbresource.Version != version {
+				return nil, errors.PreconditionFailed("connector %s has been modified", connectorId)
+			}
 
 			resource, serr := presenters.PresentConnector(dbresource)
 			if serr != nil {
@@ -147,11 +164,7 @@ func (h ConnectorsHandler) Patch(w http.ResponseWriter, r *http.Request) {
 			if serr != nil {
 				return nil, errors.BadRequest("invalid connector type id: %s", resource.ConnectorTypeId)
 			}
-
-			originalSecrets, err := getSecretRefs(dbresource, ct)
-			if err != nil {
-				return nil, errors.GeneralError("could not get existing secrets: %v", err)
-			}
+			setPreviousConnectorState(r.Context(), dbresource, ct)
 
 			// Apply the patch..
 			patchBytes, err := ioutil.ReadAll(r.Body)
@@ -184,6 +197,7 @@ func (h ConnectorsHandler) Patch(w http.ResponseWriter, r *http.Request) {
 			// If we didn't change anything, then just skip the update...
 			originalResource, _ := presenters.PresentConnector(dbresource)
 			if reflect.DeepEqual(originalResource, resource) {
+				version = dbresource.Version
 				return originalResource, nil
 			}
 
@@ -237,35 +251,44 @@ func (h ConnectorsHandler) Patch(w http.ResponseWriter, r *http.Request) {
 				}
 			}
 
-			newSecrets, err := getSecretRefs(p, ct)
-			if err != nil {
-				return nil, errors.GeneralError("could not get existing secrets: %v", err)
-			}
-
-			staleSecrets := StringListSubtract(originalSecrets, newSecrets...)
-			if len(staleSecrets) > 0 {
-				_ = db.AddPostCommitAction(r.Context(), func() {
-					for _, s := range staleSecrets {
-						err = h.vaultService.DeleteSecretString(s)
-						if err != nil {
-							logger.Logger.Errorf("failed to delete vault secret key '%s': %v", s, err)
-						}
-					}
-				})
-			}
-
 			if err := stripSecretReferences(p, ct); err != nil {
 				return nil, err
 			}
 
-			return presenters.PresentConnector(p)
+			version = p.Version
+			return presentConnector(p, ct)
 		},
+		ETag: connectorETag(r, h.connectorsService, connectorId, connectorTypeId, &version),
 	}
 
 	// return 202 status accepted
 	handlers.Handle(w, r, cfg, http.StatusAccepted)
 }
 
+// presentConnector presents a connector with the deprecation warnings of its connector type, ct may be nil
+// if the connector type is unknown.
+func presentConnector(resource *dbapi.Connector, ct *dbapi.ConnectorType) (public.Connector, *errors.ServiceError) {
+	converted, err := presenters.PresentConnector(resource)
+	if err != nil {
+		return converted, err
+	}
+	if ct != nil {
+		converted.Status.Warnings = ct.DeprecationWarnings(resource.Channel)
+	}
+	return converted, nil
+}
+
+// setPreviousConnectorState records the connector as returned by the get endpoint before it is mutated by the request
+func setPreviousConnectorState(ctx context.Context, resource *dbapi.Connector, ct *dbapi.ConnectorType) {
+	previous := *resource
+	if err := stripSecretReferences(&previous, ct); err != nil {
+		return
+	}
+	if converted, err := presentConnector(&previous, ct); err == nil {
+		audit.SetPreviousState(ctx, converted)
+	}
+}
+
 func validateConnectorPatch(bytes []byte, ct *dbapi.ConnectorType) *errors.ServiceError {
 	type Connector struct {
 		ConnectorSpec api.JSON `json:"connector_spec,omitempty"`
@@ -346,6 +369,7 @@ func PatchResource(resource interface{}, patchType string, patchBytes []byte, pa
 func (h ConnectorsHandler) Get(w http.ResponseWriter, r *http.Request) {
 	connectorId := mux.Vars(r)["connector_id"]
 	connectorTypeId := mux.Vars(r)["connector_type_id"]
+	var version int64
 	cfg := &handlers.HandlerConfig{
 		Validate: []handlers.Validate{
 			handlers.Validation("connector_id", &connectorId, handlers.MinLen(1), handlers.MaxLen(maxConnectorIdLength)),
@@ -356,6 +380,7 @@ func (h ConnectorsHandler) Get(w http.ResponseWriter, r *http.Request) {
 			if err != nil {
 				return nil, err
 			}
+			version = resource.Version
 
 			ct, serr := h.connectorTypesService.Get(resource.ConnectorTypeId)
 			if serr != nil {
@@ -369,8 +394,9 @@ func (h ConnectorsHandler) Get(w http.ResponseWriter, r *http.Request) {
 				}
 			}
 
-			return presenters.PresentConnector(resource)
+			return presentConnector(resource, ct)
 		},
+		ETag: connectorETag(r, h.connectorsService, connectorId, connectorTypeId, &version),
 	}
 	handlers.HandleGet(w, r, cfg)
 }
@@ -378,6 +404,7 @@ func (h ConnectorsHandler) Get(w http.ResponseWriter, r *http.Request) {
 // Delete is the handler for deleting a kafka request
 func (h ConnectorsHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	connectorId := mux.Vars(r)["connector_id"]
+	var version int64
 	cfg := &handlers.HandlerConfig{
 		Validate: []handlers.Validate{
 			handlers.Validation("connector_id", &connectorId, handlers.MinLen(1), handlers.MaxLen(maxConnectorIdLength)),
@@ -388,31 +415,57 @@ func (h ConnectorsHandler) Delete(w http.ResponseWriter, r *http.Request) {
 			if err != nil {
 				return nil, err
 			}
+			if version != 0 && c.Version != version {
+				return nil, errors.PreconditionFailed("connector %s has been modified", connectorId)
+			}
+			if audit.IsAudited(r.Context()) {
+				if ct, err := h.connectorTypesService.Get(c.ConnectorTypeId); err == nil {
+					setPreviousConnectorState(r.Context(), c, ct)
+				}
+			}
 			if c.DesiredState != dbapi.ConnectorStatusPhaseDeleted {
 
-				switch c.Status.Phase {
+				// the update fails if the connector was modified since it was read, so it's done before the status is saved
+				status := c.Status
+				c.DesiredState = dbapi.ConnectorStatusPhaseDeleted
+				err := h.connectorsService.Update(r.Context(), c)
+				if err != nil {
+					return nil, err
+				}
+
+				switch status.Phase {
 				case dbapi.ConnectorStatusPhaseAssigning: // don't change..
 				case dbapi.ConnectorStatusPhaseDeleted: // don't change..
 				default:
-					c.Status.Phase = dbapi.ConnectorStatusPhaseDeleting
-					err = h.connectorsService.SaveStatus(r.Context(), c.Status)
+					status.Phase = dbapi.ConnectorStatusPhaseDeleting
+					err = h.connectorsService.SaveStatus(r.Context(), status)
 					if err != nil {
 						return nil, err
 					}
 				}
-
-				c.DesiredState = dbapi.ConnectorStatusPhaseDeleted
-				err := h.connectorsService.Update(r.Context(), c)
-				if err != nil {
-					return nil, err
-				}
 			}
 			return nil, nil
 		},
+		ETag: connectorETag(r, h.connectorsService, connectorId, "", &version),
 	}
 	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
 }
 
+// connectorETag returns the entity tag of the connector at the given version, the connector is looked up to get
+// its current version if the version is not set yet.
+func connectorETag(r *http.Request, connectorsService services.ConnectorsService, connectorId string, connectorTypeId string, version *int64) func() (string, *errors.ServiceError) {
+	return func() (string, *errors.ServiceError) {
+		if *version == 0 {
+			resource, err := connectorsService.Get(r.Context(), connectorId, connectorTypeId)
+			if err != nil {
+				return "", err
+			}
+			*version = resource.Version
+		}
+		return handlers.ETagFromVersion(*version), nil
+	}
+}
+
 func (h ConnectorsHandler) List(w http.ResponseWriter, r *http.Request) {
 	kafkaId := r.URL.Query().Get("kafka_id")
 	connectorTypeId := mux.Vars(r)["connector_type_id"]
@@ -450,7 +503,7 @@ func (h ConnectorsHandler) List(w http.ResponseWriter, r *http.Request) {
 					}
 				}
 
-				converted, err := presenters.PresentConnector(resource)
+				converted, err := presentConnector(resource, ct)
 				if err != nil {
 					glog.Errorf("connector id='%s' presentation failed: %v", resource.ID, err)
 					return nil, errors.GeneralError("internal error")
@@ -465,3 +518,88 @@ func (h ConnectorsHandler) List(w http.ResponseWriter, r *http.Request) {
 
 	handlers.HandleList(w, r, cfg)
 }
+
+func (h ConnectorsHandler) ListRevisions(w http.ResponseWriter, r *http.Request) {
+	connectorId := mux.Vars(r)["connector_id"]
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.Validation("connector_id", &connectorId, handlers.MinLen(1), handlers.MaxLen(maxConnectorIdLength)),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			listArgs := coreServices.NewListArguments(r.URL.Query())
+			revisions, paging, err := h.connectorsService.ListRevisions(r.Context(), connectorId, listArgs)
+			if err != nil {
+				return nil, err
+			}
+
+			resourceList := public.ConnectorRevisionList{
+				Kind:  "ConnectorRevisionList",
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+			}
+
+			for i := range revisions {
+				revision := &revisions[i]
+
+				ct, serr := h.connectorTypesService.Get(revision.ConnectorTypeId)
+				if serr != nil {
+					// gracefully degrade by not showing the connector spec
+					revision.ConnectorSpec = api.JSON("{}")
+				} else {
+					resource := &dbapi.Connector{ConnectorSpec: revision.ConnectorSpec}
+					if err := stripSecretReferences(resource, ct); err != nil {
+						return nil, err
+					}
+					revision.ConnectorSpec = resource.ConnectorSpec
+				}
+
+				converted, err := presenters.PresentConnectorRevision(revision)
+				if err != nil {
+					glog.Errorf("connector id='%s' revision %d presentation failed: %v", connectorId, revision.Version, err)
+					return nil, errors.GeneralError("internal error")
+				}
+				resourceList.Items = append(resourceList.Items, converted)
+			}
+
+			return resourceList, nil
+		},
+	}
+
+	handlers.HandleList(w, r, cfg)
+}
+
+func (h ConnectorsHandler) Rollback(w http.ResponseWriter, r *http.Request) {
+	connectorId := mux.Vars(r)["connector_id"]
+	revisionParam := mux.Vars(r)["revision"]
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.Validation("connector_id", &connectorId, handlers.MinLen(1), handlers.MaxLen(maxConnectorIdLength)),
+			handlers.Validation("revision", &revisionParam, handlers.MinLen(1)),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			revision, err := strconv.ParseInt(revisionParam, 10, 64)
+			if err != nil {
+				return nil, errors.BadRequest("invalid revision: %s", revisionParam)
+			}
+
+			resource, serr := h.connectorsService.Rollback(r.Context(), connectorId, revision)
+			if serr != nil {
+				return nil, serr
+			}
+
+			ct, serr := h.connectorTypesService.Get(resource.ConnectorTypeId)
+			if serr != nil {
+				return nil, serr
+			}
+			if err := stripSecretReferences(resource, ct); err != nil {
+				return nil, err
+			}
+
+			return presentConnector(resource, ct)
+		},
+	}
+
+	// return 202 status accepted
+	handlers.Handle(w, r, cfg, http.StatusAccepted)
+}
@@ -0,0 +1,35 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+)
+
+func addConnectorRevisions(migrationId string) *gormigrate.Migration {
+
+	type ConnectorRevision struct {
+		ConnectorID     string `gorm:"primaryKey"`
+		Version         int64  `gorm:"primaryKey;autoIncrement:false"`
+		CreatedAt       time.Time
+		ConnectorTypeId string
+		Channel         string
+		DesiredState    string
+		ConnectorSpec   api.JSON `gorm:"type:jsonb"`
+	}
+
+	return db.CreateMigrationFromActions(migrationId,
+		db.CreateTableAction(&ConnectorRevision{}),
+		// seed the history with the current configuration of existing connectors
+		db.ExecAction(`INSERT INTO connector_revisions (connector_id, version, created_at, connector_type_id, channel, desired_state, connector_spec)
+			SELECT id, version, now(), connector_type_id, channel, desired_state, connector_spec FROM connectors WHERE deleted_at IS NULL`,
+			``),
+	)
+}
@@ -0,0 +1,33 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addRateLimitCounters(migrationId string) *gormigrate.Migration {
+	type RateLimitCounter struct {
+		Key         string    `gorm:"primaryKey"`
+		WindowStart time.Time `gorm:"primaryKey"`
+		ExpiresAt   time.Time `gorm:"index"`
+		Count       int       `gorm:"not null;default:0"`
+	}
+
+	return db.CreateMigrationFromActions(migrationId,
+		db.FuncAction(func(tx *gorm.DB) error {
+			// The rate limit counters table is shared with the kas-fleet-manager, so we just create it here
+			// if it does not exist yet.. but we don't drop it on rollback.
+			return tx.Migrator().AutoMigrate(&RateLimitCounter{})
+		}, func(tx *gorm.DB) error {
+			return nil
+		}),
+	)
+}
@@ -0,0 +1,39 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addRoleBindings(migrationId string) *gormigrate.Migration {
+	type RoleBinding struct {
+		ID             string `gorm:"primarykey"`
+		CreatedAt      time.Time
+		UpdatedAt      time.Time
+		DeletedAt      gorm.DeletedAt `gorm:"index"`
+		ResourceType   string         `gorm:"index:idx_role_bindings_resource;uniqueIndex:idx_role_bindings_subject"`
+		ResourceId     string         `gorm:"index:idx_role_bindings_resource;uniqueIndex:idx_role_bindings_subject"`
+		OrganisationId string         `gorm:"index"`
+		Subject        string         `gorm:"index;uniqueIndex:idx_role_bindings_subject"`
+		Role           string
+		CreatedBy      string
+	}
+
+	return db.CreateMigrationFromActions(migrationId,
+		db.FuncAction(func(tx *gorm.DB) error {
+			// The role bindings table is shared with the kas-fleet-manager, so we just create it here
+			// if it does not exist yet.. but we don't drop it on rollback.
+			return tx.Migrator().AutoMigrate(&RoleBinding{})
+		}, func(tx *gorm.DB) error {
+			return nil
+		}),
+	)
+}
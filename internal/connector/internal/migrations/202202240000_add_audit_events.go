@@ -0,0 +1,59 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addAuditEvents(migrationId string) *gormigrate.Migration {
+	type AuditEvent struct {
+		ID             string    `gorm:"primarykey"`
+		CreatedAt      time.Time `gorm:"index"`
+		UpdatedAt      time.Time
+		DeletedAt      gorm.DeletedAt `gorm:"index"`
+		Actor          string         `gorm:"index"`
+		OrganisationId string         `gorm:"index"`
+		Action         string
+		ResourceType   string `gorm:"index:idx_audit_events_resource"`
+		ResourceId     string `gorm:"index:idx_audit_events_resource"`
+		Method         string
+		Path           string
+		RemoteAddr     string
+		StatusCode     int
+		Outcome        string
+		Diff           string `gorm:"type:jsonb"`
+	}
+
+	return db.CreateMigrationFromActions(migrationId,
+		db.FuncAction(func(tx *gorm.DB) error {
+			// The audit events table and the lease of the worker deleting expired events are shared with the
+			// kas-fleet-manager, so we just create them here if they don't exist yet.. but we don't drop them on rollback.
+			if err := tx.Migrator().AutoMigrate(&AuditEvent{}); err != nil {
+				return err
+			}
+			var count int64
+			if err := tx.Model(&api.LeaderLease{}).Where("lease_type = ?", "audit_events").Count(&count).Error; err != nil {
+				return err
+			}
+			if count > 0 {
+				return nil
+			}
+			now := time.Now().Add(-time.Minute) //set to a expired time
+			return tx.Create(&api.LeaderLease{
+				Expires:   &now,
+				LeaseType: "audit_events",
+			}).Error
+		}, func(tx *gorm.DB) error {
+			return nil
+		}),
+	)
+}
@@ -0,0 +1,58 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addUsageRecords(migrationId string) *gormigrate.Migration {
+	type UsageRecord struct {
+		ID             string `gorm:"primarykey"`
+		CreatedAt      time.Time
+		UpdatedAt      time.Time
+		DeletedAt      gorm.DeletedAt `gorm:"index"`
+		SampledAt      time.Time      `gorm:"index"`
+		OrganisationId string         `gorm:"index"`
+		ResourceType   string
+		ResourceId     string
+		InstanceType   string
+		CloudProvider  string
+		Region         string
+		MultiAZ        bool
+		StorageSize    string
+		Hours          float64
+	}
+
+	return db.CreateMigrationFromActions(migrationId,
+		db.FuncAction(func(tx *gorm.DB) error {
+			// The usage records table and the lease of the metering worker are shared with the kas-fleet-manager,
+			// so we just create them here if they don't exist yet.. but we don't drop them on rollback.
+			if err := tx.Migrator().AutoMigrate(&UsageRecord{}); err != nil {
+				return err
+			}
+			var count int64
+			if err := tx.Model(&api.LeaderLease{}).Where("lease_type = ?", "usage_metering").Count(&count).Error; err != nil {
+				return err
+			}
+			if count > 0 {
+				return nil
+			}
+			now := time.Now().Add(-time.Minute) //set to a expired time
+			return tx.Create(&api.LeaderLease{
+				Expires:   &now,
+				LeaseType: "usage_metering",
+			}).Error
+		}, func(tx *gorm.DB) error {
+			return nil
+		}),
+	)
+}
@@ -0,0 +1,28 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addIdempotencyKeyHeaders(migrationId string) *gormigrate.Migration {
+	type IdempotencyKey struct {
+		Headers []byte
+	}
+
+	return db.CreateMigrationFromActions(migrationId,
+		db.FuncAction(func(tx *gorm.DB) error {
+			// The idempotency keys table is shared with the kas-fleet-manager, so we just add the column here
+			// if it does not exist yet.. but we don't drop it on rollback.
+			return tx.Migrator().AutoMigrate(&IdempotencyKey{})
+		}, func(tx *gorm.DB) error {
+			return nil
+		}),
+	)
+}
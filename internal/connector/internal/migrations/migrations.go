@@ -28,13 +28,30 @@ var migrations = []*gormigrate.Migration{
 	connectorRefactor("202201310000"),
 	addConnectorTypeCapabilitiesTable("202202040000"),
 	addClientId("202202030000"),
+	addConnectorSecretsLease("202202150000"),
+	addConnectorTypeDeprecation("202202160000"),
+	addConnectorRevisions("202202170000"),
+	addConnectorLogsAndMetrics("202202180000"),
+	addRateLimitCounters("202202210000"),
+	addIdempotencyKeys("202202220000"),
+	addRoleBindings("202202230000"),
+	addAuditEvents("202202240000"),
+	addOrganisationQuotas("202202280000"),
+	addUsageRecords("202203010000"),
+	addConnectorOrphanedSecrets("202203020000"),
+	addIdempotencyKeyHeaders("202203030000"),
 }
 
 func New(dbConfig *db.DatabaseConfig) (*db.Migration, func(), error) {
-	return db.NewMigration(dbConfig, &gormigrate.Options{
+	migration, cleanup, err := db.NewMigration(dbConfig, &gormigrate.Options{
 		TableName:      "connector_migrations",
 		IDColumnName:   "id",
 		IDColumnSize:   255,
 		UseTransaction: false,
 	}, migrations)
+	if err != nil {
+		return nil, nil, err
+	}
+	migration.Models = models()
+	return migration, cleanup, nil
 }
@@ -0,0 +1,33 @@
+package migrations
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+)
+
+// Unlike the migrations, the models the schema is checked against by `migrate check` MUST be the types of the
+// service: the check is there to catch changes to the types that are not backed by a migration.
+func models() []interface{} {
+	return []interface{}{
+		&dbapi.Connector{},
+		&dbapi.ConnectorStatus{},
+		&dbapi.ConnectorRevision{},
+		&dbapi.ConnectorLogEntry{},
+		&dbapi.ConnectorMetricSample{},
+		&dbapi.ConnectorOrphanedSecret{},
+		&dbapi.ConnectorDeployment{},
+		&dbapi.ConnectorDeploymentStatus{},
+		&dbapi.ConnectorCluster{},
+		&dbapi.ConnectorType{},
+		&dbapi.ConnectorChannel{},
+		&dbapi.ConnectorTypeLabel{},
+		&dbapi.ConnectorTypeCapability{},
+		&dbapi.ConnectorTypeDeprecatedChannel{},
+		&dbapi.ConnectorShardMetadata{},
+		&api.LeaderLease{},
+		&api.RoleBinding{},
+		&api.AuditEvent{},
+		&api.OrganisationQuota{},
+		&api.UsageRecord{},
+	}
+}
@@ -0,0 +1,19 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+)
+
+func PresentConnectorDeprecation(from *dbapi.ConnectorDeprecation) private.ConnectorDeprecation {
+	return private.ConnectorDeprecation{
+		ConnectorId:       from.ConnectorID,
+		ConnectorTypeId:   from.ConnectorTypeId,
+		Channel:           from.Channel,
+		Owner:             from.Owner,
+		OrganisationId:    from.OrganisationId,
+		TypeDeprecated:    from.TypeDeprecated,
+		ChannelDeprecated: from.ChannelDeprecated,
+		SuccessorId:       from.SuccessorId,
+	}
+}
@@ -0,0 +1,26 @@
+package presenters
+
+import (
+	"encoding/json"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+)
+
+func PresentConnectorRevision(from *dbapi.ConnectorRevision) (public.ConnectorRevision, *errors.ServiceError) {
+	spec := map[string]interface{}{}
+	err := json.Unmarshal([]byte(from.ConnectorSpec), &spec)
+	if err != nil {
+		return public.ConnectorRevision{}, errors.BadRequest("invalid connector spec: %v", err)
+	}
+
+	return public.ConnectorRevision{
+		Revision:        from.Version,
+		CreatedAt:       from.CreatedAt,
+		ConnectorTypeId: from.ConnectorTypeId,
+		Channel:         public.Channel(from.Channel),
+		DesiredState:    public.ConnectorDesiredState(from.DesiredState),
+		Connector:       spec,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package presenters
+
+import (
+	"sort"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/public"
+)
+
+func ConvertConnectorDeploymentLogs(from private.ConnectorDeploymentLogs) dbapi.ConnectorLogEntryList {
+	entries := make(dbapi.ConnectorLogEntryList, 0, len(from.Items))
+	for _, item := range from.Items {
+		entries = append(entries, dbapi.ConnectorLogEntry{
+			Timestamp: item.Timestamp,
+			Message:   item.Message,
+		})
+	}
+	return entries
+}
+
+func ConvertConnectorDeploymentMetrics(from private.ConnectorDeploymentMetrics) dbapi.ConnectorMetricSampleList {
+	names := make([]string, 0, len(from.Values))
+	for name := range from.Values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	samples := make(dbapi.ConnectorMetricSampleList, 0, len(names))
+	for _, name := range names {
+		samples = append(samples, dbapi.ConnectorMetricSample{
+			Timestamp: from.Timestamp,
+			Name:      name,
+			Value:     from.Values[name],
+		})
+	}
+	return samples
+}
+
+func PresentConnectorLogs(connectorId string, from dbapi.ConnectorLogEntryList) public.ConnectorLogList {
+	result := public.ConnectorLogList{
+		Kind: "ConnectorLogList",
+		Id:   connectorId,
+	}
+	for _, entry := range from {
+		result.Items = append(result.Items, public.ConnectorLogEntry{
+			Timestamp: entry.Timestamp,
+			Message:   entry.Message,
+		})
+	}
+	return result
+}
+
+// PresentConnectorMetrics groups metric samples ordered by name into one series per metric.
+func PresentConnectorMetrics(connectorId string, from dbapi.ConnectorMetricSampleList) public.ConnectorMetricsList {
+	result := public.ConnectorMetricsList{
+		Kind: "ConnectorMetricsList",
+		Id:   connectorId,
+	}
+	for _, sample := range from {
+		if len(result.Items) == 0 || result.Items[len(result.Items)-1].Name != sample.Name {
+			result.Items = append(result.Items, public.ConnectorMetric{Name: sample.Name})
+		}
+		metric := &result.Items[len(result.Items)-1]
+		metric.Values = append(metric.Values, public.ConnectorMetricValue{
+			Timestamp: sample.Timestamp.UnixNano() / int64(time.Millisecond),
+			Value:     sample.Value,
+		})
+	}
+	return result
+}
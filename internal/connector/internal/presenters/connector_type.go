@@ -40,11 +40,14 @@ func ConvertConnectorType(from public.ConnectorType) (*dbapi.ConnectorType, erro
 		Version:     from.Version,
 		Description: from.Description,
 		IconHref:    from.IconHref,
+		Deprecated:  from.Deprecated,
+		SuccessorId: from.SuccessorId,
 	}
 
 	ct.SetLabels(from.Labels)
 	ct.SetChannels(toStringSlice(from.Channels))
 	ct.SetCapabilities(from.Capabilities)
+	ct.SetDeprecatedChannels(toStringSlice(from.DeprecatedChannels))
 	schemaToBeSet := from.Schema
 	if schemaToBeSet == nil {
 		schemaToBeSet = from.Schema
@@ -73,5 +76,9 @@ func PresentConnectorType(from *dbapi.ConnectorType) (*public.ConnectorType, err
 		Labels:       from.LabelNames(),
 		Channels:     toChannelSlice(from.ChannelNames()),
 		Capabilities: from.CapabilitiesNames(),
+
+		Deprecated:         from.Deprecated,
+		DeprecatedChannels: toChannelSlice(from.DeprecatedChannelNames()),
+		SuccessorId:        from.SuccessorId,
 	}, nil
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/compat"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
 )
@@ -19,6 +20,8 @@ const (
 	KindConnectorType = "ConnectorType"
 	// KindError is a string identifier for the type api.ServiceError
 	KindError = "Error"
+	// KindRoleBinding is a string identifier for the type api.RoleBinding
+	KindRoleBinding = "RoleBinding"
 )
 
 func PresentReference(id, obj interface{}) compat.ObjectReference {
@@ -37,6 +40,8 @@ func objectKind(i interface{}) string {
 		return KindConnectorType
 	case errors.ServiceError, *errors.ServiceError:
 		return KindError
+	case api.RoleBinding, *api.RoleBinding:
+		return KindRoleBinding
 	default:
 		return ""
 	}
@@ -54,6 +59,8 @@ func objectPath(id string, obj interface{}) string {
 		return fmt.Sprintf("/api/connector_mgmt/v1/kafka_connector_clusters/%s/deployments/%s", obj.ClusterID, id)
 	case *dbapi.ConnectorDeployment:
 		return fmt.Sprintf("/api/connector_mgmt/v1/kafka_connector_clusters/%s/deployments/%s", obj.ClusterID, id)
+	case *api.RoleBinding:
+		return fmt.Sprintf("/api/connector_mgmt/v1/kafka_connectors/%s/role_bindings/%s", obj.ResourceId, id)
 	default:
 		return ""
 	}
@@ -0,0 +1,35 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+)
+
+func PresentRoleBinding(from *api.RoleBinding) public.RoleBinding {
+	reference := PresentReference(from.ID, from)
+	return public.RoleBinding{
+		Id:           reference.Id,
+		Kind:         reference.Kind,
+		Href:         reference.Href,
+		ResourceType: from.ResourceType,
+		ResourceId:   from.ResourceId,
+		Subject:      from.Subject,
+		Role:         from.Role,
+		CreatedBy:    from.CreatedBy,
+		CreatedAt:    from.CreatedAt,
+	}
+}
+
+func PresentRoleBindingList(from api.RoleBindingList) public.RoleBindingList {
+	list := public.RoleBindingList{
+		Kind:  "RoleBindingList",
+		Page:  1,
+		Size:  int32(len(from)),
+		Total: int32(len(from)),
+		Items: []public.RoleBinding{},
+	}
+	for _, binding := range from {
+		list.Items = append(list.Items, PresentRoleBinding(binding))
+	}
+	return list
+}
@@ -15,26 +15,34 @@ import (
 	coreHandlers "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/server"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/audit"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/tracing"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 	"github.com/goava/di"
 	gorillaHandlers "github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type options struct {
 	di.Inject
-	ConnectorsConfig        *config.ConnectorsConfig
-	ServerConfig            *server.ServerConfig
-	ErrorsHandler           *coreHandlers.ErrorHandler
-	AuthorizeMiddleware     *acl.AccessControlListMiddleware
-	KeycloakService         services.KafkaKeycloakService
-	AuthAgentService        auth.AuthAgentService
-	ConnectorAdminHandler   *handlers.ConnectorAdminHandler
-	ConnectorTypesHandler   *handlers.ConnectorTypesHandler
-	ConnectorsHandler       *handlers.ConnectorsHandler
-	ConnectorClusterHandler *handlers.ConnectorClusterHandler
-	DB                      *db.ConnectionFactory
+	ConnectorsConfig          *config.ConnectorsConfig
+	ServerConfig              *server.ServerConfig
+	ErrorsHandler             *coreHandlers.ErrorHandler
+	AuthorizeMiddleware       *acl.AccessControlListMiddleware
+	RateLimitMiddleware       *auth.RateLimitMiddleware
+	IdempotencyMiddleware     *coreHandlers.IdempotencyMiddleware
+	AuditMiddleware           *audit.AuditMiddleware
+	KeycloakService           services.KafkaKeycloakService
+	AuthAgentService          auth.AuthAgentService
+	ConnectorAdminHandler     *handlers.ConnectorAdminHandler
+	ConnectorTypesHandler     *handlers.ConnectorTypesHandler
+	ConnectorsHandler         *handlers.ConnectorsHandler
+	ConnectorClusterHandler   *handlers.ConnectorClusterHandler
+	ConnectorTelemetryHandler *handlers.ConnectorTelemetryHandler
+	RoleBindingsHandler       *handlers.ConnectorRoleBindingsHandler
+	DB                        *db.ConnectionFactory
 }
 
 func NewRouteLoader(s options) environments.RouteLoader {
@@ -73,6 +81,7 @@ func (s *options) AddRoutes(mainRouter *mux.Router) error {
 	apiV1ConnectorTypesRouter.HandleFunc("/{connector_type_id}", s.ConnectorTypesHandler.Get).Methods(http.MethodGet)
 	apiV1ConnectorTypesRouter.HandleFunc("", s.ConnectorTypesHandler.List).Methods(http.MethodGet)
 	apiV1ConnectorTypesRouter.Use(s.AuthorizeMiddleware.Authorize)
+	apiV1ConnectorTypesRouter.Use(s.RateLimitMiddleware.RateLimit)
 
 	//  /api/connector_mgmt/v1/kafka_connectors
 	v1Collections = append(v1Collections, api.CollectionMetadata{
@@ -86,7 +95,16 @@ func (s *options) AddRoutes(mainRouter *mux.Router) error {
 	apiV1ConnectorsRouter.HandleFunc("/{connector_id}", s.ConnectorsHandler.Get).Methods(http.MethodGet)
 	apiV1ConnectorsRouter.HandleFunc("/{connector_id}", s.ConnectorsHandler.Patch).Methods(http.MethodPatch)
 	apiV1ConnectorsRouter.HandleFunc("/{connector_id}", s.ConnectorsHandler.Delete).Methods(http.MethodDelete)
+	apiV1ConnectorsRouter.HandleFunc("/{connector_id}/revisions", s.ConnectorsHandler.ListRevisions).Methods(http.MethodGet)
+	apiV1ConnectorsRouter.HandleFunc("/{connector_id}/revisions/{revision}/rollback", s.ConnectorsHandler.Rollback).Methods(http.MethodPost)
+	apiV1ConnectorsRouter.HandleFunc("/{connector_id}/logs", s.ConnectorTelemetryHandler.GetLogs).Methods(http.MethodGet)
+	apiV1ConnectorsRouter.HandleFunc("/{connector_id}/metrics", s.ConnectorTelemetryHandler.GetMetrics).Methods(http.MethodGet)
+	apiV1ConnectorsRouter.HandleFunc("/{connector_id}/role_bindings", s.RoleBindingsHandler.List).Methods(http.MethodGet)
+	apiV1ConnectorsRouter.HandleFunc("/{connector_id}/role_bindings", s.RoleBindingsHandler.Create).Methods(http.MethodPost)
+	apiV1ConnectorsRouter.HandleFunc("/{connector_id}/role_bindings/{binding_id}", s.RoleBindingsHandler.Delete).Methods(http.MethodDelete)
 	apiV1ConnectorsRouter.Use(s.AuthorizeMiddleware.Authorize)
+	apiV1ConnectorsRouter.Use(s.RateLimitMiddleware.RateLimit)
+	apiV1ConnectorsRouter.Use(s.AuditMiddleware.Audit)
 
 	//  /api/connector_mgmt/v1/kafka_connectors_of/{connector_type_id}
 	apiV1ConnectorsTypedRouter := apiV1Router.PathPrefix("/{_:kafka[-_]connectors[-_]of}/{connector_type_id}").Subrouter()
@@ -95,6 +113,8 @@ func (s *options) AddRoutes(mainRouter *mux.Router) error {
 	apiV1ConnectorsTypedRouter.HandleFunc("/{connector_id}", s.ConnectorsHandler.Get).Methods(http.MethodGet)
 	apiV1ConnectorsTypedRouter.HandleFunc("/{connector_id}", s.ConnectorsHandler.Patch).Methods(http.MethodPatch)
 	apiV1ConnectorsTypedRouter.Use(s.AuthorizeMiddleware.Authorize)
+	apiV1ConnectorsTypedRouter.Use(s.RateLimitMiddleware.RateLimit)
+	apiV1ConnectorsTypedRouter.Use(s.AuditMiddleware.Audit)
 
 	//  /api/connector_mgmt/v1/kafka_connector_clusters
 	v1Collections = append(v1Collections, api.CollectionMetadata{
@@ -110,6 +130,8 @@ func (s *options) AddRoutes(mainRouter *mux.Router) error {
 	apiV1ConnectorClustersRouter.HandleFunc("/{connector_cluster_id}", s.ConnectorClusterHandler.Delete).Methods(http.MethodDelete)
 	apiV1ConnectorClustersRouter.HandleFunc("/{connector_cluster_id}/{_:addon[-_]parameters}", s.ConnectorClusterHandler.GetAddonParameters).Methods(http.MethodGet)
 	apiV1ConnectorClustersRouter.Use(s.AuthorizeMiddleware.Authorize)
+	apiV1ConnectorClustersRouter.Use(s.RateLimitMiddleware.RateLimit)
+	apiV1ConnectorClustersRouter.Use(s.AuditMiddleware.Audit)
 
 	// This section adds the API's accessed by the connector agent...
 	{
@@ -119,6 +141,8 @@ func (s *options) AddRoutes(mainRouter *mux.Router) error {
 		agentRouter.HandleFunc("/deployments", s.ConnectorClusterHandler.ListDeployments).Methods(http.MethodGet)
 		agentRouter.HandleFunc("/deployments/{deployment_id}", s.ConnectorClusterHandler.GetDeployment).Methods(http.MethodGet)
 		agentRouter.HandleFunc("/deployments/{deployment_id}/status", s.ConnectorClusterHandler.UpdateDeploymentStatus).Methods(http.MethodPut)
+		agentRouter.HandleFunc("/deployments/{deployment_id}/logs", s.ConnectorClusterHandler.PushDeploymentLogs).Methods(http.MethodPost)
+		agentRouter.HandleFunc("/deployments/{deployment_id}/metrics", s.ConnectorClusterHandler.PushDeploymentMetrics).Methods(http.MethodPost)
 		auth.UseOperatorAuthorisationMiddleware(agentRouter, s.KeycloakService.GetConfig().KafkaRealm.ValidIssuerURI, "connector_cluster_id", s.AuthAgentService)
 	}
 
@@ -131,12 +155,20 @@ func (s *options) AddRoutes(mainRouter *mux.Router) error {
 	adminRouter.Use(auth.NewRequireIssuerMiddleware().RequireIssuer([]string{s.KeycloakService.GetConfig().OSDClusterIDPRealm.ValidIssuerURI}, kerrors.ErrorNotFound))
 	adminRouter.Use(auth.NewRolesAuhzMiddleware().RequireRolesForMethods(rolesMapping, kerrors.ErrorNotFound))
 	adminRouter.Use(auth.NewAuditLogMiddleware().AuditLog(kerrors.ErrorNotFound))
+	adminRouter.Use(s.AuditMiddleware.Audit)
 	adminRouter.HandleFunc("", s.ConnectorAdminHandler.ListConnectorClusters).Methods(http.MethodGet)
 	adminRouter.HandleFunc("/{connector_cluster_id}/upgrades/type", s.ConnectorAdminHandler.GetConnectorUpgradesByType).Methods(http.MethodGet)
 	adminRouter.HandleFunc("/{connector_cluster_id}/upgrades/type", s.ConnectorAdminHandler.UpgradeConnectorsByType).Methods(http.MethodPut)
 	adminRouter.HandleFunc("/{connector_cluster_id}/upgrades/operator", s.ConnectorAdminHandler.GetConnectorUpgradesByOperator).Methods(http.MethodGet)
 	adminRouter.HandleFunc("/{connector_cluster_id}/upgrades/operator", s.ConnectorAdminHandler.UpgradeConnectorsByOperator).Methods(http.MethodPut)
 
+	adminTypesRouter := apiV1Router.PathPrefix("/admin/{_:kafka[-_]connector[-_]types}").Subrouter()
+	adminTypesRouter.Use(auth.NewRequireIssuerMiddleware().RequireIssuer([]string{s.KeycloakService.GetConfig().OSDClusterIDPRealm.ValidIssuerURI}, kerrors.ErrorNotFound))
+	adminTypesRouter.Use(auth.NewRolesAuhzMiddleware().RequireRolesForMethods(rolesMapping, kerrors.ErrorNotFound))
+	adminTypesRouter.Use(auth.NewAuditLogMiddleware().AuditLog(kerrors.ErrorNotFound))
+	adminTypesRouter.Use(s.AuditMiddleware.Audit)
+	adminTypesRouter.HandleFunc("/deprecations", s.ConnectorAdminHandler.ListDeprecatedConnectors).Methods(http.MethodGet)
+
 	v1Metadata := api.VersionMetadata{
 		ID:          "v1",
 		Collections: v1Collections,
@@ -151,8 +183,13 @@ func (s *options) AddRoutes(mainRouter *mux.Router) error {
 	apiRouter.HandleFunc("", apiMetadata.ServeHTTP).Methods(http.MethodGet)
 	apiV1Router.HandleFunc("", v1Metadata.ServeHTTP).Methods(http.MethodGet)
 
+	apiRouter.Use(tracing.VarsMiddleware(map[string]attribute.Key{
+		"connector_id":         tracing.ConnectorIDKey,
+		"connector_cluster_id": tracing.ConnectorClusterIDKey,
+	}))
 	apiRouter.Use(coreHandlers.MetricsMiddleware)
 	apiRouter.Use(db.TransactionMiddleware(s.DB))
+	apiRouter.Use(s.IdempotencyMiddleware.Idempotency)
 	apiRouter.Use(gorillaHandlers.CompressHandler)
 	return nil
 }
@@ -0,0 +1,38 @@
+package services
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/quota"
+)
+
+// ConnectorsQuotaResource is the resource of the organisation quotas budgeting the connectors, each connector consumes one unit
+const ConnectorsQuotaResource = "connectors"
+
+type connectorQuotaConsumer struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+var _ quota.QuotaConsumer = &connectorQuotaConsumer{}
+
+func NewConnectorQuotaConsumer(connectionFactory *db.ConnectionFactory) *connectorQuotaConsumer {
+	return &connectorQuotaConsumer{
+		connectionFactory: connectionFactory,
+	}
+}
+
+func (c *connectorQuotaConsumer) Resources() []string {
+	return []string{ConnectorsQuotaResource}
+}
+
+func (c *connectorQuotaConsumer) Consumed(orgId string, resource string) (int, *errors.ServiceError) {
+	var count int64
+	if err := c.connectionFactory.New().
+		Model(&dbapi.Connector{}).
+		Where("organisation_id = ?", orgId).
+		Count(&count).Error; err != nil {
+		return 0, errors.NewWithCause(errors.ErrorGeneral, err, "unable to count connectors of organisation %s", orgId)
+	}
+	return int(count), nil
+}
@@ -0,0 +1,447 @@
+package services
+
+import (
+	"strings"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/vault"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/secrets"
+	"github.com/spyzhov/ajson"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ConnectorOwningResourcePrefix is the prefix of the owning resource tag of vault secrets that belong to a connector
+const ConnectorOwningResourcePrefix = "/v1/connector/"
+
+// ConnectorOwningResource returns the owning resource tag used for the vault secrets of a connector
+func ConnectorOwningResource(connectorId string) string {
+	return ConnectorOwningResourcePrefix + connectorId
+}
+
+//go:generate moq -out connector_secrets_moq.go . ConnectorSecretsService
+type ConnectorSecretsService interface {
+	// ForEachOrphanedSecret calls f for every connector secret in the vault that is no longer referenced by its owning
+	// connector, with the time the secret was first found orphaned.
+	ForEachOrphanedSecret(f func(name string, owningResource string, orphanedSince time.Time) bool) *errors.ServiceError
+	// RotateSecrets copies every secret of the connectors and their revisions to a new key in the target vault and
+	// updates the secret references of the connectors and their revisions. The old secrets are left in place for the connectors that still use them, and are
+	// deleted once orphaned for the grace period. It returns the number of secrets rotated.
+	RotateSecrets(target vault.VaultService, dryRun bool) (int, *errors.ServiceError)
+}
+
+var _ ConnectorSecretsService = &connectorSecretsService{}
+
+type connectorSecretsService struct {
+	connectionFactory     *db.ConnectionFactory
+	vaultService          vault.VaultService
+	connectorTypesService ConnectorTypesService
+}
+
+func NewConnectorSecretsService(connectionFactory *db.ConnectionFactory, vaultService vault.VaultService, connectorTypesService ConnectorTypesService) *connectorSecretsService {
+	return &connectorSecretsService{
+		connectionFactory:     connectionFactory,
+		vaultService:          vaultService,
+		connectorTypesService: connectorTypesService,
+	}
+}
+
+func (k *connectorSecretsService) ForEachOrphanedSecret(f func(name string, owningResource string, orphanedSince time.Time) bool) *errors.ServiceError {
+	now := time.Now()
+
+	// group the connector secrets in the vault by owning connector
+	owned := map[string]map[string]string{}
+	err := k.vaultService.ForEachSecret(func(name string, owningResource string) bool {
+		if !strings.HasPrefix(owningResource, ConnectorOwningResourcePrefix) {
+			return true
+		}
+		id := strings.TrimPrefix(owningResource, ConnectorOwningResourcePrefix)
+		if owned[id] == nil {
+			owned[id] = map[string]string{}
+		}
+		owned[id][name] = owningResource
+		return true
+	})
+	if err != nil {
+		return errors.GeneralError("failed to list vault secrets: %v", err)
+	}
+
+	orphaned := map[string]string{}
+	for id, names := range owned {
+		referenced, serr := k.getReferencedSecrets(id)
+		if serr != nil {
+			return serr
+		}
+		if referenced == nil {
+			// the connector type is unknown so the references can't be determined, leave the secrets alone
+			continue
+		}
+		for name, owningResource := range names {
+			if _, found := referenced[name]; !found {
+				orphaned[name] = owningResource
+			}
+		}
+	}
+
+	orphanedSince, serr := k.updateOrphanedSecrets(orphaned, now)
+	if serr != nil {
+		return serr
+	}
+	for name, owningResource := range orphaned {
+		if !f(name, owningResource, orphanedSince[name]) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// updateOrphanedSecrets persists since when the given secrets are orphaned, so that the grace period survives restarts
+// and is shared by every instance and the vault gc command, and forgets the secrets that are no longer orphaned.
+func (k *connectorSecretsService) updateOrphanedSecrets(orphaned map[string]string, now time.Time) (map[string]time.Time, *errors.ServiceError) {
+	dbConn := k.connectionFactory.New()
+	var known []dbapi.ConnectorOrphanedSecret
+	if err := dbConn.Find(&known).Error; err != nil {
+		return nil, errors.GeneralError("unable to list orphaned connector secrets: %v", err)
+	}
+
+	result := map[string]time.Time{}
+	var forgotten []string
+	for _, secret := range known {
+		if _, found := orphaned[secret.Name]; found {
+			result[secret.Name] = secret.OrphanedSince
+		} else {
+			forgotten = append(forgotten, secret.Name)
+		}
+	}
+	if len(forgotten) > 0 {
+		if err := dbConn.Where("name IN ?", forgotten).Delete(&dbapi.ConnectorOrphanedSecret{}).Error; err != nil {
+			return nil, errors.GeneralError("unable to delete orphaned connector secrets: %v", err)
+		}
+	}
+
+	for name, owningResource := range orphaned {
+		if _, found := result[name]; found {
+			continue
+		}
+		secret := dbapi.ConnectorOrphanedSecret{
+			Name:           name,
+			OwningResource: owningResource,
+			OrphanedSince:  now,
+		}
+		// another instance may have found the secret orphaned concurrently, keep its time
+		if err := dbConn.Clauses(clause.OnConflict{DoNothing: true}).Create(&secret).Error; err != nil {
+			return nil, errors.GeneralError("unable to save orphaned connector secret %s: %v", name, err)
+		}
+		result[name] = now
+	}
+	return result, nil
+}
+
+// getReferencedSecrets returns the set of secret keys referenced by a connector and its revisions, an empty set if
+// the connector does not exist, and nil if the references could not be determined.
+func (k *connectorSecretsService) getReferencedSecrets(connectorId string) (map[string]struct{}, *errors.ServiceError) {
+	dbConn := k.connectionFactory.New()
+	var connectors dbapi.ConnectorList
+	if err := dbConn.Where("id = ?", connectorId).Find(&connectors).Error; err != nil {
+		return nil, errors.GeneralError("unable to find connector %s: %v", connectorId, err)
+	}
+
+	result := map[string]struct{}{}
+	if len(connectors) == 0 {
+		return result, nil
+	}
+
+	refs, serr := getConnectorSecretRefs(dbConn, k.connectorTypesService, connectors[0])
+	if serr != nil || refs == nil {
+		return nil, serr
+	}
+	for _, ref := range refs {
+		result[ref] = struct{}{}
+	}
+	return result, nil
+}
+
+// getConnectorSecretRefs returns the secret keys referenced by a connector and by its revisions, the secrets of
+// a revision are kept until the revision is pruned so that the connector can be rolled back to it. It returns nil
+// if the references could not be determined because a connector type is unknown.
+func getConnectorSecretRefs(dbConn *gorm.DB, connectorTypesService ConnectorTypesService, connector *dbapi.Connector) ([]string, *errors.ServiceError) {
+	var revisions dbapi.ConnectorRevisionList
+	if err := dbConn.Where("connector_id = ?", connector.ID).Find(&revisions).Error; err != nil {
+		return nil, errors.GeneralError("unable to find revisions of connector %s: %v", connector.ID, err)
+	}
+	return getRevisionSecretRefs(connectorTypesService, connector.ID, append([]*dbapi.Connector{connector}, revisionConnectors(connector.ID, revisions)...))
+}
+
+// revisionConnectors returns the connector configurations of the revisions, without their service account
+func revisionConnectors(connectorId string, revisions dbapi.ConnectorRevisionList) []*dbapi.Connector {
+	result := make([]*dbapi.Connector, 0, len(revisions))
+	for _, rev := range revisions {
+		resource := &dbapi.Connector{
+			ConnectorTypeId: rev.ConnectorTypeId,
+			ConnectorSpec:   rev.ConnectorSpec,
+		}
+		resource.ID = connectorId
+		result = append(result, resource)
+	}
+	return result
+}
+
+// getRevisionSecretRefs returns the secret keys referenced by configurations of a connector, or nil if a connector
+// type is unknown.
+func getRevisionSecretRefs(connectorTypesService ConnectorTypesService, connectorId string, resources []*dbapi.Connector) ([]string, *errors.ServiceError) {
+	result := []string{}
+	types := map[string]*dbapi.ConnectorType{}
+	for _, resource := range resources {
+		ct, found := types[resource.ConnectorTypeId]
+		if !found {
+			var serr *errors.ServiceError
+			if ct, serr = connectorTypesService.Get(resource.ConnectorTypeId); serr != nil {
+				logger.Logger.Warningf("skipping secrets of connector %s with unknown connector type %s", connectorId, resource.ConnectorTypeId)
+				return nil, nil
+			}
+			types[resource.ConnectorTypeId] = ct
+		}
+		refs, err := getSecretRefs(resource, ct)
+		if err != nil {
+			return nil, errors.GeneralError("could not get secrets of connector %s: %v", connectorId, err)
+		}
+		result = append(result, refs...)
+	}
+	return result, nil
+}
+
+func (k *connectorSecretsService) RotateSecrets(target vault.VaultService, dryRun bool) (int, *errors.ServiceError) {
+	dbConn := k.connectionFactory.New()
+	var connectors dbapi.ConnectorList
+	if err := dbConn.Find(&connectors).Error; err != nil {
+		return 0, errors.GeneralError("unable to list connectors: %v", err)
+	}
+
+	count := 0
+	for _, connector := range connectors {
+		rotated, serr := k.rotateConnectorSecrets(connector, target, dryRun)
+		count += rotated
+		if serr != nil {
+			return count, serr
+		}
+	}
+	return count, nil
+}
+
+// rotateSecretsMaxAttempts is the number of times the secrets of a connector are rotated before giving up, when the
+// connector keeps being modified while its secrets are rotated
+const rotateSecretsMaxAttempts = 3
+
+// rotateConnectorSecrets rotates the secrets of the connector and of its revisions, it rotates them again from the
+// current configuration of the connector when the connector was modified concurrently.
+func (k *connectorSecretsService) rotateConnectorSecrets(connector *dbapi.Connector, target vault.VaultService, dryRun bool) (int, *errors.ServiceError) {
+	for attempt := 1; ; attempt++ {
+		rotated, modified, serr := k.tryRotateConnectorSecrets(connector, target, dryRun)
+		if serr != nil || !modified {
+			return rotated, serr
+		}
+		if attempt == rotateSecretsMaxAttempts {
+			return 0, errors.Conflict("connector %s has been modified while rotating its secrets %d times", connector.ID, attempt)
+		}
+
+		var connectors dbapi.ConnectorList
+		if err := k.connectionFactory.New().Where("id = ?", connector.ID).Find(&connectors).Error; err != nil {
+			return 0, errors.GeneralError("unable to find connector %s: %v", connector.ID, err)
+		}
+		if len(connectors) == 0 {
+			// the connector was deleted, its secrets are orphaned
+			return 0, nil
+		}
+		connector = connectors[0]
+	}
+}
+
+// tryRotateConnectorSecrets copies the secrets referenced by the connector and its revisions to new keys, and updates
+// the references of the connector and its revisions in a transaction. The connector is only updated if its version
+// didn't change, otherwise the new secrets are deleted and modified is true.
+func (k *connectorSecretsService) tryRotateConnectorSecrets(connector *dbapi.Connector, target vault.VaultService, dryRun bool) (rotated int, modified bool, serr *errors.ServiceError) {
+	types := map[string]*dbapi.ConnectorType{}
+	getType := func(id string) *dbapi.ConnectorType {
+		ct, found := types[id]
+		if !found {
+			var err *errors.ServiceError
+			if ct, err = k.connectorTypesService.Get(id); err != nil {
+				logger.Logger.Warningf("skipping secrets of connector %s with unknown connector type %s", connector.ID, id)
+				ct = nil
+			}
+			types[id] = ct
+		}
+		return ct
+	}
+	ct := getType(connector.ConnectorTypeId)
+	if ct == nil {
+		return 0, false, nil
+	}
+
+	dbConn := k.connectionFactory.New()
+	var revisions dbapi.ConnectorRevisionList
+	if err := dbConn.Where("connector_id = ?", connector.ID).Find(&revisions).Error; err != nil {
+		return 0, false, errors.GeneralError("unable to find revisions of connector %s: %v", connector.ID, err)
+	}
+
+	// the connector and its revisions share the secrets they have in common, each secret is copied once
+	rekeyed := map[string]string{}
+	rekey := func(ref string) (string, error) {
+		if keyId, found := rekeyed[ref]; found {
+			return keyId, nil
+		}
+		keyId := api.NewID()
+		if !dryRun {
+			value, err := k.vaultService.GetSecretString(ref)
+			if err != nil {
+				return "", err
+			}
+			if err := target.SetSecretString(keyId, value, ConnectorOwningResource(connector.ID)); err != nil {
+				return "", err
+			}
+		}
+		rekeyed[ref] = keyId
+		return keyId, nil
+	}
+
+	// remove the new secrets if the connector could not be switched over to them
+	rollback := func() {
+		if dryRun {
+			return
+		}
+		for _, ref := range rekeyed {
+			if err := target.DeleteSecretString(ref); err != nil {
+				logger.Logger.Errorf("failed to delete vault secret key '%s': %v", ref, err)
+			}
+		}
+	}
+
+	rekeySpec := func(ct *dbapi.ConnectorType, spec api.JSON) (api.JSON, error) {
+		updated, err := secrets.ModifySecrets(ct.JsonSchema, spec, func(node *ajson.Node) error {
+			if node.Type() != ajson.Object {
+				return nil
+			}
+			ref, err := node.GetKey("ref")
+			if err != nil {
+				return nil
+			}
+			r, err := ref.GetString()
+			if err != nil {
+				return nil
+			}
+			keyId, err := rekey(r)
+			if err != nil {
+				return err
+			}
+			return node.SetObject(map[string]*ajson.Node{
+				"kind": ajson.StringNode("", target.Kind()),
+				"ref":  ajson.StringNode("", keyId),
+			})
+		})
+		return api.JSON(updated), err
+	}
+
+	update := map[string]interface{}{}
+	if connector.ServiceAccount.ClientSecretRef != "" {
+		keyId, err := rekey(connector.ServiceAccount.ClientSecretRef)
+		if err != nil {
+			rollback()
+			return 0, false, errors.GeneralError("could not rotate kafka client secret of connector %s: %v", connector.ID, err)
+		}
+		update["service_account_client_secret"] = keyId
+	}
+
+	if len(connector.ConnectorSpec) != 0 {
+		updated, err := rekeySpec(ct, connector.ConnectorSpec)
+		if err != nil {
+			rollback()
+			return 0, false, errors.GeneralError("could not rotate secrets of connector %s: %v", connector.ID, err)
+		}
+		update["connector_spec"] = updated
+	}
+
+	revisionSpecs := map[int64]api.JSON{}
+	for _, rev := range revisions {
+		rct := getType(rev.ConnectorTypeId)
+		if rct == nil || len(rev.ConnectorSpec) == 0 {
+			continue
+		}
+		updated, err := rekeySpec(rct, rev.ConnectorSpec)
+		if err != nil {
+			rollback()
+			return 0, false, errors.GeneralError("could not rotate secrets of revision %d of connector %s: %v", rev.Version, connector.ID, err)
+		}
+		revisionSpecs[rev.Version] = updated
+	}
+
+	if dryRun || len(rekeyed) == 0 {
+		return len(rekeyed), false, nil
+	}
+
+	// we must ensure we commit or rollback this transaction, the connector and its revisions are switched over to the
+	// new secrets together
+	tx := dbConn.Begin()
+	if tx.Error != nil {
+		rollback()
+		return 0, false, errors.GeneralError("failed to update secret references of connector %s: %v", connector.ID, tx.Error)
+	}
+	// the update bumps the version of the connector, so that its deployments pick up the new references
+	result := tx.Model(connector).Where("version = ?", connector.Version).Updates(update)
+	if result.Error != nil {
+		tx.Rollback()
+		rollback()
+		return 0, false, errors.GeneralError("failed to update secret references of connector %s: %v", connector.ID, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		tx.Rollback()
+		rollback()
+		return 0, true, nil
+	}
+	for version, spec := range revisionSpecs {
+		if err := tx.Model(&dbapi.ConnectorRevision{}).
+			Where("connector_id = ? AND version = ?", connector.ID, version).
+			Update("connector_spec", spec).Error; err != nil {
+			tx.Rollback()
+			rollback()
+			return 0, false, errors.GeneralError("failed to update secret references of revision %d of connector %s: %v", version, connector.ID, err)
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		rollback()
+		return 0, false, errors.GeneralError("failed to update secret references of connector %s: %v", connector.ID, err)
+	}
+
+	// the old secrets are not deleted here, the deployments of the connector keep using them until they pick up the
+	// new references, they are orphaned now and get deleted once the grace period is over
+	return len(rekeyed), false, nil
+}
+
+func getSecretRefs(resource *dbapi.Connector, ct *dbapi.ConnectorType) (result []string, err error) {
+	if resource.ServiceAccount.ClientSecretRef != "" {
+		result = append(result, resource.ServiceAccount.ClientSecretRef)
+	}
+
+	if len(resource.ConnectorSpec) != 0 {
+		_, err = secrets.ModifySecrets(ct.JsonSchema, resource.ConnectorSpec, func(node *ajson.Node) error {
+			if node.Type() != ajson.Object {
+				return nil
+			}
+			ref, err := node.GetKey("ref")
+			if err != nil {
+				return nil
+			}
+			key, err := ref.GetString()
+			if err != nil {
+				return nil
+			}
+			result = append(result, key)
+			return nil
+		})
+	}
+	return
+}
@@ -0,0 +1,191 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/vault"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	. "github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+const testSecretsSchema = `{
+	"type": "object",
+	"properties": {
+		"topic": {"type": "string"},
+		"aws_secret_key": {
+			"oneOf": [
+				{"type": "string", "format": "password"},
+				{"type": "object"}
+			]
+		}
+	}
+}`
+
+type connectorTypesServiceStub struct {
+	ConnectorTypesService
+	types map[string]*dbapi.ConnectorType
+}
+
+func (s *connectorTypesServiceStub) Get(id string) (*dbapi.ConnectorType, *errors.ServiceError) {
+	if ct, found := s.types[id]; found {
+		return ct, nil
+	}
+	return nil, errors.NotFound("connector type %s not found", id)
+}
+
+func newTestSecretsVault(t *testing.T, secrets map[string]string) *vault.TmpVaultService {
+	vaultService, err := vault.NewTmpVaultService()
+	Expect(err).To(BeNil())
+	for name, owningResource := range secrets {
+		Expect(vaultService.SetSecretString(name, "value of "+name, owningResource)).To(BeNil())
+	}
+	vaultService.ResetCounters()
+	return vaultService
+}
+
+func Test_connectorSecretsService_ForEachOrphanedSecret(t *testing.T) {
+	RegisterTestingT(t)
+	vaultService := newTestSecretsVault(t, map[string]string{
+		"used-ref":    ConnectorOwningResource("connector-1"),
+		"removed-ref": ConnectorOwningResource("connector-1"),
+		"deleted-ref": ConnectorOwningResource("connector-2"),
+		"kafka-ref":   "/v1/kafka/kafka-1",
+	})
+	orphanedSince := time.Now().Add(-2 * time.Hour)
+
+	mocket.Catcher.Reset()
+	mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "connectors" WHERE id = $1`).WithArgs("connector-1").
+		WithReply([]map[string]interface{}{{
+			"id":                            "connector-1",
+			"connector_type_id":             "test-type",
+			"service_account_client_secret": "used-ref",
+		}})
+	mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "connector_orphaned_secrets"`).
+		WithReply([]map[string]interface{}{
+			{"name": "deleted-ref", "owning_resource": ConnectorOwningResource("connector-2"), "orphaned_since": orphanedSince},
+			{"name": "readopted-ref", "owning_resource": ConnectorOwningResource("connector-3"), "orphaned_since": orphanedSince},
+		})
+	forget := mocket.Catcher.NewMock().WithQuery(`DELETE FROM "connector_orphaned_secrets" WHERE name IN ($1)`).WithArgs("readopted-ref")
+	remember := mocket.Catcher.NewMock().WithQuery(`INSERT INTO "connector_orphaned_secrets"`)
+
+	k := NewConnectorSecretsService(db.NewMockConnectionFactory(nil), vaultService, &connectorTypesServiceStub{
+		types: map[string]*dbapi.ConnectorType{"test-type": {JsonSchema: api.JSON(testSecretsSchema)}},
+	})
+	start := time.Now()
+	orphaned := map[string]time.Time{}
+	err := k.ForEachOrphanedSecret(func(name string, owningResource string, since time.Time) bool {
+		orphaned[name] = since
+		return true
+	})
+
+	Expect(err).To(BeNil())
+	Expect(orphaned).To(HaveLen(2))
+	Expect(orphaned["deleted-ref"]).To(BeTemporally("==", orphanedSince))
+	Expect(orphaned["removed-ref"]).To(BeTemporally(">=", start))
+	Expect(forget.Triggered).To(BeTrue())
+	Expect(remember.Triggered).To(BeTrue())
+	Expect(vaultService.Counters().Deletes).To(BeZero())
+}
+
+func Test_connectorSecretsService_RotateSecrets(t *testing.T) {
+	tests := []struct {
+		name        string
+		dryRun      bool
+		modified    bool
+		wantRotated int
+	}{
+		{
+			name:        "should only count the secrets on a dry run",
+			dryRun:      true,
+			wantRotated: 3,
+		},
+		{
+			name:        "should copy the secrets to new keys and keep the old ones",
+			dryRun:      false,
+			wantRotated: 3,
+		},
+		{
+			name:        "should rotate the secrets again when the connector was modified concurrently",
+			dryRun:      false,
+			modified:    true,
+			wantRotated: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			vaultService := newTestSecretsVault(t, map[string]string{
+				"client-secret-ref":   ConnectorOwningResource("connector-1"),
+				"spec-secret-ref":     ConnectorOwningResource("connector-1"),
+				"revision-secret-ref": ConnectorOwningResource("connector-1"),
+			})
+			target := newTestSecretsVault(t, nil)
+
+			mocket.Catcher.Reset()
+			mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "connectors"`).
+				WithReply([]map[string]interface{}{{
+					"id":                            "connector-1",
+					"version":                       1,
+					"connector_type_id":             "test-type",
+					"service_account_client_secret": "client-secret-ref",
+					"connector_spec":                []byte(`{"topic": "test", "aws_secret_key": {"kind": "tmp", "ref": "spec-secret-ref"}}`),
+				}})
+			mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "connector_revisions"`).
+				WithReply([]map[string]interface{}{
+					{
+						"connector_id":      "connector-1",
+						"version":           1,
+						"connector_type_id": "test-type",
+						"connector_spec":    []byte(`{"topic": "test", "aws_secret_key": {"kind": "tmp", "ref": "spec-secret-ref"}}`),
+					},
+					{
+						"connector_id":      "connector-1",
+						"version":           0,
+						"connector_type_id": "test-type",
+						"connector_spec":    []byte(`{"topic": "old", "aws_secret_key": {"kind": "tmp", "ref": "revision-secret-ref"}}`),
+					},
+				})
+			if tt.modified {
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "connectors" SET`).WithRowsNum(0).OneTime()
+			}
+			update := mocket.Catcher.NewMock().WithQuery(`UPDATE "connectors" SET`).WithRowsNum(1)
+			updateRevisions := mocket.Catcher.NewMock().WithQuery(`UPDATE "connector_revisions" SET "connector_spec"`)
+
+			k := NewConnectorSecretsService(db.NewMockConnectionFactory(nil), vaultService, &connectorTypesServiceStub{
+				types: map[string]*dbapi.ConnectorType{"test-type": {JsonSchema: api.JSON(testSecretsSchema)}},
+			})
+			rotated, err := k.RotateSecrets(target, tt.dryRun)
+
+			Expect(err).To(BeNil())
+			Expect(rotated).To(Equal(tt.wantRotated))
+			Expect(update.Triggered).To(Equal(!tt.dryRun))
+			Expect(updateRevisions.Triggered).To(Equal(!tt.dryRun))
+			// the old secrets are left for the deployments still using them
+			Expect(vaultService.Counters().Deletes).To(BeZero())
+			if tt.dryRun {
+				Expect(target.Counters().Inserts).To(BeZero())
+				return
+			}
+			if tt.modified {
+				// the secrets copied for the modified connector are removed
+				Expect(target.Counters().Inserts).To(BeEquivalentTo(6))
+				Expect(target.Counters().Deletes).To(BeEquivalentTo(3))
+			} else {
+				Expect(target.Counters().Inserts).To(BeEquivalentTo(3))
+			}
+			Expect(target.ForEachSecret(func(name string, owningResource string) bool {
+				Expect(owningResource).To(Equal(ConnectorOwningResource("connector-1")))
+				value, err := target.GetSecretString(name)
+				Expect(err).To(BeNil())
+				Expect(value).To(BeElementOf("value of client-secret-ref", "value of spec-secret-ref", "value of revision-secret-ref"))
+				return true
+			})).To(BeNil())
+		})
+	}
+}
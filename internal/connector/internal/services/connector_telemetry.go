@@ -0,0 +1,172 @@
+package services
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+)
+
+// maxLogMessageLength is the length log lines reported by connector agents are truncated to
+const maxLogMessageLength = 4096
+
+// ConnectorTelemetryService stores the logs and metrics that connector agents report for the connector deployments
+// they run, and keeps them within the configured limits and retention.
+//go:generate moq -out connector_telemetry_moq.go . ConnectorTelemetryService
+type ConnectorTelemetryService interface {
+	SaveLogs(deployment *dbapi.ConnectorDeployment, entries dbapi.ConnectorLogEntryList) *errors.ServiceError
+	SaveMetrics(deployment *dbapi.ConnectorDeployment, samples dbapi.ConnectorMetricSampleList) *errors.ServiceError
+	// GetLogs returns the log lines of a connector reported since the given time, oldest first.
+	GetLogs(connectorId string, since time.Time) (dbapi.ConnectorLogEntryList, *errors.ServiceError)
+	// GetMetrics returns the metric samples of a connector reported since the given time, ordered by metric name
+	// and time. All metrics are returned if no names are given.
+	GetMetrics(connectorId string, since time.Time, names []string) (dbapi.ConnectorMetricSampleList, *errors.ServiceError)
+	// PruneTelemetry deletes the logs and metrics past their retention, and those of deleted connectors.
+	PruneTelemetry() *errors.ServiceError
+}
+
+var _ ConnectorTelemetryService = &connectorTelemetryService{}
+
+type connectorTelemetryService struct {
+	connectorsConfig  *config.ConnectorsConfig
+	connectionFactory *db.ConnectionFactory
+}
+
+func NewConnectorTelemetryService(connectorsConfig *config.ConnectorsConfig, connectionFactory *db.ConnectionFactory) *connectorTelemetryService {
+	return &connectorTelemetryService{
+		connectorsConfig:  connectorsConfig,
+		connectionFactory: connectionFactory,
+	}
+}
+
+func (k *connectorTelemetryService) SaveLogs(deployment *dbapi.ConnectorDeployment, entries dbapi.ConnectorLogEntryList) *errors.ServiceError {
+	if limit := k.connectorsConfig.LogsLimit; limit > 0 && len(entries) > limit {
+		entries = entries[len(entries)-limit:]
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	for i := range entries {
+		entries[i].ID = 0
+		entries[i].ConnectorID = deployment.ConnectorID
+		entries[i].DeploymentID = deployment.ID
+		entries[i].Timestamp = reportedTimestamp(entries[i].Timestamp, now)
+		if len(entries[i].Message) > maxLogMessageLength {
+			entries[i].Message = entries[i].Message[:maxLogMessageLength]
+		}
+	}
+
+	dbConn := k.connectionFactory.New()
+	if err := dbConn.Create(&entries).Error; err != nil {
+		return errors.GeneralError("failed to save logs of connector %s: %v", deployment.ConnectorID, err)
+	}
+
+	if k.connectorsConfig.LogsLimit > 0 {
+		if err := dbConn.Where("connector_id = ? AND id NOT IN (?)", deployment.ConnectorID,
+			k.connectionFactory.New().Model(&dbapi.ConnectorLogEntry{}).Select("id").
+				Where("connector_id = ?", deployment.ConnectorID).
+				Order("timestamp desc, id desc").
+				Limit(k.connectorsConfig.LogsLimit)).
+			Delete(&dbapi.ConnectorLogEntry{}).Error; err != nil {
+			return errors.GeneralError("failed to prune logs of connector %s: %v", deployment.ConnectorID, err)
+		}
+	}
+	return nil
+}
+
+func (k *connectorTelemetryService) SaveMetrics(deployment *dbapi.ConnectorDeployment, samples dbapi.ConnectorMetricSampleList) *errors.ServiceError {
+	if limit := k.connectorsConfig.MetricsLimit; limit > 0 && len(samples) > limit {
+		samples = samples[len(samples)-limit:]
+	}
+	if len(samples) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	for i := range samples {
+		samples[i].ID = 0
+		samples[i].ConnectorID = deployment.ConnectorID
+		samples[i].DeploymentID = deployment.ID
+		samples[i].Timestamp = reportedTimestamp(samples[i].Timestamp, now)
+	}
+
+	dbConn := k.connectionFactory.New()
+	if err := dbConn.Create(&samples).Error; err != nil {
+		return errors.GeneralError("failed to save metrics of connector %s: %v", deployment.ConnectorID, err)
+	}
+
+	if k.connectorsConfig.MetricsLimit > 0 {
+		if err := dbConn.Where("connector_id = ? AND id NOT IN (?)", deployment.ConnectorID,
+			k.connectionFactory.New().Model(&dbapi.ConnectorMetricSample{}).Select("id").
+				Where("connector_id = ?", deployment.ConnectorID).
+				Order("timestamp desc, id desc").
+				Limit(k.connectorsConfig.MetricsLimit)).
+			Delete(&dbapi.ConnectorMetricSample{}).Error; err != nil {
+			return errors.GeneralError("failed to prune metrics of connector %s: %v", deployment.ConnectorID, err)
+		}
+	}
+	return nil
+}
+
+func (k *connectorTelemetryService) GetLogs(connectorId string, since time.Time) (dbapi.ConnectorLogEntryList, *errors.ServiceError) {
+	// entries past the retention are only pruned periodically
+	if oldest := time.Now().Add(-k.connectorsConfig.LogsRetention); since.Before(oldest) {
+		since = oldest
+	}
+
+	var result dbapi.ConnectorLogEntryList
+	dbConn := k.connectionFactory.New()
+	if err := dbConn.Where("connector_id = ? AND timestamp >= ?", connectorId, since).
+		Order("timestamp, id").
+		Find(&result).Error; err != nil {
+		return nil, errors.GeneralError("unable to get logs of connector %s: %v", connectorId, err)
+	}
+	return result, nil
+}
+
+func (k *connectorTelemetryService) GetMetrics(connectorId string, since time.Time, names []string) (dbapi.ConnectorMetricSampleList, *errors.ServiceError) {
+	if oldest := time.Now().Add(-k.connectorsConfig.MetricsRetention); since.Before(oldest) {
+		since = oldest
+	}
+
+	var result dbapi.ConnectorMetricSampleList
+	dbConn := k.connectionFactory.New()
+	dbConn = dbConn.Where("connector_id = ? AND timestamp >= ?", connectorId, since)
+	if len(names) > 0 {
+		dbConn = dbConn.Where("name IN ?", names)
+	}
+	if err := dbConn.Order("name, timestamp").Find(&result).Error; err != nil {
+		return nil, errors.GeneralError("unable to get metrics of connector %s: %v", connectorId, err)
+	}
+	return result, nil
+}
+
+func (k *connectorTelemetryService) PruneTelemetry() *errors.ServiceError {
+	now := time.Now()
+	dbConn := k.connectionFactory.New()
+	// soft deleted connectors are excluded from the sub query, so their entries are pruned too
+	connectorIds := k.connectionFactory.New().Model(&dbapi.Connector{}).Select("id")
+
+	if err := dbConn.Where("timestamp < ? OR connector_id NOT IN (?)", now.Add(-k.connectorsConfig.LogsRetention), connectorIds).
+		Delete(&dbapi.ConnectorLogEntry{}).Error; err != nil {
+		return errors.GeneralError("failed to prune connector logs: %v", err)
+	}
+	if err := dbConn.Where("timestamp < ? OR connector_id NOT IN (?)", now.Add(-k.connectorsConfig.MetricsRetention), connectorIds).
+		Delete(&dbapi.ConnectorMetricSample{}).Error; err != nil {
+		return errors.GeneralError("failed to prune connector metrics: %v", err)
+	}
+	return nil
+}
+
+// reportedTimestamp returns the time an agent reported an entry at, agents with a skewed clock can't report
+// entries in the future that would outlive the retention.
+func reportedTimestamp(timestamp time.Time, now time.Time) time.Time {
+	if timestamp.IsZero() || timestamp.After(now) {
+		return now
+	}
+	return timestamp
+}
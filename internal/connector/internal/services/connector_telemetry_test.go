@@ -0,0 +1,106 @@
+package services
+
+import (
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	. "github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func newTestConnectorTelemetryService() *connectorTelemetryService {
+	return NewConnectorTelemetryService(&config.ConnectorsConfig{
+		LogsRetention:    time.Hour,
+		LogsLimit:        2,
+		MetricsRetention: time.Hour,
+		MetricsLimit:     2,
+	}, db.NewMockConnectionFactory(nil))
+}
+
+// insertedTimestamps returns the timestamps of the rows inserted by a statement
+func insertedTimestamps(args []driver.NamedValue) []time.Time {
+	var timestamps []time.Time
+	for _, arg := range args {
+		if timestamp, ok := arg.Value.(time.Time); ok {
+			timestamps = append(timestamps, timestamp)
+		}
+	}
+	return timestamps
+}
+
+func Test_connectorTelemetryService_SaveLogs(t *testing.T) {
+	RegisterTestingT(t)
+	past := time.Now().Add(-time.Minute).Round(time.Second)
+
+	mocket.Catcher.Reset()
+	var inserted []driver.NamedValue
+	insert := mocket.Catcher.NewMock().WithQuery(`INSERT INTO "connector_log_entries"`).
+		WithCallback(func(_ string, args []driver.NamedValue) {
+			inserted = args
+		})
+	prune := mocket.Catcher.NewMock().WithQuery(`DELETE FROM "connector_log_entries" WHERE connector_id = $1 AND id NOT IN`)
+
+	start := time.Now()
+	err := newTestConnectorTelemetryService().SaveLogs(&dbapi.ConnectorDeployment{ConnectorID: "connector-1"}, dbapi.ConnectorLogEntryList{
+		{Timestamp: past, Message: "dropped, over the limit"},
+		{Timestamp: past, Message: "reported in the past"},
+		{Timestamp: time.Now().Add(time.Hour), Message: "reported in the future"},
+	})
+
+	Expect(err).To(BeNil())
+	Expect(insert.Triggered).To(BeTrue())
+	Expect(prune.Triggered).To(BeTrue())
+	timestamps := insertedTimestamps(inserted)
+	Expect(timestamps).To(HaveLen(2))
+	Expect(timestamps[0]).To(BeTemporally("==", past))
+	Expect(timestamps[1]).To(BeTemporally(">=", start))
+	Expect(timestamps[1]).To(BeTemporally("<=", time.Now()))
+}
+
+func Test_connectorTelemetryService_SaveMetrics(t *testing.T) {
+	RegisterTestingT(t)
+
+	mocket.Catcher.Reset()
+	var inserted []driver.NamedValue
+	insert := mocket.Catcher.NewMock().WithQuery(`INSERT INTO "connector_metric_samples"`).
+		WithCallback(func(_ string, args []driver.NamedValue) {
+			inserted = args
+		})
+	prune := mocket.Catcher.NewMock().WithQuery(`DELETE FROM "connector_metric_samples" WHERE connector_id = $1 AND id NOT IN`)
+
+	start := time.Now()
+	err := newTestConnectorTelemetryService().SaveMetrics(&dbapi.ConnectorDeployment{ConnectorID: "connector-1"}, dbapi.ConnectorMetricSampleList{
+		{Name: "a", Value: 1},
+		{Name: "b", Value: 2, Timestamp: time.Now().Add(time.Hour)},
+		{Name: "c", Value: 3},
+	})
+
+	Expect(err).To(BeNil())
+	Expect(insert.Triggered).To(BeTrue())
+	Expect(prune.Triggered).To(BeTrue())
+	timestamps := insertedTimestamps(inserted)
+	Expect(timestamps).To(HaveLen(2))
+	for _, timestamp := range timestamps {
+		Expect(timestamp).To(BeTemporally(">=", start))
+		Expect(timestamp).To(BeTemporally("<=", time.Now()))
+	}
+}
+
+func Test_connectorTelemetryService_PruneTelemetry(t *testing.T) {
+	RegisterTestingT(t)
+
+	mocket.Catcher.Reset()
+	deletedConnectors := `connector_id NOT IN (SELECT "id" FROM "connectors" WHERE "connectors"."deleted_at" IS NULL)`
+	pruneLogs := mocket.Catcher.NewMock().WithQuery(`DELETE FROM "connector_log_entries" WHERE timestamp < $1 OR ` + deletedConnectors)
+	pruneMetrics := mocket.Catcher.NewMock().WithQuery(`DELETE FROM "connector_metric_samples" WHERE timestamp < $1 OR ` + deletedConnectors)
+
+	err := newTestConnectorTelemetryService().PruneTelemetry()
+
+	Expect(err).To(BeNil())
+	Expect(pruneLogs.Triggered).To(BeTrue())
+	Expect(pruneMetrics.Triggered).To(BeTrue())
+}
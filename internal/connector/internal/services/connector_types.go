@@ -17,17 +17,25 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/queryparser"
+	"github.com/golang/glog"
+	"gorm.io/gorm/clause"
 )
 
 type ConnectorTypesService interface {
 	Get(id string) (*dbapi.ConnectorType, *errors.ServiceError)
 	List(ctx context.Context, listArgs *services.ListArguments) (dbapi.ConnectorTypeList, *api.PagingMeta, *errors.ServiceError)
 	ForEachConnectorCatalogEntry(f func(id string, channel string, ccc *config.ConnectorChannelConfig) *errors.ServiceError) *errors.ServiceError
+	// PruneConnectorTypes deletes the connector types that are not in the given catalog, the ones still used by
+	// connectors are deprecated instead and deleted once no connector uses them anymore.
+	PruneConnectorTypes(catalogTypeIds []string) *errors.ServiceError
 
 	PutConnectorShardMetadata(ctc *dbapi.ConnectorShardMetadata) (int64, *errors.ServiceError)
 	GetConnectorShardMetadata(id int64) (*dbapi.ConnectorShardMetadata, *errors.ServiceError)
 	GetLatestConnectorShardMetadataID(tid, channel string) (int64, *errors.ServiceError)
+
+	ListDeprecatedConnectors(listArgs *services.ListArguments) (dbapi.ConnectorDeprecationList, *api.PagingMeta, *errors.ServiceError)
 }
 
 var _ ConnectorTypesService = &connectorTypesService{}
@@ -81,11 +89,26 @@ func (cts *connectorTypesService) Create(resource *dbapi.ConnectorType) *errors.
 		if dbConn.Model(&resource).Association("Capabilities").Replace(resource.Capabilities) != nil {
 			return errors.GeneralError("failed to update connector type %q capabilities: %v", tid, err)
 		}
+		// zero values are skipped by Updates, so a type can't be un-deprecated through it
+		if err := dbConn.Model(resource).Updates(map[string]interface{}{
+			"deprecated":   resource.Deprecated,
+			"successor_id": resource.SuccessorId,
+		}).Error; err != nil {
+			return errors.GeneralError("failed to update connector type %q deprecation: %v", tid, err)
+		}
+		if err := dbConn.Where("connector_type_id = ?", tid).Delete(&dbapi.ConnectorTypeDeprecatedChannel{}).Error; err != nil {
+			return errors.GeneralError("failed to update connector type %q deprecated channels: %v", tid, err)
+		}
+		if len(resource.DeprecatedChannels) > 0 {
+			if err := dbConn.Create(&resource.DeprecatedChannels).Error; err != nil {
+				return errors.GeneralError("failed to update connector type %q deprecated channels: %v", tid, err)
+			}
+		}
 	}
 
 	// read it back.... to get the updated version...
 	if err := dbConn.Where("id = ?", tid).
-		Preload("Channels").Preload("Labels").Preload("Capabilities").
+		Preload("Channels").Preload("Labels").Preload("Capabilities").Preload("DeprecatedChannels").
 		First(&resource).Error; err != nil {
 		return services.HandleGetError("Connector", "id", tid, err)
 	}
@@ -113,6 +136,7 @@ func (cts *connectorTypesService) Get(id string) (*dbapi.ConnectorType, *errors.
 		Preload("Channels").
 		Preload("Labels").
 		Preload("Capabilities").
+		Preload("DeprecatedChannels").
 		Where("connector_types.id = ?", id).
 		First(&resource).Error
 
@@ -179,6 +203,7 @@ func (cts *connectorTypesService) List(ctx context.Context, listArgs *services.L
 		Preload("Channels").
 		Preload("Labels").
 		Preload("Capabilities").
+		Preload("DeprecatedChannels").
 		Find(&resourceList)
 	if result.Error != nil {
 		return nil, nil, errors.ToServiceError(result.Error)
@@ -210,6 +235,89 @@ func (cts *connectorTypesService) ForEachConnectorCatalogEntry(f func(id string,
 	return nil
 }
 
+func (cts *connectorTypesService) PruneConnectorTypes(catalogTypeIds []string) *errors.ServiceError {
+	if len(catalogTypeIds) == 0 {
+		// an empty catalog is rather a misconfiguration than the removal of every connector type
+		return nil
+	}
+	dbConn := cts.connectionFactory.New()
+
+	var removed []string
+	if err := dbConn.Model(&dbapi.ConnectorType{}).Where("id NOT IN ?", catalogTypeIds).Pluck("id", &removed).Error; err != nil {
+		return errors.GeneralError("failed to list removed connector types: %v", err)
+	}
+	if len(removed) == 0 {
+		return nil
+	}
+	var used []string
+	if err := dbConn.Model(&dbapi.Connector{}).Distinct("connector_type_id").Where("connector_type_id IN ?", removed).Pluck("connector_type_id", &used).Error; err != nil {
+		return errors.GeneralError("failed to list connector types in use: %v", err)
+	}
+
+	for _, tid := range removed {
+		if shared.Contains(used, tid) {
+			if err := dbConn.Model(&dbapi.ConnectorType{}).Where("id = ? AND NOT deprecated", tid).Update("deprecated", true).Error; err != nil {
+				return errors.GeneralError("failed to deprecate removed connector type %q: %v", tid, err)
+			}
+			continue
+		}
+		// hard delete the type so that it can be added back to the catalog later
+		resource := dbapi.ConnectorType{Meta: api.Meta{ID: tid}}
+		if err := dbConn.Unscoped().Select(clause.Associations).Delete(&resource).Error; err != nil {
+			return errors.GeneralError("failed to delete removed connector type %q: %v", tid, err)
+		}
+		glog.Infof("deleted connector type %s removed from the connector catalog", tid)
+	}
+	return nil
+}
+
+// ListDeprecatedConnectors returns the connectors that use a deprecated connector type or a deprecated channel
+func (cts *connectorTypesService) ListDeprecatedConnectors(listArgs *services.ListArguments) (dbapi.ConnectorDeprecationList, *api.PagingMeta, *errors.ServiceError) {
+	var results dbapi.ConnectorDeprecationList
+	pagingMeta := &api.PagingMeta{
+		Page: listArgs.Page,
+		Size: listArgs.Size,
+	}
+
+	dbConn := cts.connectionFactory.New()
+	dbConn = dbConn.Table("connectors")
+	dbConn = dbConn.Joins("JOIN connector_types ON connector_types.id = connectors.connector_type_id")
+	dbConn = dbConn.Joins("LEFT JOIN connector_type_deprecated_channels ON connector_type_deprecated_channels.connector_type_id = connectors.connector_type_id AND connector_type_deprecated_channels.channel = connectors.channel")
+	dbConn = dbConn.Where("connectors.deleted_at IS NULL")
+	dbConn = dbConn.Where("connector_types.deprecated OR connector_type_deprecated_channels.channel IS NOT NULL")
+
+	total := int64(pagingMeta.Total)
+	if err := dbConn.Count(&total).Error; err != nil {
+		return nil, nil, errors.GeneralError("Unable to count deprecated connectors: %s", err)
+	}
+	pagingMeta.Total = int(total)
+
+	// a zero size lists all deprecated connectors
+	if pagingMeta.Size > 0 {
+		if pagingMeta.Size > pagingMeta.Total {
+			pagingMeta.Size = pagingMeta.Total
+		}
+		dbConn = dbConn.Offset((pagingMeta.Page - 1) * pagingMeta.Size).Limit(pagingMeta.Size)
+	} else {
+		pagingMeta.Size = pagingMeta.Total
+	}
+
+	dbConn = dbConn.Select(
+		"connectors.id AS connector_id",
+		"connectors.connector_type_id",
+		"connectors.channel",
+		"connectors.owner",
+		"connectors.organisation_id",
+		"connector_types.deprecated AS type_deprecated",
+		"connector_type_deprecated_channels.channel IS NOT NULL AS channel_deprecated",
+		"COALESCE(connector_types.successor_id, '') AS successor_id",
+	)
+	if err := dbConn.Order("connectors.id").Scan(&results).Error; err != nil {
+		return nil, nil, errors.GeneralError("Unable to list deprecated connectors: %s", err)
+	}
+	return results, pagingMeta, nil
+}
+
 func (cts *connectorTypesService) PutConnectorShardMetadata(ctc *dbapi.ConnectorShardMetadata) (int64, *errors.ServiceError) {
 
 	var resource dbapi.ConnectorShardMetadata
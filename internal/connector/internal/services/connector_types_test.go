@@ -0,0 +1,29 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	. "github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_connectorTypesService_PruneConnectorTypes(t *testing.T) {
+	RegisterTestingT(t)
+	mocket.Catcher.Reset()
+	mocket.Catcher.NewMock().WithQuery(`SELECT "id" FROM "connector_types" WHERE id NOT IN ($1,$2)`).
+		WithReply([]map[string]interface{}{{"id": "removed-type"}, {"id": "used-type"}})
+	mocket.Catcher.NewMock().WithQuery(`SELECT DISTINCT "connector_type_id" FROM "connectors" WHERE (connector_type_id IN ($1,$2))`).
+		WithReply([]map[string]interface{}{{"connector_type_id": "used-type"}})
+	deprecate := mocket.Catcher.NewMock().WithQuery(`UPDATE "connector_types" SET "deprecated"=$1`)
+	remove := mocket.Catcher.NewMock().WithQuery(`DELETE FROM "connector_types" WHERE "connector_types"."id" = $1`).WithArgs("removed-type")
+	removeUsed := mocket.Catcher.NewMock().WithQuery(`DELETE FROM "connector_types" WHERE "connector_types"."id" = $1`).WithArgs("used-type")
+
+	cts := NewConnectorTypesService(nil, db.NewMockConnectionFactory(nil))
+	err := cts.PruneConnectorTypes([]string{"catalog-type-1", "catalog-type-2"})
+
+	Expect(err).To(BeNil())
+	Expect(deprecate.Triggered).To(BeTrue())
+	Expect(remove.Triggered).To(BeTrue())
+	Expect(removeUsed.Triggered).To(BeFalse())
+}
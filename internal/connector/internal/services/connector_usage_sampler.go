@@ -0,0 +1,46 @@
+package services
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/metering"
+)
+
+// connectorUsageSampler meters the ready connectors, their instance type is the connector type
+type connectorUsageSampler struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+var _ metering.UsageSampler = &connectorUsageSampler{}
+
+func NewConnectorUsageSampler(connectionFactory *db.ConnectionFactory) *connectorUsageSampler {
+	return &connectorUsageSampler{
+		connectionFactory: connectionFactory,
+	}
+}
+
+func (s *connectorUsageSampler) Sample() (api.UsageRecordList, *errors.ServiceError) {
+	var connectors dbapi.ConnectorList
+	if err := s.connectionFactory.New().
+		Joins("left join connector_statuses on connector_statuses.id = connectors.id").
+		Where("connector_statuses.phase = ?", dbapi.ConnectorStatusPhaseReady).
+		Find(&connectors).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list ready connectors")
+	}
+
+	records := api.UsageRecordList{}
+	for _, connector := range connectors {
+		records = append(records, &api.UsageRecord{
+			OrganisationId: connector.OrganisationId,
+			ResourceType:   api.UsageResourceTypeConnector,
+			ResourceId:     connector.ID,
+			InstanceType:   connector.ConnectorTypeId,
+			CloudProvider:  connector.CloudProvider,
+			Region:         connector.Region,
+			MultiAZ:        connector.MultiAZ,
+		})
+	}
+	return records, nil
+}
@@ -1,16 +1,22 @@
 package services
 
 import (
+	"bytes"
 	"context"
 	goerrors "errors"
+	"fmt"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/vault"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/rbac"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/secrets"
 	"github.com/spyzhov/ajson"
+	"github.com/xeipuuv/gojsonschema"
 
 	"gorm.io/gorm"
 
@@ -28,23 +34,30 @@ type ConnectorsService interface {
 	SaveStatus(ctx context.Context, resource dbapi.ConnectorStatus) *errors.ServiceError
 	Delete(ctx context.Context, id string) *errors.ServiceError
 	ForEach(f func(*dbapi.Connector) *errors.ServiceError, query string, args ...interface{}) *errors.ServiceError
+	MigrateConnectorType(ctx context.Context, id string, successor *dbapi.ConnectorType) *errors.ServiceError
+	ListRevisions(ctx context.Context, id string, listArgs *services.ListArguments) (dbapi.ConnectorRevisionList, *api.PagingMeta, *errors.ServiceError)
+	Rollback(ctx context.Context, id string, revision int64) (*dbapi.Connector, *errors.ServiceError)
 }
 
 var _ ConnectorsService = &connectorsService{}
 
 type connectorsService struct {
+	connectorsConfig      *config.ConnectorsConfig
 	connectionFactory     *db.ConnectionFactory
 	bus                   signalbus.SignalBus
 	vaultService          vault.VaultService
 	connectorTypesService ConnectorTypesService
+	roleBindingService    rbac.RoleBindingService
 }
 
-func NewConnectorsService(connectionFactory *db.ConnectionFactory, bus signalbus.SignalBus, vaultService vault.VaultService, connectorTypesService ConnectorTypesService) *connectorsService {
+func NewConnectorsService(connectorsConfig *config.ConnectorsConfig, connectionFactory *db.ConnectionFactory, bus signalbus.SignalBus, vaultService vault.VaultService, connectorTypesService ConnectorTypesService, roleBindingService rbac.RoleBindingService) *connectorsService {
 	return &connectorsService{
+		connectorsConfig:      connectorsConfig,
 		connectionFactory:     connectionFactory,
 		bus:                   bus,
 		vaultService:          vaultService,
 		connectorTypesService: connectorTypesService,
+		roleBindingService:    roleBindingService,
 	}
 }
 
@@ -71,6 +84,10 @@ func (k *connectorsService) Create(ctx context.Context, resource *dbapi.Connecto
 		return errors.GeneralError("failed to save status: %v", err)
 	}
 
+	if serr := k.saveRevision(ctx, resource); serr != nil {
+		return serr
+	}
+
 	_ = db.AddPostCommitAction(ctx, func() {
 		// Wake up the reconcile loop...
 		k.bus.Notify("reconcile:connector")
@@ -93,7 +110,7 @@ func (k *connectorsService) Get(ctx context.Context, id string, tid string) (*db
 	dbConn = dbConn.Preload("Status")
 
 	var err *errors.ServiceError
-	dbConn, err = filterToOwnerOrOrg(ctx, dbConn)
+	dbConn, err = k.filterByUserAccess(ctx, dbConn)
 	if err != nil {
 		return nil, err
 	}
@@ -130,6 +147,45 @@ func filterToOwnerOrOrg(ctx context.Context, dbConn *gorm.DB) (*gorm.DB, *errors
 	return dbConn, nil
 }
 
+// ConnectorResource returns the resource roles on the connector are checked and granted on
+func ConnectorResource(resource *dbapi.Connector) rbac.Resource {
+	return rbac.Resource{
+		Type:           rbac.ResourceTypeConnector,
+		ID:             resource.ID,
+		Owner:          resource.Owner,
+		OrganisationId: resource.OrganisationId,
+	}
+}
+
+// filterByUserAccess filters connectors to the ones owned by the user or its organisation, and the ones the user
+// was granted access to through role bindings
+func (k *connectorsService) filterByUserAccess(ctx context.Context, dbConn *gorm.DB) (*gorm.DB, *errors.ServiceError) {
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		return dbConn, errors.Unauthenticated("user not authenticated")
+	}
+	owner := auth.GetUsernameFromClaims(claims)
+	if owner == "" {
+		return dbConn, errors.Unauthenticated("user not authenticated")
+	}
+
+	query, args := "owner = ?", []interface{}{owner}
+	// filter by organisationId if a user is part of an organisation and is not allowed as a service account
+	if auth.GetFilterByOrganisationFromContext(ctx) {
+		query, args = "organisation_id = ?", []interface{}{auth.GetOrgIdFromClaims(claims)}
+	}
+
+	grantQuery, grantArgs, serviceErr := k.roleBindingService.Filter(ctx, rbac.ResourceTypeConnector, rbac.RoleViewer)
+	if serviceErr != nil {
+		return dbConn, serviceErr
+	}
+	if grantQuery != "" {
+		query = fmt.Sprintf("%s OR %s", query, grantQuery)
+		args = append(args, grantArgs...)
+	}
+	return dbConn.Where(query, args...), nil
+}
+
 // Delete deletes a connector from the database.
 func (k *connectorsService) Delete(ctx context.Context, id string) *errors.ServiceError {
 	if id == "" {
@@ -141,6 +197,25 @@ func (k *connectorsService) Delete(ctx context.Context, id string) *errors.Servi
 	if err := dbConn.Where("id = ?", id).First(&resource).Error; err != nil {
 		return services.HandleGetError("Connector", "id", id, err)
 	}
+	// connectors whose deletion was requested are deleted once undeployed, the admin role was checked on the request
+	if resource.DesiredState != dbapi.ConnectorStatusPhaseDeleted {
+		if serr := k.roleBindingService.CheckRole(ctx, ConnectorResource(&resource), rbac.RoleAdmin); serr != nil {
+			return serr
+		}
+	}
+	// the secrets kept for the revisions are deleted along with the ones of the connector
+	var revisionSecrets []string
+	if ct, serr := k.connectorTypesService.Get(resource.ConnectorTypeId); serr == nil {
+		refs, serr := getConnectorSecretRefs(dbConn, k.connectorTypesService, &resource)
+		if serr != nil {
+			return serr
+		}
+		currentSecrets, err := getSecretRefs(&resource, ct)
+		if err != nil {
+			return errors.GeneralError("could not get secrets of connector %s: %v", id, err)
+		}
+		revisionSecrets = subtractSecretRefs(refs, currentSecrets)
+	}
 	if err := dbConn.Delete(&resource).Error; err != nil {
 		return errors.GeneralError("unable to delete connector with id %s: %s", resource.ID, err)
 	}
@@ -149,6 +224,18 @@ func (k *connectorsService) Delete(ctx context.Context, id string) *errors.Servi
 	if err := dbConn.Where("id = ?", id).Delete(&dbapi.ConnectorStatus{}).Error; err != nil {
 		return services.HandleGetError("ConnectorStatus", "id", id, err)
 	}
+	if err := dbConn.Where("connector_id = ?", id).Delete(&dbapi.ConnectorRevision{}).Error; err != nil {
+		return errors.GeneralError("unable to delete revisions of connector %s: %s", id, err)
+	}
+	if err := dbConn.Where("connector_id = ?", id).Delete(&dbapi.ConnectorLogEntry{}).Error; err != nil {
+		return errors.GeneralError("unable to delete logs of connector %s: %s", id, err)
+	}
+	if err := dbConn.Where("connector_id = ?", id).Delete(&dbapi.ConnectorMetricSample{}).Error; err != nil {
+		return errors.GeneralError("unable to delete metrics of connector %s: %s", id, err)
+	}
+	if err := k.roleBindingService.DeleteResourceBindings(rbac.ResourceTypeConnector, id); err != nil {
+		return err
+	}
 
 	_ = db.AddPostCommitAction(ctx, func() {
 		// delete related distributed resources...
@@ -182,6 +269,12 @@ func (k *connectorsService) Delete(ctx context.Context, id string) *errors.Servi
 				})
 			}
 		}
+
+		for _, r := range revisionSecrets {
+			if err := k.vaultService.DeleteSecretString(r); err != nil {
+				logger.Logger.Errorf("failed to delete vault secret key '%s': %v", r, err)
+			}
+		}
 	})
 
 	return nil
@@ -202,7 +295,7 @@ func (k *connectorsService) List(ctx context.Context, kafka_id string, listArgs
 	}
 
 	var err *errors.ServiceError
-	dbConn, err = filterToOwnerOrOrg(ctx, dbConn)
+	dbConn, err = k.filterByUserAccess(ctx, dbConn)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -233,19 +326,32 @@ func (k *connectorsService) List(ctx context.Context, kafka_id string, listArgs
 
 func (k connectorsService) Update(ctx context.Context, resource *dbapi.Connector) *errors.ServiceError {
 
-	// If the version is set, then lets verify that the version has not changed...
-	if resource.Version != 0 {
-		dbConn := k.connectionFactory.New()
-		dbConn = dbConn.Where("id = ? AND version = ?", resource.ID, resource.Version)
-		t := dbapi.Connector{}
-		if err := dbConn.First(&t).Error; err != nil {
-			return errors.BadRequest("resource version changed")
-		}
+	dbConn := k.connectionFactory.New()
+	var original dbapi.Connector
+	if err := dbConn.Where("id = ?", resource.ID).First(&original).Error; err != nil {
+		return services.HandleGetError("Connector", "id", resource.ID, err)
+	}
+	// the user updating the connector must be an editor of it, and an admin to request its deletion
+	role := rbac.RoleEditor
+	if resource.DesiredState == dbapi.ConnectorStatusPhaseDeleted && original.DesiredState != dbapi.ConnectorStatusPhaseDeleted {
+		role = rbac.RoleAdmin
+	}
+	if serr := k.roleBindingService.CheckRole(ctx, ConnectorResource(&original), role); serr != nil {
+		return serr
 	}
 
-	dbConn := k.connectionFactory.New()
-	if err := dbConn.Model(resource).Updates(resource).Error; err != nil {
-		return errors.GeneralError("failed to update: %s", err.Error())
+	// If the version is set, the update only applies if the version has not changed... it's checked by the update
+	// itself so that a concurrent update can't be overwritten
+	updateConn := dbConn.Model(resource)
+	if resource.Version != 0 {
+		updateConn = updateConn.Where("version = ?", resource.Version)
+	}
+	result := updateConn.Updates(resource)
+	if result.Error != nil {
+		return errors.GeneralError("failed to update: %s", result.Error.Error())
+	}
+	if resource.Version != 0 && result.RowsAffected == 0 {
+		return errors.PreconditionFailed("connector %s has been modified", resource.ID)
 	}
 
 	// read it back.... to get the updated version...
@@ -254,6 +360,21 @@ func (k connectorsService) Update(ctx context.Context, resource *dbapi.Connector
 		return services.HandleGetError("Connector", "id", resource.ID, err)
 	}
 
+	if serr := k.saveRevision(ctx, resource); serr != nil {
+		return serr
+	}
+
+	// secrets replaced by the update are deleted, unless a revision still references them
+	if ct, serr := k.connectorTypesService.Get(original.ConnectorTypeId); serr == nil {
+		originalSecrets, err := getSecretRefs(&original, ct)
+		if err != nil {
+			return errors.GeneralError("could not get secrets of connector %s: %v", resource.ID, err)
+		}
+		if serr := k.deleteUnusedSecrets(ctx, resource, originalSecrets); serr != nil {
+			return serr
+		}
+	}
+
 	_ = db.AddPostCommitAction(ctx, func() {
 		// Wake up the reconcile loop...
 		k.bus.Notify("reconcile:connector")
@@ -308,3 +429,331 @@ func (k connectorsService) ForEach(f func(*dbapi.Connector) *errors.ServiceError
 	}
 	return nil
 }
+
+// MigrateConnectorType moves a connector of a deprecated connector type to the successor connector type.
+// The connector spec must be valid for the successor, which must also offer the channel of the connector.
+func (k *connectorsService) MigrateConnectorType(ctx context.Context, id string, successor *dbapi.ConnectorType) *errors.ServiceError {
+	dbConn := k.connectionFactory.New()
+	var resource dbapi.Connector
+	if err := dbConn.Where("id = ?", id).Preload("Status").First(&resource).Error; err != nil {
+		return services.HandleGetError("Connector", "id", id, err)
+	}
+
+	if !shared.Contains(successor.ChannelNames(), resource.Channel) || successor.IsChannelDeprecated(resource.Channel) {
+		return errors.Validation("connector type %s does not support channel %s of connector %s", successor.ID, resource.Channel, id)
+	}
+
+	ct, serr := k.connectorTypesService.Get(resource.ConnectorTypeId)
+	if serr != nil {
+		return serr
+	}
+	if serr := validateConnectorSpec(id, resource.ConnectorSpec, successor); serr != nil {
+		return serr
+	}
+
+	// secrets must be found in the same places, or they would be presented as plain values
+	oldRefs, err := getSecretRefs(&resource, ct)
+	if err != nil {
+		return errors.GeneralError("could not get secrets of connector %s: %v", id, err)
+	}
+	newRefs, err := getSecretRefs(&resource, successor)
+	if err != nil {
+		return errors.GeneralError("could not get secrets of connector %s: %v", id, err)
+	}
+	if !sameSecretRefs(oldRefs, newRefs) {
+		return errors.Validation("connector type %s does not have the secrets of connector %s", successor.ID, id)
+	}
+
+	if err := dbConn.Model(&resource).Update("connector_type_id", successor.ID).Error; err != nil {
+		return errors.GeneralError("failed to update connector type of connector %s: %v", id, err)
+	}
+	if serr := k.saveRevision(ctx, &resource); serr != nil {
+		return serr
+	}
+
+	// connectors without a deployment get the successor's shard metadata when they are assigned
+	if resource.Status.Phase != dbapi.ConnectorStatusPhaseAssigning {
+		channelVersion, serr := k.connectorTypesService.GetLatestConnectorShardMetadataID(successor.ID, resource.Channel)
+		if serr != nil {
+			return serr
+		}
+		if err := dbConn.Model(&dbapi.ConnectorDeployment{}).
+			Where("connector_id = ?", id).
+			Update("connector_type_channel_id", channelVersion).Error; err != nil {
+			return errors.GeneralError("failed to update deployment of connector %s: %v", id, err)
+		}
+
+		resource.Status.Phase = dbapi.ConnectorStatusPhaseUpdating
+		if serr := k.SaveStatus(ctx, resource.Status); serr != nil {
+			return serr
+		}
+	}
+
+	_ = db.AddPostCommitAction(ctx, func() {
+		// Wake up the reconcile loop...
+		k.bus.Notify("reconcile:connector")
+	})
+
+	return nil
+}
+
+// sameSecretRefs reports whether both lists reference the same secrets, regardless of their order
+func sameSecretRefs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	counts := make(map[string]int, len(a))
+	for _, ref := range a {
+		counts[ref]++
+	}
+	for _, ref := range b {
+		if counts[ref] == 0 {
+			return false
+		}
+		counts[ref]--
+	}
+	return true
+}
+
+// subtractSecretRefs returns the distinct secrets of refs that are not in removed
+func subtractSecretRefs(refs []string, removed []string) []string {
+	result := []string{}
+	for _, ref := range refs {
+		if !shared.Contains(removed, ref) && !shared.Contains(result, ref) {
+			result = append(result, ref)
+		}
+	}
+	return result
+}
+
+// deleteUnusedSecrets deletes the given secrets from the vault once the transaction commits, unless the connector or
+// one of its revisions still references them.
+func (k *connectorsService) deleteUnusedSecrets(ctx context.Context, resource *dbapi.Connector, secrets []string) *errors.ServiceError {
+	if len(secrets) == 0 {
+		return nil
+	}
+	used, serr := getConnectorSecretRefs(k.connectionFactory.New(), k.connectorTypesService, resource)
+	if serr != nil {
+		return serr
+	}
+	if used == nil {
+		// the secrets in use can't be determined, leave them to the orphaned secrets garbage collection
+		return nil
+	}
+	unused := subtractSecretRefs(secrets, used)
+	if len(unused) == 0 {
+		return nil
+	}
+	_ = db.AddPostCommitAction(ctx, func() {
+		for _, s := range unused {
+			if err := k.vaultService.DeleteSecretString(s); err != nil {
+				logger.Logger.Errorf("failed to delete vault secret key '%s': %v", s, err)
+			}
+		}
+	})
+	return nil
+}
+
+func validateConnectorSpec(id string, spec api.JSON, ct *dbapi.ConnectorType) *errors.ServiceError {
+	result, err := gojsonschema.Validate(gojsonschema.NewBytesLoader(ct.JsonSchema), gojsonschema.NewBytesLoader(spec))
+	if err != nil {
+		return errors.GeneralError("failed to validate connector %s against connector type %s: %v", id, ct.ID, err)
+	}
+	if !result.Valid() {
+		return errors.Validation("connector %s is not valid for connector type %s: %v", id, ct.ID, result.Errors())
+	}
+	return nil
+}
+
+// saveRevision records the configuration of a connector at its current version, unless it's the same as the
+// configuration of the latest revision, and prunes the revisions beyond the configured history limit.
+func (k *connectorsService) saveRevision(ctx context.Context, resource *dbapi.Connector) *errors.ServiceError {
+	dbConn := k.connectionFactory.New()
+
+	var latest dbapi.ConnectorRevisionList
+	if err := dbConn.Where("connector_id = ?", resource.ID).Order("version desc").Limit(1).Find(&latest).Error; err != nil {
+		return errors.GeneralError("unable to find revisions of connector %s: %v", resource.ID, err)
+	}
+	if len(latest) > 0 &&
+		latest[0].ConnectorTypeId == resource.ConnectorTypeId &&
+		latest[0].Channel == resource.Channel &&
+		latest[0].DesiredState == resource.DesiredState &&
+		bytes.Equal(latest[0].ConnectorSpec, resource.ConnectorSpec) {
+		return nil
+	}
+
+	revision := dbapi.ConnectorRevision{
+		ConnectorID:     resource.ID,
+		Version:         resource.Version,
+		ConnectorTypeId: resource.ConnectorTypeId,
+		Channel:         resource.Channel,
+		DesiredState:    resource.DesiredState,
+		ConnectorSpec:   resource.ConnectorSpec,
+	}
+	if err := dbConn.Create(&revision).Error; err != nil {
+		return errors.GeneralError("failed to save revision of connector %s: %v", resource.ID, err)
+	}
+
+	if k.connectorsConfig.RevisionHistoryLimit > 0 {
+		var pruned dbapi.ConnectorRevisionList
+		if err := dbConn.Where("connector_id = ? AND version NOT IN (?)", resource.ID,
+			k.connectionFactory.New().Model(&dbapi.ConnectorRevision{}).Select("version").
+				Where("connector_id = ?", resource.ID).
+				Order("version desc").
+				Limit(k.connectorsConfig.RevisionHistoryLimit)).
+			Find(&pruned).Error; err != nil {
+			return errors.GeneralError("failed to find revisions of connector %s to prune: %v", resource.ID, err)
+		}
+		if len(pruned) == 0 {
+			return nil
+		}
+
+		versions := make([]int64, 0, len(pruned))
+		for _, rev := range pruned {
+			versions = append(versions, rev.Version)
+		}
+		if err := dbConn.Where("connector_id = ? AND version IN ?", resource.ID, versions).
+			Delete(&dbapi.ConnectorRevision{}).Error; err != nil {
+			return errors.GeneralError("failed to prune revisions of connector %s: %v", resource.ID, err)
+		}
+
+		// the secrets of the pruned revisions are not needed for rollbacks anymore
+		prunedSecrets, serr := getRevisionSecretRefs(k.connectorTypesService, resource.ID, revisionConnectors(resource.ID, pruned))
+		if serr != nil {
+			return serr
+		}
+		return k.deleteUnusedSecrets(ctx, resource, prunedSecrets)
+	}
+	return nil
+}
+
+// ListRevisions returns the revisions of a connector visible to the user, newest first.
+func (k *connectorsService) ListRevisions(ctx context.Context, id string, listArgs *services.ListArguments) (dbapi.ConnectorRevisionList, *api.PagingMeta, *errors.ServiceError) {
+	if _, serr := k.Get(ctx, id, ""); serr != nil {
+		return nil, nil, serr
+	}
+
+	var resourceList dbapi.ConnectorRevisionList
+	dbConn := k.connectionFactory.New()
+	dbConn = dbConn.Where("connector_id = ?", id)
+	pagingMeta := &api.PagingMeta{
+		Page: listArgs.Page,
+		Size: listArgs.Size,
+	}
+
+	total := int64(pagingMeta.Total)
+	dbConn.Model(&resourceList).Count(&total)
+	pagingMeta.Total = int(total)
+	if pagingMeta.Size > pagingMeta.Total {
+		pagingMeta.Size = pagingMeta.Total
+	}
+	dbConn = dbConn.Offset((pagingMeta.Page - 1) * pagingMeta.Size).Limit(pagingMeta.Size)
+	dbConn = dbConn.Order("version desc")
+
+	if err := dbConn.Find(&resourceList).Error; err != nil {
+		return resourceList, pagingMeta, errors.GeneralError("Unable to list revisions of connector %s: %s", id, err)
+	}
+
+	return resourceList, pagingMeta, nil
+}
+
+// Rollback re-applies the connector spec, channel and desired state of a previous revision of a connector,
+// including the secrets of the revision, which are kept in the vault until the revision is pruned.
+func (k *connectorsService) Rollback(ctx context.Context, id string, revision int64) (*dbapi.Connector, *errors.ServiceError) {
+	resource, serr := k.Get(ctx, id, "")
+	if serr != nil {
+		return nil, serr
+	}
+	if serr := k.roleBindingService.CheckRole(ctx, ConnectorResource(resource), rbac.RoleEditor); serr != nil {
+		return nil, serr
+	}
+	if resource.DesiredState == dbapi.ConnectorStatusPhaseDeleted {
+		return nil, errors.BadRequest("connector %s is being deleted", id)
+	}
+
+	dbConn := k.connectionFactory.New()
+	var rev dbapi.ConnectorRevision
+	if err := dbConn.Where("connector_id = ? AND version = ?", id, revision).First(&rev).Error; err != nil {
+		return nil, services.HandleGetError("ConnectorRevision", "revision", revision, err)
+	}
+	if rev.DesiredState == dbapi.ConnectorStatusPhaseDeleted {
+		return nil, errors.BadRequest("revision %d of connector %s is deleted", revision, id)
+	}
+	if rev.ConnectorTypeId != resource.ConnectorTypeId {
+		return nil, errors.BadRequest("revision %d of connector %s has connector type %s instead of %s", revision, id, rev.ConnectorTypeId, resource.ConnectorTypeId)
+	}
+
+	ct, serr := k.connectorTypesService.Get(resource.ConnectorTypeId)
+	if serr != nil {
+		return nil, errors.BadRequest("invalid connector type id: %s", resource.ConnectorTypeId)
+	}
+	if !shared.Contains(ct.ChannelNames(), rev.Channel) || ct.IsChannelDeprecated(rev.Channel) {
+		return nil, errors.BadRequest("channel %s of revision %d is not available for connector type %s", rev.Channel, revision, ct.ID)
+	}
+
+	spec := rev.ConnectorSpec
+	if serr := validateConnectorSpec(id, spec, ct); serr != nil {
+		return nil, serr
+	}
+	revisionSecrets, err := getSecretRefs(&dbapi.Connector{ConnectorSpec: spec}, ct)
+	if err != nil {
+		return nil, errors.GeneralError("could not get secrets of revision %d of connector %s: %v", revision, id, err)
+	}
+	for _, ref := range revisionSecrets {
+		// revisions saved before their secrets were kept may reference deleted secrets
+		if _, err := k.vaultService.GetSecretString(ref); err != nil {
+			return nil, errors.BadRequest("secrets of revision %d of connector %s are no longer available", revision, id)
+		}
+	}
+
+	originalSecrets, err := getSecretRefs(resource, ct)
+	if err != nil {
+		return nil, errors.GeneralError("could not get secrets of connector %s: %v", id, err)
+	}
+
+	if err := dbConn.Model(resource).Updates(map[string]interface{}{
+		"connector_spec": spec,
+		"channel":        rev.Channel,
+		"desired_state":  rev.DesiredState,
+	}).Error; err != nil {
+		return nil, errors.GeneralError("failed to roll back connector %s: %v", id, err)
+	}
+
+	if resource.Status.Phase != dbapi.ConnectorStatusPhaseAssigning {
+		if rev.Channel != resource.Channel {
+			channelVersion, serr := k.connectorTypesService.GetLatestConnectorShardMetadataID(ct.ID, rev.Channel)
+			if serr != nil {
+				return nil, serr
+			}
+			if err := dbConn.Model(&dbapi.ConnectorDeployment{}).
+				Where("connector_id = ?", id).
+				Update("connector_type_channel_id", channelVersion).Error; err != nil {
+				return nil, errors.GeneralError("failed to update deployment of connector %s: %v", id, err)
+			}
+		}
+
+		resource.Status.Phase = dbapi.ConnectorStatusPhaseUpdating
+		if serr := k.SaveStatus(ctx, resource.Status); serr != nil {
+			return nil, serr
+		}
+	}
+
+	// read it back.... to get the updated version...
+	if err := k.connectionFactory.New().Where("id = ?", id).Preload("Status").First(resource).Error; err != nil {
+		return nil, services.HandleGetError("Connector", "id", id, err)
+	}
+	if serr := k.saveRevision(ctx, resource); serr != nil {
+		return nil, serr
+	}
+
+	if serr := k.deleteUnusedSecrets(ctx, resource, originalSecrets); serr != nil {
+		return nil, serr
+	}
+
+	_ = db.AddPostCommitAction(ctx, func() {
+		// Wake up the reconcile loop...
+		k.bus.Notify("reconcile:connector")
+	})
+
+	return resource, nil
+}
@@ -0,0 +1,341 @@
+package services
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/vault"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/rbac"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
+	"github.com/golang-jwt/jwt/v4"
+	. "github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_sameSecretRefs(t *testing.T) {
+	// the successor marks another field of the spec as secret, both types find one secret
+	successorSchema := `{
+		"type": "object",
+		"properties": {
+			"topic": {"oneOf": [{"type": "string", "format": "password"}, {"type": "object"}]},
+			"aws_secret_key": {"type": "object"}
+		}
+	}`
+	resource := &dbapi.Connector{
+		ConnectorSpec: api.JSON(`{"topic": {"kind": "base64", "ref": "topic-ref"}, "aws_secret_key": {"kind": "base64", "ref": "key-ref"}}`),
+	}
+
+	tests := []struct {
+		name   string
+		schema string
+		want   bool
+	}{
+		{
+			name:   "should match the secrets found at the same places",
+			schema: testSecretsSchema,
+			want:   true,
+		},
+		{
+			name:   "should not match the secrets found at other places",
+			schema: successorSchema,
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			oldRefs, err := getSecretRefs(resource, &dbapi.ConnectorType{JsonSchema: api.JSON(testSecretsSchema)})
+			Expect(err).To(BeNil())
+			newRefs, err := getSecretRefs(resource, &dbapi.ConnectorType{JsonSchema: api.JSON(tt.schema)})
+			Expect(err).To(BeNil())
+			Expect(newRefs).To(HaveLen(len(oldRefs)))
+			Expect(sameSecretRefs(oldRefs, newRefs)).To(Equal(tt.want))
+		})
+	}
+}
+
+func newTestConnectorsService(t *testing.T, vaultService vault.VaultService) *connectorsService {
+	return NewConnectorsService(&config.ConnectorsConfig{RevisionHistoryLimit: 2}, db.NewMockConnectionFactory(nil),
+		signalbus.NewSignalBus(), vaultService,
+		&connectorTypesServiceStub{
+			types: map[string]*dbapi.ConnectorType{"test-type": {
+				Meta:       api.Meta{ID: "test-type"},
+				JsonSchema: api.JSON(testSecretsSchema),
+				Channels:   []dbapi.ConnectorChannel{{Channel: "stable"}},
+			}},
+		},
+		&rbac.RoleBindingServiceMock{
+			FilterFunc: func(ctx context.Context, resourceType rbac.ResourceType, role rbac.Role) (string, []interface{}, *errors.ServiceError) {
+				return "", nil, nil
+			},
+			CheckRoleFunc: func(ctx context.Context, resource rbac.Resource, role rbac.Role) *errors.ServiceError {
+				return nil
+			},
+		})
+}
+
+func newTestConnectorsContext(t *testing.T, connectionFactory *db.ConnectionFactory) context.Context {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"username": "test-user"})
+	mocket.Catcher.NewMock().WithQuery(`select txid_current()`).WithReply([]map[string]interface{}{{"txid_current": 1}})
+	ctx, err := connectionFactory.NewContext(auth.SetTokenInContext(context.Background(), token))
+	Expect(err).To(BeNil())
+	return ctx
+}
+
+func testSpec(ref string) []byte {
+	return []byte(`{"topic": "test", "aws_secret_key": {"kind": "tmp", "ref": "` + ref + `"}}`)
+}
+
+func Test_connectorsService_saveRevision(t *testing.T) {
+	tests := []struct {
+		name        string
+		latestSpec  []byte
+		pruned      []map[string]interface{}
+		wantSaved   bool
+		wantDeleted []string
+	}{
+		{
+			name:       "should not save an unchanged configuration",
+			latestSpec: testSpec("current-ref"),
+			wantSaved:  false,
+		},
+		{
+			name:       "should save a changed configuration",
+			latestSpec: testSpec("previous-ref"),
+			wantSaved:  true,
+		},
+		{
+			name:       "should delete the secrets of the pruned revisions no longer referenced",
+			latestSpec: testSpec("previous-ref"),
+			pruned: []map[string]interface{}{
+				{"connector_id": "connector-1", "version": 1, "connector_type_id": "test-type", "connector_spec": testSpec("pruned-ref")},
+				{"connector_id": "connector-1", "version": 2, "connector_type_id": "test-type", "connector_spec": testSpec("previous-ref")},
+			},
+			wantSaved:   true,
+			wantDeleted: []string{"pruned-ref"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			vaultService := newTestSecretsVault(t, map[string]string{
+				"pruned-ref":   ConnectorOwningResource("connector-1"),
+				"previous-ref": ConnectorOwningResource("connector-1"),
+				"current-ref":  ConnectorOwningResource("connector-1"),
+			})
+			mocket.Catcher.Reset()
+			mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "connector_revisions" WHERE connector_id = $1 ORDER BY version desc LIMIT 1`).
+				WithReply([]map[string]interface{}{{"connector_id": "connector-1", "version": 3, "connector_type_id": "test-type", "channel": "stable", "connector_spec": tt.latestSpec}})
+			insert := mocket.Catcher.NewMock().WithQuery(`INSERT INTO "connector_revisions"`)
+			mocket.Catcher.NewMock().WithQuery(`version NOT IN (SELECT "version" FROM "connector_revisions"`).WithReply(tt.pruned)
+			prune := mocket.Catcher.NewMock().WithQuery(`DELETE FROM "connector_revisions" WHERE connector_id = $1 AND version IN`)
+			// the revisions kept after pruning
+			mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "connector_revisions" WHERE connector_id = $1`).
+				WithReply([]map[string]interface{}{
+					{"connector_id": "connector-1", "version": 3, "connector_type_id": "test-type", "connector_spec": tt.latestSpec},
+					{"connector_id": "connector-1", "version": 4, "connector_type_id": "test-type", "connector_spec": testSpec("current-ref")},
+				})
+
+			k := newTestConnectorsService(t, vaultService)
+			ctx := newTestConnectorsContext(t, k.connectionFactory)
+			resource := &dbapi.Connector{
+				Meta:            api.Meta{ID: "connector-1"},
+				Version:         4,
+				ConnectorTypeId: "test-type",
+				Channel:         "stable",
+				ConnectorSpec:   testSpec("current-ref"),
+			}
+			serr := k.saveRevision(ctx, resource)
+			Expect(serr).To(BeNil())
+			Expect(db.Resolve(ctx)).To(BeNil())
+
+			Expect(insert.Triggered).To(Equal(tt.wantSaved))
+			Expect(prune.Triggered).To(Equal(len(tt.pruned) > 0))
+			Expect(vaultService.Counters().Deletes).To(BeEquivalentTo(len(tt.wantDeleted)))
+			for _, ref := range tt.wantDeleted {
+				_, err := vaultService.GetSecretString(ref)
+				Expect(err).To(Equal(vault.NotFound))
+			}
+		})
+	}
+}
+
+func Test_connectorsService_Update(t *testing.T) {
+	RegisterTestingT(t)
+	mocket.Catcher.Reset()
+	mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "connectors" WHERE id = $1`).
+		WithReply([]map[string]interface{}{{"id": "connector-1", "version": 4, "connector_type_id": "test-type", "connector_spec": testSpec("current-ref")}})
+	// the first update changes the version the second update is conditional on
+	update := mocket.Catcher.NewMock().WithQuery(`UPDATE "connectors" SET`).WithRowsNum(1).OneTime()
+	mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "connector_revisions" WHERE connector_id = $1 ORDER BY version desc LIMIT 1`).
+		WithReply([]map[string]interface{}{{"connector_id": "connector-1", "version": 4, "connector_type_id": "test-type", "connector_spec": testSpec("current-ref")}})
+
+	k := newTestConnectorsService(t, newTestSecretsVault(t, map[string]string{"current-ref": ConnectorOwningResource("connector-1")}))
+	ctx := newTestConnectorsContext(t, k.connectionFactory)
+
+	// both writers read the connector before either of them updates it
+	var errs []*errors.ServiceError
+	for _, name := range []string{"first", "second"} {
+		resource := &dbapi.Connector{
+			Meta:            api.Meta{ID: "connector-1"},
+			Name:            name,
+			Version:         4,
+			ConnectorTypeId: "test-type",
+			ConnectorSpec:   testSpec("current-ref"),
+		}
+		errs = append(errs, k.Update(ctx, resource))
+	}
+	Expect(db.Resolve(ctx)).To(BeNil())
+
+	Expect(update.Triggered).To(BeTrue())
+	Expect(errs[0]).To(BeNil())
+	Expect(errs[1]).NotTo(BeNil())
+	Expect(errs[1].Code).To(Equal(errors.ErrorPreconditionFailed))
+}
+
+func Test_connectorsService_Update_CheckRole(t *testing.T) {
+	tests := []struct {
+		name         string
+		desiredState dbapi.ConnectorStatusPhase
+		wantRole     rbac.Role
+	}{
+		{
+			name:         "should require the editor role to update the connector",
+			desiredState: dbapi.ConnectorStatusPhaseReady,
+			wantRole:     rbac.RoleEditor,
+		},
+		{
+			name:         "should require the admin role to delete the connector",
+			desiredState: dbapi.ConnectorStatusPhaseDeleted,
+			wantRole:     rbac.RoleAdmin,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			mocket.Catcher.Reset()
+			mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "connectors" WHERE id = $1`).
+				WithReply([]map[string]interface{}{{"id": "connector-1", "owner": "owner", "organisation_id": "test-org", "desired_state": dbapi.ConnectorStatusPhaseReady}})
+			update := mocket.Catcher.NewMock().WithQuery(`UPDATE "connectors" SET`)
+
+			k := newTestConnectorsService(t, newTestSecretsVault(t, nil))
+			roleBindingService := &rbac.RoleBindingServiceMock{
+				CheckRoleFunc: func(ctx context.Context, resource rbac.Resource, role rbac.Role) *errors.ServiceError {
+					return errors.Forbidden("user test-user is not allowed to perform this action, %s role is required", role)
+				},
+			}
+			k.roleBindingService = roleBindingService
+
+			err := k.Update(context.Background(), &dbapi.Connector{Meta: api.Meta{ID: "connector-1"}, DesiredState: tt.desiredState})
+
+			Expect(err).NotTo(BeNil())
+			Expect(err.IsForbidden()).To(BeTrue())
+			Expect(update.Triggered).To(BeFalse())
+			Expect(roleBindingService.CheckRoleCalls()).To(HaveLen(1))
+			Expect(roleBindingService.CheckRoleCalls()[0].Resource).To(Equal(rbac.Resource{Type: rbac.ResourceTypeConnector, ID: "connector-1", Owner: "owner", OrganisationId: "test-org"}))
+			Expect(roleBindingService.CheckRoleCalls()[0].Role).To(Equal(tt.wantRole))
+		})
+	}
+}
+
+func Test_connectorsService_ListRevisions(t *testing.T) {
+	RegisterTestingT(t)
+	mocket.Catcher.Reset()
+	mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "connectors" WHERE id = $1`).
+		WithReply([]map[string]interface{}{{"id": "connector-1", "owner": "test-user", "connector_type_id": "test-type"}})
+	mocket.Catcher.NewMock().WithQuery(`SELECT count(1) FROM "connector_revisions" WHERE connector_id = $1`).
+		WithReply([]map[string]interface{}{{"count": 3}})
+	mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "connector_revisions" WHERE connector_id = $1 ORDER BY version desc LIMIT 2`).
+		WithReply([]map[string]interface{}{
+			{"connector_id": "connector-1", "version": 3},
+			{"connector_id": "connector-1", "version": 2},
+		})
+
+	k := newTestConnectorsService(t, newTestSecretsVault(t, nil))
+	revisions, paging, serr := k.ListRevisions(newTestConnectorsContext(t, k.connectionFactory), "connector-1", &coreServices.ListArguments{Page: 1, Size: 2})
+
+	Expect(serr).To(BeNil())
+	Expect(revisions).To(HaveLen(2))
+	Expect(revisions[0].Version).To(BeEquivalentTo(3))
+	Expect(paging.Total).To(Equal(3))
+	Expect(paging.Size).To(Equal(2))
+}
+
+func Test_connectorsService_Rollback(t *testing.T) {
+	tests := []struct {
+		name        string
+		vault       map[string]string
+		wantErr     bool
+		wantSpec    []byte
+		wantDeleted []string
+	}{
+		{
+			name: "should restore the secrets of the revision",
+			vault: map[string]string{
+				"revision-ref": ConnectorOwningResource("connector-1"),
+				"current-ref":  ConnectorOwningResource("connector-1"),
+			},
+			wantSpec: testSpec("revision-ref"),
+		},
+		{
+			name: "should fail when the secrets of the revision were deleted",
+			vault: map[string]string{
+				"current-ref": ConnectorOwningResource("connector-1"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			vaultService := newTestSecretsVault(t, tt.vault)
+			mocket.Catcher.Reset()
+			mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "connectors" WHERE id = $1`).OneTime().
+				WithReply([]map[string]interface{}{{"id": "connector-1", "owner": "test-user", "version": 5, "connector_type_id": "test-type", "channel": "stable", "desired_state": "ready", "connector_spec": testSpec("current-ref")}})
+			mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "connector_statuses"`).
+				WithReply([]map[string]interface{}{{"id": "connector-1", "phase": "ready"}})
+			mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "connector_revisions" WHERE connector_id = $1 AND version = $2`).
+				WithReply([]map[string]interface{}{{"connector_id": "connector-1", "version": 2, "connector_type_id": "test-type", "channel": "stable", "desired_state": "ready", "connector_spec": testSpec("revision-ref")}})
+			var updatedSpec []byte
+			mocket.Catcher.NewMock().WithQuery(`UPDATE "connectors" SET`).WithCallback(func(query string, args []driver.NamedValue) {
+				for _, arg := range args {
+					if spec, ok := arg.Value.([]byte); ok {
+						updatedSpec = spec
+					}
+				}
+			})
+			mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "connectors" WHERE id = $1`).
+				WithReply([]map[string]interface{}{{"id": "connector-1", "owner": "test-user", "version": 6, "connector_type_id": "test-type", "channel": "stable", "desired_state": "ready", "connector_spec": testSpec("revision-ref")}})
+			mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "connector_revisions" WHERE connector_id = $1`).
+				WithReply([]map[string]interface{}{
+					{"connector_id": "connector-1", "version": 2, "connector_type_id": "test-type", "connector_spec": testSpec("revision-ref")},
+					{"connector_id": "connector-1", "version": 5, "connector_type_id": "test-type", "connector_spec": testSpec("current-ref")},
+				})
+
+			k := newTestConnectorsService(t, vaultService)
+			ctx := newTestConnectorsContext(t, k.connectionFactory)
+			_, serr := k.Rollback(ctx, "connector-1", 2)
+			Expect(db.Resolve(ctx)).To(BeNil())
+
+			Expect(serr != nil).To(Equal(tt.wantErr))
+			if tt.wantErr {
+				Expect(updatedSpec).To(BeNil())
+				return
+			}
+			Expect(string(updatedSpec)).To(MatchJSON(tt.wantSpec))
+			// the secrets of the configuration rolled back from are kept for its revision
+			Expect(vaultService.Counters().Deletes).To(BeZero())
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package vault
 
 import (
+	"time"
+
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 	"github.com/spf13/pflag"
 )
@@ -13,14 +15,20 @@ type Config struct {
 	SecretAccessKey     string `json:"secret_access_key"`
 	SecretAccessKeyFile string `json:"secret_access_key_file"`
 	Region              string `json:"region"`
+	// Orphaned connector secrets are only deleted after they have been seen as orphaned for this long
+	OrphanedSecretsGracePeriod time.Duration `json:"orphaned_secrets_grace_period"`
+	// How often the vault is scanned for orphaned connector secrets
+	OrphanedSecretsScanInterval time.Duration `json:"orphaned_secrets_scan_interval"`
 }
 
 func NewConfig() *Config {
 	return &Config{
-		Kind:                "tmp",
-		AccessKeyFile:       "secrets/vault.accesskey",
-		SecretAccessKeyFile: "secrets/vault.secretaccesskey",
-		Region:              "us-east-1",
+		Kind:                        "tmp",
+		AccessKeyFile:               "secrets/vault.accesskey",
+		SecretAccessKeyFile:         "secrets/vault.secretaccesskey",
+		Region:                      "us-east-1",
+		OrphanedSecretsGracePeriod:  1 * time.Hour,
+		OrphanedSecretsScanInterval: 1 * time.Hour,
 	}
 }
 
@@ -29,6 +37,8 @@ func (c *Config) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.AccessKeyFile, "vault-access-key-file", c.AccessKeyFile, "File containing vault access key")
 	fs.StringVar(&c.SecretAccessKeyFile, "vault-secret-access-key-file", c.SecretAccessKeyFile, "File containing vault secret access key")
 	fs.StringVar(&c.Region, "vault-region", c.Region, "The region of the vault")
+	fs.DurationVar(&c.OrphanedSecretsGracePeriod, "vault-orphaned-secrets-grace-period", c.OrphanedSecretsGracePeriod, "How long a connector secret must stay orphaned before it is deleted from the vault")
+	fs.DurationVar(&c.OrphanedSecretsScanInterval, "vault-orphaned-secrets-scan-interval", c.OrphanedSecretsScanInterval, "How often the vault is scanned for orphaned connector secrets")
 }
 
 func (c *Config) ReadFiles() error {
@@ -2,6 +2,7 @@ package vault
 
 import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/health"
 	"github.com/goava/di"
 )
 
@@ -14,6 +15,6 @@ func ConfigProviders() di.Option {
 
 func ServiceProviders() di.Option {
 	return di.Options(
-		di.Provide(NewVaultService),
+		di.Provide(NewVaultService, di.As(new(health.Checker))),
 	)
 }
@@ -3,6 +3,7 @@ package vault
 import (
 	"fmt"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/metrics"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/health"
 )
 
 type VaultService interface {
@@ -11,6 +12,7 @@ type VaultService interface {
 	DeleteSecretString(name string) error
 	ForEachSecret(f func(name string, owningResource string) bool) error
 	Kind() string
+	health.Checker
 }
 
 func NewVaultService(vaultConfig *Config) (VaultService, error) {
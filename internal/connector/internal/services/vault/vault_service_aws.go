@@ -1,6 +1,8 @@
 package vault
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/credentials"
@@ -8,6 +10,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/aws/aws-secretsmanager-caching-go/secretcache"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/metrics"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/health"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/tracing"
 )
 
 var OwnerResourceTagKey = "owner-resource"
@@ -32,6 +36,7 @@ func NewAwsVaultService(vaultConfig *Config) (*awsVaultService, error) {
 	if err != nil {
 		return nil, err
 	}
+	sess.Config.HTTPClient = tracing.HTTPClient(sess.Config.HTTPClient)
 
 	secretClient := secretsmanager.New(sess)
 	secretCache, err := secretcache.New(func(cache *secretcache.Cache) {
@@ -46,6 +51,22 @@ func NewAwsVaultService(vaultConfig *Config) (*awsVaultService, error) {
 	}, nil
 }
 
+// HealthChecks checks the secrets manager can be reached, the connectors can't be deployed without their secrets
+func (k *awsVaultService) HealthChecks() []health.Check {
+	return []health.Check{
+		{
+			Name:     "vault",
+			External: true,
+			Func: func(ctx context.Context) error {
+				_, err := k.secretClient.ListSecretsWithContext(ctx, &secretsmanager.ListSecretsInput{
+					MaxResults: aws.Int64(1),
+				})
+				return err
+			},
+		},
+	}
+}
+
 func (k *awsVaultService) Kind() string {
 	return "aws"
 }
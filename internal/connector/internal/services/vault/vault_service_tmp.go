@@ -3,6 +3,7 @@ package vault
 import (
 	"fmt"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/metrics"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/health"
 	"sync"
 )
 
@@ -43,6 +44,10 @@ func (k *TmpVaultService) Kind() string {
 	return "tmp"
 }
 
+func (k *TmpVaultService) HealthChecks() []health.Check {
+	return nil
+}
+
 func (k *TmpVaultService) ResetCounters() {
 	k.mu.Lock()
 	defer k.mu.Unlock()
@@ -4,12 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"sync"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/vault"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/server"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
@@ -28,8 +30,11 @@ type ConnectorManager struct {
 	connectorService        services.ConnectorsService
 	connectorClusterService services.ConnectorClusterService
 	connectorTypesService   services.ConnectorTypesService
+	connectorsConfig        *config.ConnectorsConfig
 	vaultService            vault.VaultService
 	lastVersion             int64
+	lastCatalogRefresh      time.Time
+	migrateDeprecated       bool
 	startupReconcileDone    bool
 	startupReconcileWG      sync.WaitGroup
 	db                      *db.ConnectionFactory
@@ -47,6 +52,7 @@ func NewConnectorManager(
 	connectorTypesService services.ConnectorTypesService,
 	connectorService services.ConnectorsService,
 	connectorClusterService services.ConnectorClusterService,
+	connectorsConfig *config.ConnectorsConfig,
 	vaultService vault.VaultService,
 	bus signalbus.SignalBus,
 	db *db.ConnectionFactory,
@@ -62,6 +68,7 @@ func NewConnectorManager(
 		connectorService:        connectorService,
 		connectorClusterService: connectorClusterService,
 		connectorTypesService:   connectorTypesService,
+		connectorsConfig:        connectorsConfig,
 		vaultService:            vaultService,
 		startupReconcileDone:    false,
 		db:                      db,
@@ -86,11 +93,13 @@ func (k *ConnectorManager) Reconcile() []error {
 
 	if !k.startupReconcileDone {
 
-		// We only need to reconcile channel updates once per process startup since,
-		// configured channel settings are only loaded on startup.
-		if err := k.connectorTypesService.ForEachConnectorCatalogEntry(k.ReconcileConnectorCatalogEntry); err != nil {
+		// Local channel settings are only loaded on startup, remote catalog indexes
+		// are reconciled again below whenever they change.
+		if err := k.reconcileConnectorCatalog(); err != nil {
 			return []error{err}
 		}
+		k.lastCatalogRefresh = time.Now()
+		k.migrateDeprecated = true
 
 		if err := k.connectorClusterService.CleanupDeployments(); err != nil {
 			return []error{err}
@@ -100,6 +109,12 @@ func (k *ConnectorManager) Reconcile() []error {
 		k.startupReconcileWG.Done()
 	}
 
+	if len(k.connectorsConfig.CatalogIndexURLs) > 0 && time.Since(k.lastCatalogRefresh) >= k.connectorsConfig.CatalogRefreshInterval {
+		if err := k.refreshConnectorCatalog(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
 	if k.ctx == nil {
 		ctx, err := k.db.NewContext(context.Background())
 		if err != nil {
@@ -108,6 +123,12 @@ func (k *ConnectorManager) Reconcile() []error {
 		k.ctx = ctx
 	}
 
+	if k.migrateDeprecated {
+		// migrations that failed are retried when the catalog changes again
+		k.migrateDeprecated = false
+		errs = append(errs, k.migrateDeprecatedConnectors()...)
+	}
+
 	serviceErr := k.connectorService.ForEach(func(connector *dbapi.Connector) *serviceError.ServiceError {
 		return InDBTransaction(k.ctx, func(ctx context.Context) error {
 			switch connector.Status.Phase {
@@ -177,6 +198,80 @@ func InDBTransaction(ctx context.Context, f func(ctx context.Context) error) (re
 	return nil
 }
 
+// refreshConnectorCatalog reloads the remote connector catalog indexes and reconciles the
+// connector types and channels when they changed.
+func (k *ConnectorManager) refreshConnectorCatalog() error {
+	k.lastCatalogRefresh = time.Now()
+	changed, err := k.connectorsConfig.RefreshCatalog()
+	if err != nil {
+		return errors.Wrap(err, "failed to refresh connector catalog")
+	}
+	if !changed {
+		return nil
+	}
+	glog.Infof("connector catalog changed, reconciling %d connector types", len(k.connectorsConfig.CatalogEntries))
+	if serr := k.reconcileConnectorCatalog(); serr != nil {
+		return errors.Wrap(serr, "failed to reconcile connector catalog")
+	}
+	k.migrateDeprecated = true
+	return nil
+}
+
+// reconcileConnectorCatalog creates or updates the connector types and channels of the catalog, and prunes the
+// connector types that were removed from the remote catalog indexes once all of them could be loaded.
+func (k *ConnectorManager) reconcileConnectorCatalog() *serviceError.ServiceError {
+	if serr := k.connectorTypesService.ForEachConnectorCatalogEntry(k.ReconcileConnectorCatalogEntry); serr != nil {
+		return serr
+	}
+	if len(k.connectorsConfig.CatalogIndexURLs) == 0 || !k.connectorsConfig.IsCatalogComplete() {
+		return nil
+	}
+	typeIds := make([]string, 0, len(k.connectorsConfig.CatalogEntries))
+	for _, entry := range k.connectorsConfig.CatalogEntries {
+		typeIds = append(typeIds, entry.ConnectorType.Id)
+	}
+	return k.connectorTypesService.PruneConnectorTypes(typeIds)
+}
+
+// migrateDeprecatedConnectors moves the connectors of deprecated connector types to their successor connector types
+func (k *ConnectorManager) migrateDeprecatedConnectors() []error {
+	deprecations, _, serr := k.connectorTypesService.ListDeprecatedConnectors(&coreServices.ListArguments{})
+	if serr != nil {
+		return []error{errors.Wrap(serr, "failed to list deprecated connectors")}
+	}
+
+	var errs []error
+	successors := map[string]*dbapi.ConnectorType{}
+	for _, deprecation := range deprecations {
+		if !deprecation.TypeDeprecated || deprecation.SuccessorId == "" {
+			continue
+		}
+		successor, found := successors[deprecation.SuccessorId]
+		if !found {
+			successor, serr = k.connectorTypesService.Get(deprecation.SuccessorId)
+			if serr != nil {
+				errs = append(errs, errors.Wrapf(serr, "failed to get successor of connector type %s", deprecation.ConnectorTypeId))
+				continue
+			}
+			successors[deprecation.SuccessorId] = successor
+		}
+
+		connectorId := deprecation.ConnectorID
+		serr = InDBTransaction(k.ctx, func(ctx context.Context) error {
+			if serr := k.connectorService.MigrateConnectorType(ctx, connectorId, successor); serr != nil {
+				return serr
+			}
+			return nil
+		})
+		if serr != nil {
+			errs = append(errs, errors.Wrapf(serr, "failed to migrate connector %s to connector type %s", connectorId, successor.ID))
+			continue
+		}
+		glog.Infof("migrated connector %s from deprecated connector type %s to %s", connectorId, deprecation.ConnectorTypeId, successor.ID)
+	}
+	return errs
+}
+
 func (k *ConnectorManager) ReconcileConnectorCatalogEntry(id string, channel string, ccc *config.ConnectorChannelConfig) *serviceError.ServiceError {
 
 	ctc := dbapi.ConnectorShardMetadata{
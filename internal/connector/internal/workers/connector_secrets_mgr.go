@@ -0,0 +1,83 @@
+package workers
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/vault"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// ConnectorSecretsManager periodically deletes connector secrets from the vault that are no longer
+// referenced by their owning connector, e.g. because the transaction that stored them was rolled back.
+type ConnectorSecretsManager struct {
+	workers.BaseWorker
+	connectorSecretsService services.ConnectorSecretsService
+	vaultService            vault.VaultService
+	vaultConfig             *vault.Config
+	lastScan                time.Time
+}
+
+// NewConnectorSecretsManager creates a new connector secrets manager
+func NewConnectorSecretsManager(
+	connectorSecretsService services.ConnectorSecretsService,
+	vaultService vault.VaultService,
+	vaultConfig *vault.Config,
+	bus signalbus.SignalBus,
+) *ConnectorSecretsManager {
+	return &ConnectorSecretsManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: "connector_secrets",
+			Reconciler: workers.Reconciler{
+				SignalBus: bus,
+			},
+		},
+		connectorSecretsService: connectorSecretsService,
+		vaultService:            vaultService,
+		vaultConfig:             vaultConfig,
+	}
+}
+
+// Start initializes the connector secrets manager to reconcile vault secrets
+func (k *ConnectorSecretsManager) Start() {
+	k.StartWorker(k)
+}
+
+// Stop causes the process for reconciling vault secrets to stop.
+func (k *ConnectorSecretsManager) Stop() {
+	k.StopWorker(k)
+	k.lastScan = time.Time{}
+}
+
+func (k *ConnectorSecretsManager) Reconcile() []error {
+	now := time.Now()
+	if now.Sub(k.lastScan) < k.vaultConfig.OrphanedSecretsScanInterval {
+		return nil
+	}
+	glog.V(5).Infoln("reconciling orphaned connector secrets")
+	k.lastScan = now
+
+	var errs []error
+	serr := k.connectorSecretsService.ForEachOrphanedSecret(func(name string, owningResource string, orphanedSince time.Time) bool {
+		// orphaned secrets are only deleted once they have stayed orphaned for the configured grace period,
+		// this avoids deleting the secrets of connectors that are still being created, updated or rotated.
+		if now.Sub(orphanedSince) < k.vaultConfig.OrphanedSecretsGracePeriod {
+			return true
+		}
+		glog.Infof("deleting orphaned vault secret %s of %s", name, owningResource)
+		if err := k.vaultService.DeleteSecretString(name); err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to delete orphaned vault secret %s", name))
+		}
+		return true
+	})
+	if serr != nil {
+		return []error{errors.Wrap(serr, "connector secrets manager")}
+	}
+	return errs
+}
@@ -0,0 +1,83 @@
+package workers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/vault"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	. "github.com/onsi/gomega"
+)
+
+func TestConnectorSecretsManager_Reconcile(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name         string
+		lastScan     time.Time
+		orphaned     map[string]time.Time
+		listErr      *errors.ServiceError
+		wantDeleted  []string
+		wantListings int
+		wantErr      bool
+	}{
+		{
+			name:         "should only delete the secrets orphaned for longer than the grace period",
+			orphaned:     map[string]time.Time{"old-ref": now.Add(-2 * time.Hour), "new-ref": now.Add(-time.Minute)},
+			wantDeleted:  []string{"old-ref"},
+			wantListings: 1,
+		},
+		{
+			name:         "should not scan the vault before the scan interval",
+			lastScan:     now.Add(-time.Minute),
+			orphaned:     map[string]time.Time{"old-ref": now.Add(-2 * time.Hour)},
+			wantListings: 0,
+		},
+		{
+			name:         "should fail when the orphaned secrets can't be listed",
+			listErr:      errors.GeneralError("test"),
+			wantListings: 1,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			vaultService, err := vault.NewTmpVaultService()
+			Expect(err).To(BeNil())
+			for name := range tt.orphaned {
+				Expect(vaultService.SetSecretString(name, "value", services.ConnectorOwningResource("connector-1"))).To(BeNil())
+			}
+			secretsService := &services.ConnectorSecretsServiceMock{
+				ForEachOrphanedSecretFunc: func(f func(name string, owningResource string, orphanedSince time.Time) bool) *errors.ServiceError {
+					for name, since := range tt.orphaned {
+						if !f(name, services.ConnectorOwningResource("connector-1"), since) {
+							return nil
+						}
+					}
+					return tt.listErr
+				},
+			}
+			k := &ConnectorSecretsManager{
+				connectorSecretsService: secretsService,
+				vaultService:            vaultService,
+				vaultConfig: &vault.Config{
+					OrphanedSecretsGracePeriod:  time.Hour,
+					OrphanedSecretsScanInterval: time.Hour,
+				},
+				lastScan: tt.lastScan,
+			}
+
+			errs := k.Reconcile()
+
+			Expect(len(errs) > 0).To(Equal(tt.wantErr))
+			Expect(secretsService.ForEachOrphanedSecretCalls()).To(HaveLen(tt.wantListings))
+			Expect(vaultService.Counters().Deletes).To(BeEquivalentTo(len(tt.wantDeleted)))
+			for _, name := range tt.wantDeleted {
+				_, err := vaultService.GetSecretString(name)
+				Expect(err).To(Equal(vault.NotFound))
+			}
+		})
+	}
+}
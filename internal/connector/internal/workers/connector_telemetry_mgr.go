@@ -0,0 +1,67 @@
+package workers
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// ConnectorTelemetryManager periodically prunes the logs and metrics reported by connector agents, so that
+// the entries of connectors that stopped reporting or were deleted don't outlive the retention.
+type ConnectorTelemetryManager struct {
+	workers.BaseWorker
+	telemetryService services.ConnectorTelemetryService
+	connectorsConfig *config.ConnectorsConfig
+	lastPrune        time.Time
+}
+
+// NewConnectorTelemetryManager creates a new connector telemetry manager
+func NewConnectorTelemetryManager(
+	telemetryService services.ConnectorTelemetryService,
+	connectorsConfig *config.ConnectorsConfig,
+	bus signalbus.SignalBus,
+) *ConnectorTelemetryManager {
+	return &ConnectorTelemetryManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: "connector_telemetry",
+			Reconciler: workers.Reconciler{
+				SignalBus: bus,
+			},
+		},
+		telemetryService: telemetryService,
+		connectorsConfig: connectorsConfig,
+	}
+}
+
+// Start initializes the connector telemetry manager to prune connector logs and metrics
+func (k *ConnectorTelemetryManager) Start() {
+	k.StartWorker(k)
+}
+
+// Stop causes the process for pruning connector logs and metrics to stop.
+func (k *ConnectorTelemetryManager) Stop() {
+	k.StopWorker(k)
+	k.lastPrune = time.Time{}
+}
+
+func (k *ConnectorTelemetryManager) Reconcile() []error {
+	now := time.Now()
+	if now.Sub(k.lastPrune) < k.connectorsConfig.TelemetryPruneInterval {
+		return nil
+	}
+	glog.V(5).Infoln("pruning connector logs and metrics")
+	k.lastPrune = now
+
+	if err := k.telemetryService.PruneTelemetry(); err != nil {
+		return []error{errors.Wrap(err, "connector telemetry manager")}
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package workers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	. "github.com/onsi/gomega"
+)
+
+func TestConnectorTelemetryManager_Reconcile(t *testing.T) {
+	tests := []struct {
+		name       string
+		lastPrune  time.Time
+		pruneErr   *errors.ServiceError
+		wantPrunes int
+		wantErr    bool
+	}{
+		{
+			name:       "should prune the connector telemetry",
+			wantPrunes: 1,
+		},
+		{
+			name:       "should not prune before the prune interval",
+			lastPrune:  time.Now().Add(-time.Minute),
+			wantPrunes: 0,
+		},
+		{
+			name:       "should fail when the connector telemetry can't be pruned",
+			pruneErr:   errors.GeneralError("test"),
+			wantPrunes: 1,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			telemetryService := &services.ConnectorTelemetryServiceMock{
+				PruneTelemetryFunc: func() *errors.ServiceError {
+					return tt.pruneErr
+				},
+			}
+			k := NewConnectorTelemetryManager(telemetryService, &config.ConnectorsConfig{TelemetryPruneInterval: time.Hour}, nil)
+			k.lastPrune = tt.lastPrune
+
+			errs := k.Reconcile()
+
+			Expect(len(errs) > 0).To(Equal(tt.wantErr))
+			Expect(telemetryService.PruneTelemetryCalls()).To(HaveLen(tt.wantPrunes))
+		})
+	}
+}
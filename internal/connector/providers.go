@@ -13,6 +13,8 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
 	environments2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/providers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/metering"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/quota"
 	coreWorkers "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
 
 	"github.com/goava/di"
@@ -51,12 +53,20 @@ func serviceProviders() di.Option {
 		di.Provide(services.NewConnectorTypesService, di.As(new(services.ConnectorTypesService))),
 		di.Provide(services.NewConnectorClusterService, di.As(new(services.ConnectorClusterService))),
 		di.Provide(services.NewConnectorClusterService, di.As(new(auth.AuthAgentService))),
+		di.Provide(services.NewConnectorSecretsService, di.As(new(services.ConnectorSecretsService))),
+		di.Provide(services.NewConnectorTelemetryService, di.As(new(services.ConnectorTelemetryService))),
+		di.Provide(services.NewConnectorQuotaConsumer, di.As(new(quota.QuotaConsumer))),
+		di.Provide(services.NewConnectorUsageSampler, di.As(new(metering.UsageSampler))),
 		di.Provide(handlers.NewConnectorAdminHandler),
 		di.Provide(handlers.NewConnectorTypesHandler),
 		di.Provide(handlers.NewConnectorsHandler),
 		di.Provide(handlers.NewConnectorClusterHandler),
+		di.Provide(handlers.NewConnectorTelemetryHandler),
+		di.Provide(handlers.NewConnectorRoleBindingsHandler),
 		di.Provide(routes.NewRouteLoader),
 		di.Provide(workers.NewConnectorManager, di.As(new(coreWorkers.Worker))),
+		di.Provide(workers.NewConnectorSecretsManager, di.As(new(coreWorkers.Worker))),
+		di.Provide(workers.NewConnectorTelemetryManager, di.As(new(coreWorkers.Worker))),
 		di.Provide(workers.NewApiServerReadyCondition),
 	)
 }
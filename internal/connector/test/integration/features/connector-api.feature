@@ -1356,7 +1356,8 @@ Feature: create a connector
         }
         """
       Then the response code should be 202
-      And the vault delete counter should be 2
+      # the previous aws_secret_key is kept for the revision it belongs to, it's deleted along with the connector
+      And the vault delete counter should be 1
     And UNLOCK---------------------------------------------------------------
 
 
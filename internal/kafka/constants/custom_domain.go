@@ -0,0 +1,17 @@
+package constants
+
+// CustomDomainStatus type
+type CustomDomainStatus string
+
+const (
+	// CustomDomainStatusPendingVerification - the ownership of the custom domain is not verified yet
+	CustomDomainStatusPendingVerification CustomDomainStatus = "pending_verification"
+	// CustomDomainStatusPendingCertificate - the custom domain is verified and its certificate is being issued
+	CustomDomainStatusPendingCertificate CustomDomainStatus = "pending_certificate"
+	// CustomDomainStatusReady - the kafka is reachable at the custom domain
+	CustomDomainStatusReady CustomDomainStatus = "ready"
+)
+
+func (s CustomDomainStatus) String() string {
+	return string(s)
+}
@@ -0,0 +1,36 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.0.2
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// AuditEvent struct for AuditEvent
+type AuditEvent struct {
+	Id        string    `json:"id,omitempty"`
+	Kind      string    `json:"kind,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Username of the user who made the request
+	Actor          string `json:"actor,omitempty"`
+	OrganisationId string `json:"organisation_id,omitempty"`
+	// Operation of the request. For example update-kafka
+	Action       string `json:"action,omitempty"`
+	ResourceType string `json:"resource_type,omitempty"`
+	ResourceId   string `json:"resource_id,omitempty"`
+	Method       string `json:"method,omitempty"`
+	Path         string `json:"path,omitempty"`
+	RemoteAddr   string `json:"remote_addr,omitempty"`
+	StatusCode   int32  `json:"status_code,omitempty"`
+	// Values: [success, failure]
+	Outcome string `json:"outcome,omitempty"`
+	// Changed top level fields of the resource, with their value before and after the request. Secrets are redacted.
+	Diff map[string]interface{} `json:"diff,omitempty"`
+}
@@ -0,0 +1,25 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.0.2
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// AvailabilityReport struct for AvailabilityReport
+type AvailabilityReport struct {
+	Kind string    `json:"kind,omitempty"`
+	From time.Time `json:"from,omitempty"`
+	To   time.Time `json:"to,omitempty"`
+	// Ratio of time the kafkas are expected to be available
+	SloTarget float64               `json:"slo_target,omitempty"`
+	Items     []KafkaAvailability   `json:"items,omitempty"`
+	Clusters  []ClusterAvailability `json:"clusters,omitempty"`
+}
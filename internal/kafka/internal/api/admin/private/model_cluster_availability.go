@@ -0,0 +1,20 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.0.2
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ClusterAvailability struct for ClusterAvailability
+type ClusterAvailability struct {
+	ClusterId          string  `json:"cluster_id,omitempty"`
+	AvailableSeconds   float64 `json:"available_seconds,omitempty"`
+	UnavailableSeconds float64 `json:"unavailable_seconds,omitempty"`
+	UnknownSeconds     float64 `json:"unknown_seconds,omitempty"`
+	// Ratio of the available and unavailable time the kafkas of the cluster were available, 1 when nothing was measured
+	Availability float64 `json:"availability,omitempty"`
+}
@@ -0,0 +1,29 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.0.2
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// ClusterStatusHistory struct for ClusterStatusHistory
+type ClusterStatusHistory struct {
+	Kind      string    `json:"kind,omitempty"`
+	ClusterId string    `json:"cluster_id,omitempty"`
+	From      time.Time `json:"from,omitempty"`
+	To        time.Time `json:"to,omitempty"`
+	// The interval in seconds the status reports are aggregated by
+	Interval int64 `json:"interval,omitempty"`
+	// Number of times the scaling of the compute nodes changed direction over the period
+	ScalingDirectionChanges int32 `json:"scaling_direction_changes,omitempty"`
+	// Whether the scaling of the compute nodes was flapping as of the latest status report
+	Flapping bool                      `json:"flapping,omitempty"`
+	Items    []ClusterStatusTrendPoint `json:"items,omitempty"`
+}
@@ -0,0 +1,36 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.0.2
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// ClusterStatusHistorySummary struct for ClusterStatusHistorySummary
+type ClusterStatusHistorySummary struct {
+	ClusterId string `json:"cluster_id,omitempty"`
+	// Number of status reports received over the period
+	Reports        int32     `json:"reports,omitempty"`
+	LastReportedAt time.Time `json:"last_reported_at,omitempty"`
+	// Number of compute nodes in the latest status report
+	NodesCurrent            int32 `json:"nodes_current,omitempty"`
+	NodesMin                int32 `json:"nodes_min,omitempty"`
+	NodesMax                int32 `json:"nodes_max,omitempty"`
+	RemainingConnectionsMin int32 `json:"remaining_connections_min,omitempty"`
+	RemainingPartitionsMin  int32 `json:"remaining_partitions_min,omitempty"`
+	// Number of status reports the compute nodes were scaled up after
+	ScaleUps int32 `json:"scale_ups,omitempty"`
+	// Number of status reports the compute nodes were scaled down after
+	ScaleDowns int32 `json:"scale_downs,omitempty"`
+	// Number of times the scaling of the compute nodes changed direction over the period
+	ScalingDirectionChanges int32 `json:"scaling_direction_changes,omitempty"`
+	// Whether the scaling of the compute nodes was flapping as of the latest status report
+	Flapping bool `json:"flapping,omitempty"`
+}
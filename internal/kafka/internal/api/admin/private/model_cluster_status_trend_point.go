@@ -0,0 +1,31 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.0.2
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// ClusterStatusTrendPoint struct for ClusterStatusTrendPoint
+type ClusterStatusTrendPoint struct {
+	// Start of the interval
+	Timestamp               time.Time `json:"timestamp,omitempty"`
+	Reports                 int32     `json:"reports,omitempty"`
+	NodesMin                int32     `json:"nodes_min,omitempty"`
+	NodesMax                int32     `json:"nodes_max,omitempty"`
+	NodesCeiling            int32     `json:"nodes_ceiling,omitempty"`
+	NodesFloor              int32     `json:"nodes_floor,omitempty"`
+	RemainingConnectionsMin int32     `json:"remaining_connections_min,omitempty"`
+	RemainingPartitionsMin  int32     `json:"remaining_partitions_min,omitempty"`
+	ScaleUps                int32     `json:"scale_ups,omitempty"`
+	ScaleDowns              int32     `json:"scale_downs,omitempty"`
+	// Whether the scaling of the compute nodes was flapping in any status report of the interval
+	Flapping bool `json:"flapping,omitempty"`
+}
@@ -0,0 +1,24 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.0.2
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// KafkaAvailability struct for KafkaAvailability
+type KafkaAvailability struct {
+	KafkaId            string  `json:"kafka_id,omitempty"`
+	ClusterId          string  `json:"cluster_id,omitempty"`
+	OrganisationId     string  `json:"organisation_id,omitempty"`
+	AvailableSeconds   float64 `json:"available_seconds,omitempty"`
+	UnavailableSeconds float64 `json:"unavailable_seconds,omitempty"`
+	UnknownSeconds     float64 `json:"unknown_seconds,omitempty"`
+	// Ratio of the available and unavailable time the kafka was available, 1 when nothing was measured
+	Availability float64 `json:"availability,omitempty"`
+	// Ratio of the error budget left over the period, negative once the budget is exhausted
+	ErrorBudgetRemaining float64 `json:"error_budget_remaining,omitempty"`
+}
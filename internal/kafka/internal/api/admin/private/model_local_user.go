@@ -0,0 +1,26 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.0.2
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// LocalUser struct for LocalUser
+type LocalUser struct {
+	Id             string    `json:"id,omitempty"`
+	Kind           string    `json:"kind,omitempty"`
+	CreatedAt      time.Time `json:"created_at,omitempty"`
+	UpdatedAt      time.Time `json:"updated_at,omitempty"`
+	Username       string    `json:"username,omitempty"`
+	OrganisationId string    `json:"organisation_id,omitempty"`
+	Banned         bool      `json:"banned,omitempty"`
+	TermsAccepted  bool      `json:"terms_accepted,omitempty"`
+}
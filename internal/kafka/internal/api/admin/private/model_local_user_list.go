@@ -0,0 +1,19 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.0.2
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// LocalUserList struct for LocalUserList
+type LocalUserList struct {
+	Kind  string      `json:"kind"`
+	Page  int32       `json:"page"`
+	Size  int32       `json:"size"`
+	Total int32       `json:"total"`
+	Items []LocalUser `json:"items"`
+}
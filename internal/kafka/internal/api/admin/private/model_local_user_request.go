@@ -0,0 +1,17 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.0.2
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// LocalUserRequest struct for LocalUserRequest
+type LocalUserRequest struct {
+	OrganisationId string `json:"organisation_id"`
+	Banned         bool   `json:"banned,omitempty"`
+	TermsAccepted  bool   `json:"terms_accepted,omitempty"`
+}
@@ -0,0 +1,27 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.0.2
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// OrganisationQuota struct for OrganisationQuota
+type OrganisationQuota struct {
+	Id             string    `json:"id,omitempty"`
+	Kind           string    `json:"kind,omitempty"`
+	CreatedAt      time.Time `json:"created_at,omitempty"`
+	UpdatedAt      time.Time `json:"updated_at,omitempty"`
+	OrganisationId string    `json:"organisation_id,omitempty"`
+	// The resource of the quota, a kafka instance type or connectors. Values: [standard, eval, connectors]
+	Resource string `json:"resource,omitempty"`
+	// Capacity units of the resource the organisation is allowed to consume
+	Allowed int32 `json:"allowed,omitempty"`
+}
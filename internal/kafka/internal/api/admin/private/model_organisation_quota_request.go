@@ -0,0 +1,15 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.0.2
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// OrganisationQuotaRequest struct for OrganisationQuotaRequest
+type OrganisationQuotaRequest struct {
+	Allowed int32 `json:"allowed"`
+}
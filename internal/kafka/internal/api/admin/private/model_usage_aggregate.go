@@ -0,0 +1,27 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.0.2
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// UsageAggregate struct for UsageAggregate
+type UsageAggregate struct {
+	OrganisationId string `json:"organisation_id,omitempty"`
+	// Values: [kafka, connector]
+	ResourceType string `json:"resource_type,omitempty"`
+	// The kafka instance type or the connector type
+	InstanceType  string `json:"instance_type,omitempty"`
+	CloudProvider string `json:"cloud_provider,omitempty"`
+	Region        string `json:"region,omitempty"`
+	MultiAz       bool   `json:"multi_az,omitempty"`
+	StorageSize   string `json:"storage_size,omitempty"`
+	// Number of distinct kafkas or connectors sampled
+	Instances int32 `json:"instances,omitempty"`
+	// Instance-hours consumed over the period
+	Hours float64 `json:"hours,omitempty"`
+}
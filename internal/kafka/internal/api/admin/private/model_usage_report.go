@@ -0,0 +1,22 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.0.2
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// UsageReport struct for UsageReport
+type UsageReport struct {
+	Kind  string           `json:"kind,omitempty"`
+	From  time.Time        `json:"from,omitempty"`
+	To    time.Time        `json:"to,omitempty"`
+	Items []UsageAggregate `json:"items,omitempty"`
+}
@@ -0,0 +1,63 @@
+package dbapi
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+const (
+	DataPlaneClusterScalingUp   = "scale_up"
+	DataPlaneClusterScalingDown = "scale_down"
+)
+
+// DataPlaneClusterStatusReport records the nodes and the remaining capacity of a data plane cluster reported by the kas
+// fleet shard operator, and the scaling of the compute nodes of the cluster it triggered
+type DataPlaneClusterStatusReport struct {
+	api.Meta
+	ClusterId                              string
+	ReportedAt                             time.Time
+	NodesCurrent                           int
+	NodesCeiling                           int
+	NodesFloor                             int
+	NodesWorkloadMinimum                   int
+	ResizeNodeDelta                        int
+	RemainingConnections                   int
+	RemainingPartitions                    int
+	RemainingIngressEgressThroughputPerSec string
+	RemainingDataRetentionSize             string
+	// ScalingAction is either DataPlaneClusterScalingUp or DataPlaneClusterScalingDown when the report changed the
+	// number of compute nodes, empty otherwise
+	ScalingAction string
+	DesiredNodes  int
+	// Flapping is true when the scaling of the cluster changed direction too often over the flapping window ending
+	// with this report
+	Flapping bool
+}
+
+type DataPlaneClusterStatusReportList []*DataPlaneClusterStatusReport
+
+func NewDataPlaneClusterStatusReport(clusterId string, status *DataPlaneClusterStatus) *DataPlaneClusterStatusReport {
+	return &DataPlaneClusterStatusReport{
+		ClusterId:                              clusterId,
+		ReportedAt:                             time.Now(),
+		NodesCurrent:                           status.NodeInfo.Current,
+		NodesCeiling:                           status.NodeInfo.Ceiling,
+		NodesFloor:                             status.NodeInfo.Floor,
+		NodesWorkloadMinimum:                   status.NodeInfo.CurrentWorkLoadMinimum,
+		ResizeNodeDelta:                        status.ResizeInfo.NodeDelta,
+		RemainingConnections:                   status.Remaining.Connections,
+		RemainingPartitions:                    status.Remaining.Partitions,
+		RemainingIngressEgressThroughputPerSec: status.Remaining.IngressEgressThroughputPerSec,
+		RemainingDataRetentionSize:             status.Remaining.DataRetentionSize,
+		DesiredNodes:                           status.NodeInfo.Current,
+	}
+}
+
+func (r *DataPlaneClusterStatusReport) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == "" {
+		r.ID = api.NewID()
+	}
+	return nil
+}
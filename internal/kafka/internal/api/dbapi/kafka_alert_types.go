@@ -0,0 +1,106 @@
+package dbapi
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+const (
+	AlertRuleStateInactive = "inactive"
+	AlertRuleStatePending  = "pending"
+	AlertRuleStateFiring   = "firing"
+
+	AlertStateFiring   = "firing"
+	AlertStateResolved = "resolved"
+
+	AlertAggregationSum = "sum"
+	AlertAggregationMax = "max"
+	AlertAggregationMin = "min"
+	AlertAggregationAvg = "avg"
+
+	AlertOperatorGreaterThan        = "gt"
+	AlertOperatorGreaterThanOrEqual = "gte"
+	AlertOperatorLessThan           = "lt"
+	AlertOperatorLessThanOrEqual    = "lte"
+
+	AlertNotificationEmail   = "email"
+	AlertNotificationWebhook = "webhook"
+)
+
+// KafkaAlertRule is a condition on a metric of a kafka instance its owner is notified about when it holds
+type KafkaAlertRule struct {
+	api.Meta
+	KafkaId        string
+	Owner          string
+	OrganisationId string
+	Name           string
+	// Metric is the name of one of the metrics of the kafka exposed to the users
+	Metric string
+	// RelativeTo is the name of the metric the value of Metric is divided by, the rule applies to the absolute value
+	// of Metric when empty
+	RelativeTo string
+	// Aggregation is how the series of a metric, e.g. one per broker or per topic, are combined into a single value
+	Aggregation string
+	Operator    string
+	Threshold   float64
+	// ForSeconds is how long the condition must hold before the alert fires
+	ForSeconds int64
+	// NotificationType is either email or webhook, NotificationTarget is the email address or the https URL notified
+	NotificationType   string
+	NotificationTarget string
+	State              string
+	// ActiveSince is when the condition started to hold, it is unset while the rule is inactive
+	ActiveSince     *time.Time
+	LastEvaluatedAt *time.Time
+	LastValue       *float64
+	LastError       string
+}
+
+type KafkaAlertRuleList []*KafkaAlertRule
+
+func (r *KafkaAlertRule) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == "" {
+		r.ID = api.NewID()
+	}
+	return nil
+}
+
+// Holds returns whether the value satisfies the condition of the rule
+func (r *KafkaAlertRule) Holds(value float64) bool {
+	switch r.Operator {
+	case AlertOperatorGreaterThan:
+		return value > r.Threshold
+	case AlertOperatorGreaterThanOrEqual:
+		return value >= r.Threshold
+	case AlertOperatorLessThan:
+		return value < r.Threshold
+	case AlertOperatorLessThanOrEqual:
+		return value <= r.Threshold
+	}
+	return false
+}
+
+// KafkaAlert is a transition of an alert rule to firing or back to resolved, and the outcome of its notification
+type KafkaAlert struct {
+	api.Meta
+	RuleId    string
+	RuleName  string
+	KafkaId   string
+	State     string
+	Value     float64
+	Threshold float64
+	// NotifiedAt is unset when the notification failed, the reason is then in NotificationError
+	NotifiedAt        *time.Time
+	NotificationError string
+}
+
+type KafkaAlertList []*KafkaAlert
+
+func (a *KafkaAlert) BeforeCreate(tx *gorm.DB) error {
+	if a.ID == "" {
+		a.ID = api.NewID()
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package dbapi
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+const (
+	KafkaAvailabilityStatusAvailable   = "available"
+	KafkaAvailabilityStatusUnavailable = "unavailable"
+	// KafkaAvailabilityStatusUnknown is recorded when neither the data plane nor observatorium reported the state of
+	// the kafka. Unknown samples do not count towards the availability.
+	KafkaAvailabilityStatusUnknown = "unknown"
+
+	KafkaAvailabilitySourceDataPlane     = "data_plane"
+	KafkaAvailabilitySourceObservatorium = "observatorium"
+)
+
+// KafkaReadinessReport is the latest readiness of a kafka reported by the data plane
+type KafkaReadinessReport struct {
+	KafkaId    string `gorm:"primarykey"`
+	ClusterId  string
+	Ready      bool
+	Reason     string
+	ReportedAt time.Time
+}
+
+type KafkaReadinessReportList []*KafkaReadinessReport
+
+// KafkaAvailabilitySample records the availability of a kafka over the sample interval ending at SampledAt
+type KafkaAvailabilitySample struct {
+	api.Meta
+	KafkaId        string
+	ClusterId      string
+	OrganisationId string
+	SampledAt      time.Time
+	Seconds        float64
+	Status         string
+	Source         string
+	Reason         string
+}
+
+type KafkaAvailabilitySampleList []*KafkaAvailabilitySample
+
+func (s *KafkaAvailabilitySample) BeforeCreate(tx *gorm.DB) error {
+	if s.ID == "" {
+		s.ID = api.NewID()
+	}
+	return nil
+}
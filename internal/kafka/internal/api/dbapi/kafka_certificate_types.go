@@ -0,0 +1,39 @@
+package dbapi
+
+import (
+	"strings"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+// KafkaCertificate is the TLS certificate issued for the endpoints of a kafka instance
+type KafkaCertificate struct {
+	api.Meta
+	KafkaId string
+	// Domains are the comma separated domains the certificate is issued for
+	Domains string
+	// Certificate is the PEM encoded certificate chain
+	Certificate string
+	// Key is the PEM encoded private key of the certificate, encrypted with the certificate encryption key
+	Key      string
+	NotAfter time.Time
+}
+
+type KafkaCertificateList []*KafkaCertificate
+
+func (c *KafkaCertificate) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == "" {
+		c.ID = api.NewID()
+	}
+	return nil
+}
+
+// GetDomains returns the domains the certificate is issued for
+func (c *KafkaCertificate) GetDomains() []string {
+	if c.Domains == "" {
+		return nil
+	}
+	return strings.Split(c.Domains, ",")
+}
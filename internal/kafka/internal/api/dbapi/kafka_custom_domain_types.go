@@ -0,0 +1,48 @@
+package dbapi
+
+import (
+	"strings"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+// KafkaCustomDomain is a domain of the customer a kafka instance is reachable at in addition to the kafka domain
+type KafkaCustomDomain struct {
+	api.Meta
+	KafkaId string
+	// Domain is the bootstrap server host of the kafka in the custom domain, the hosts of its routes are derived from it
+	// the same way they are derived from the bootstrap server host in the kafka domain
+	Domain string
+	// VerificationToken is the value of the TXT record proving the ownership of the domain
+	VerificationToken string
+	Status            string
+	VerifiedAt        *time.Time
+	// CertificateProvided is true when the certificate was provided by the customer instead of being issued
+	CertificateProvided bool
+	// Hosts are the comma separated hosts the certificate is issued for
+	Hosts string
+	// Certificate is the PEM encoded certificate chain
+	Certificate string
+	// Key is the PEM encoded private key of the certificate, encrypted with the certificate encryption key
+	Key      string
+	NotAfter *time.Time
+}
+
+type KafkaCustomDomainList []*KafkaCustomDomain
+
+func (d *KafkaCustomDomain) BeforeCreate(tx *gorm.DB) error {
+	if d.ID == "" {
+		d.ID = api.NewID()
+	}
+	return nil
+}
+
+// GetHosts returns the hosts the certificate is issued for
+func (d *KafkaCustomDomain) GetHosts() []string {
+	if d.Hosts == "" {
+		return nil
+	}
+	return strings.Split(d.Hosts, ",")
+}
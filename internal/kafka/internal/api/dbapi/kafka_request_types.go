@@ -51,6 +51,8 @@ type KafkaRequest struct {
 	Namespace               string `json:"namespace"`
 	ReauthenticationEnabled bool   `json:"reauthentication_enabled"`
 	RoutesCreationId        string `json:"routes_creation_id"`
+	// CustomBootstrapServerHost is the bootstrap server host in the custom domain of the kafka once the domain is ready
+	CustomBootstrapServerHost string `json:"custom_bootstrap_server_host"`
 }
 
 type KafkaList []*KafkaRequest
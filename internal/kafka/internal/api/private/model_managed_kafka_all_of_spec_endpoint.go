@@ -13,4 +13,6 @@ package private
 type ManagedKafkaAllOfSpecEndpoint struct {
 	BootstrapServerHost string                            `json:"bootstrapServerHost,omitempty"`
 	Tls                 *ManagedKafkaAllOfSpecEndpointTls `json:"tls,omitempty"`
+	// The custom domains the Kafka is also reachable at, the hosts of the routes in a custom domain are derived from its bootstrap server host
+	CustomDomains []ManagedKafkaAllOfSpecEndpointCustomDomains `json:"customDomains,omitempty"`
 }
@@ -0,0 +1,16 @@
+/*
+ * Kafka Service Fleet Manager
+ *
+ * Kafka Service Fleet Manager APIs that are used by internal services e.g kas-fleetshard operators.
+ *
+ * API version: 1.4.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ManagedKafkaAllOfSpecEndpointCustomDomains struct for ManagedKafkaAllOfSpecEndpointCustomDomains
+type ManagedKafkaAllOfSpecEndpointCustomDomains struct {
+	BootstrapServerHost string                            `json:"bootstrapServerHost,omitempty"`
+	Tls                 *ManagedKafkaAllOfSpecEndpointTls `json:"tls,omitempty"`
+}
@@ -0,0 +1,31 @@
+/*
+ * Kafka Service Fleet Manager
+ *
+ * Kafka Service Fleet Manager is a Rest API to manage Kafka instances.
+ *
+ * API version: 1.3.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+import (
+	"time"
+)
+
+// Alert An alert rule of a Kafka instance firing or resolving
+type Alert struct {
+	Id            string `json:"id,omitempty"`
+	Kind          string `json:"kind,omitempty"`
+	AlertRuleId   string `json:"alert_rule_id,omitempty"`
+	AlertRuleName string `json:"alert_rule_name,omitempty"`
+	State         string `json:"state,omitempty"`
+	// the value of the metric when the alert fired or resolved
+	Value     float64 `json:"value,omitempty"`
+	Threshold float64 `json:"threshold,omitempty"`
+	// when the notification target was notified, unset when the notification failed
+	NotifiedAt time.Time `json:"notified_at,omitempty"`
+	// the reason the notification failed
+	NotificationError string    `json:"notification_error,omitempty"`
+	CreatedAt         time.Time `json:"created_at,omitempty"`
+}
@@ -0,0 +1,46 @@
+/*
+ * Kafka Service Fleet Manager
+ *
+ * Kafka Service Fleet Manager is a Rest API to manage Kafka instances.
+ *
+ * API version: 1.3.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+import (
+	"time"
+)
+
+// AlertRule A rule on a metric of a Kafka instance its notification target is notified about when the condition holds
+type AlertRule struct {
+	Id   string `json:"id,omitempty"`
+	Kind string `json:"kind,omitempty"`
+	Href string `json:"href,omitempty"`
+	Name string `json:"name,omitempty"`
+	// the name of the metric the rule applies to
+	Metric string `json:"metric,omitempty"`
+	// the name of the metric the value of the metric is divided by
+	RelativeTo string `json:"relative_to,omitempty"`
+	// how the series of the metrics are combined into a single value
+	Aggregation string  `json:"aggregation,omitempty"`
+	Operator    string  `json:"operator,omitempty"`
+	Threshold   float64 `json:"threshold,omitempty"`
+	// how long the condition must hold before the alert fires
+	ForSeconds       int64  `json:"for_seconds,omitempty"`
+	NotificationType string `json:"notification_type,omitempty"`
+	// the email address or the https URL of the webhook notified
+	NotificationTarget string `json:"notification_target,omitempty"`
+	// the rule is pending while its condition holds for less than its duration, then firing until its condition no longer holds
+	State string `json:"state,omitempty"`
+	// when the condition started to hold
+	ActiveSince     time.Time `json:"active_since,omitempty"`
+	LastEvaluatedAt time.Time `json:"last_evaluated_at,omitempty"`
+	// the value of the last evaluation of the rule
+	LastValue float64 `json:"last_value,omitempty"`
+	// the reason the last evaluation of the rule failed
+	LastError string    `json:"last_error,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
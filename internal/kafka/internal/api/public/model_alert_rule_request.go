@@ -0,0 +1,28 @@
+/*
+ * Kafka Service Fleet Manager
+ *
+ * Kafka Service Fleet Manager is a Rest API to manage Kafka instances.
+ *
+ * API version: 1.3.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// AlertRuleRequest Schema for the request to create an alert rule
+type AlertRuleRequest struct {
+	Name string `json:"name"`
+	// the name of a metric of the Kafka instance
+	Metric string `json:"metric"`
+	// the name of a metric the value of the metric is divided by, e.g. to alert on a ratio of a limit
+	RelativeTo string `json:"relative_to,omitempty"`
+	// how the series of the metrics, e.g. one per broker, are combined into a single value, sum when omitted
+	Aggregation string  `json:"aggregation,omitempty"`
+	Operator    string  `json:"operator"`
+	Threshold   float64 `json:"threshold"`
+	// how long the condition must hold before the alert fires, it fires at the first evaluation where it holds when omitted
+	ForSeconds       int64  `json:"for_seconds,omitempty"`
+	NotificationType string `json:"notification_type"`
+	// the verified email address of the user creating the alert rule or the https URL of the webhook to notify
+	NotificationTarget string `json:"notification_target"`
+}
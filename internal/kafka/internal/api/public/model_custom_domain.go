@@ -0,0 +1,33 @@
+/*
+ * Kafka Service Fleet Manager
+ *
+ * Kafka Service Fleet Manager is a Rest API to manage Kafka instances.
+ *
+ * API version: 1.3.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+import (
+	"time"
+)
+
+// CustomDomain A domain of the customer a Kafka instance is reachable at
+type CustomDomain struct {
+	Id   string `json:"id,omitempty"`
+	Kind string `json:"kind,omitempty"`
+	Href string `json:"href,omitempty"`
+	// the bootstrap server host of the Kafka instance in the custom domain
+	Domain string `json:"domain,omitempty"`
+	// the custom domain is pending the verification of its ownership, then pending its certificate and then ready
+	Status string `json:"status,omitempty"`
+	// whether the certificate of the custom domain was provided instead of being issued
+	CertificateProvided bool `json:"certificate_provided,omitempty"`
+	// the DNS records to create in the custom domain
+	DnsRecords           []CustomDomainDNSRecord `json:"dns_records,omitempty"`
+	VerifiedAt           time.Time               `json:"verified_at,omitempty"`
+	CertificateExpiresAt time.Time               `json:"certificate_expires_at,omitempty"`
+	CreatedAt            time.Time               `json:"created_at,omitempty"`
+	UpdatedAt            time.Time               `json:"updated_at,omitempty"`
+}
@@ -0,0 +1,18 @@
+/*
+ * Kafka Service Fleet Manager
+ *
+ * Kafka Service Fleet Manager is a Rest API to manage Kafka instances.
+ *
+ * API version: 1.3.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// CustomDomainDNSRecord A DNS record to create in a custom domain
+type CustomDomainDNSRecord struct {
+	Name string `json:"name,omitempty"`
+	// TXT for the record verifying the ownership of the domain, CNAME for the records pointing the hosts of the custom domain to the Kafka instance
+	Type  string `json:"type,omitempty"`
+	Value string `json:"value,omitempty"`
+}
@@ -0,0 +1,20 @@
+/*
+ * Kafka Service Fleet Manager
+ *
+ * Kafka Service Fleet Manager is a Rest API to manage Kafka instances.
+ *
+ * API version: 1.3.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// CustomDomainRequest Schema for the request to register a custom domain
+type CustomDomainRequest struct {
+	// the bootstrap server host of the Kafka instance in the custom domain, the hosts of its other routes are derived from it
+	Domain string `json:"domain"`
+	// PEM encoded certificate chain covering the hosts of the Kafka instance in the custom domain, a certificate is issued when omitted
+	Certificate string `json:"certificate,omitempty"`
+	// PEM encoded private key of the certificate
+	PrivateKey string `json:"private_key,omitempty"`
+}
@@ -24,15 +24,17 @@ type KafkaRequest struct {
 	CloudProvider string `json:"cloud_provider,omitempty"`
 	MultiAz       bool   `json:"multi_az"`
 	// Values will be regions of specific cloud provider. For example: us-east-1 for AWS
-	Region                  string    `json:"region,omitempty"`
-	Owner                   string    `json:"owner,omitempty"`
-	Name                    string    `json:"name,omitempty"`
-	BootstrapServerHost     string    `json:"bootstrap_server_host,omitempty"`
-	CreatedAt               time.Time `json:"created_at,omitempty"`
-	UpdatedAt               time.Time `json:"updated_at,omitempty"`
-	FailedReason            string    `json:"failed_reason,omitempty"`
-	Version                 string    `json:"version,omitempty"`
-	InstanceType            string    `json:"instance_type,omitempty"`
-	ReauthenticationEnabled bool      `json:"reauthentication_enabled"`
-	KafkaStorageSize        string    `json:"kafka_storage_size,omitempty"`
+	Region              string `json:"region,omitempty"`
+	Owner               string `json:"owner,omitempty"`
+	Name                string `json:"name,omitempty"`
+	BootstrapServerHost string `json:"bootstrap_server_host,omitempty"`
+	// the bootstrap server host of the Kafka instance in its custom domain, once the custom domain is ready
+	CustomBootstrapServerHost string    `json:"custom_bootstrap_server_host,omitempty"`
+	CreatedAt                 time.Time `json:"created_at,omitempty"`
+	UpdatedAt                 time.Time `json:"updated_at,omitempty"`
+	FailedReason              string    `json:"failed_reason,omitempty"`
+	Version                   string    `json:"version,omitempty"`
+	InstanceType              string    `json:"instance_type,omitempty"`
+	ReauthenticationEnabled   bool      `json:"reauthentication_enabled"`
+	KafkaStorageSize          string    `json:"kafka_storage_size,omitempty"`
 }
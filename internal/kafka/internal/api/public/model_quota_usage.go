@@ -0,0 +1,24 @@
+/*
+ * Kafka Service Fleet Manager
+ *
+ * Kafka Service Fleet Manager is a Rest API to manage Kafka instances.
+ *
+ * API version: 1.3.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// QuotaUsage struct for QuotaUsage
+type QuotaUsage struct {
+	// The resource of the quota, a kafka instance type or connectors. Values: [standard, eval, connectors]
+	Resource string `json:"resource,omitempty"`
+	// Capacity units of the resource the organisation is allowed to consume
+	Allowed int32 `json:"allowed,omitempty"`
+	// Capacity units of the resource consumed by the organisation
+	Consumed int32 `json:"consumed,omitempty"`
+	// Capacity units of the resource the organisation can still consume
+	Remaining int32 `json:"remaining,omitempty"`
+	// Whether the organisation has no quota for the resource, the allowed and remaining units are 0 then
+	Unlimited bool `json:"unlimited,omitempty"`
+}
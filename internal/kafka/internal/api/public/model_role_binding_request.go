@@ -0,0 +1,18 @@
+/*
+ * Kafka Service Fleet Manager
+ *
+ * Kafka Service Fleet Manager is a Rest API to manage Kafka instances.
+ *
+ * API version: 1.3.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// RoleBindingRequest Schema for the request to grant a user a role
+type RoleBindingRequest struct {
+	// username of the user the role is granted to, the user must be a member of the organisation owning the resource
+	Subject string `json:"subject"`
+	// viewers can see the resource, editors can also update it and admins can also delete it and grant roles on it
+	Role string `json:"role"`
+}
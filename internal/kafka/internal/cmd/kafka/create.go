@@ -1,6 +1,7 @@
 package kafka
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
@@ -54,7 +55,7 @@ func runCreate(env *environments.Env, cmd *cobra.Command, _ []string) {
 		OrganisationId: orgId,
 	}
 
-	if err := kafkaService.RegisterKafkaJob(kafkaRequest); err != nil {
+	if err := kafkaService.RegisterKafkaJob(context.Background(), kafkaRequest); err != nil {
 		glog.Fatalf("Unable to create kafka request: %s", err.Error())
 	}
 	indentedKafkaRequest, err := json.MarshalIndent(kafkaRequest, "", "    ")
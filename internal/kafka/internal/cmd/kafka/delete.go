@@ -9,6 +9,7 @@ import (
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/golang/glog"
 	"github.com/spf13/cobra"
+	"time"
 )
 
 // NewDeleteCommand command for deleting kafkas.
@@ -39,7 +40,7 @@ func runDelete(env *environments.Env, cmd *cobra.Command, _ []string) {
 	})
 	ctx := auth.SetTokenInContext(context.TODO(), jwt)
 
-	if err := kafkaService.RegisterKafkaDeprovisionJob(ctx, id); err != nil {
+	if err := kafkaService.RegisterKafkaDeprovisionJob(ctx, id, time.Time{}); err != nil {
 		glog.Fatalf("Unable to register the deprovisioning request: %s", err.Error())
 	} else {
 		glog.V(10).Infof("Deprovisioning request accepted for kafka cluster with id %s", id)
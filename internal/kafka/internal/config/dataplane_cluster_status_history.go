@@ -0,0 +1,52 @@
+package config
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+)
+
+// DataplaneClusterStatusHistoryConfig configures the history of the status reports of the data plane clusters and
+// the detection of the clusters whose compute nodes keep being scaled up and down
+type DataplaneClusterStatusHistoryConfig struct {
+	// Retention is how long the status reports are kept, the reports are not recorded when zero
+	Retention time.Duration `json:"retention"`
+	// FlappingWindow is the period the changes of direction of the scaling of a cluster are counted over
+	FlappingWindow time.Duration `json:"flapping_window"`
+	// FlappingThreshold is the number of changes of direction of the scaling within the flapping window from which a
+	// cluster is flapping
+	FlappingThreshold int `json:"flapping_threshold"`
+}
+
+func NewDataplaneClusterStatusHistoryConfig() *DataplaneClusterStatusHistoryConfig {
+	return &DataplaneClusterStatusHistoryConfig{
+		Retention:         7 * 24 * time.Hour,
+		FlappingWindow:    6 * time.Hour,
+		FlappingThreshold: 3,
+	}
+}
+
+func (c *DataplaneClusterStatusHistoryConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&c.Retention, "dataplane-cluster-status-history-retention", c.Retention, "How long the status reports of the data plane clusters are kept, 0 disables the history")
+	fs.DurationVar(&c.FlappingWindow, "dataplane-cluster-flapping-window", c.FlappingWindow, "The period the changes of direction of the scaling of the compute nodes of a data plane cluster are counted over")
+	fs.IntVar(&c.FlappingThreshold, "dataplane-cluster-flapping-threshold", c.FlappingThreshold, "The number of changes of direction of the scaling of the compute nodes within the flapping window from which a data plane cluster is flapping")
+}
+
+func (c *DataplaneClusterStatusHistoryConfig) ReadFiles() error {
+	if !c.IsHistoryEnabled() {
+		return nil
+	}
+	if c.FlappingThreshold < 1 {
+		return errors.Errorf("the data plane cluster flapping threshold must be at least 1, not %d", c.FlappingThreshold)
+	}
+	if c.FlappingWindow > c.Retention {
+		return errors.Errorf("the data plane cluster flapping window (%s) must not be longer than the status history retention (%s)", c.FlappingWindow, c.Retention)
+	}
+	return nil
+}
+
+// IsHistoryEnabled returns true when the status reports of the data plane clusters are recorded
+func (c *DataplaneClusterStatusHistoryConfig) IsHistoryEnabled() bool {
+	return c.Retention > 0
+}
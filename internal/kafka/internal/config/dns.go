@@ -0,0 +1,66 @@
+package config
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/dns"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/spf13/pflag"
+)
+
+// DNSConfig selects the DNS provider managing the records of the kafka domain. The Route53 credentials are part of the
+// AWSConfig.
+type DNSConfig struct {
+	Provider string `json:"provider"`
+
+	CloudDNSProject         string `json:"cloud_dns_project"`
+	CloudDNSManagedZone     string `json:"cloud_dns_managed_zone"`
+	CloudDNSCredentials     string `json:"cloud_dns_credentials"`
+	CloudDNSCredentialsFile string `json:"cloud_dns_credentials_file"`
+
+	RFC2136Server         string `json:"rfc2136_server"`
+	RFC2136Zone           string `json:"rfc2136_zone"`
+	RFC2136TSIGKeyName    string `json:"rfc2136_tsig_key_name"`
+	RFC2136TSIGAlgorithm  string `json:"rfc2136_tsig_algorithm"`
+	RFC2136TSIGSecret     string `json:"rfc2136_tsig_secret"`
+	RFC2136TSIGSecretFile string `json:"rfc2136_tsig_secret_file"`
+
+	ExternalDNSKubeconfig string `json:"external_dns_kubeconfig"`
+	ExternalDNSNamespace  string `json:"external_dns_namespace"`
+}
+
+func NewDNSConfig() *DNSConfig {
+	return &DNSConfig{
+		Provider:                dns.ProviderRoute53,
+		CloudDNSCredentialsFile: "secrets/clouddns.credentials.json",
+		RFC2136TSIGAlgorithm:    dns.TSIGAlgorithmHMACSHA256,
+		RFC2136TSIGSecretFile:   "secrets/rfc2136.tsig.secret",
+		ExternalDNSNamespace:    "kas-fleet-manager",
+	}
+}
+
+func (c *DNSConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.Provider, "dns-provider", c.Provider, "The DNS provider managing the records of the kafka domain (options: route53, clouddns, rfc2136, external-dns)")
+	fs.StringVar(&c.CloudDNSProject, "dns-cloud-dns-project", c.CloudDNSProject, "The GCP project of the Cloud DNS managed zone")
+	fs.StringVar(&c.CloudDNSManagedZone, "dns-cloud-dns-managed-zone", c.CloudDNSManagedZone, "The name of the Cloud DNS managed zone of the kafka domain")
+	fs.StringVar(&c.CloudDNSCredentialsFile, "dns-cloud-dns-credentials-file", c.CloudDNSCredentialsFile, "File containing the JSON key of the GCP service account managing the Cloud DNS records")
+	fs.StringVar(&c.RFC2136Server, "dns-rfc2136-server", c.RFC2136Server, "The address of the DNS server accepting the dynamic updates, e.g. ns1.example.com:53")
+	fs.StringVar(&c.RFC2136Zone, "dns-rfc2136-zone", c.RFC2136Zone, "The zone the records are updated in, defaults to the kafka domain")
+	fs.StringVar(&c.RFC2136TSIGKeyName, "dns-rfc2136-tsig-key-name", c.RFC2136TSIGKeyName, "The name of the TSIG key signing the dynamic updates, the updates are not signed if empty")
+	fs.StringVar(&c.RFC2136TSIGAlgorithm, "dns-rfc2136-tsig-algorithm", c.RFC2136TSIGAlgorithm, "The algorithm of the TSIG key (options: hmac-sha256, hmac-sha512)")
+	fs.StringVar(&c.RFC2136TSIGSecretFile, "dns-rfc2136-tsig-secret-file", c.RFC2136TSIGSecretFile, "File containing the base64 encoded secret of the TSIG key")
+	fs.StringVar(&c.ExternalDNSKubeconfig, "dns-external-dns-kubeconfig", c.ExternalDNSKubeconfig, "The kubeconfig of the cluster external-dns watches, the in-cluster configuration is used if empty")
+	fs.StringVar(&c.ExternalDNSNamespace, "dns-external-dns-namespace", c.ExternalDNSNamespace, "The namespace the external-dns DNSEndpoint resources are created in")
+}
+
+// ReadFiles only reads the secrets of the selected provider
+func (c *DNSConfig) ReadFiles() error {
+	switch c.Provider {
+	case dns.ProviderCloudDNS:
+		return shared.ReadFileValueString(c.CloudDNSCredentialsFile, &c.CloudDNSCredentials)
+	case dns.ProviderRFC2136:
+		if c.RFC2136TSIGKeyName == "" {
+			return nil
+		}
+		return shared.ReadFileValueString(c.RFC2136TSIGSecretFile, &c.RFC2136TSIGSecret)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package config
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+)
+
+// KafkaAlertingConfig configures the alert rules the users define on the metrics of their kafka instances and how
+// their owners are notified when the alerts fire
+type KafkaAlertingConfig struct {
+	EnableAlerting bool `json:"enable_alerting"`
+	// EvaluationInterval is how often the alert rules are evaluated against the latest metrics of the kafkas
+	EvaluationInterval time.Duration `json:"evaluation_interval"`
+	MaxRulesPerKafka   int           `json:"max_rules_per_kafka"`
+	// HistoryRetention is how long the fired and resolved alerts are kept
+	HistoryRetention time.Duration `json:"history_retention"`
+	SMTPHost         string        `json:"smtp_host"`
+	SMTPPort         int           `json:"smtp_port"`
+	SMTPUsername     string        `json:"smtp_username"`
+	SMTPPassword     string        `json:"-"`
+	SMTPPasswordFile string        `json:"smtp_password_file"`
+	// SMTPFrom is the sender address of the email notifications
+	SMTPFrom       string        `json:"smtp_from"`
+	WebhookTimeout time.Duration `json:"webhook_timeout"`
+	// WebhookAllowHTTP allows webhook targets to be plain http URLs, they must be https otherwise
+	WebhookAllowHTTP bool `json:"webhook_allow_http"`
+	// WebhookAllowPrivateNetworks allows webhook targets resolving to loopback, private and link-local addresses
+	WebhookAllowPrivateNetworks bool `json:"webhook_allow_private_networks"`
+}
+
+func NewKafkaAlertingConfig() *KafkaAlertingConfig {
+	return &KafkaAlertingConfig{
+		EvaluationInterval: time.Minute,
+		MaxRulesPerKafka:   20,
+		HistoryRetention:   30 * 24 * time.Hour,
+		SMTPPort:           587,
+		SMTPPasswordFile:   "secrets/smtp.password",
+		WebhookTimeout:     10 * time.Second,
+	}
+}
+
+func (c *KafkaAlertingConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&c.EnableAlerting, "enable-kafka-alerting", c.EnableAlerting, "Enable the alert rules on the metrics of the kafka instances and the notifications of their owners")
+	fs.DurationVar(&c.EvaluationInterval, "kafka-alerting-evaluation-interval", c.EvaluationInterval, "How often the kafka alert rules are evaluated")
+	fs.IntVar(&c.MaxRulesPerKafka, "kafka-alerting-max-rules-per-kafka", c.MaxRulesPerKafka, "The maximum number of alert rules of a kafka instance")
+	fs.DurationVar(&c.HistoryRetention, "kafka-alerting-history-retention", c.HistoryRetention, "How long the fired and resolved kafka alerts are kept")
+	fs.StringVar(&c.SMTPHost, "kafka-alerting-smtp-host", c.SMTPHost, "The host of the SMTP server the email notifications are sent with, email notifications are disabled if empty")
+	fs.IntVar(&c.SMTPPort, "kafka-alerting-smtp-port", c.SMTPPort, "The port of the SMTP server")
+	fs.StringVar(&c.SMTPUsername, "kafka-alerting-smtp-username", c.SMTPUsername, "The username authenticating to the SMTP server, no authentication if empty")
+	fs.StringVar(&c.SMTPPasswordFile, "kafka-alerting-smtp-password-file", c.SMTPPasswordFile, "File containing the password authenticating to the SMTP server")
+	fs.StringVar(&c.SMTPFrom, "kafka-alerting-smtp-from", c.SMTPFrom, "The sender address of the email notifications")
+	fs.DurationVar(&c.WebhookTimeout, "kafka-alerting-webhook-timeout", c.WebhookTimeout, "The timeout of the webhook notifications")
+	fs.BoolVar(&c.WebhookAllowHTTP, "kafka-alerting-webhook-allow-http", c.WebhookAllowHTTP, "Allow plain http webhook targets, for testing only")
+	fs.BoolVar(&c.WebhookAllowPrivateNetworks, "kafka-alerting-webhook-allow-private-networks", c.WebhookAllowPrivateNetworks, "Allow webhook targets resolving to loopback, private and link-local addresses, for testing only")
+}
+
+// ReadFiles only reads the SMTP password when the alerting is enabled and authenticates to an SMTP server
+func (c *KafkaAlertingConfig) ReadFiles() error {
+	if !c.EnableAlerting {
+		return nil
+	}
+	if c.EvaluationInterval <= 0 {
+		return errors.Errorf("the kafka alerting evaluation interval must be positive, not %s", c.EvaluationInterval)
+	}
+	if c.IsEmailEnabled() && c.SMTPFrom == "" {
+		return errors.New("the sender address of the kafka alert emails must be set with --kafka-alerting-smtp-from")
+	}
+	if c.IsEmailEnabled() && c.SMTPUsername != "" {
+		return shared.ReadFileValueString(c.SMTPPasswordFile, &c.SMTPPassword)
+	}
+	return nil
+}
+
+// IsEmailEnabled returns true when the alerts can be notified by email
+func (c *KafkaAlertingConfig) IsEmailEnabled() bool {
+	return c.SMTPHost != ""
+}
@@ -0,0 +1,69 @@
+package config
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+)
+
+// KafkaAvailabilityConfig configures the sampling of the availability of the kafka instances and the service level
+// objective their availability is measured against
+type KafkaAvailabilityConfig struct {
+	// SampleInterval is how often the availability of the kafkas is sampled, the sampling is disabled when zero
+	SampleInterval time.Duration `json:"sample_interval"`
+	// MaxSampleGap is the longest time a sample accounts for since the previous one, the time the sampling stopped for
+	// beyond it, e.g. while no instance was the leader, is not sampled and so does not count towards the availability
+	MaxSampleGap time.Duration `json:"max_sample_gap"`
+	// SLOTarget is the ratio of time a kafka is expected to be available over the SLO window
+	SLOTarget float64 `json:"slo_target"`
+	// SLOWindow is the rolling window the availability and the error budget are computed over
+	SLOWindow time.Duration `json:"slo_window"`
+	// BurnRateWindow is the window the rate the error budget is consumed at is computed over
+	BurnRateWindow time.Duration `json:"burn_rate_window"`
+	// Retention is how long the availability samples are kept
+	Retention time.Duration `json:"retention"`
+	// ReadinessReportMaxAge is how old a readiness reported by the data plane may be to be sampled, observatorium is
+	// queried for the state of the kafka otherwise
+	ReadinessReportMaxAge time.Duration `json:"readiness_report_max_age"`
+}
+
+func NewKafkaAvailabilityConfig() *KafkaAvailabilityConfig {
+	return &KafkaAvailabilityConfig{
+		SampleInterval:        5 * time.Minute,
+		MaxSampleGap:          15 * time.Minute,
+		SLOTarget:             0.995,
+		SLOWindow:             30 * 24 * time.Hour,
+		BurnRateWindow:        time.Hour,
+		Retention:             90 * 24 * time.Hour,
+		ReadinessReportMaxAge: 5 * time.Minute,
+	}
+}
+
+func (c *KafkaAvailabilityConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&c.SampleInterval, "kafka-availability-sample-interval", c.SampleInterval, "How often the availability of the kafka instances is sampled, 0 disables the sampling")
+	fs.DurationVar(&c.MaxSampleGap, "kafka-availability-max-sample-gap", c.MaxSampleGap, "The longest time since the previous samples the availability samples of the kafka instances account for, at least the sample interval")
+	fs.Float64Var(&c.SLOTarget, "kafka-availability-slo-target", c.SLOTarget, "The ratio of time a kafka instance is expected to be available over the SLO window")
+	fs.DurationVar(&c.SLOWindow, "kafka-availability-slo-window", c.SLOWindow, "The rolling window the availability and the error budget of the kafka instances are computed over")
+	fs.DurationVar(&c.BurnRateWindow, "kafka-availability-burn-rate-window", c.BurnRateWindow, "The window the error budget burn rate of the kafka instances is computed over")
+	fs.DurationVar(&c.Retention, "kafka-availability-retention", c.Retention, "How long the availability samples of the kafka instances are kept")
+	fs.DurationVar(&c.ReadinessReportMaxAge, "kafka-availability-readiness-report-max-age", c.ReadinessReportMaxAge, "How old a readiness reported by the data plane may be to be sampled before observatorium is queried instead")
+}
+
+func (c *KafkaAvailabilityConfig) ReadFiles() error {
+	if c.SLOTarget <= 0 || c.SLOTarget >= 1 {
+		return errors.Errorf("the kafka availability SLO target must be between 0 and 1, not %v", c.SLOTarget)
+	}
+	if c.IsSamplingEnabled() && c.MaxSampleGap < c.SampleInterval {
+		return errors.Errorf("the kafka availability max sample gap (%s) must not be shorter than the sample interval (%s)", c.MaxSampleGap, c.SampleInterval)
+	}
+	if c.Retention < c.SLOWindow {
+		return errors.Errorf("the kafka availability retention (%s) must not be shorter than the SLO window (%s)", c.Retention, c.SLOWindow)
+	}
+	return nil
+}
+
+// IsSamplingEnabled returns true when the availability of the kafkas is sampled
+func (c *KafkaAvailabilityConfig) IsSamplingEnabled() bool {
+	return c.SampleInterval > 0
+}
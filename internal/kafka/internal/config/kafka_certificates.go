@@ -0,0 +1,100 @@
+package config
+
+import (
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+)
+
+// KafkaCertificateConfig configures the issuance of a TLS certificate per kafka instance with an ACME certificate
+// authority, in place of the certificate shared by all the instances, and the custom domains of the instances
+type KafkaCertificateConfig struct {
+	EnableACMECertificates bool `json:"enable_acme_certificates"`
+	// EnableCustomDomains allows the kafka instances to be reachable at domains of the customers, with a certificate
+	// provided by the customer or issued by the ACME certificate authority
+	EnableCustomDomains bool   `json:"enable_custom_domains"`
+	ACMEDirectoryURL    string `json:"acme_directory_url"`
+	ACMEAccountEmail    string `json:"acme_account_email"`
+	ACMEAccountKey      string `json:"acme_account_key"`
+	ACMEAccountKeyFile  string `json:"acme_account_key_file"`
+	ACMECABundle        string `json:"acme_ca_bundle"`
+	ACMECABundleFile    string `json:"acme_ca_bundle_file"`
+	// ACMEPropagationTimeout is how long the DNS provider is waited for to publish the DNS-01 challenge records
+	ACMEPropagationTimeout  time.Duration `json:"acme_propagation_timeout"`
+	ACMEPropagationInterval time.Duration `json:"acme_propagation_interval"`
+	// MaxIssuancesPerReconcile is the number of certificates issued by a reconciliation, the other kafkas are left to the
+	// following ones so that the issuances don't block the worker
+	MaxIssuancesPerReconcile int `json:"max_issuances_per_reconcile"`
+	// CustomDomainReverifyInterval is how often the ownership of the ready custom domains is verified again
+	CustomDomainReverifyInterval time.Duration `json:"custom_domain_reverify_interval"`
+	// CustomDomainRevokeAfter is how long a ready custom domain whose ownership can't be verified is kept
+	CustomDomainRevokeAfter time.Duration `json:"custom_domain_revoke_after"`
+	// RenewBefore is how long before their expiry the certificates are renewed
+	RenewBefore time.Duration `json:"renew_before"`
+	// EncryptionKey is the AES key the private keys of the certificates are encrypted with in the database
+	EncryptionKey     []byte `json:"-"`
+	EncryptionKeyFile string `json:"encryption_key_file"`
+}
+
+func NewKafkaCertificateConfig() *KafkaCertificateConfig {
+	return &KafkaCertificateConfig{
+		ACMEDirectoryURL:             "https://acme-v02.api.letsencrypt.org/directory",
+		ACMEPropagationTimeout:       10 * time.Minute,
+		ACMEPropagationInterval:      10 * time.Second,
+		MaxIssuancesPerReconcile:     3,
+		CustomDomainReverifyInterval: 24 * time.Hour,
+		CustomDomainRevokeAfter:      72 * time.Hour,
+		RenewBefore:                  30 * 24 * time.Hour,
+		EncryptionKeyFile:            "secrets/kafka-certificate-encryption.key",
+	}
+}
+
+func (c *KafkaCertificateConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&c.EnableACMECertificates, "enable-kafka-acme-certificates", c.EnableACMECertificates, "Enable the issuance of a TLS certificate per kafka instance with an ACME certificate authority")
+	fs.BoolVar(&c.EnableCustomDomains, "enable-kafka-custom-domains", c.EnableCustomDomains, "Enable the registration of custom domains the kafka instances are reachable at in addition to the kafka domain")
+	fs.StringVar(&c.ACMEDirectoryURL, "kafka-acme-directory-url", c.ACMEDirectoryURL, "The directory URL of the ACME certificate authority")
+	fs.StringVar(&c.ACMEAccountEmail, "kafka-acme-account-email", c.ACMEAccountEmail, "The contact email of the ACME account")
+	fs.StringVar(&c.ACMEAccountKeyFile, "kafka-acme-account-key-file", c.ACMEAccountKeyFile, "File containing the PEM encoded private key of the ACME account, a new account is registered at every start if empty")
+	fs.StringVar(&c.ACMECABundleFile, "kafka-acme-ca-bundle-file", c.ACMECABundleFile, "File containing PEM encoded certificates trusted to reach the ACME directory, e.g. the certificate of a Pebble test server")
+	fs.DurationVar(&c.ACMEPropagationTimeout, "kafka-acme-propagation-timeout", c.ACMEPropagationTimeout, "How long the DNS provider is waited for to publish the DNS-01 challenge records")
+	fs.DurationVar(&c.ACMEPropagationInterval, "kafka-acme-propagation-interval", c.ACMEPropagationInterval, "The interval the changes of the DNS-01 challenge records are polled at")
+	fs.IntVar(&c.MaxIssuancesPerReconcile, "kafka-acme-max-issuances-per-reconcile", c.MaxIssuancesPerReconcile, "The number of kafka certificates issued by a reconciliation of the certificate worker, the other kafkas wait for the following reconciliations")
+	fs.DurationVar(&c.CustomDomainReverifyInterval, "kafka-custom-domain-reverify-interval", c.CustomDomainReverifyInterval, "How often the ownership of the ready custom domains is verified again")
+	fs.DurationVar(&c.CustomDomainRevokeAfter, "kafka-custom-domain-revoke-after", c.CustomDomainRevokeAfter, "How long after its last successful verification a ready custom domain is revoked, the kafka is no longer reachable at it")
+	fs.DurationVar(&c.RenewBefore, "kafka-certificate-renew-before", c.RenewBefore, "How long before their expiry the kafka certificates are renewed")
+	fs.StringVar(&c.EncryptionKeyFile, "kafka-certificate-encryption-key-file", c.EncryptionKeyFile, "File containing the base64 encoded AES key (16, 24 or 32 bytes) the private keys of the kafka certificates are encrypted with")
+}
+
+// ReadFiles only reads the files when the ACME certificates or the custom domains are enabled
+func (c *KafkaCertificateConfig) ReadFiles() error {
+	if !c.EnableACMECertificates && !c.EnableCustomDomains {
+		return nil
+	}
+	if c.EnableACMECertificates {
+		if err := shared.ReadFileValueString(c.ACMEAccountKeyFile, &c.ACMEAccountKey); err != nil {
+			return err
+		}
+		if err := shared.ReadFileValueString(c.ACMECABundleFile, &c.ACMECABundle); err != nil {
+			return err
+		}
+	}
+	var encryptionKey string
+	if err := shared.ReadFileValueString(c.EncryptionKeyFile, &encryptionKey); err != nil {
+		return err
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encryptionKey))
+	if err != nil {
+		return errors.Wrap(err, "unable to decode the kafka certificate encryption key")
+	}
+	switch len(key) {
+	case 16, 24, 32:
+	default:
+		return errors.Errorf("the kafka certificate encryption key must be 16, 24 or 32 bytes long, not %d", len(key))
+	}
+	c.EncryptionKey = key
+	return nil
+}
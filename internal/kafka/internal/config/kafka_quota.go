@@ -5,11 +5,25 @@ import "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 type KafkaQuotaConfig struct {
 	Type                   string `json:"type"`
 	AllowEvaluatorInstance bool   `json:"allow_evaluator_instance"`
+	// InstanceTypeUnits are the capacity units of the organisation quotas consumed by a kafka of each instance type
+	InstanceTypeUnits map[string]int `json:"instance_type_units"`
 }
 
 func NewKafkaQuotaConfig() *KafkaQuotaConfig {
 	return &KafkaQuotaConfig{
 		Type:                   api.QuotaManagementListQuotaType.String(),
 		AllowEvaluatorInstance: true,
+		InstanceTypeUnits: map[string]int{
+			"standard": 1,
+			"eval":     1,
+		},
 	}
 }
+
+// GetInstanceTypeUnits returns the capacity units consumed by a kafka of the instance type, 1 if undefined
+func (c *KafkaQuotaConfig) GetInstanceTypeUnits(instanceType string) int {
+	if units, ok := c.InstanceTypeUnits[instanceType]; ok {
+		return units
+	}
+	return 1
+}
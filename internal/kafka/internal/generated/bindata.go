@@ -78,7 +78,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _kasFleetManagerYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x7b\x73\xdb\xb6\xb2\xf8\xff\xf9\x14\xfb\x53\x7e\x67\x74\x4e\xaf\x25\x4b\xb2\xfc\x88\xe6\xf6\xce\x38\x8e\x93\xba\x4d\x9c\xc4\x8f\xa6\x69\xa7\x23\x43\x24\x24\xc1\x26\x01\x1a\x80\x6c\x2b\xe7\x9e\xef\x7e\x07\x0f\x92\xe0\x53\x92\xed\x24\x76\x6b\x9f\x39\xd3\x88\x04\xc0\x7d\x61\xb1\x58\xec\x2e\x58\x84\x29\x8a\xc8\x00\x36\xda\x9d\x76\x07\x9e\x03\xc5\xd8\x07\x39\x25\x02\x90\x80\x31\xe1\x42\x42\x40\x28\x06\xc9\x00\x05\x01\xbb\x06\xc1\x42\x0c\x07\xaf\xf6\x85\x7a\x74\x41\xd9\xb5\x69\xad\x3a\x50\xb0\xc3\x81\xcf\xbc\x59\x88\xa9\x6c\x3f\x7b\x0e\xbb\x41\x00\x98\xfa\x11\x23\x54\x0a\xf0\xf1\x98\x50\xec\xc3\x14\x73\x0c\xd7\x24\x08\x60\x84\xc1\x27\xc2\x63\x57\x98\xa3\x51\x80\x61\x34\x57\x5f\x82\x99\xc0\x5c\xb4\xe1\x60\x0c\x52\xb7\x55\x1f\xb0\xd0\x31\xb8\xc0\x38\x32\x90\xa4\x23\x37\x22\x4e\xae\x90\xc4\x8d\x35\x40\xbe\xc2\x01\x87\xaa\xa9\x9c\x62\x68\x84\x88\xa2\x09\xf6\x5b\x02\xf3\x2b\xe2\x61\xd1\x42\x11\x69\xd9\xf6\xed\x39\x0a\x83\x06\x8c\x49\x80\x9f\x11\x3a\x66\x83\x67\x00\x92\xc8\x00\x0f\xe0\x17\x34\xbe\x40\x70\x6c\x3a\xc1\xeb\x00\x63\x09\xef\xf4\x50\xfc\x19\xc0\x15\xe6\x82\x30\x3a\x80\x6e\x7b\xa3\xdd\x79\x06\xe0\x63\xe1\x71\x12\x49\xfd\xb0\xa6\xaf\xc1\xe5\x08\x0b\x09\xbb\x1f\x0e\x14\x90\x06\x3e\xdb\x87\x50\x21\x11\xf5\xb0\x68\x3f\x53\xf0\x62\x2e\x14\x48\x2d\x98\xf1\x60\x00\x53\x29\x23\x31\x58\x5f\x47\x11\x69\x2b\x6a\x8b\x29\x19\xcb\xb6\xc7\xc2\x67\x00\x39\x08\xde\x21\x42\xe1\x9f\x11\x67\xfe\xcc\x53\x4f\xfe\x05\x66\xb8\xf2\xc1\x84\x44\x13\xbc\x68\xc8\x63\x89\x26\x84\x4e\x4a\x07\x1a\xac\xaf\x07\xcc\x43\xc1\x94\x09\x39\xd8\xe9\x74\x3a\xc5\xee\xc9\xfb\xb4\xe7\x7a\xb1\x95\x37\xe3\x1c\x53\x09\x3e\x0b\x11\xa1\xcf\x22\x24\xa7\x9a\x02\x0a\xcc\xf5\x0b\x45\x22\x31\x0c\x27\xa1\x5c\xbf\xea\x0e\x74\xef\x09\x96\xe6\x1f\xa0\x04\x90\x23\x35\xcc\x81\x3f\x50\xcf\x7f\x35\x3c\x7a\x87\x25\xf2\x91\x44\xb6\x15\xc7\x22\x62\x54\x60\x11\x77\x03\x68\xf4\x3a\x9d\x46\xfa\x13\xc0\x63\x54\x62\x2a\xdd\x47\x00\x28\x8a\x02\xe2\xe9\x0f\xac\x9f\x0b\x46\xb3\x6f\x01\x84\x37\xc5\x21\xca\x3f\x05\xf8\xff\x1c\x8f\x07\xd0\x7c\xbe\xee\xb1\x30\x62\x14\x53\x29\xd6\x4d\x5b\xb1\x9e\x03\xb1\xe9\x74\xce\x90\xc5\xb6\x83\x30\x8b\x8b\x98\x85\x21\xe2\xf3\x01\x1c\x61\x39\xe3\x54\x68\x81\xbf\xca\xb7\x2d\x27\xdf\x3a\xe6\x9c\x71\xb1\xfe\x6f\xe2\xff\x67\x21\x29\xf7\x55\xdb\x97\xf3\x03\xff\x21\x12\x51\x03\x57\x49\xba\x37\x58\x82\x46\x55\x29\x97\x04\x81\x52\xca\x25\xcd\x48\xdc\x4c\xa2\x89\x83\x62\xcb\xb4\x10\xf6\x41\x84\x38\x0a\xb1\xb4\x73\x34\x6e\x62\x20\x6d\x64\x20\x4d\x5b\xae\x13\xbf\x51\xcf\x90\xe5\x78\x21\x1e\x2c\x23\xde\x12\x21\x2b\x99\xa1\x5e\x02\x1b\x43\xc4\x84\x20\x4a\xe1\x67\x08\x5a\xca\x94\x20\xdf\x45\xa9\xcd\x4c\xb7\x0a\x26\x55\x50\xd9\xfc\x5c\x4e\xec\xb5\x4e\x7e\xa8\x62\xaf\x81\x3b\xc2\x97\x33\x9c\x25\xb8\xfa\xc3\x37\x28\x8c\x02\x17\xce\xf8\xcf\xed\xf5\x06\xcb\x23\x8b\xd1\xbe\xe9\x50\x6c\x5f\x0e\x43\x3c\x7e\x06\x08\x3b\x46\x1e\x96\xca\x6f\x7e\x22\x72\xfa\x1a\x91\x00\xfb\x7b\x1c\x6b\xda\x1c\x4b\x24\x67\xe2\x3e\x60\xa9\x19\xb7\x52\x38\xcd\x0a\xcc\xcd\x00\x30\x66\x33\xea\x6b\x9d\xf1\x2a\x65\x76\xbf\xd3\x7d\x20\x3a\xae\x9e\xcb\xfd\x4e\xf7\xb6\x54\x4c\xbb\x56\x12\x6a\x77\x26\xa7\x20\xd9\x05\xa6\xca\x9a\x21\xf4\x0a\x05\x89\xc6\xd4\x44\xda\x78\x24\x44\xda\xb8\x3d\x91\x36\x16\x11\xe9\x54\x60\x0e\x94\x49\x40\x33\x39\x65\x9c\x7c\x31\xd6\x2b\xf2\x3c\x2c\x8c\x66\xb3\x06\xa9\x4b\xb8\xfe\x23\x21\x5c\xff\xf6\x84\xeb\x2f\x22\xdc\x21\xcb\xcd\xc4\x6b\x22\xa7\x20\x22\xec\x91\x31\xc1\x3e\x1c\xbc\x02\x7c\x43\x84\x14\x29\xe1\x36\x1f\x8c\xe9\x51\x4f\xb8\xcd\x4e\xe7\xb6\x84\x4b\xbb\x56\x4b\x1c\xc5\x37\x11\xf6\x24\xf6\xad\x25\xc3\x3c\x6d\x4e\x27\x36\x0f\xf6\x66\x9c\xc8\xb9\xbb\x56\xbe\xc4\x88\x63\x3e\x80\x3f\xe0\xcf\xaa\x45\x18\xe5\xd8\x91\xaa\x44\x1f\x07\x58\xe2\xd2\xc5\xd3\xbc\xca\xaf\x9f\xe5\x16\x13\xa1\x03\xb8\x9c\x61\x3e\x77\x10\xa3\x28\xc4\x03\x40\x62\x4e\xbd\x2a\x74\x3f\x60\x3e\x66\x3c\xd4\x53\x09\xe9\x4d\x0e\x10\xaa\x36\xa2\xba\xd7\x94\x33\xca\x66\x42\xed\xae\xa8\xde\xad\xd4\xb1\x59\xce\x23\x3c\x80\x11\x63\x01\x46\xd4\x79\xa3\x50\x26\x1c\xfb\x03\x90\x7c\x86\x6b\x8d\x80\xde\xc3\x13\xc0\xfc\x48\xcf\x0f\x19\xec\x19\xc0\xaa\x68\xfa\x4a\xb3\x2d\xa3\xcb\x1f\xc7\xcc\xea\x77\x3a\x1a\x76\xc2\xe8\xed\x55\x53\x7e\x88\xea\xed\x98\x5a\xf0\x34\xbe\xd6\xd8\xcc\x4f\xb5\x27\x53\xe1\xc9\x54\x78\x32\x15\x8c\xa9\x60\x74\xca\x1d\x0c\x86\xcc\x00\x7f\x53\xb3\xe1\x6e\x44\xcc\x0f\x70\x7b\x13\x22\x36\x0e\xcc\x70\x75\xc6\xc1\x72\xf6\x46\x84\xa4\x37\x1d\xe4\x47\x3f\x8d\x7c\x24\x71\x32\x78\xec\x14\xcd\xb8\x66\x96\xb3\x66\x32\x46\xc9\x4c\x0f\x5b\xdc\xd4\x6b\xd0\x5f\x32\xdf\x19\x2b\x4b\x15\x03\x0e\xbb\xa6\x98\x03\x1b\x83\x76\x21\x3c\xab\x91\x9a\x7a\x99\x29\x97\x98\x85\x5b\x7d\x03\x45\x61\xc3\xbf\x82\x8d\x92\x95\xf6\x92\xbd\xaf\x21\x50\x7e\xd7\xfb\xa8\x7c\x1a\x1f\x98\xf8\xba\x4e\x8d\x82\x49\x94\xa1\xe3\x4b\xe4\xc7\x02\xf5\x08\x14\xcb\x3b\x22\x04\xa1\x93\x0f\xb1\x59\x7e\x07\xd3\xa9\x62\xa8\x66\xb5\x41\xb4\x82\x9d\xf0\x70\x29\xb8\xd8\x7a\x82\x95\xcc\xa7\x82\x45\x54\x34\x14\x88\x70\x6d\x05\xb1\xd0\x56\x78\xc8\xc4\xbb\x57\xab\xaa\x60\x14\x95\xdb\x07\xc6\xb1\xa7\xad\x03\x4d\x2e\xc7\x42\x78\x14\x34\xbb\x57\xdf\x4b\xc1\x06\x5a\xc9\x1c\x78\xc8\x84\xba\x47\x5f\x4b\xd1\x6d\xb1\xd4\x31\x4f\xdd\xf9\x83\x19\x28\x62\xa2\xfc\xec\xc1\xe3\x38\xb6\x54\xec\xeb\xbf\x88\xeb\x64\x91\xa9\x65\xa6\xa8\x73\xc4\xf9\xed\xec\xab\xd8\x80\x40\xf3\x80\x21\x3f\x2b\x68\x55\x62\x76\x7a\x7c\x84\x27\xa4\x28\xdf\x0b\x04\x2c\xee\x56\x71\x62\xb2\x7f\x7a\xab\x51\xe3\x6e\x85\x51\x1f\xbe\x1b\xeb\x11\xd8\x7d\x79\x83\xc5\xf3\x70\xf4\x58\x5d\x65\xf1\xb9\xd8\x1d\xec\xbd\xdc\x10\x4f\xae\xb2\x27\x57\x59\x4c\xa4\x7b\x76\x95\x25\xc3\xbe\x43\x37\xbb\x41\xc0\xae\xb1\x7f\x60\x1d\x02\x47\x18\x79\x53\xec\xdf\xe1\x7b\x8b\xc6\x2c\x05\xe4\x04\xf3\x50\x1c\x32\x19\xeb\x80\x3b\x7c\xbf\x62\xa8\x7a\x57\xe1\x98\xf1\x11\xf1\x7d\x4c\x01\x13\x39\xc5\x1c\x46\xd8\x43\x33\x81\xf5\x7a\x3e\x2b\xee\x11\x2a\xfd\x89\xc0\xb2\x7d\x43\x74\x43\xc2\x59\x08\x74\x16\x8e\x8c\xab\x23\x89\x47\x03\x39\x45\x12\x3c\x44\x61\x84\xad\x79\xa2\xfd\x04\x3a\x00\x50\x7f\x73\x8a\x04\x8c\x30\xa6\xc0\x0d\x05\xdb\xd5\x86\xf9\xc3\x95\xdd\xaf\x79\xb0\x79\x32\xc5\xb1\x05\x84\x7d\xb5\xfe\xb2\x19\xf7\x30\xf8\x0c\x0b\xda\x94\xc6\x3b\xe9\xd2\xec\xc5\x23\xa1\xd9\x8b\x43\x14\xe2\x3d\x46\xc7\x01\xf1\xe4\xed\xe9\x57\x36\x4c\xb5\xb2\x54\xf4\xd0\x2d\x53\xb9\xf3\xb1\x34\x7b\x15\x42\xb5\x34\x7b\x76\x89\x52\x72\xac\xc5\x34\x26\x79\xf5\xee\xe7\xa1\x12\xf9\xeb\x1e\x1c\xef\x52\x98\x55\xed\xf4\xe0\x7a\x4a\x82\x98\x96\x74\xa2\x09\x9b\x71\xf9\xda\x41\x57\x3c\x5c\xd6\xe6\x43\xd1\x7f\xac\x9b\x39\x01\x59\x25\x87\xd1\x71\x3c\x58\xa6\x9f\x28\xdb\x44\xc5\x01\x5c\x62\x25\x10\x57\x76\x9d\xee\xd6\x83\xf4\x4d\xc5\xca\xb5\x60\xb3\x81\x78\x7f\x25\xb7\xe5\x81\xb1\x8d\x3e\xaa\x8d\xef\x1d\x4c\xd8\x92\x61\x9e\xdc\x95\x77\xf3\x56\x3e\x5c\xbc\x1f\xd8\xf9\xed\x93\xdb\x6d\x99\x95\xaa\x2e\xc2\xba\x59\xe5\x7a\x8b\xd0\xc4\x61\xd5\xc2\xe6\x82\x7c\x59\xa5\x39\xe3\x3e\xe6\x2f\xe7\xab\x7c\x00\x23\xee\x4d\x9b\x15\xee\x40\x2f\x60\x33\x7f\x18\x71\x76\x45\x7c\x5c\x12\xfd\x5d\x1b\x13\x2d\x66\x51\xc4\xb8\x92\x13\x3d\x0c\x24\xc3\x54\x2c\x87\x7b\xaa\xd5\x87\x5c\xa3\x5b\x2f\x8b\xcd\x5e\xa7\xd3\xac\x14\x62\x03\x2f\xf6\x97\x06\x16\xbe\xa5\x54\x67\x28\x91\x5d\x29\x9b\xfd\x4e\xb7\x1a\xad\x27\xcd\x6f\x88\xb4\x59\xc7\xfb\x27\x05\xf6\x1d\x14\xd8\x12\xda\x45\x67\x3d\xac\x73\xed\x25\xbe\xb5\xaa\xb1\xdd\xcd\xae\x0a\x57\x4e\xeb\x65\x54\x90\xf1\x57\x3f\x14\x45\x14\x63\xf6\xdd\xf4\x91\x21\xc7\x93\x36\x7a\xd2\x46\xc9\xdf\x37\xd3\x46\x0b\x4e\x32\xb3\x8d\xbf\x93\xed\x15\x3b\x23\x87\x72\x1e\x55\xaa\x3c\x6b\x6a\x0f\x91\xe7\xb1\x19\x95\x45\x35\xb7\xea\x49\xaa\x17\x10\x4c\xe5\x30\x33\xaf\xd2\x13\xb5\x31\x0a\x84\x1b\x6b\x51\x7d\x46\x2a\x24\x27\x74\x52\x25\xa4\xc9\x57\x12\xbd\x6a\x5d\xb4\x16\x0f\xb5\xa1\x18\x61\xe0\x58\x72\x82\xaf\x70\x4d\x46\x5a\x41\x1b\x7e\x33\xd9\xb6\x09\xcf\xbb\x06\xe2\xda\x44\xc0\xa2\x52\xce\xa2\x2b\xaa\x15\xe0\x43\x9d\xaa\xdf\xf3\x74\xa8\xd9\xef\x6c\x3c\x12\x22\x3d\xac\x8d\x78\x61\xe5\x78\xa8\x84\x7b\x0c\xa9\x43\xf9\x44\xdc\xb8\x57\x85\x29\x98\x55\x17\x95\x49\xc0\xa8\x5e\x47\xb8\x31\x34\x8b\xe3\x4b\x8e\x73\x5a\x35\xef\xf4\xfc\x06\xc1\x26\x59\xb4\x4b\x83\x1e\xaa\xc4\x40\x2c\x29\x63\x09\xeb\x4b\xbf\x75\xeb\xf8\x90\x87\xb2\xb2\x2c\x3f\x6b\xac\xc4\x58\x6e\xaf\x3c\x73\xb2\x9f\x5d\x34\x89\xf2\xb2\x65\x4f\x49\x9f\x56\xb2\xa7\x95\x6c\xe5\x95\xec\xed\x42\xb3\xe8\x69\xe1\xba\xbf\x85\xab\x24\xf6\x32\x3b\xf5\x97\x5b\xe0\x4a\x4e\x37\x73\xfc\x5b\x72\xcf\x52\x5e\x9d\xe2\x8e\xdb\xb7\xbf\x86\x42\x2f\xf9\xce\x4a\x4a\xfc\xe5\xfc\x60\x61\x90\x4d\x6a\x79\xe4\x37\x61\xab\xa6\x37\x2d\x32\x7a\x9c\x34\xa4\x65\x65\x2b\xd9\x39\x55\xc3\x96\xb4\x7d\x83\x65\x59\x33\xab\x6e\x0b\x65\x72\xca\xb6\x9d\x49\x9c\xfc\x84\x5c\x29\x8d\x1d\x77\x75\x33\xbf\xbf\x8a\x60\xf6\x1f\x88\x76\xab\xcd\x8f\x7e\x5a\xd2\xff\x5a\x4b\xfa\x1d\x88\xf4\xd0\x37\xa7\xf0\x6f\xf8\xcf\x5f\x77\xd1\x36\x0a\xe9\xce\xca\x35\x4d\x6b\xad\xd2\xae\x4b\x2f\xdf\xeb\x1c\x0b\x2c\x87\x1e\xc7\x3e\xa6\x92\xa0\xa0\x24\xe7\xe3\x69\x45\x57\x2b\x7a\x4b\x53\xea\x2b\x6f\xce\x8e\xd4\x37\xc0\xe1\xc6\x93\x0e\x7f\xd2\xe1\x4f\x3a\xfc\x21\xe9\x70\xad\x06\xb2\xb3\x7a\x8f\x63\xbf\xaa\xcc\x5f\xb5\x81\x2c\xb0\x14\x71\x04\x70\x3c\xdd\x61\xcc\x78\x8d\x5a\x7f\xae\xfe\x0f\x27\x53\x2c\x30\x20\x9e\x46\xd2\xb7\xc6\xc8\x23\x74\x02\x1c\x07\x3a\xe2\x3d\x29\x39\x6b\xfb\x2c\xa8\x30\xb8\x1e\x62\xc9\x89\x27\xd6\xf5\xd1\xd2\x90\x23\x3a\xc1\x85\x7d\x5d\xc1\xe3\x69\x3b\x59\xdb\x9b\x84\x58\x60\x4e\xb0\x00\xdd\xdd\x9c\x52\x29\xc0\x4d\xb8\x69\xb2\x1f\xc9\xef\x34\xde\x99\x51\x5e\xce\x8f\x54\xb7\x8f\xce\xd9\xd6\xd7\x3e\x68\xff\xf9\xf8\xfd\x21\x20\xce\xd1\x1c\xd8\x18\x3e\x70\x16\x62\x39\xc5\xb3\x14\x31\x36\x3a\xc7\x9e\x14\x30\xe6\x2c\x04\x36\x52\x4c\x41\x92\x71\x32\x0b\xbf\x87\x86\xb1\x84\x4a\xc9\xf4\x74\x02\xff\x74\x02\x9f\xfc\x3d\xcc\x13\xf8\xca\xc6\xfe\xcc\x28\x81\x15\xba\x10\x2a\xd5\x04\x0c\x56\xe8\x32\x26\x81\xfa\x6f\x7d\x8e\x73\x89\x06\x5c\x51\xf7\x99\x03\x7f\xb9\xba\xca\x33\xc9\x5c\xf2\x49\xe9\x2d\x52\x7a\x2e\xa1\x9e\xd4\xde\x93\xda\x4b\xfe\x1e\x99\xda\xbb\x85\x42\x1a\x63\x5f\x69\x8f\x25\xec\x31\x14\x04\xc9\x2c\x26\x14\x84\xc7\x51\x84\xf5\x7d\x05\x63\xc6\x43\x24\xad\x6d\x69\x3c\xa4\x17\xa6\x64\x95\x5f\xa6\xa2\xe2\x4f\xda\xc9\xf7\x8d\x34\x93\x51\x9a\x0e\x02\xc8\x55\x4f\x12\xdf\x48\x8b\xc7\x22\xb1\x54\x4d\xd7\xa3\x00\x91\xa5\x05\xb2\x34\xf2\xa9\xd9\xaf\x03\xfb\x71\xa5\x22\x7d\xcb\x0a\x4a\x4f\x1a\x79\x19\x8d\xdc\xcf\x9d\x1c\x94\x94\x17\x21\xbe\xde\xc3\xeb\x42\x40\x8f\x82\x42\xf7\x9a\x95\xfc\xb4\x66\x7d\xdd\x35\xeb\x59\xfa\x4a\xf5\xb4\xb8\x98\x41\xde\x6b\x1b\xf0\x08\x8f\x31\xc7\xd4\x4b\xc0\x34\x6a\xd2\x18\x88\xf1\xe7\xb9\x5a\x39\x24\x71\xf1\x24\xbe\x8b\x57\xa9\x6e\xbd\x20\x74\x71\xa3\xa9\x42\xa2\xae\x91\xb2\x04\x07\xc9\xba\x63\x83\x83\x1c\x2a\xa8\xaf\x38\x3f\x23\x34\xc1\xce\x4f\x41\xbe\xb8\x3f\x25\x93\x28\x70\x7e\x13\x89\x43\xb1\x1a\xe2\x4b\x61\xa5\xa0\x28\x36\x52\x9b\x9b\x89\x53\xc5\x48\x01\xb7\xb8\x95\x86\x79\x71\x33\x8d\x4a\xb1\x99\xde\x05\x38\x4f\x0b\xcd\xa0\x54\x8e\x62\xa9\xcf\x09\x89\xb1\x82\xf4\x54\x88\xc7\x40\x41\xf0\x7e\xbc\x48\x2c\x6b\x87\xb3\xac\x29\x92\xbf\x8a\x05\xa0\xe7\xbd\x5f\x98\x59\x15\xb1\xcd\x4a\x6e\x50\x89\x16\xa8\x6c\x9e\xd8\x49\xc3\xac\x94\x97\x76\x4a\x2e\x1a\xb9\x15\x41\x54\xc7\x3b\x50\xa1\x84\x9b\x55\x8c\xaf\x6c\x5e\x2f\x00\x1a\x3d\x03\xa1\x9b\xd0\xfd\x8d\xb8\x5f\x9c\xf0\xa6\x39\xc7\x68\x26\xa7\x98\x4a\xab\xe5\x87\x98\x2a\x1b\xd8\xcf\x35\x0b\x67\x81\x24\x43\xf4\x65\x09\x4a\x0a\x7d\x2d\x47\x9e\x36\x99\xe5\xa8\xf1\x2b\x0a\x66\x58\x0c\xe0\x0f\x64\x2b\xa4\xac\x41\xc4\x71\x84\x94\x2c\xac\x99\xd4\x1d\x41\x18\xd5\xbf\x38\x46\xfe\x7c\x0d\xc6\xfa\xee\x8f\x35\xf0\x71\xf2\x7a\xcd\x1c\x10\x12\x3a\xf9\x13\x1a\xcb\x8a\x64\x36\x79\xaa\x1e\xcc\x43\x14\x62\xb5\xef\xd7\x79\x3c\x30\xb3\x55\x19\x7d\x1c\x05\x6c\xde\x86\xd7\x8c\xc7\xcb\x16\xec\x7e\x3a\x5e\x1a\x82\x98\x96\xe5\xd2\x56\x2c\xba\x06\x36\x85\x69\x19\x92\x26\x17\xb0\x39\xf9\x5c\xb6\x16\xa2\x97\x4b\x8c\xca\x20\x30\x80\x99\x68\x61\x24\x64\xab\xab\xf7\x3d\xab\xe0\xa3\x2b\xe8\x2e\xad\x12\x74\xfa\xc5\xb2\x8d\x47\x8c\x49\x21\x39\x8a\x86\xe6\x7e\xb2\xe1\xd4\x39\x67\x5d\xd8\xdb\x86\x6a\x0e\x51\xa1\x8b\xd9\x19\x0d\xc0\x47\x12\xb7\x24\x09\xf1\xb2\x43\xda\x5a\xba\xf7\x39\xa4\x11\xec\xe1\x8a\x9a\x35\xbe\xaa\x6e\xd9\xf6\x99\x2c\x9b\x55\xd4\x7d\xa9\x76\x58\x5e\x74\xf5\xc6\x79\x28\x24\xe3\x68\x82\x87\xf9\x75\xba\xe6\xe3\xa5\xd7\x1d\x94\xe9\xc4\xba\x42\x6f\x45\x75\xfb\xb5\xd7\x97\x52\xb0\xb5\xa5\x03\x8d\x3c\x1c\xd9\x19\xa6\x2d\x1d\x68\x74\xb3\x4f\x35\xc5\x0a\x4f\x8d\x25\x53\x78\xac\x56\xa5\x3c\x79\xef\x52\x1b\xef\xeb\x2e\x96\x39\xf2\xa7\x7f\xf5\x8c\x70\x61\x36\xe8\xe7\xee\xdb\xfb\x46\x2b\x6a\x1d\xa7\x77\x3f\x1c\x58\xa0\x72\x0c\x52\x2f\xaf\x72\x5c\x9b\x1a\xb0\x4a\x3c\x5c\x8d\x9c\xa1\x16\x04\x58\xd7\xf5\x2c\x10\xb3\x65\x46\x36\xbd\xf3\x8a\xbb\xee\x0b\xeb\x55\x5d\x5c\x91\xcd\xcb\x6a\xb5\x25\x59\x09\xe0\xb7\x12\x8e\x52\x36\x96\x14\x02\x8d\x47\xce\x86\xfa\xeb\x41\xf4\xfa\x27\xd3\xaa\x5e\x30\x62\xfe\x1c\x04\x36\xd9\x7a\x96\x60\xf0\xe1\xfd\xf1\x49\xcd\x5e\x4a\xad\x72\xab\xed\x86\xaa\xed\x92\x42\xb9\xb1\x5c\x82\xf3\xb5\xbe\x4d\x35\x2d\xe1\xe4\x05\x33\x21\xd5\x73\x6b\x0a\xc4\x75\xdd\x08\x5d\xb4\xd9\x2a\xb3\x4c\x72\xc9\x10\xd2\x14\xdd\x92\x4c\x67\x95\xa8\xff\x7a\x8c\x8e\xc9\x64\x56\x0a\x82\x49\x6f\xd4\xc3\xee\xfe\x5e\xf8\x7a\x7e\xbd\xc8\x9b\x06\x99\x4f\x37\x15\xe6\xd4\x1a\x64\x85\x2f\xb5\xe1\x40\x42\x38\x13\x52\x81\x23\x6c\x98\x7d\xc0\xae\x31\x6f\x79\x48\x60\x40\x41\x34\x45\x74\x16\x62\xae\xec\xa0\x29\xe2\xc8\x53\x5b\x7b\x60\x1c\x9a\xcd\x56\xb3\xb9\xa6\xcc\x56\x6e\x03\x63\x11\x35\xed\x47\x58\xba\xad\xd7\x00\x51\x1d\x2b\x90\x6d\x55\x18\xd5\xb4\xf3\x10\xd5\xfe\xa8\x11\x86\x80\xd1\x89\x22\xc6\x14\x51\xd8\xe8\x39\x9f\x6f\x37\x17\x71\xa4\x68\xf9\x95\x14\x9f\x53\x4d\xee\x51\x0a\x96\x59\xf4\x33\x50\x7c\x9a\x62\x5d\xb0\xd0\x63\x94\x9a\xf9\x5f\x18\x03\x88\x00\x3b\x8c\xa2\x39\x65\x52\x5f\x04\x2c\xb0\x8c\x45\x69\xad\xb6\x3b\xa3\x0e\x6a\xc9\xcd\x11\xa9\xb1\x6b\x66\x20\xe0\x2b\xcc\xe7\xb0\x09\x21\xa1\x33\x89\x45\x5b\x13\xc8\xc7\x63\x34\x0b\x24\x5c\x29\x0b\x59\x01\xe2\xe4\x43\x55\x0b\x23\x00\x9d\x05\x81\x82\xd8\x49\xa0\x2a\x14\x18\xf9\x5e\xa6\x44\x01\x90\xef\x6f\x4b\x64\x40\x7a\x2c\xc6\x44\x06\xe8\x46\xca\xe3\xb4\x68\xc3\x77\xe5\x70\x0a\xc6\x03\xe1\x6f\x65\x85\xeb\x87\xcb\x5d\x03\x72\xa3\x38\x7f\x4b\x6d\x80\xe6\x5e\x76\x93\xdc\xac\x59\xb2\xf3\x0e\xcc\xec\x40\x07\xd4\x57\xda\x0b\x9b\xa0\x35\x85\x72\x52\xb2\xd2\x08\x42\x1b\x3e\x59\xfd\xd5\x6c\x66\x00\x6b\x36\x21\x20\xf4\x62\xf1\xea\x40\x6a\x3e\x7f\x4a\xc9\xa5\x52\x77\x3a\x54\x6e\x4c\x4c\xe1\x57\x05\x89\xfd\xf8\xc2\xc1\x7d\x22\xa2\x00\xcd\x87\xf5\xab\xf2\xa1\xb3\x22\xe7\xec\x12\x65\x47\xd9\x41\x20\x9a\xf1\x88\x09\xbc\xc4\x8a\x57\xff\xb9\x9f\x66\x21\xa2\x30\xe6\x04\x53\x3f\x98\x97\x60\x97\x85\x61\x4d\x03\x11\x3b\x69\xce\xd0\xb5\x38\x5b\x0c\xc1\xa2\xe5\xae\x19\xaf\x77\x25\x38\x3b\xcb\x9c\x46\x5f\xbb\x8a\x08\x9d\x28\x6b\xe1\xfd\xf1\xab\xc4\x5c\x29\x02\x91\x5d\x7f\xca\x6c\x4a\xd7\x33\xe7\x48\x76\xb9\x18\xbf\x4a\x7f\x29\xd2\xa0\xd8\x4c\xd0\xff\xf6\xbe\x9f\x8c\x1b\x98\x9b\xcd\x47\x27\xdc\x96\x7e\x65\x42\x9d\x93\xb2\xc3\x36\xfc\x4a\xf8\x84\x50\x82\xee\x5b\xda\x2c\x10\xf7\x25\x65\xe6\x63\xda\x3a\xca\xd7\x3c\x49\xca\x29\x0d\x33\x9e\x23\x51\x6f\x9f\xe7\xec\x33\xdd\xc3\xa9\xcc\x14\x57\x96\x36\x68\x94\x80\xb7\xc4\x19\x4b\x29\x11\x3d\x14\x21\x2f\x13\x6c\x51\x2d\xa9\xd7\x29\x3d\xb9\x36\x07\xe3\xce\x10\xe0\xb1\x84\x48\xcd\x62\x17\x81\x5b\x41\x59\xba\x60\xd5\x2f\x56\x66\x66\xec\x59\x60\xd4\x9a\x7f\x20\x71\xd8\x58\x52\x21\x98\x27\x55\x5c\x73\x9a\xc4\xd8\xea\x47\xd9\xb8\xec\x72\x4d\x12\x27\xc0\xef\x66\x13\xe0\x81\x50\x78\xb7\x7b\xdc\x3a\x3e\x7e\x9f\xec\x98\x0d\xfb\xf7\xec\xce\x43\xc7\xcf\x64\xcc\xf8\xe6\xb7\xf1\xcc\x54\xda\x22\x05\xbf\x65\x16\x53\xe3\x63\x86\x09\xa6\x3a\x9e\xc7\x87\x59\xac\x66\x2a\xca\xf7\xe4\x0f\xb1\x57\x72\x7a\x67\xbf\xbd\xf4\x50\x6e\xb7\xfb\x19\x31\x29\x52\xb4\xbc\x63\xdd\xf4\x10\xd8\xe3\xc5\x14\x9f\x7b\x3a\x27\x00\x7d\xd2\x83\xd5\x9c\xcd\x96\xb0\xb0\x20\x58\xdf\xfe\x68\xfe\xfd\x8e\x03\x56\x77\x51\x97\x66\x37\x35\x4a\xa6\x62\xee\x70\x30\x37\x23\xcb\xfd\x54\x92\x59\x14\x8b\x19\x11\xcd\x1a\x2d\xb2\xba\xab\x6a\x35\x3f\x4d\xcd\x9c\x29\x5f\x9a\xcb\x05\x3c\xfb\x91\x5d\xf7\x77\x42\x89\xd5\x3e\x55\x60\xdf\x0a\xac\x2b\x3b\x66\x28\x57\xe0\xe5\x2c\x14\x29\x0b\x51\x1c\x5c\xe8\x2e\x3a\xe9\xa2\x44\xa8\x5d\x2e\x9b\xab\x31\xa9\xf2\xc0\x27\x0b\x48\xc9\xb7\x17\x72\x28\x44\x37\xc3\x18\xbe\xa1\xbd\x17\xa2\xfa\x0b\xe3\x00\x4d\x80\x98\xe5\x57\xd9\x28\xd7\xae\xf5\x1c\x63\x19\x73\x30\x4b\x04\x5b\xf2\x3f\xb5\x7a\xec\xc7\x6e\x63\x3d\x97\x01\x5d\x32\xf1\xea\xd9\xf6\xb7\x5d\xc0\x2a\xd7\x88\x2c\x00\xa6\xd9\x37\x59\x30\x97\x54\x31\xb5\x5f\x29\x5d\x91\xb2\x9f\x49\xae\x87\xbd\xcb\x77\x6e\xbd\x96\x15\xd9\x5b\x52\x8a\xc8\x98\xd5\x26\xb3\x6d\x79\x86\xde\x7a\x31\x5c\x02\x26\x35\x5b\x75\x86\x9b\x44\x61\x74\x1f\x96\x4d\x2d\x65\x5d\x70\xfc\xec\xb6\xb7\x92\x69\xc5\x49\x5f\xe9\xe7\xbb\x85\xef\xae\x38\x7a\xd1\xf7\x56\x72\x86\xb7\x42\x62\x74\xac\xa6\x56\xf0\xc4\xe5\x77\xf2\xb5\x74\xfd\xae\x6e\xbb\x72\x54\x5d\x12\x56\x45\x33\x65\x42\x18\x21\x17\x98\xf8\x3c\x93\xfb\x19\x47\xce\xc7\x39\xa0\xcf\x75\x9b\xd2\xac\xc1\xfb\x14\x8d\xd2\x0f\x94\x1c\x12\x77\xe9\x28\x3a\xde\xee\xfc\xe4\xcf\x3e\xe0\x7e\xd0\x91\x6c\xe7\xfc\x78\xd2\xdb\x7b\xfb\x65\x3c\x5b\x42\x96\x6a\x25\xa9\x00\xc2\x57\x13\xa2\x47\x22\x6f\x29\x25\xac\x21\x97\xfc\x1e\xac\x66\x73\x19\x99\x1a\x14\xac\x93\x82\x84\x20\xdf\x27\x4a\x47\xa1\xe0\x43\x05\xa1\x4b\x29\x75\x65\xc2\xe6\x0a\xe3\x2f\x1d\x9a\x5a\x1e\x90\x6d\x86\x35\xec\xcf\x7e\x62\x49\xbc\x13\x5d\x5f\x04\x2d\x1f\x5c\x9b\xae\x2f\x84\xca\xad\x7e\x16\xb5\x62\x77\x73\x67\x59\x49\x6f\x9f\xcd\x46\x01\xae\xb1\xf7\xf4\x80\xee\x9c\xce\x27\xc5\x7d\x85\x59\x9d\xff\xc4\x77\x99\xd7\x2e\x10\x7f\xf7\x99\xed\xd2\xa2\xe1\x0a\xc3\x6b\x93\xb3\x45\x18\x3d\xc2\x62\x16\xc8\xac\xc0\x3b\x68\xb8\x23\x3c\x30\x6d\xf0\xb0\x67\x9d\xf6\x05\x9e\xea\x70\xc9\x9c\x33\x63\x49\xf2\x3d\xd7\x9b\x42\xca\xae\x8d\x99\xae\x0f\xee\xa7\x18\x18\x0d\xe6\x8e\x4b\x79\x4c\x70\x60\xbc\xe0\x26\x34\x33\xe9\x5e\xb0\xed\x2b\x24\xb4\xe4\x94\x1f\xfe\x5a\x41\x10\x05\x1a\x2c\x0c\x75\x78\x56\x4c\x94\x49\x67\xbc\xa9\x9f\x9e\x64\xa1\x15\xe2\x51\x0e\x5e\x29\xcb\x9b\x63\x8f\xf1\xa4\x6c\x48\x2e\x4b\xa8\x84\x15\x84\x0e\x20\x42\x72\x9a\x97\xad\x94\x2b\x71\x0e\x7c\x16\x8e\xf8\xa9\x33\x8c\x5b\xf0\xbd\x00\x5d\x80\xe9\x44\x4e\xf5\xde\x80\x84\xda\xc3\x60\xc9\xa4\x65\xe8\x7a\x4a\xbc\xa9\x62\x06\xd7\x69\x96\xe6\xfa\xcc\x4c\x5a\x67\x69\x55\xdd\x72\xfc\xf2\x93\xb0\x7c\x0a\x26\xe7\x2f\x9b\xa9\xe2\x20\x94\x84\xb3\x70\x00\xdd\xf4\x91\xb9\xc3\x73\x00\xfd\x8d\x5e\xc7\x3e\x2d\xa6\x4c\xe5\x49\x04\xc9\x14\xb7\xa3\xc7\x45\x01\x72\xbc\xb4\x4f\x97\xa5\x61\xdc\x5e\xa7\xcd\x62\x8f\x51\x5f\xc0\x08\xcb\x6b\x7d\x5d\x23\x92\x08\x92\x62\x2a\x5f\x97\x62\x1b\x9d\xa5\x48\xd6\xed\xec\x74\xaa\x69\x96\x27\x89\x43\x33\x3b\xbe\xcd\x42\xce\xd2\xcc\x3e\x5c\x86\x64\x71\xd5\xd7\x78\xc7\x21\x19\x8c\xb1\xf4\xa6\x6d\x78\xad\xfe\x93\x49\x44\xbe\x9e\x62\x0a\x38\x8c\xe4\xbc\x6d\xfa\x61\x2a\x75\x95\x18\xc4\xd3\x89\x2f\x31\xa7\x28\xee\xa3\xe1\x49\x26\x79\x39\x5d\xb3\x0b\x6d\x45\x7e\x53\xc1\x0d\x6b\xa9\x1c\x27\x2b\xbb\x99\x58\x86\x06\x4e\x86\x58\x2d\x01\x3e\xa0\x89\x12\x1a\x1f\xdf\x14\x44\xc2\x3d\x74\x5c\x42\x4b\x14\xd9\x97\xcf\x0f\xb3\xac\x8b\xa3\x5d\xdc\x80\x73\x03\xb4\x93\xc7\x56\x0b\xf4\x61\x7a\x5b\xae\xa2\x97\x92\x75\x8c\xbc\xa9\x8b\xf4\x3d\xa2\x91\x0f\x8c\x4f\xd0\xe8\x74\x0c\x22\xf6\x86\xb2\x52\xb7\xe4\xff\xb6\x92\x9e\xc7\x26\xd7\xc3\x1e\xc8\xeb\x4e\x30\x9a\x83\xc7\x89\xc4\x9c\x20\x13\x10\x27\xe6\x54\xa2\x9b\xe4\xa4\x3e\x51\xf5\x40\x84\x03\x50\x48\x02\xa4\x23\x38\x65\xae\x0b\x86\xb3\x78\xe0\x33\xf0\x02\x7d\xcf\x30\x1b\x03\xa2\x70\xfc\xf1\xad\x4e\xff\xc1\x21\xa6\x32\x5d\x77\xf6\x15\xdd\x4c\xb5\x0f\x7b\xd5\xb0\xee\x6f\x3c\x57\x88\xce\xe3\x61\xc7\x2c\x08\xd8\xb5\xda\x9c\x9f\x5d\x38\x21\xbb\xe2\xcc\xac\xf2\x62\xf0\x2c\x19\xf2\x87\xf2\xd4\x10\xe7\x7d\x36\x9e\x36\xf3\x42\x1f\x4f\xba\x57\x7f\xfc\xe0\xb8\xc3\x9c\x87\x53\x8e\xc7\xce\xcf\x4c\x87\x8c\x7b\xdd\x79\x5e\x48\x94\xfa\xc1\x3d\x60\x51\x3f\x19\x9f\x20\x4a\x44\x9c\x16\xe7\xbe\x51\x26\x8b\xf3\x7b\x61\x6e\xd6\x0f\xd6\x35\xee\x3c\x30\xd9\x57\xce\x83\x34\x63\xc5\x79\x68\xb3\x47\x52\x7a\x3a\xa9\x40\x6b\xce\xfa\xa7\x54\x53\xd6\xdc\x10\x2e\xef\xe4\x14\x13\xae\xf1\x5b\x83\xf8\xb6\xe9\x94\x89\x46\x66\x1c\xa6\x9d\x9d\x9d\x89\xcb\x34\x69\x54\x7b\x70\x91\xf0\xdc\xf7\x69\xe3\x93\xd5\x81\x80\x21\xa2\xfe\x30\x71\x8b\x2a\xbc\xef\x02\xd7\x9a\x23\x15\xd5\x70\x1e\x18\xd9\x75\x27\x11\x6d\xca\x38\xb8\xc6\x5f\x53\x96\x1e\x31\x6d\x92\x00\x54\xad\xe0\xd7\xd4\xb3\x94\x75\xe6\xa0\x43\xed\x45\x8c\xb2\x77\x30\x54\x00\xb5\x13\xd5\x11\x05\xcc\xcf\x5a\xab\x45\x75\x92\xd3\x16\xe0\x68\x94\x18\xbb\x46\x85\x12\x34\x5a\xd2\x0e\x70\x57\x45\x27\xe4\x5c\x19\x95\x6a\x1d\x37\xea\x58\xdf\x9a\x58\xae\xc4\x52\x1d\xa6\x1b\xa5\x3a\xcb\x91\x89\x7a\xe5\xb5\x40\x69\xe9\x08\xe9\xac\xc6\x4a\xbf\x99\xd1\x5c\x60\x6f\x8f\xb7\x7a\x27\x3e\x87\x32\xd0\x6b\xee\x9c\x65\xd5\xcb\xd9\x1a\x9c\x29\xc2\xa9\xff\xea\x59\xac\xfe\x61\xe6\xe6\x99\x09\x07\x3f\x33\x13\xf3\x2c\x1d\x5b\x6d\x57\x11\x47\x92\x71\xc3\xf0\xb3\xff\xfe\x1f\xd5\xeb\xc7\x33\x2d\x32\x67\x6f\x0f\x7e\xd9\x3f\x4b\x75\x68\xdc\xeb\x9c\x11\x6a\xdb\xef\x1e\xbe\x3a\x33\x63\xbf\x3f\x3a\x6b\xc3\x4f\xec\x5a\x59\xfe\x6b\x30\x67\x33\xad\x67\x15\x96\x28\xb9\xfd\x9d\x8d\xa1\xdb\xb1\xdd\x75\xb5\x10\x8b\x8d\xe6\xbd\x43\xe3\xfd\x44\x98\xca\xa6\x62\x71\xf3\x61\x0b\x49\x6b\xb1\x3a\x0b\xe7\x2d\xad\xb9\x0d\x5c\xce\xd9\x9d\x0e\xbd\x5b\x76\x32\x66\x67\xe2\x8f\x10\x8f\x6a\xe2\xea\x33\x84\x87\x1f\x01\x5d\x0b\xb7\xf3\x1f\x51\xeb\xcf\xe5\x41\x47\xe6\x1b\xfa\x1a\x7c\x9d\x01\x60\x6b\x54\x9d\x85\xf3\x5b\x82\x1b\x90\x0b\x0c\xe1\xfc\x1f\xbd\xcd\xaf\xa2\x2f\xb4\x36\x2c\xee\x02\x85\xa3\x47\x90\x4c\x8e\x83\xf4\x35\xea\x11\xe6\x21\x11\x42\x1f\xca\x30\x10\xd8\xd4\x42\xe4\xb6\x90\x8c\xc3\xfa\x43\x26\x71\x3b\x06\xd0\xac\xd7\x69\xd1\x11\x25\xc6\xb6\x78\x84\x3e\x88\x8d\x7b\x57\xab\x25\x6b\x6f\x69\x31\xab\x50\x36\xe5\x8a\xa5\xc4\x3c\xca\xe8\x0d\xc8\xab\xb3\x25\x44\xa4\x71\x7b\xa5\x55\x7a\x92\x1e\xef\x9c\x8a\x56\x40\x61\xbb\x54\x12\x14\xa7\xf7\x00\x7a\x07\x91\xd1\xfb\xa3\x79\x05\x9d\x96\x80\x7a\x59\x52\xe2\x2b\x14\x0c\x2b\x83\x03\x62\xb2\xe2\x4c\xe5\x38\xd5\xd8\x47\xdc\x5f\xdc\x2f\x6e\xa9\xfa\xc6\x15\x90\x74\xb8\x4a\x0c\x82\x2d\x81\xe4\xe2\x85\x07\x30\xd2\x4f\xed\x43\xf3\xe3\xb5\xdd\xfb\xfd\xfc\x29\xce\xb3\x32\x48\x4f\xa5\x8c\x9e\xe5\x11\x3b\x3d\xce\x04\xa6\xc7\xc3\xe7\xdc\x5b\x36\x99\x06\x1a\x49\xae\x73\x8a\x62\x2e\xfd\x0a\x1a\x8e\xcc\xc4\xdc\x6e\xc4\x17\xcf\x44\x44\x26\xc9\x87\xfb\xa7\x2b\x7d\x1a\xcf\x5a\xd7\xf8\x9e\x3e\x5d\x92\xbd\x59\xf1\x79\xe3\x7a\x26\xc7\x9f\xb7\x8e\x3e\x6e\xfc\xfc\xcb\xc1\xce\xc7\xce\xfb\x93\xf0\xfc\xe3\x6b\x7f\x83\x79\xaf\x8f\x26\xe9\xe7\xac\x43\x5b\x4f\xa6\xf4\xe9\xc2\x04\xc2\xf5\xa5\x06\xb7\xa5\x01\xa0\xa1\x73\xfa\x97\xa5\x40\x92\x95\x96\xf7\xd0\x55\x73\xd3\x38\xff\xa0\x81\x22\x32\xb4\x19\xc8\x86\x7e\x35\x74\x4d\x5f\x95\x67\x9d\xbb\x6d\x5b\x5d\x22\xe6\x5b\xfc\x72\xe3\xfc\x82\xec\x5c\x76\x98\x0c\xcf\x2f\xc7\x0a\xdd\x31\x9f\xb4\x51\x14\x89\x76\x78\xd1\x1a\x49\x39\xe9\x9c\xd3\xee\x76\x67\x1a\xb5\x6f\x36\x67\x3b\x6d\xd1\x6d\xfb\xf8\x4a\x4c\xc9\x58\xb6\x19\x77\x08\xe3\x9c\xc6\x43\xa3\xd7\xe9\x75\x5a\xdd\x4e\xab\xb3\x79\xd2\xed\x0d\x36\xbb\x83\x5e\xbf\xdd\xd9\xdc\xe8\xf6\x7b\xbf\xa7\x3d\x9c\x44\xf4\x42\x8f\xad\xc1\xc6\x56\x7b\x63\xab\xd7\xeb\xec\x38\x3d\xe2\x8c\x71\x68\xf4\xda\x5b\xed\x4e\xfa\x22\x3b\xa9\x93\xc9\xee\x10\xba\xc2\x15\x9a\xf2\xc3\x95\xc4\xd7\x3a\x9f\x7d\xcf\xc6\x01\x1c\x6b\x96\x3f\x2e\xe9\x34\x19\xf9\x4f\xe2\xf9\x4d\xc5\x33\x5b\x06\x01\x1a\xc8\xd6\x9a\x71\x8c\x9d\x38\xd0\x31\x89\x31\xc9\x33\xea\x1e\x24\xb9\x2c\x8b\xad\x42\x6c\xcb\x52\xf1\x1a\x59\xa1\x2e\xd3\xe4\x99\x67\x99\x3c\x04\x68\xec\x86\xe8\x0b\xa3\xf0\x09\x8f\xe2\x08\x15\xa7\x6d\x05\xb0\xcb\x2c\x3f\xc5\x9c\xb2\x1c\xa0\x25\x42\x9a\x03\xed\xf4\x18\xf6\x91\x90\x6b\xe0\xa4\x37\xd4\xc1\x06\x75\x49\x04\xf0\x47\x6a\x29\xfc\x99\x8a\x59\x1c\xc5\x0f\x7f\x38\xa6\xc5\xbf\x9d\x7f\x97\x30\x39\x1d\x68\x2d\xd7\xb0\x34\x4c\x31\x1f\x7d\x95\x56\x5e\x37\x70\xd4\x85\x79\x56\x10\xd7\x12\x28\x9c\xb7\x50\x14\xb5\x84\x43\x95\x6c\x85\x96\x7c\xa8\xd4\x98\x71\x08\xe7\x80\xa2\xa8\x2c\x02\x78\x19\x95\x59\x50\x8c\xd9\x21\x96\xd2\x90\xd9\xcb\xf4\xc4\x7a\xb7\x20\xb0\x77\x46\x0c\x32\x01\x84\xd0\x38\xde\x6d\x75\x7b\xea\x7f\x85\xd7\x36\xa2\x5c\x0d\xa9\xfe\x51\xd4\x98\xca\xf8\x69\xa9\x9d\x4d\x51\x39\x8d\xe6\xf5\xef\x63\x55\xd4\x6d\x75\xfa\xad\xce\xf6\x49\x77\x6b\xd0\xeb\x0f\x3a\xdd\xff\xea\x6c\x0e\x36\x3a\x65\x2c\x70\x6e\x95\xfa\x9b\xb0\xe1\xbb\x90\x39\x17\xcb\x76\x17\x52\x17\x63\xc5\x9e\x48\x9e\x89\x7b\x28\x04\x7d\x55\x50\xbb\x18\xbb\x30\xd4\x0b\xc1\x70\x38\x80\xd4\x62\xc1\x7c\x38\xe2\xec\x02\x73\xc9\x22\xe2\xd9\x33\xac\xe1\x68\x2e\xb1\x18\x12\x3a\xcc\xd6\x08\x04\xbd\x5f\x0d\xbf\x90\x21\x61\x43\xeb\x84\xb7\x83\xb5\xf2\xf7\x6b\x00\xe8\x11\x07\x30\x1c\x7a\x8c\x8a\x59\x88\xf9\x90\x8d\xc7\x02\x3b\x77\x25\x16\x83\xa1\x5a\x4e\x48\x04\x74\xb7\xba\xdd\xad\xed\x4e\x6f\xa3\xd3\xe9\x74\x32\x2b\x83\xdd\xab\xee\xf4\xbb\x9b\xfd\x45\xbd\xb7\x2a\x7b\x6f\xee\xec\xec\x2c\xea\xfd\xa2\xb2\xf7\xf6\x56\xaf\xe7\xf2\xa5\x24\x68\xe7\xf1\x72\x66\x21\x17\x0a\x1c\xe8\x77\x3a\xfa\x92\xa6\x85\x86\x8c\xd1\x02\x9d\x8d\x82\x1e\x70\xaa\xeb\x41\xfd\xb4\xd7\x3e\x2c\xb1\x9e\x19\x44\xd7\x40\x84\xc6\x2f\xbb\xaf\x7f\xd9\x3d\x6e\xbd\x7b\xf3\xee\xa4\x95\x79\x9f\x58\xa5\xc7\x73\xea\x4d\x39\xa3\x6c\x26\x00\x79\x71\x50\x07\x65\x32\xb5\x75\x8c\xdb\x10\x89\x39\xf5\x7e\xd4\x35\x52\x12\x57\x9f\x33\xe9\xdd\xba\x88\x6a\xef\xf3\xe9\x80\x84\x97\x6f\x3c\xfe\x6a\xf6\x76\xab\x8b\x4e\x6f\x0e\x7e\xbf\x7c\x79\x72\x79\x78\x64\x35\x4f\xbf\xd3\x89\x37\x54\x4f\xf4\x29\xa7\xcf\x81\x71\x53\x2e\x31\x83\xf4\x90\xbd\x7b\x20\x51\xaf\x9e\x42\xbd\x32\x02\x99\xdd\x31\x48\xa6\xd0\x16\x38\xe3\x85\x1f\xc0\xa9\x36\xa3\xd5\x5b\x7d\x3b\x76\x66\xdb\x63\x2f\xbb\xc9\x6f\x19\x07\x90\xfd\xe6\x00\x16\x7d\x22\x0d\x9e\xf2\x58\x30\x0b\xa9\xf1\x5b\xab\xc1\xad\x9b\x15\x9a\xc4\x6f\xb6\xe1\xb8\xac\x9d\x3e\x7b\x18\xd8\xdd\xed\x9a\x3d\xfb\xcb\x6e\x90\xe3\xa7\x66\x3f\xdd\x86\x8f\xc6\x93\x6c\xf8\x33\x00\xe2\xc3\x8f\xd0\x75\x89\x93\xe7\x76\xf0\xe9\xd5\x9b\xd9\x7c\x74\xc0\xf7\xe9\x0d\xdf\xc5\xe1\x76\xaf\x3f\xb9\xbc\xb8\x20\xaf\xae\x12\x6e\x2f\xa8\xd0\x5d\xca\xf1\xa2\xf1\xb0\x3a\xc7\xbb\xf5\x1c\xef\x96\x70\x3c\x34\xa0\xea\xe8\xa6\x54\xd6\x07\x49\x49\xf9\xbb\xd0\x21\x5f\x42\xba\x0c\xef\xed\xbb\xa3\xbd\x5d\x8b\xf5\x76\x09\xd2\x27\x69\x5e\x22\xf6\x81\x63\xc1\x66\xdc\xc3\xe0\x33\xac\x4f\x3b\xf0\x4d\x12\x1d\xdb\xef\xf4\xcd\xfd\x7c\x0f\x15\x15\xeb\xdb\xb2\x18\x98\x1b\x4c\xfc\x1f\x9b\x5d\xf2\xcb\x86\x3f\xfb\xf5\xf3\xc1\xd5\xd5\xe6\xe7\xab\xb7\xc1\xfc\x4b\x37\x7c\x73\xb4\xf1\xf3\xfc\xf2\xb0\x99\x16\x22\xaf\x51\x69\x9f\xdf\x6f\x4f\x7a\x93\xad\x9f\x4e\xfc\xd3\x5f\x4e\x51\xef\x42\xfc\xb4\xd3\xbb\xf8\xf8\x6a\x63\x1e\xd3\x25\x5f\x41\xbd\x54\xd5\xdf\x83\x50\x77\xeb\x85\xba\x5b\x26\xd4\xa9\xa2\xba\xc2\x9c\x8c\xe7\xf0\xf3\xa7\x13\x53\xa7\x7e\x00\x47\x71\x20\x62\x7c\x9d\x9a\x4d\x08\xd2\x55\xec\x97\xa2\xcc\xc6\xe9\x74\x7f\x7a\x1d\xfe\xf6\x32\xfa\xf4\x61\x7c\xd0\x0b\x0e\xf1\x45\xe4\xf7\x7f\x7f\x15\x53\x26\x7f\x47\x77\x19\x65\xfa\x77\x27\x4c\xbf\x96\x2e\xfd\x32\xb2\x08\xcc\xa1\x39\x66\xac\x35\x42\xbc\x19\x2f\x7d\x8b\xae\x95\x6b\xd7\xa8\x80\xcf\x1b\xa7\x64\x7f\xfa\x85\x3a\xb4\x38\x8f\xfc\xfe\xe7\xbd\x84\x16\xef\xd0\x8d\x3d\x1c\x3e\xb0\x9e\x91\x23\xe3\xeb\x58\x82\x48\x9b\x77\x27\xd2\x66\x2d\x91\x36\x17\x13\x69\x8a\x92\xbc\x4e\xe7\xb8\x9a\x26\xe1\x57\x5b\x80\xec\xd9\x77\x72\xd8\xb9\x90\x60\x17\x37\x8a\x60\xbf\x7e\xc0\x07\x3d\x76\x88\xcf\xfd\x8d\xdf\x5e\x26\xf4\x3a\xc1\x3c\x14\x87\x4c\xee\xda\xd2\xc3\xcb\xcc\xb2\xde\x3d\xcc\xb2\x5e\xfd\x2c\xeb\x95\x50\x2a\x99\x49\x52\xc1\x0c\x53\x74\x85\x6d\x4d\x39\x4c\x21\x2e\x9d\x5c\x49\x8b\x8b\xdf\xf6\xbe\x7c\xd2\x24\x88\x69\xf1\xf6\xea\xf5\x8b\xf3\x77\x1f\x3f\xc7\xb4\x78\x71\x88\x42\xbc\xc7\xe8\x38\x20\xde\x32\x0e\xa7\x8d\xad\xbb\xd3\xc1\x1d\xa3\x84\x0e\xee\xeb\xac\x0a\x4e\x2a\xda\x69\x73\x85\x08\x40\x81\x3e\x46\xd2\x75\x99\x2b\x89\xb0\x75\xf1\xb9\xa3\x04\xe2\x4b\x4a\x8d\xcf\x78\xea\x6f\xec\x5b\x65\x52\xbc\x5d\xa0\x0c\xf1\x17\x77\xc7\xfb\x45\x2d\xda\x2f\x4a\x75\xac\xad\xdc\x1c\xdf\xda\x50\xa3\x32\xf1\x7e\xcc\xdb\xad\xcf\x93\xe9\xf8\xdd\x8b\xc9\x9b\x23\xf1\xd3\xd5\xfe\xa7\x04\xcb\xa5\x17\xd9\xef\x82\xab\x09\x2c\x88\xcb\x79\x83\xda\x1c\x08\x2c\x07\xf0\x7e\xef\x5d\x6b\xff\xb7\xd6\x8b\x81\xf5\xf5\x9b\xfa\xdb\x0a\x93\xb4\x0d\xbe\x91\xad\xcc\xd9\xc7\x4d\x67\x23\xa0\x7e\x10\x5e\x76\x2e\xc7\xde\xb6\x20\x12\x6d\x8a\xe0\xfc\x6a\xc7\xdd\xc5\x2a\x73\x37\x16\x28\x85\x76\x77\xb2\xe9\xef\xec\x5c\x76\x02\xee\xf9\x57\xfd\xc9\x36\x0a\x46\xdb\x22\x18\x4f\xe8\xf9\x86\x3f\x1d\x89\xf3\x7f\xfc\xbf\x7f\xee\xff\x76\x72\xb4\x0b\x3f\x18\x1c\xdb\x9a\x28\x3f\xa6\x15\x88\x9c\xb1\x89\x30\x17\x96\xac\x69\xec\xf5\xcf\xbd\xb7\xa7\xc7\x27\xfb\x47\xf1\xd2\xd1\xe9\x37\x75\xa4\x42\xc2\x47\xb7\x94\x91\x6a\xdf\x9d\x6c\x32\xbe\xd9\xb9\x22\xb3\xce\x36\xc3\x8a\x4b\x53\x7e\xe1\xf5\xb6\xfc\xc9\x58\x9e\x77\x91\x97\xb9\xea\x23\x2e\xb8\xd2\x5c\x84\x84\x63\x98\xfc\xab\x6e\xfd\x3d\x11\x9f\xf8\x7c\x8b\x8a\xcb\x51\x4f\x1c\x86\xaf\xcf\x37\x47\xbf\x45\xaf\xb6\xf7\x50\xe3\xd9\xff\x05\x00\x00\xff\xff\x32\x91\x10\xb5\x5e\xca\x00\x00")
+var _kasFleetManagerYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x7d\x69\x73\xdb\xb8\xb2\xe8\x77\xff\x8a\x7e\x9a\x77\x4b\xf7\x9e\x67\xc9\x92\xb7\x24\xaa\x37\xb7\xca\xd9\x66\x7c\x66\xb2\x8c\xed\x4c\x66\xce\xa9\x29\x19\x22\x21\x09\x31\x45\x28\x00\x68\x47\x99\x77\xff\xfb\x2b\x2c\x24\x41\x12\x5c\x24\x2b\xb6\x93\xf0\xdc\xba\x35\x31\x85\xa5\xd1\x68\x74\x37\x7a\x03\x5d\xe2\x10\x2d\xc9\x08\x0e\xfa\x83\xfe\x00\x7e\x80\x10\x63\x1f\xc4\x9c\x70\x40\x1c\xa6\x84\x71\x01\x01\x09\x31\x08\x0a\x28\x08\xe8\x0d\x70\xba\xc0\x70\xfa\xfc\x05\x97\x9f\xae\x42\x7a\xa3\x5b\xcb\x0e\x21\x98\xe1\xc0\xa7\x5e\xb4\xc0\xa1\xe8\xef\xfc\x00\x27\x41\x00\x38\xf4\x97\x94\x84\x82\x83\x8f\xa7\x24\xc4\x3e\xcc\x31\xc3\x70\x43\x82\x00\x26\x18\x7c\xc2\x3d\x7a\x8d\x19\x9a\x04\x18\x26\x2b\x39\x13\x44\x1c\x33\xde\x87\xd3\x29\x08\xd5\x56\x4e\x60\xa0\xa3\x70\x85\xf1\x52\x43\x92\x8e\xdc\x59\x32\x72\x8d\x04\xee\xec\x02\xf2\xe5\x1a\xf0\x42\x36\x15\x73\x0c\x9d\x05\x0a\xd1\x0c\xfb\x3d\x8e\xd9\x35\xf1\x30\xef\xa1\x25\xe9\x99\xf6\xfd\x15\x5a\x04\x1d\x98\x92\x00\xef\x90\x70\x4a\x47\x3b\x00\x82\x88\x00\x8f\xe0\x17\x34\xbd\x42\x70\xae\x3b\xc1\xcb\x00\x63\x01\xaf\xd4\x50\x6c\x07\xe0\x1a\x33\x4e\x68\x38\x82\x61\xff\xa0\x3f\xd8\x01\xf0\x31\xf7\x18\x59\x0a\xf5\xb1\xa2\xaf\x5e\xcb\x19\xe6\x02\x4e\xde\x9e\x82\xa0\xa0\xe1\x33\x7d\x48\xc8\x05\x0a\x3d\xcc\xfb\x3b\x12\x5e\xcc\xf8\x68\x07\xa0\x07\x11\x0b\x46\x30\x17\x62\xc9\x47\x7b\x7b\x68\x49\xfa\x12\xdb\x7c\x4e\xa6\xa2\xef\xd1\xc5\x0e\x40\x0e\x82\x57\x88\x84\xf0\x9f\x4b\x46\xfd\xc8\x93\x5f\xfe\x0b\xf4\x70\xee\xc1\xb8\x40\x33\x5c\x37\xe4\xb9\x40\x33\x12\xce\x9c\x03\x8d\xf6\xf6\x02\xea\xa1\x60\x4e\xb9\x18\x3d\x1e\x0c\x06\xc5\xee\xc9\xef\x69\xcf\xbd\x62\x2b\x2f\x62\x0c\x87\x02\x7c\xba\x40\x24\xdc\x59\x22\x31\x57\x18\x90\x60\xee\x5d\x49\x14\xf1\xf1\x62\xb6\x10\x7b\xd7\x43\xf9\x19\x60\x86\x85\xfe\x07\x48\x02\x64\x48\x0e\x73\xea\x8f\xe4\xf7\xdf\xf5\x1e\xbd\xc2\x02\xf9\x48\xa0\x1d\x00\x00\x00\x86\xf9\x92\x86\x1c\xf3\xb8\x1b\x40\x67\x7f\x30\xe8\xa4\x7f\x02\x78\x34\x14\x38\x14\xf6\x27\x00\xb4\x5c\x06\xc4\x53\x13\xec\x7d\xe0\x34\xcc\xfe\x0a\xc0\xbd\x39\x5e\xa0\xfc\x57\x80\xff\xcd\xf0\x74\x04\xdd\x1f\xf6\x3c\xba\x58\xd2\x10\x87\x82\xef\xe9\xb6\x7c\x2f\x07\x62\xd7\xea\x9c\x41\x8b\x69\x07\x8b\xec\x5a\x78\xb4\x58\x20\xb6\x1a\xc1\x19\x16\x11\x0b\xb9\x22\xf8\xeb\x7c\x5b\x37\xfa\xf6\x30\x63\x94\xf1\xbd\xbf\x89\xff\x3f\xb5\xa8\x7c\x21\xdb\x3e\x5d\x9d\xfa\x0f\x11\x89\x0a\xb8\x52\xd4\xfd\x84\x05\xa8\xa5\x4a\xe6\x72\xea\x57\x61\x2e\x69\x46\xe2\x66\x02\xcd\xac\x25\xf6\x74\x0b\x6e\x3e\x2c\x11\x43\x0b\x2c\xcc\x19\x8d\x9b\x68\x48\x3b\x19\x48\xd3\x96\x7b\xc4\xef\x54\x6f\x48\xb3\xbd\xe0\x0f\x76\x23\x7e\x25\x5c\x94\x6e\x86\xfc\x11\xe8\x14\x96\x94\x73\x22\x19\x7e\x06\xa1\xce\x4d\x09\xf2\x5d\x24\xdb\xcc\x74\x2b\xd9\xa4\x12\x2c\xeb\x3f\x9b\x91\xbd\xe2\xc9\x0f\x95\xec\x15\x70\x67\xf8\x63\x84\xb3\x08\x07\x00\xc0\x9f\xd0\x62\x19\xd8\x70\xc6\xff\xb3\x7b\xfd\x84\xc5\x99\x59\xd1\x0b\xdd\xa1\xd8\xde\x0d\x43\x3c\x7e\x06\x08\x33\x46\xb7\xe9\x9c\xef\x89\x98\xbf\x44\x24\xc0\xfe\x33\x86\x15\x6e\xce\x05\x12\x11\xdf\x06\x2c\x15\xe3\x96\x12\xa7\xea\x0f\x4c\x0f\x00\x53\x1a\x85\xbe\xe2\x19\xcf\xd3\xcd\x3e\x1c\x0c\x1f\x08\x8f\xab\xde\xe5\xc3\xc1\x70\x53\x2c\xa6\x5d\x4b\x11\x75\x12\x89\x39\x08\x7a\x85\x43\x90\xda\x5f\x78\x8d\x02\xe2\xdb\x48\x3a\xf8\x4a\x90\x74\xb0\x39\x92\x0e\xea\x90\xf4\x8e\x63\x06\x21\x15\x80\x22\x31\xa7\x8c\x7c\xd6\xda\x2b\xf2\x3c\xcc\x35\x67\x33\x0a\xa9\x8d\xb8\xc3\xaf\x04\x71\x87\x9b\x23\xee\xb0\x0e\x71\xaf\x69\xee\x24\xde\x10\x31\x07\xbe\xc4\x1e\x99\x12\xec\xc3\xe9\x73\xc0\x9f\x08\x17\x3c\x45\xdc\xd1\x83\x51\x3d\xaa\x11\x77\x34\x18\x6c\x8a\xb8\xb4\x6b\x39\xc5\x85\xf8\xd3\x12\x7b\x02\xfb\x5a\x04\x02\xf5\x94\x3a\x9d\xe8\x3c\xd8\x8b\x18\x11\x2b\x5b\x56\x3e\xc5\x88\x61\x36\x82\x7f\xc3\x5f\x65\x42\x18\xe5\xb6\x23\x65\x89\x3e\x0e\xb0\xc0\x4e\xe1\xa9\x7f\xca\xcb\x4f\xb7\xc6\x44\xc2\x11\x7c\x8c\x30\x5b\x25\xdf\x00\x42\xb4\xc0\x23\x40\x7c\x15\x7a\x65\xcb\x7d\x8b\xd9\x94\xb2\x85\x3a\x4a\x48\x5d\x72\x80\x84\x80\x42\xdd\x6b\xce\x68\x48\x23\x0e\x0b\x14\x86\x98\xed\x54\x6f\xb3\x58\x2d\xf1\x08\x26\x94\x06\x18\x85\xd6\x2f\x72\xc9\x84\x61\x7f\x04\x82\x45\xb8\x52\x09\xd8\x7f\x78\x04\x98\x1f\xe9\x87\xd7\x14\x9e\x69\xc0\xca\x70\xfa\x5c\x6d\x5b\x86\x97\x0f\xbe\x12\x96\x34\x50\xb0\x13\x1a\x6e\xce\x9a\xf2\x43\x94\x5f\xc7\xa4\xc0\x53\xeb\x35\xca\x66\xfe\xa8\xb5\xaa\x42\xab\x2a\xb4\xaa\x82\x56\x15\x34\x4f\xb9\x85\xc2\x90\x19\xe0\x3b\x55\x1b\x6e\x87\xc4\xfc\x00\x9b\xab\x10\xb1\x72\xa0\x87\xab\x52\x0e\x9a\xe9\x1b\x4b\x24\xbc\xf9\x28\x3f\xfa\xbb\xa5\x8f\x04\x06\x94\x33\x8a\x66\x4c\x33\x4d\x46\xcf\x29\x25\x91\x1a\xb6\x78\xa9\x57\xa0\x3f\xa5\xbe\x35\x56\x16\x2b\xaa\x1f\xd0\x9b\x10\x33\xa0\x53\x50\x26\x84\x9d\x0a\xaa\xa9\xa6\x19\x37\xc5\xd4\x5e\xf5\x35\x14\x85\x0b\xff\x1a\x3a\x4a\x96\xda\x1d\x77\x5f\x8d\xa0\xfc\xad\xf7\xab\xb2\x69\xbc\xa5\xfc\xcb\x1a\x35\x3a\x87\x55\x78\x7c\x8a\xfc\x98\xa0\xbe\x02\xc6\xf2\x8a\x70\x4e\xc2\xd9\xdb\x58\x2d\xbf\x85\xea\x54\x32\x54\xb7\x5c\x21\x5a\x43\x4f\xf8\x9a\xb5\x27\x58\x4b\x7d\x2a\x68\x44\x45\x45\x81\x70\x5b\x57\xe0\xb5\xba\xc2\x77\xa3\x55\x15\x94\x22\xb7\x7e\xa0\x0d\x7b\x4a\x3b\x50\xe8\xb2\x34\x84\xef\xcf\xf6\x52\xd0\x81\xd6\x52\x07\xe0\xfb\xb0\xb5\x14\xcd\x16\x8d\xdc\x3c\x55\xfe\x07\x3d\xd0\x52\xba\x4b\x5d\x9a\x8a\xc7\x70\xac\xa9\x7c\x5b\xa6\x93\x3a\x55\x4b\x2d\x19\x2c\x17\xe7\xdd\xe9\x57\xb1\x02\x81\x56\x01\x45\x7e\x96\xd0\xca\xc8\xec\xdd\xf9\x19\x9e\x91\x3c\x00\xb5\x04\x16\x77\x2b\xf1\x98\xbc\x78\xb7\xd1\xa8\x2f\xde\x95\x8c\xfa\xf0\xcd\x58\x5f\x81\xde\x97\x57\x58\x3c\x0f\x2f\xbf\x56\x53\x59\xec\x17\xbb\x85\xbe\x97\x1b\xa2\x35\x95\xb5\xa6\xb2\x2f\xa2\xd4\x59\xc3\xbe\x42\x9f\x4e\x64\x18\x1a\xf6\x4f\x8d\x41\xe0\x0c\x23\x6f\x8e\xfd\x5b\xcc\x57\x37\xa6\x13\x90\x0b\xcc\x16\xfc\x35\x15\x31\x0f\xb8\xc5\xfc\x25\x43\x55\x9b\x0a\xa7\x94\x4d\x88\xef\xe3\x10\x30\x91\x01\x72\x30\xc1\x1e\x8a\x38\x56\xf2\x3c\x2a\xde\x11\x4a\xed\x89\x40\xb3\x7d\x17\xe8\x13\x59\x44\x0b\x08\xa3\xc5\x44\x9b\x3a\x92\x78\x34\x10\x73\x24\xc0\x43\x21\x4c\xb0\x51\x4f\x94\x9d\x40\x05\x00\xaa\x39\xe7\x88\xc3\x04\xe3\x10\x98\xc6\x60\xbf\x75\x6c\x66\xf7\xee\x62\x8e\x63\x0d\x08\xfb\xc0\x30\xa7\x11\xf3\x30\xf8\x14\xf3\xb0\x2b\xb4\x75\xd2\xc6\xd9\x93\xaf\x04\x67\x4f\x5e\xa3\x05\x7e\x46\xc3\x69\x40\x3c\xb1\x39\xfe\x5c\xc3\x94\x33\x4b\xf0\x4c\xcb\x94\xee\x7c\x2c\xf4\x5d\x85\x84\x8a\x9a\x3d\x23\xa2\x80\x4e\x35\x99\xc6\x28\x6f\x1d\xc7\x39\x64\x86\x10\x95\xdd\xf4\xe0\x66\x4e\x82\x18\x97\xe1\x4c\x21\x36\x63\xf2\xdd\xcc\xb9\xac\xd4\x87\xa2\xfd\x38\x1f\x90\xe5\x70\x46\xc7\xf1\x60\x99\x7e\xbc\x2a\x80\x8b\xaf\x05\xe2\xda\xa6\xd3\x93\x6a\x90\xee\x4d\x8f\xce\x06\xe2\x7d\x4b\x66\xcb\x53\xad\x1b\xfd\x26\x2f\xbe\xb7\x50\x61\x1d\xc3\xb4\xe6\xca\xdb\x59\x2b\x5b\xff\x6d\x43\xff\x6d\x6b\x76\x6b\x22\xa9\xaa\x22\xac\xbb\x65\xa6\xb7\x25\x9a\xe1\x6e\xf3\xe6\x9c\x7c\x5e\xa7\x39\x65\x3e\x66\x4f\x57\xeb\x4c\x80\x11\xf3\xe6\xdd\x12\x73\xa0\x17\xd0\xc8\x1f\x2f\x19\xbd\x26\x3e\x76\x44\x7f\x57\xc6\x44\xf3\x68\xb9\xa4\x4c\xd2\x89\x1a\x06\x92\x61\x4a\xc4\xe1\x33\xd9\xea\x6d\xae\xd1\xc6\x62\xb1\xbb\x3f\x18\x74\x4b\x89\x58\xc3\x8b\xfd\xc6\xc0\xde\x29\x55\x67\x30\x91\x95\x94\xdd\xc3\xc1\xb0\xdb\x72\xfe\x6a\xce\xdf\x3d\xaa\xda\xfb\x96\x81\xdd\x03\x03\x6b\xc0\x5d\x54\xd6\xc3\x1e\x53\x56\xe2\x8d\x59\x8d\xe9\xae\x6f\x55\xb8\xf4\x58\x37\x61\x41\xda\x5e\xfd\x50\x18\x51\xbc\xb2\x7b\xe3\x47\x1a\x1d\x2d\x37\x6a\xb9\xd1\xdd\x73\xa3\x1a\x4f\xe6\x83\xd0\xbd\x62\x63\xe4\x58\x3a\x1d\xcb\x58\x9e\x51\xb5\xc7\xc8\xf3\x68\x14\x8a\x22\x9b\x5b\xd7\x93\xea\x05\x04\x87\x62\x9c\x39\x57\xa9\x47\x6d\x8a\x02\x8e\x1b\xf9\x48\xb9\x60\x24\x9c\x95\x11\x69\x32\x4b\xc2\x57\xf5\x3a\xc0\xac\x03\x04\x85\x09\x06\x86\x05\x23\xf8\x1a\xfb\x6b\x70\xc3\x3b\xa3\x6d\x93\xf0\x7c\xa2\x21\xae\x4c\x04\x2c\x32\xe5\xec\x72\x79\x39\x03\x6c\xbd\x43\x45\x4e\xdf\x3d\x1c\x1c\x74\xdb\x8b\xf8\xfa\x17\xf1\x82\xe4\x68\x53\x87\x36\x4f\x1d\xca\x27\xe2\xc6\xbd\x4a\x54\xc1\x2c\xbb\xe0\xf5\x26\x5f\x27\x8f\xb0\x63\x68\xea\xe3\x4b\xce\xb3\x43\x14\x8c\x9e\x77\x10\x6c\x92\x5d\xb6\x33\xe8\xa1\x8c\x0c\x38\x5a\x33\x24\xc4\x39\xd7\xc6\xf1\x21\x0f\x45\xb2\x34\x3f\x35\x86\x62\xcc\x6e\xaf\x7d\x72\xb2\xd3\xd6\x1d\xa2\x3c\x6d\x19\x2f\x69\x2b\xc9\x5a\x49\xb6\xb6\x24\xfb\xb5\x56\x2d\x6a\x05\xd7\xf6\x04\x97\x23\xf6\x32\x7b\xf4\x9b\x09\x38\x87\x77\x33\xb7\x7f\x0d\xef\x2c\xee\xea\x14\xb7\xbc\xbe\x7d\x1b\x0c\x1d\xdd\x92\x89\xcb\xc4\x9f\x3a\xa2\x4a\x35\x8f\xdc\xf6\xad\x9d\xde\x54\xa7\xf4\x58\x69\x48\x4d\x69\x2b\xb9\x39\x95\xc3\x96\xb4\x95\xb5\x6f\x1c\xcd\x0c\xbb\x2d\x94\xc9\x71\x5d\x3b\x93\x38\xf9\x19\xb9\xc6\x61\xda\xd5\xce\xfc\xfe\x22\x84\x79\xd8\x7d\x80\xc5\x84\xf2\xf9\xd1\xad\x48\xff\xb6\x44\xfa\xf0\xdb\xbd\x9c\xc2\xdf\xf0\x3f\xdf\xae\xd0\xd6\x0c\xe9\xd6\xcc\x35\x4d\x6b\x2d\xe3\xae\x8d\xc5\xf7\x1e\xc3\x1c\x8b\xb1\xc7\xb0\x8f\x43\x41\x50\xe0\xc8\xf9\x68\x25\x3a\x00\x47\x3d\x85\xa9\x2f\x7c\x39\x3b\x93\x73\x80\xb5\x1b\x2d\x0f\x6f\x79\x78\xcb\xc3\x1f\x12\x0f\x57\x6c\x20\x7b\xaa\x9f\x31\xec\xf3\xb5\x15\x64\x8e\x05\x8f\x23\x80\xe3\xe3\x0e\x53\xca\x2a\xd8\xfa\x0f\xf2\xff\x65\x84\x36\xc7\x80\x58\x1a\x49\xdf\x9b\x22\x4f\x86\xbd\x32\x1c\x20\xb5\xd6\xb8\xe4\xac\xe9\x53\x53\x61\x70\x6f\x21\xfd\x35\x1e\xdf\x53\xae\xa5\x31\x43\xe1\x0c\xd7\xfb\xdd\x4d\x27\xa3\x7b\x93\x05\xe6\x98\x11\xcc\x41\x75\xd7\x5e\x2a\x09\xb8\x0e\x37\x3d\x7d\x6e\x86\xc9\xdf\x34\x5e\xe9\x51\x9e\xae\xce\x64\xb7\xdf\x2c\xdf\xd6\x97\x76\xb4\xff\xf3\xfc\xcd\x6b\x40\x8c\xa1\x15\xd0\x29\xbc\x65\x74\x81\xc5\x1c\x47\xe9\xc2\xe8\xe4\x03\xf6\x04\x87\x29\xa3\x0b\xa0\x13\xb9\x29\x48\x50\x46\xa2\xc5\x7d\x70\x18\x83\xa8\x14\x4d\xad\x07\xbe\xf5\xc0\x7f\x19\x36\xba\x35\x0f\x7c\x69\x63\x3f\xd2\x4c\x60\x8d\x2e\x24\x14\xf2\x00\x06\x6b\x74\x99\x92\x40\xfe\xb7\xb3\x2e\x07\x5c\x93\xf7\x69\x87\xbf\x58\x9f\xe5\xe9\x64\x2e\xd1\x32\xbd\x3a\xa6\x67\x23\xaa\x65\x7b\x2d\xdb\xfb\x5a\xd9\xde\x06\x0c\x69\x8a\x7d\xc9\x3d\x1a\xe8\x63\x28\x08\x92\x53\x4c\x42\xe0\x1e\x43\x4b\xac\xde\x2b\x90\x55\x14\x90\x00\xad\x5b\x6a\x0b\xa9\x9a\x0a\x88\xef\x62\x51\xf1\x94\xe6\xf0\xdd\x11\x67\xd2\x4c\xd3\x5a\x00\xb2\xd9\x93\xc0\x9f\x84\x59\x47\x1d\x59\xca\xa6\x7b\xcb\x00\x91\xc6\x04\xe9\x8c\x7c\xea\x1e\x56\x81\xdd\x56\x50\x2a\xab\xa0\xd4\x72\xe4\x26\x1c\xf9\x70\x70\x58\x8e\x24\x53\x4f\xce\x87\x90\x9a\x0a\xdf\xdf\x5f\x56\x72\x2b\xb3\xbe\xb4\xcc\xaa\x93\x40\x8c\x06\x78\x3c\x21\xa1\x4f\xc2\x59\xc3\x30\x7c\xd9\x05\xe2\x2e\x30\x63\x28\x54\xd9\xb8\xea\xa1\x9c\xc4\xfc\x44\x0b\x15\x13\xab\xd2\x62\xcf\x68\x80\x9f\x9a\x11\xef\x48\x12\x15\x57\x62\x82\x5d\x9d\x50\xdf\x29\xa9\x59\xd8\x68\xf5\xe0\x4d\xb8\xee\x41\x77\xb4\x3d\xab\xe9\x77\x13\xf6\x53\x2d\xae\xea\x6a\xda\x12\x3f\x5f\xd3\xb6\x95\x62\xad\x14\x4b\x11\x65\xfb\x1a\xd3\xa0\x10\x29\x3c\x38\x20\x25\x3c\x00\x69\x86\x4c\xc3\x32\xd9\x91\x8d\x12\x89\xfb\x2e\x70\x5c\x21\x46\x1e\x5f\xca\x66\x28\x24\x5c\xa1\x4d\x96\xc9\xcd\x16\x8a\x88\x07\x54\x9f\xae\x09\xbe\xc1\x6c\x17\xb0\x4f\x84\xdc\x17\x06\xc8\x5f\x90\x30\x81\x82\x88\x3e\x9c\xe8\xbf\x96\x0c\x5f\x13\x1a\xf1\x60\xa5\x05\x9e\xe6\x1a\x76\x8d\x1b\x86\x97\x01\xf2\xb0\xdf\x87\x37\x61\xb0\xd2\x23\x95\xc8\x14\x40\x0c\xeb\x57\xec\xf4\x30\x6a\x44\x35\x0f\xef\xd7\x54\xe0\xb3\x44\xc3\x9a\x72\xb2\x2e\x1a\xf9\xcc\x92\x85\x77\x5e\xf4\xce\x5a\xd6\x5a\x41\xc8\xc5\x7e\xa5\xe4\x5a\x49\xac\xa5\xe3\x6c\x14\x98\x5c\x21\xa3\xe5\x44\x31\x05\xdd\xb3\x6a\xd1\x9c\x0b\x58\x9d\x36\xe5\x06\xc5\x21\xba\x0d\xef\xe0\x0d\x8a\xb6\xdd\x1f\xff\x6c\x15\xb2\x2f\xa5\x90\x59\x3c\x11\x68\xf8\x40\x14\xf3\x56\x3f\x6b\xf5\xb3\x6f\x56\x3f\xdb\x7e\x61\x61\x87\x85\x61\xef\x6f\xf3\xaf\x71\x12\x55\x9e\x8d\xdf\xb5\x2c\x0e\xd7\xf4\x0a\xf3\x58\x29\x34\x32\xb3\x42\x39\x2c\x7b\xf3\x69\x73\x95\xa9\x3e\x14\xb8\x28\xdb\x99\x02\xbb\x95\x4d\xdf\xb2\x6c\xd2\x7b\xdc\x0a\xa7\x5b\x08\x27\xca\x32\xc6\xb7\x56\x58\xb5\xc2\xea\x4e\x84\x55\xa3\xa6\xa9\x88\x6a\x22\xdf\xbc\x88\x0b\xba\x18\xeb\x57\xc2\x1b\x9a\xd0\x75\x1f\xf3\xb2\xb8\xb2\x12\xac\x63\x2d\x7f\xa6\x7a\x3f\xd7\x9d\xef\xd0\x5c\x5e\x84\xfa\x61\x70\x3e\x1b\x1f\xad\xc1\xbc\x35\x98\xb7\x17\xb2\x56\xc6\x7d\xa3\x06\x73\x59\x96\x8b\x0b\xe5\x68\xcd\xb2\x63\x13\xf7\x53\x6f\x36\xb7\x47\x30\x5d\x0d\x23\xd7\xe3\x61\xe6\xe0\xea\xda\xc0\x8d\xbc\xb9\x8a\x34\x42\xa2\x2f\xc3\xd3\xf5\x23\x74\x7c\x4e\x96\xf1\x08\x66\x3c\xc2\xe1\x1a\x33\x4d\xe5\x49\xce\xe6\xc5\x1f\x17\xc0\xb0\x47\x99\xae\xb3\x93\x96\xc6\x7e\xfe\xfa\xdc\xfc\xc0\xb3\x90\x98\xe1\xa4\x0d\xde\xc3\x4c\x90\xa9\xdc\x5b\x05\x0a\xe1\x3c\xc2\xbe\x5a\xb2\x35\x6d\x14\x06\x98\x2b\xc4\x58\xad\x51\xe8\xc3\x92\x91\x6b\xf9\xef\x2b\xbc\x02\xc4\x70\x5c\x64\x2d\x36\xd5\x6b\xf3\x7f\x43\x5b\x3d\x33\xe8\xcb\x09\xc3\x3a\xbb\xbd\x2d\xa2\xb6\x6c\xb8\x7f\x66\x03\x72\xe7\x96\x7b\x7b\x61\x6b\x99\xee\x1d\x1d\x37\xb3\xdd\x97\x0f\xb4\x6d\xe3\x7d\x16\xd3\x31\x25\xdc\x0f\x5f\xb2\x57\xdd\x9c\x3d\xd9\xbd\x36\xe5\x53\x8e\x31\x5a\x5b\x7e\xab\x2b\x56\xda\x4b\x9c\x3c\x33\xe1\xdf\xad\xed\xa4\xd5\x23\x37\xd3\x23\x0f\x07\x4f\xca\x11\x77\x91\xd1\x48\x50\xc0\x30\xf2\x57\x16\xdb\x06\x27\xf9\x25\x0d\xe7\xa8\xa0\x64\x3d\x04\x2e\xd5\xaa\xce\xf7\xe8\xcb\xc8\xda\x7a\xf6\xfe\xce\xfc\x3d\x76\x15\xca\x29\x06\xf0\x67\x49\xea\x36\xb6\x1f\xab\xa8\xc0\x9d\x9b\x7f\x5a\x9d\xa7\x55\x1c\x5a\x23\xd3\x83\x74\xac\x64\xce\x69\xab\x2d\xb4\xa2\x73\x1d\xd1\x59\xe2\x8d\x4f\x8b\xd1\x34\x95\x5e\x05\x55\xac\x68\x51\x0a\x29\x04\x34\x9c\x61\x96\xb1\x2d\xb9\x2c\x40\xeb\xdb\x69\xf4\x3a\x9a\x58\x69\xac\x50\x81\x5b\x58\x69\xd6\x8c\x15\xc8\x19\x6d\x72\xa5\xbc\xda\x4b\xf0\xb7\x27\xcb\x9c\x04\xf9\x70\x7c\x68\xad\x90\x6b\x85\x5c\x7b\x3f\xdc\x4a\xf8\x40\xfe\x52\xd8\xe4\x62\x89\x02\xcc\xc4\x98\x45\xc9\xca\xeb\x22\x08\x54\x07\x50\x1d\xd6\xbd\x42\x9e\xc8\xae\x67\xb2\xe7\x1d\x5e\x1e\x73\xf0\x3e\x0c\xa6\x97\x60\xa2\x8d\x1a\x68\x2f\x74\xad\xb5\xb7\x15\x6d\xdf\x68\xd4\x40\x52\x03\x3c\xb4\xf8\x70\xe3\x88\x81\xa4\xb7\xee\x46\x43\x88\x2b\x78\xb8\x39\xf9\x2e\x20\x0e\x2c\x66\xfe\xea\x5d\x76\x6c\x3a\x70\x53\x3e\x29\x2e\x5d\xa7\xe3\x08\xd4\xb0\x84\x03\xbe\x46\x41\xa4\x4a\xdb\x2d\x31\x23\xd4\x27\x1e\x0a\x64\x2a\xdd\x4c\xaa\xca\xfa\x5e\x18\x48\x48\x04\xc8\x86\x38\x9e\x5d\x0f\xbd\xab\x5c\xfd\x44\xa8\xd7\xe6\xb5\xf7\x9f\xd0\x10\x04\x62\x33\x2c\xcc\x23\xf4\xfa\x08\x4d\x56\x80\x17\x88\x04\x40\x19\xdc\xe0\xc9\x9c\xd2\x2b\xb8\x99\x63\xf3\x50\x37\x0d\x7d\xa2\x7a\xce\x11\x87\x39\x0e\xac\x30\x03\x53\xce\x2a\x9e\x57\x81\x2d\x27\x55\x00\x02\x55\xb7\x5a\x61\xdd\x6a\xe7\x34\xf0\xf9\x26\x97\x57\x1d\x38\x60\xcb\xcc\xba\xf8\x82\x44\x92\x6d\x39\xb8\xe0\x24\x01\xe1\xce\x23\x0b\x92\x25\xad\x15\x56\x90\xef\xb5\x59\x4c\x41\xc9\x28\xdb\x0e\x28\xb0\xb0\x9b\x7f\xa0\xe3\x3e\xb4\xa0\xe6\x0c\x2b\xe9\xb2\x29\xdb\xca\x0f\xd0\x46\x10\xb4\x7a\x63\x85\xde\x58\x64\x88\x6d\xf0\x40\xab\x4e\xb6\xea\xe4\xd7\xeb\x49\xb7\x0c\x1e\x7b\x7f\xa7\x7f\x34\xf4\xa1\x67\xf4\xd8\x4d\xcd\x1f\x77\xec\x3e\x4f\x21\x6e\x85\x7c\x2b\x29\x5b\x0b\xcb\x83\x11\x89\x94\x59\x67\xb3\x15\x90\xad\x80\xdc\xa6\xbf\xbc\xa1\xa4\x2a\x78\xcb\xad\x5e\x19\x4f\x79\x62\x23\xd9\x05\x22\xb8\x6e\xc6\x01\x31\x0c\x57\x78\x29\xe2\x9c\x0a\xf5\x19\xe6\x84\x0b\xca\x56\xb7\x70\x9f\xd7\x59\x20\x2c\xdf\xf9\xa6\x16\x88\x35\x1d\xe7\xb6\x41\xa2\xf5\x9a\x7f\x2f\x5e\xf3\x07\xe9\x3d\x6a\x85\x5c\x2b\xe4\xda\x5b\xe0\xed\xfd\xe5\x99\xdb\x5f\xe3\xbb\xe3\x5a\x7e\x72\x23\x09\x9b\x0a\xe0\xc2\x00\x1c\xa6\xd2\xda\xab\xac\xfd\x0c\x73\x1a\x5c\xa7\x9e\x8d\x5a\xde\xb4\x0b\x0b\xca\x05\x30\xec\xe1\x50\xc8\x81\xb8\xe8\xd7\x5e\x50\xd7\xf3\xcd\x57\x15\x08\xee\x96\xe1\x7d\x89\x66\xb8\xdb\xbc\x39\x27\x9f\x71\xb7\x52\x6c\xaf\x75\x13\x7e\x68\x61\x00\x85\x10\x80\x8a\xd5\x9c\x6a\x19\x0e\x4b\x34\x23\xe1\xcc\xc2\x7e\xab\x8b\xb4\x57\xeb\x56\xeb\x68\xad\xcd\xad\x9e\x71\xb7\x7a\x86\x4b\x63\xf8\x82\x95\xed\x83\xc0\x21\xb9\xb8\xab\x02\x71\x89\x9c\xbf\xa7\x7a\xf7\x36\x64\x70\x43\xfc\xdc\x8a\xdb\x5a\xf7\xad\x1c\xfb\x5e\xe4\x58\xcb\x8e\xb7\x5d\xb2\xbd\x84\x29\xca\x2b\x93\x47\xc3\x10\x7b\xb6\xf5\xd1\xc1\x23\xd7\x2e\xee\xde\xa4\x84\xbb\x84\x8a\x08\x5e\x03\xd5\xe6\x65\xde\x33\x00\xa9\xa9\xb9\xbb\xba\xbb\x9b\xf5\x56\xc5\x75\xb5\xa5\xde\x33\xff\x6b\x4b\xbd\x6f\xa3\xd4\x7b\x1b\x5d\xd5\x2a\x04\x25\xb5\xd6\x4b\x38\x54\xab\x1b\xb4\xba\x41\x41\x37\xa8\xbd\x6f\x6d\x50\xe7\x3b\x2c\xa1\xc0\x72\xbf\x63\x5b\xd9\xbb\xe5\x84\x5f\xa4\xb2\x77\xcb\x0a\x53\x24\x56\x9b\xfb\xda\x22\xde\xad\xb5\x6f\xe3\xeb\xe5\xfa\xd6\xbe\xfa\xca\xdc\x1f\x23\x2a\x50\xc3\x22\xdc\x68\x89\x3c\x22\x56\x10\x85\x44\x38\x6f\xa6\xa0\x46\xe3\xf1\x6d\x6e\x57\xee\x0a\x8f\x16\x89\x23\x50\x26\xf8\x2a\x07\x10\x66\xca\x2d\x18\x31\xb7\x3f\xf1\xbf\x7b\xe6\x2b\xe8\x8b\x28\x8f\x07\x72\xc1\xe1\xd1\x70\x4a\x66\x11\x4b\x0b\x9c\x12\x66\xbd\x5b\xb6\x5a\xe2\xfc\x9d\x3a\x1e\x8c\x86\x58\x8d\x01\xb2\x9a\x46\x3f\x99\x52\xa5\x58\x19\xe8\x78\x71\x8d\x73\xc4\x21\xa4\x7a\xa9\x6a\x46\xc4\xe4\x30\x01\x59\x10\x15\x66\x74\x33\x47\x02\x5f\x9b\xb2\xb0\xba\x95\x04\xa2\xcc\x85\xf9\x9b\x6c\xf1\x8e\xa3\x19\xbe\x43\xc3\xa6\x06\x2b\x92\xb3\x56\x58\x18\xee\xf4\x5c\xa5\x78\x68\xed\x9a\xad\x5d\xb3\xbd\xbb\x3c\x10\xc1\xb3\x93\x36\x90\xe3\x18\x60\xe4\x3f\x01\xde\x4c\x3e\x60\x4f\x9c\xe1\x29\x66\x38\xf4\x92\x79\xf4\xc3\xf1\x54\xfd\x68\x3e\x2d\x99\x64\x7b\x82\xd8\x80\x12\x3f\xfd\x77\xc9\x6b\xf3\x57\x24\xac\x6f\x34\x97\x4b\xa9\x6a\x24\xf9\xc9\x68\x27\x67\xaa\xb2\x98\xab\x9c\xc5\xfa\x73\x99\xb2\x62\xf9\xa7\x0c\xe3\xb0\xfe\x14\x54\xa0\xc0\xfa\x9b\x08\xbc\xe0\xeb\x2d\xbc\xd1\xaa\x24\x14\xc5\x46\x24\x14\x78\x86\x59\xf2\x5d\x02\x57\xdf\x4a\xc1\x5c\xdf\x4c\x2d\xa5\xd8\x0c\x31\x86\x56\xd6\xd7\x42\x33\x70\x6a\x21\x31\xd9\xe6\x88\xa4\xb3\x03\x00\xa0\x68\x39\x1e\x03\x05\xc1\x9b\x69\xdd\x43\xdd\x95\xc3\x99\xad\x29\xa2\xbf\x6c\x0b\x00\x00\x3c\xea\x17\x8e\x86\x73\x2b\x00\x00\x18\x46\x8e\x63\x5c\xda\x3c\x11\xf2\xe3\x2c\x95\x3b\x3b\x29\x64\xd8\x44\xba\x16\x42\x64\xc7\x5b\x60\xc1\xb1\x9b\x65\x1b\x5f\xda\xbc\x9a\x00\xd4\xf2\x3a\x3b\x89\x32\x67\xac\xc8\x77\xb4\xfb\xc5\x03\xaf\x9b\x33\x2c\x85\x21\x0e\x85\x61\xd3\x63\x1c\xca\x7a\x6a\x7e\xae\xd9\x22\x0a\x04\x19\xa3\xcf\x0d\x30\xc9\x05\x12\x51\xee\x5b\x4e\x9e\x74\x7e\x97\x79\xf8\x7c\x04\xff\x96\xb2\x77\xa9\xd4\xc5\x25\xc3\x4b\x24\x69\x61\x57\xd7\xd9\xe7\x84\x86\xea\x2f\x55\x55\x77\x17\xa6\x88\x04\xb2\x9d\x8f\x93\x9f\x77\xb5\x3d\x87\x84\xb3\xbf\xa0\xd3\x94\x24\xbd\x80\x46\xfe\xd8\x94\xf2\x67\xd5\x60\xbe\x46\x0b\xa5\x17\x3e\x93\x7d\x20\xe2\x71\x6c\xf1\x32\xa0\xab\x3e\xbc\xa4\x2c\x96\x3b\x70\xf2\xfe\xbc\x31\x04\x31\x2e\xdd\xd4\x36\xa1\x34\xc0\x28\xcc\x9d\xba\x19\xa1\x61\x35\xac\x1a\xa5\x70\x43\x82\x00\x26\xd8\x74\x51\xb7\x13\x73\xb3\xf6\xf4\xd2\xe3\x57\x0c\x58\x66\x01\x23\x88\x78\x0f\x23\x2e\x7a\x43\xa5\xcb\xaf\xb3\x1e\xf5\x9c\x43\x63\x96\x10\xa2\x45\x73\x76\x33\xa1\x54\x70\xc1\xd0\x72\x2c\x35\x33\xcc\xc6\x73\xcb\xb5\x58\xbf\xd5\xba\xa0\x52\xa3\x41\x32\xc8\xec\x8a\x39\x4e\xe7\x06\xdd\x0d\x64\xb7\x92\x2c\x08\x12\x82\xba\x82\xd9\xc5\xc5\x76\x75\x65\x87\x42\x55\x42\xf3\x26\x86\xbf\xea\x36\x5e\x87\xce\xf1\x1f\xa3\x02\xd4\x53\xca\x16\x48\x8c\xc0\x47\x02\xf7\x04\x59\xe0\xa6\x43\x46\x4b\x7f\xdb\x43\xea\x03\x3a\x5e\x53\x42\x5c\x63\xc6\xc9\x1a\xed\x63\x94\x8f\x55\x8b\x35\xc4\x96\x93\xcb\x35\x3f\x82\xca\x5c\x30\x96\xd1\xc0\x68\x86\xc7\x79\x7d\xa3\x62\x72\xec\x52\x3e\x5d\xbc\x3d\x51\x3b\x6d\xe9\x60\xf4\xcf\xa2\xd8\xf8\xd2\x72\xd2\x09\xb6\xd2\xd8\xa0\x93\x87\xa3\x93\x69\xa4\x34\x36\xe8\x0c\xb3\x5f\x15\xc6\x0a\x5f\xb5\x46\x56\xf8\x2c\xa5\x6b\x13\xdf\x70\x15\xca\xba\x77\x26\xf4\x73\xe8\x07\x68\xb6\x11\x36\xcc\x7a\xf9\xbf\xeb\xb3\xf0\x0a\x0b\x24\xbd\xeb\x77\xa4\x19\x54\xed\xf4\xc9\xdb\x53\x03\x54\x6e\x83\xe4\x8f\xd7\xb9\x5d\x9b\x6b\xb0\x1c\xd6\xb5\x4e\x4e\xe1\x0c\x02\xec\xc9\x63\x58\x40\x66\x4f\x8f\xac\x7b\x77\x72\x3f\x56\xcd\xb0\x57\xd6\xc5\x26\xd9\x3c\xad\x96\x6b\xc4\xa5\x00\xde\x15\x71\x38\xb7\xd1\xa6\x98\xb7\x68\x15\x50\x94\x30\xb0\x8c\xf8\x3a\x57\x83\xc4\x56\xc0\x24\xa8\x76\x42\xfd\x15\x70\x1c\x0a\x10\x14\x0c\xc2\xe0\xed\x9b\xf3\x8b\x8a\x3b\xa1\x94\xd6\xeb\xdd\xea\xca\xf5\xab\x42\xae\x62\x56\x1f\x91\xb5\x94\x18\xb6\xa4\xab\x17\x44\x5c\x60\x96\xa8\x34\x46\x0c\x42\xa6\x88\xbc\x93\xeb\xba\x34\xac\x2c\x86\xb0\x00\x31\x27\x1c\x04\x55\xb1\x1a\xf2\xbf\x89\xfd\xd4\x01\x82\xa0\x12\x00\x35\xec\xc9\xbf\x76\xea\xe4\x45\x5e\xc5\xc9\xea\x16\x72\xe5\xa1\x51\x2c\x0b\x33\xf5\xe1\x54\xc0\x22\xe2\x42\x82\xc3\x89\x56\x38\xa4\x11\x99\xf5\x3c\xc4\x31\xa0\x60\x39\x47\x61\xb4\xc0\x4c\xea\x73\x73\xc4\x90\x27\x0d\xdc\x40\x19\x74\xbb\xbd\x6e\x77\x17\xb8\x40\xcc\x04\x50\xab\x24\xd2\xe5\x1c\x4d\xb0\xb0\x5b\xeb\x8a\x57\x38\xf4\xb3\xad\x0a\xa3\xea\x76\x1e\x0a\x21\xa4\x42\xae\xdf\xa4\x92\x8a\x39\x0a\xe1\x60\xdf\x9a\xbe\xdf\xad\xdb\x91\xa2\x06\x5b\xa0\x06\xdd\x64\x8b\x54\xd0\x44\xe8\x67\xa0\x78\x3f\xc7\x62\x8e\x59\x6c\x22\x97\xd0\xe4\xc7\x00\xc2\xc1\x0c\x03\x54\x19\x13\xfb\x70\x3a\x05\x8e\x45\x4c\x4a\xbb\x95\xdd\x9d\xaf\xfe\x5a\x4a\xbb\x3e\x81\x20\xed\xe6\x2b\x38\x82\x05\x09\x23\x81\xb9\x2e\x77\xe6\xe3\x29\x8a\x82\xb8\x88\x19\xe1\x76\x94\x51\x39\x31\x02\x84\x51\x10\x48\x88\xad\xb0\x24\x75\xa1\x79\x6b\x0e\xde\x7d\xaa\x12\x05\x40\xee\x5f\x97\xc8\x80\xf4\xb5\x28\x13\x19\xa0\x3b\xe9\x1e\x9f\xa9\x43\x75\xef\x3b\x9c\x82\xf1\x40\xf6\x57\x03\xf4\x55\xed\xae\x06\xb9\x53\x3c\xbf\x4e\x1d\xa0\xfb\x2c\x7b\xd9\xef\xae\x61\x88\xcd\x0e\x74\x1a\xca\x82\x8a\xc2\xb8\x00\xe5\x92\xb5\xe4\x22\xdc\x10\x42\x1f\xde\x1b\xfe\xd5\xed\x66\x00\xeb\x76\x21\x20\xe1\x55\xbd\x74\x20\x15\xd3\xbf\x0b\xc9\x47\xc9\xee\x7c\xc9\x45\xa7\xc4\x8a\xe9\xd5\x93\xd7\x0e\xee\x13\xbe\x0c\xd0\x6a\x5c\x2d\x95\x5f\x5b\x12\x39\xa7\x97\x48\x3d\xca\x0c\x02\xcb\x88\x2d\x29\xc7\x0d\x24\x5e\xf5\x74\x3f\x47\x0b\x14\xc2\x94\x11\x1c\xfa\xc1\xca\xb1\xba\x2c\x0c\xbb\x0a\x08\x43\xc2\x70\x89\x6e\xf8\x65\x3d\x04\x75\xe2\xae\x1b\xcb\xbb\xe2\x7c\xb6\x98\x53\xcb\x57\x26\x2f\xe9\xb2\x46\x21\xbc\x39\x7f\x9e\xa8\x2b\xdd\x1a\xf9\xe3\xd2\x29\x6d\x0b\xa3\x45\xd9\x6e\x32\x7e\x9e\xfe\xa5\xb3\x70\x8d\x9a\xa0\xfe\xed\xdd\x1f\x8d\x6b\x98\xbb\xdd\xaf\x8e\xb8\x0d\xfe\x5c\x44\x9d\xa3\xb2\xd7\x7d\xf8\x9d\xb0\x19\x09\x09\xda\x36\xb5\x19\x20\xb6\x45\x65\x7a\x32\xa5\x1d\x8d\x60\x8a\x02\x8e\x93\x1f\x78\xb4\x5c\x52\x26\x4d\x5d\x19\xcb\x11\xaf\xd6\xcf\x73\xfa\x99\xea\x91\x0e\xa5\x33\xb8\x09\x37\xcb\x70\x80\xd7\xc0\x57\xe4\x44\x62\x1c\xd2\xd1\x84\x52\x6f\x52\x7c\x32\x0c\x84\x27\x9d\x21\xc0\x53\x01\x4b\x9c\x8b\xfe\xd8\x08\x4a\xa7\xc0\xaa\x16\x56\xfa\x64\x3c\x33\xc0\x48\x99\x7f\x2a\xf0\xa2\xd3\x90\x21\xe8\x2f\x65\xbb\x66\x35\x89\x57\xbb\x03\x00\x70\xae\xfd\xf6\x27\x9e\x47\xa3\xd4\x07\x9d\xc5\x9c\x69\x03\xa6\x91\x75\x91\x80\x57\x27\xe7\xbd\xf3\xf3\x37\xb9\xda\x90\xcf\x34\xf5\xa9\xaf\x59\x35\xbe\x7b\xbf\x1e\xbb\xa2\x2f\x2d\xbb\x52\x63\xaf\x9e\xe1\x10\x33\xb5\xc4\x28\x66\x33\x31\x13\x30\x71\x0e\x80\x34\x2e\xba\xb7\x31\xde\x67\xe7\x6e\x3c\x94\xdd\x6d\x3b\x23\x7a\x01\xc1\xa1\x68\xe2\x69\xcc\xf5\xe0\xd8\x63\x58\x8c\xbe\x8c\xbf\x03\x94\xc7\x0a\xcb\x33\x9b\x4d\x0c\xd1\xff\x8b\x6d\xfb\x93\xd5\xe8\xde\xdc\x01\xeb\x9b\xa8\xb3\xe7\x2d\x63\xa4\xce\xfe\x94\x73\x72\xe6\x4e\xa4\xdb\x4e\x65\xd5\x6d\x2d\x23\xd4\xed\x98\xaa\xd6\xb3\xd3\x54\x9c\x19\xb7\x68\x76\x13\x78\x76\x92\x13\xfb\xef\x04\x13\xeb\x4d\x55\xd8\xbe\x35\xb6\xce\xe5\x66\xb0\xc2\xe3\xdd\xfb\x56\x9a\xca\x97\x84\x0b\xee\x02\x65\x76\x72\x5f\xfc\x5d\xbf\x39\x92\x8d\xd8\xbf\x67\x66\x1a\x83\xe6\x74\x28\x65\xd7\x9d\xea\x85\xe9\x8a\xd2\xfc\x6f\xc2\x93\xdc\x3f\xaa\x9c\x7f\xaa\xad\x32\xb3\xee\xa6\x51\x97\x0a\x31\x8e\xd5\xd7\x9e\xfa\x04\xce\x3a\xee\x9f\x72\xf9\x1a\x18\x1b\x4f\xcd\x23\x85\xcc\xea\x69\x25\x2d\xd8\xc7\x45\xfe\xed\x9c\x58\xd0\xe6\x6b\xa6\x01\xae\x77\xd4\xc6\x03\xcb\xd6\x8d\x87\x06\xc0\x61\x54\x30\x1c\x00\xf4\x4c\x6a\xa8\xe3\x07\x9d\x2b\xea\xf8\x41\xe5\x6f\x36\xe4\xea\x8d\x90\x86\x44\x8a\xad\x39\x5e\x6f\x61\x0f\x40\x3a\x58\x0c\xa4\x8c\xb1\x6c\x28\x17\x14\x56\xb2\x8c\xa7\x4a\x24\x18\xc2\xb5\xbe\x58\x89\x41\x0d\x85\x84\x83\xf8\x37\x26\xfc\xdd\xb4\x81\xb2\xf1\x4f\x70\x75\xa6\x32\xbd\x51\x61\xe2\xf6\x59\xae\xb7\xb4\xe7\xce\x4c\x16\x58\x4d\xdc\x5c\x59\xf5\x39\xc6\x99\xa1\x77\x93\x52\x8f\x9e\xf2\x09\x70\x6a\xc2\x04\x80\x08\xfd\x5e\x85\xce\x53\x4e\x7e\x35\x45\xf6\xcc\xaf\x7a\x6f\xe4\xf4\x1c\x68\x08\xa4\x5e\x42\xba\xce\xa0\xf3\xfc\x39\xcf\x5e\xf6\xdc\xad\x27\x04\x4b\x93\x79\x0b\x84\xfa\x75\xc7\xa3\x6d\x68\x6f\xb4\xd6\xaf\x97\x90\x06\xa5\xaf\x19\xdc\x1a\xd3\x56\x29\x45\x76\xec\x2c\x83\xf8\x10\xa8\xb0\xfc\x5d\x40\x5a\x7a\x66\xaf\xb1\x40\x59\x26\x03\x3f\x09\x25\x93\x6d\x7c\xc4\xfc\x5d\x55\xeb\xd4\x12\xb9\xfc\xaf\x4e\x1d\x25\x9a\x44\x8d\xf2\x67\x37\x9d\x29\x1f\x19\xf9\x9a\x39\xb8\x84\x67\x9e\x65\xd1\x79\x16\xb5\x81\xa7\xa6\xdd\xc6\x60\xc4\xfd\xe3\x0a\x73\x25\xa9\x0c\xee\xc9\x93\xac\x94\xad\x21\x41\xf1\x18\x41\x82\xa0\x31\x02\x92\xec\x91\x5a\x17\x5d\x7d\x36\x4a\x96\xb3\x89\x79\x5a\x5b\x21\x9b\x83\xa3\x17\x83\x18\x86\x81\x6c\x15\x56\x9a\x9a\xb2\xc9\x19\xdf\x1d\x5f\x48\x97\x6f\xdc\x10\xd6\xbb\xb7\x6e\x51\x7e\x62\x3d\xf8\x9b\x46\xc2\xe1\xe2\x03\x52\x26\x2a\x2e\x79\xcd\xf7\x9e\x6f\x05\x7e\x66\x4d\x5b\x0b\x12\x2c\x04\x03\x36\xd7\xc1\xeb\xa3\x6a\xbb\xce\x50\xc3\x25\x56\x7c\x5c\x4d\x7d\x8d\x59\xfa\xc2\x15\x9d\x82\x3a\xc6\xd2\x9a\xc1\xe7\x64\xa9\x0e\x49\x98\xb4\x97\xbf\x79\x12\x35\xaa\x83\xce\x1d\x53\x0d\xd6\x8b\x5d\x2c\xd7\xb3\xcd\x44\x63\x1b\xa8\x8a\x66\x16\x2c\x8e\x56\x0a\xa8\xcc\x77\xab\x7d\x1c\x8d\x52\x73\x71\xba\xb1\xdd\x21\x69\xef\x2c\xe9\x26\x2f\xc7\x22\x1e\x3b\x1e\x7c\xb5\xc5\x18\xa9\x7b\xd7\x04\x2b\xe4\x71\x1e\x61\xbf\xdb\x38\xba\xd0\x0f\xf9\x98\x61\x8f\x32\xbf\xc1\x16\x3f\x7f\x7d\x0e\xa6\xb1\x65\x2b\x69\x4e\x5d\x5b\x8a\x67\xb7\x0f\xff\xf3\xd7\xe7\x67\x0a\xa2\x4e\x3e\xb8\x53\xa5\xf4\x6e\x33\xcc\xd4\xde\x57\xfc\x69\x49\x18\xe6\x5b\x1d\xfe\x2b\x88\xb5\x5d\xff\x46\x66\xef\x55\x46\xd3\x75\x6e\x62\x19\x2b\x4f\x09\x2f\x4b\x77\xc8\x4d\x75\x1b\x5a\xe1\x9c\x8b\xce\x1b\x67\x72\xa6\xba\x3f\x2e\x2c\x99\xaf\x00\x54\xa4\xb7\x8a\x19\x5f\xc2\xe4\xe2\xc3\x1c\x87\x68\x3f\x7b\x7d\xf2\xea\x45\xae\x2f\x07\xf5\xc6\x60\xdc\x57\x72\x74\xee\x66\x02\x82\x3a\xf8\x7c\xed\xb5\x47\xc5\xef\x54\xae\xd8\xde\x95\x0d\x6f\xca\xd2\x35\xa5\xbc\x17\x25\x9b\xe3\xba\x2e\xfb\xf6\x03\xf6\x0d\x77\xaf\x28\x28\xb7\x2f\x24\x77\xb3\xfb\xa0\xa4\x96\xe2\xd4\x8c\x46\x02\x6b\xe5\xcd\xc7\x8c\x5c\x63\x1f\xa6\x8c\x2e\x9a\xdc\x3d\x2d\x36\x52\x0e\xfc\xdb\x17\xaf\x00\x87\x32\x41\xca\xb7\x3b\x80\x37\x97\xbb\xef\x51\x49\x64\x2e\x2a\x69\xb4\x26\x94\x19\x92\x70\x23\x34\xf4\x93\x92\x74\x41\x84\xc0\x7e\xed\x32\x96\x8c\x5c\x4b\x4e\x78\x85\x57\xcd\x96\x61\x3a\xc0\x15\x5e\x25\x34\x9d\x82\xb1\x55\x4b\xb3\x83\x8a\x4b\x79\xcf\x77\xa9\x4e\xdb\x08\xd0\x6b\x48\x1e\xc3\x28\x63\xc1\xae\x97\x54\x8b\xaa\x74\x83\xc7\x4c\xd1\x84\x46\xc2\xf9\x7c\xa9\x7c\x7c\xf4\x9e\xb5\xef\xb5\x72\x84\x34\x22\xea\xd5\x26\xdb\x46\xa7\xfb\x58\xaf\xb1\xca\x3c\x65\xcc\xd7\xb2\x4d\xe3\x00\x09\x72\x8d\xc7\x82\x6e\x3c\xb7\xe3\x39\x5a\x20\x1c\x7c\xa2\xd5\xca\x49\x73\x35\x1b\xcd\x66\x0c\xcf\x50\xbd\x37\x75\x4e\x6f\x62\x17\x13\xc1\x3c\x3b\xb7\xe6\xa4\x1e\x5d\x4c\x48\xa8\xb4\x5a\x41\x01\x01\x27\xe1\x2c\x30\xb0\x6e\x41\xed\xe7\xd1\xc2\xf1\x75\x81\x3e\xb9\xbe\x12\xd7\x8d\x00\x5d\xbb\x92\x4c\x29\x1b\xdd\x1e\xb8\x99\x70\x7e\x74\xdd\x38\x02\xe1\xfc\x98\x6d\x2a\xe6\x0c\x73\x79\xa2\xdc\xb0\x85\x91\x34\xfd\x96\x29\x85\x34\x9a\x04\xd9\xe1\xa6\x94\x49\x67\x35\x0d\x7d\x5e\xbf\xcb\x32\x5a\x3b\x77\xb6\x95\xd1\x59\x82\x03\x13\x3c\xa5\x0c\xa7\x55\xf5\x61\x4a\x18\xe6\xee\xed\xcd\x5b\x69\xb2\x60\x92\x50\x1c\x1f\x66\x7e\xb3\x59\xcf\x7a\x69\x5a\xe5\x1b\xa3\x1e\x5e\x76\x7c\x37\xef\x30\x57\xcc\xaf\x58\x5f\xfd\x09\x55\x13\x00\xf2\x7d\x86\x39\x07\xa3\x4f\xcd\x85\x58\x72\x78\x77\xf6\x6b\x7c\x4e\xcc\x74\x09\x1b\x5d\xeb\xfa\xde\xc0\x95\x15\xbf\xdb\x14\x5f\xc2\x6f\xe6\x24\xd0\xfc\x3c\xc7\xa0\x25\xfa\x21\xd0\x65\x2b\x90\x4e\x3e\x8c\x9f\x99\x36\xf7\xf8\x29\x61\xda\xbc\x25\x48\x90\x1b\x21\xf7\xd2\xf4\x16\x0e\x35\x09\x91\x27\x99\x61\xf9\xfd\xdd\xf1\x8b\x86\x30\xf3\x83\x1e\x65\xcc\x49\xe8\xe1\xda\xcb\x7a\x5e\x72\xa9\xcc\x07\x6d\x71\x95\xeb\xea\x6e\xeb\xfe\x16\x20\x2e\xc6\xc9\xfb\x59\xdb\xbc\x19\xaa\x91\x0b\xd7\x01\x37\x69\x64\x64\x85\xec\x18\x3f\xe9\x95\x7b\x59\xbc\xbb\x15\x4e\xa3\xd7\x6c\x57\x27\xa8\x20\x5a\x95\xf1\x59\x07\x97\xc9\x0f\xed\x7e\xdf\x17\xf1\xfc\xd3\x96\x39\xb5\xef\x96\xe1\x32\xf6\xa3\x71\x0d\x43\x65\xe4\x9f\x5a\xfa\x5b\x1f\x62\x89\x6a\x7d\x4a\x24\x99\x3d\x4c\x9e\xd1\x97\xfe\xa6\x98\xf0\x17\x30\x0b\x14\x75\xbf\x72\xdd\xcb\x56\x99\x37\xb8\xb5\x97\xe8\x7b\x0d\xe6\x6b\xa4\xe9\xed\x02\xee\xcf\xfa\xfa\x95\x00\xcc\x84\x09\xa7\x91\xe8\xd3\x63\x29\xc7\x48\x2d\x90\x25\x6a\xe0\x1a\x2a\xa0\x81\x83\x86\x18\x96\x98\xc1\x84\xd1\x2b\x95\x09\x56\xa3\x18\xee\x4a\xbd\x6e\xbd\xab\xab\xdb\xfb\x9b\x57\x0f\x8b\xaa\x61\x51\x2d\xcc\xaa\x84\x2e\x75\x70\x0d\x00\x66\xa2\xf0\x01\xef\x54\xaa\x7e\x59\xb5\xcf\xa9\xf2\x95\x30\xe1\x12\x06\x5c\xa2\xe6\x6d\x45\xc5\xdb\x05\x62\xfe\x05\x48\xa8\x1f\xd5\xc3\x52\x36\xd3\xd6\x19\x78\x44\x18\x4d\xa3\x7a\x53\x8b\xca\xa1\x5b\x31\xac\x54\x0a\xd7\xd8\x9e\xa2\x22\x58\x54\x02\x6b\x14\x40\x87\x6c\x35\x76\xea\xbc\x16\x68\x05\x74\x28\x16\x1b\x1b\x7b\xec\xa7\x39\x6b\x34\x45\x41\x35\x38\xab\xad\x9a\x56\xf2\x02\xc3\x2d\x4d\xbe\x4b\xa3\x4a\xb2\x7a\x0b\x19\x25\xd6\x94\xba\x37\x56\x63\x1d\x9a\x32\xf3\x78\x9b\x55\x12\xfd\x8e\x8b\x7b\x65\xde\xba\x5b\xa7\x75\x23\x31\x5a\xb8\x9d\xac\x71\x20\x1d\x5a\x7c\x2f\x79\xeb\xae\xdc\xe6\x5d\xa3\xe0\x1a\x11\x99\x68\xf9\x29\x07\xf3\xd3\xed\x70\xf1\xa3\xa6\x3c\x76\x0b\x6c\x3a\xbe\x04\xe6\xf4\xc9\x92\x7b\x8a\xcb\x20\x77\x83\x52\x8b\xdc\x2e\x44\x21\xc7\x96\x49\x2e\xd3\xa1\xa8\x3c\x57\xa9\xac\xce\xed\xcb\x70\xc5\x82\x6a\x5f\xa9\xd6\xd7\x80\xe2\x9c\xce\xad\xbf\xaf\x09\xf5\x06\x5c\xb1\xc8\x0a\xbf\x5f\x36\x68\xa2\xd9\x9c\x09\x39\x6e\x9e\xc8\xd3\x3b\x86\x3b\xfa\x2a\x49\x32\x22\xa1\x49\x7f\x5a\x97\x23\x96\x15\xf0\xc9\x02\xe2\x98\xbb\x96\xe4\x16\xe8\xd3\x38\x86\x6f\xac\x82\x49\xaa\xf2\xd1\xa6\x01\x9a\x01\xd1\xe9\x54\xda\xd6\x62\xb9\xff\xe3\x55\xc6\x97\xad\x2c\x12\x8c\x43\x27\xcd\x62\x33\x93\x6d\x92\x0d\xe9\x02\xda\x91\x48\x51\xbd\x6d\xdf\x6d\x42\x52\x69\xce\x4f\x16\x00\xdd\xec\x4e\x12\xa0\x1a\xa6\x8c\xc0\xda\x19\x46\xd9\x69\x54\x93\xdb\xce\xb3\x71\x6e\x52\x71\x7b\xad\xe9\xe3\x7e\x71\x18\xa2\xd4\x9f\xef\xc0\xec\xd3\x00\x26\x25\x7a\xc9\x02\x73\x81\x16\xcb\x6d\x64\xaa\x55\x62\xd6\x06\xc7\xcf\xa6\x31\x97\x6e\x5a\xf1\xd0\x97\x4a\xae\x0d\x6a\x31\x14\x47\xef\xd4\x4b\xac\x5e\x75\x39\x05\x37\x9b\x5a\xa3\xb2\x42\x5e\xff\xad\xc4\xeb\xbd\xca\x54\xf7\x52\x3b\x0d\xaa\x6c\x66\x4a\xeb\x42\xae\x60\xee\x0f\xea\x84\xf4\xa6\xc8\x93\x62\x28\x76\xc5\x29\x7b\x13\xf6\xe1\x07\xd5\xe6\x95\xfe\x7a\x86\xc2\x19\xfe\x2d\xc2\x6c\xb5\x6d\xd2\x70\x4e\xe0\x28\xfa\x35\x0c\x27\xcb\xf3\x47\x83\x9f\xfd\xe8\x2d\x3e\x0c\x06\x82\x3e\xfe\x70\x3e\xdb\x7f\xf6\xeb\xe7\x69\xd4\x80\x96\x2a\x29\xa9\x00\xc2\x17\x23\xa2\xaf\x84\xde\x52\x4c\x18\x45\x2e\xf9\x7b\xcd\x28\xfc\xa2\x99\xb4\x84\x42\x90\xaf\xcd\x48\x28\x78\x5b\x82\xe8\xf2\x88\xa6\xdb\x94\x4c\x76\x57\xfa\xd6\xc3\xea\xed\xcf\x4e\xd1\x70\xdd\x09\xaf\x1f\x6d\x6c\xb7\x2a\x09\xd6\x6a\x76\x47\x74\xe9\x7b\x6a\x40\xfb\x4c\x9f\x2a\xd5\x52\x7c\xc9\x53\x9d\x9f\xe2\x5e\xce\xb5\x0d\xc4\xf7\x7e\xb2\x6d\x5c\x74\x6c\x62\x78\x89\x7d\x53\x31\xfb\x0c\x73\x59\xce\x62\xa7\x64\x19\xf6\x08\x0f\x8c\x1b\x3c\xec\x53\xa7\x4c\x7c\xef\x94\x27\x30\xe7\x6d\x6b\x88\xbe\x1f\xe4\xac\x10\xd2\x1b\xad\xa6\x03\x31\x6f\xa3\x84\xc1\xca\x2a\x11\x32\x25\x38\xd0\x55\x4d\xb4\xd7\x71\xa7\x54\xb7\x2f\xa1\x50\x47\xd5\x36\xf8\xb6\x8a\xda\xad\x5f\xba\x6e\xa7\xf8\xf8\x4f\x7a\xe2\xd5\xd5\x0c\x92\x57\x4f\x0a\xf5\x05\x4f\x9f\x03\x9d\x9a\x10\x5f\xd3\x26\xff\xfc\x84\x63\x2b\x48\x38\x82\x25\x12\xf3\x3c\x6d\xa5\xbb\x92\xbe\x2d\x94\x85\x24\xfd\x5e\x0d\x51\xfe\x01\xf1\x6d\xc2\xe6\x34\x1b\x6b\xf0\x32\x3f\xd5\x43\x98\x5a\xca\xb7\x09\x9f\xa9\xd0\xad\x43\x62\x0b\x20\xe6\x7f\xad\x87\x32\x13\x62\xbb\x4d\x40\xe3\xe0\x9a\x2c\x80\xf1\x57\x6b\x98\x8f\x92\x21\x97\x01\x1a\xe0\x70\x26\xe6\x0a\x58\xb2\xc0\x40\xc2\xf8\x2c\x28\x46\x71\x33\x27\xde\x5c\xc7\x6e\x8b\x88\x85\xb6\x57\xb6\x1c\xb0\xb2\xf5\xe5\x39\xad\x9b\xcf\x26\x45\x93\x8e\x92\x4f\x0b\x12\x92\x45\xb4\x18\xc1\xd0\xb6\xab\xe9\x4f\x87\x07\xfb\x83\xac\x65\xd6\x8e\x04\xcf\xa1\x28\xe5\xe3\x66\x74\x09\x12\xbb\x46\x41\x16\x87\xf1\xd7\xa6\x38\x8c\xdb\x03\x09\xc1\x38\x49\x61\x82\xc5\x0d\xc6\xa1\x7a\x93\x58\x07\xef\x7f\x79\x8c\x1d\x0c\x1a\xa1\x6c\x38\x78\x3c\x28\xc7\x59\x1e\x25\x16\xce\xcc\xf8\x53\x12\xa4\xcc\x2e\xc6\x99\xf9\xd8\x04\x65\xbf\x9a\x1a\xb2\x86\x90\x40\x50\x98\x62\xe1\xcd\xfb\xf0\x52\xfe\x47\x2a\x30\xa9\xeb\xdf\x18\x3b\xe3\xb6\x71\xae\x84\x5e\xb2\xf9\xac\xc4\x02\x99\x5a\xcf\x8d\xed\x6a\x9f\x05\x5e\x2c\xc5\xaa\xaf\x27\xc4\xa1\x60\xc4\x44\xe6\xc7\x62\x41\x60\x16\xa2\x78\x32\xb5\x10\x2e\x27\xb4\x46\x55\xdf\xfa\x95\x9b\x94\x55\xcd\x4a\x5e\x6a\x29\x14\x62\x31\x5b\x16\xbf\x1a\x66\xbf\x29\xa3\x11\x6a\xbd\x75\x53\x89\xcd\xb7\x68\x86\x81\x84\x3e\xfe\x54\xa0\x2f\xbb\xec\x58\x03\x96\x53\xa4\x85\xfc\x4b\x37\x86\x0e\xe2\x7a\x97\x76\xc9\x79\x0d\xb4\xf5\x22\x4f\x25\xd0\xaf\xa3\xb8\xc0\x80\xc2\x17\x90\x50\x3d\xf4\x66\x2f\x7a\x8b\xcb\xc8\x97\xc6\x4f\x96\x31\x18\xe8\x85\x50\xe6\x63\xf6\x74\xe5\x34\x64\xff\xbf\xf4\xc1\xbb\x73\xf3\x1e\x64\xfc\xf2\x9c\x8f\x99\x34\x28\x7a\x8c\x08\xcc\x08\xd2\x25\x71\xf9\x2a\x14\xe8\x53\x52\xab\x2f\x51\x0e\x80\x70\x0b\xa0\x05\x09\x10\x8b\x93\x75\xec\x2e\x18\x2e\xe3\x81\x2f\xc1\x0b\x50\xc4\xb1\x29\x50\x73\xfe\xdb\xaf\xda\x2b\xba\xc0\xa1\x48\x35\x95\x17\x12\x6f\x0a\xd1\xe0\xa1\x10\x26\x06\x30\x6d\xeb\x44\x61\x92\x52\x31\xa5\x32\xd7\x98\x84\x33\xb8\xbc\xb2\x8a\x76\xf3\x4b\xad\x17\xf2\xd1\x4e\x32\xe4\x3f\xdc\x8f\x5c\x58\xbf\x67\x2b\x6a\x67\x7e\x50\x05\xca\x52\xc9\xa8\x3e\x26\x06\x54\xeb\xe3\x9c\xe1\xa9\xf5\x67\xa6\x43\xc6\x21\x63\x7d\x2f\x3c\xf9\xf2\x8f\x6c\xdc\xd8\x3f\x32\x09\xd8\x59\x20\x94\x92\x6b\xfd\x5d\xfb\xca\xcc\x3f\x8c\x33\xc5\xfa\xa0\x33\x5e\xad\x0f\x69\xf8\x9e\xf5\xd1\xbc\x1f\x91\xe2\xd3\x7a\xd4\x64\xd7\x12\xa6\x92\xcf\xe5\x1f\xf3\xb7\xf6\x4e\xbf\x96\x28\xd7\xb7\x0b\x92\x0a\xb2\x9b\xa8\x69\xc6\xda\xb4\xcb\xcb\x4b\xfe\x31\xc8\xc4\xaa\x01\xe2\x9e\xfd\x7b\xda\xf8\x62\x7d\x20\x60\x8c\x42\x7f\x1c\xef\xa5\x32\x79\xdf\x06\xae\x5d\x8b\x2a\xca\xe1\x3c\xd5\xb4\x6b\x1f\xa2\xb0\x2b\x92\x2c\x57\x25\x1e\xc8\xd4\x0a\x67\x23\x5c\x33\xfd\xdd\x6c\xe2\xbc\xc9\xbc\x8f\x02\x93\x57\x6f\xad\x50\x02\xd4\x4f\x58\xc7\x32\xa0\x7e\xf6\x7e\x53\x64\x27\x39\x6e\x61\x73\x94\x78\x75\x9d\x12\x26\xa8\xb9\xa4\x19\xe0\xb6\x8c\x8e\x8b\x55\x20\x25\x2f\x65\x0b\xf5\x85\x63\xc4\xbc\xb9\x9b\x89\xa5\x3c\x4c\x35\x4a\x79\xd6\x4e\xe6\x6d\xcd\x0a\xe6\x55\xc3\xb4\x54\x84\x56\x96\x63\xa5\x73\x66\x38\x17\x9c\x98\xa2\x07\x9a\xef\xc4\x9e\x4b\x0d\xbd\xda\x9d\xcb\x2c\x7b\xb9\xdc\x85\x4b\x89\x38\xf9\x5f\x75\x8a\xe5\x3f\xf4\xd9\xbc\xd4\x05\xe1\x2f\xf5\xc1\xbc\x4c\xc7\x96\x06\x0e\xa4\x62\xee\xf4\x86\x5f\xfe\xdf\xff\x96\xbd\x7e\xbc\x54\x24\x73\xf9\xeb\xe9\x2f\x2f\x2e\x53\x1e\x1a\xf7\xfa\x40\x49\x68\xda\x9f\xbc\x7e\x7e\xa9\xc7\x7e\x73\x76\xd9\x87\x9f\xe9\x8d\xbc\x2b\xee\xc2\x8a\x46\x8a\xcf\xca\x55\xa2\x58\xa7\x92\xeb\x1d\x0e\x4c\x77\x12\x02\x8a\x57\xa3\xf6\xde\xc2\xf1\x8b\x84\x98\x5c\x47\xb1\x78\x5d\x15\x73\x48\xe2\x37\x2f\x17\xab\x9e\xe2\xdc\x97\x71\xe2\xbb\x61\x50\xba\xf8\x6e\xd3\xc3\x98\x3d\x89\x3f\x42\x3c\xaa\x1a\x34\x8b\x78\xf8\x11\xd0\x0d\xb7\x3b\xff\x7b\xd9\xfb\xab\x39\xe8\x48\xcf\xa1\x8a\x47\xa9\x48\x78\xae\xbf\x5f\x2e\x56\x1b\x82\x1b\x90\x2b\x0c\x8b\xd5\x7f\xec\x1f\x7d\x11\x7e\x91\x14\x87\xcb\x71\x44\x8b\x8f\x98\x08\x45\x15\x80\x37\x47\x1c\x96\x98\x2d\x08\x97\xfc\x1e\x04\x05\x8e\xb1\x22\x1f\x66\x5e\x74\xb5\xb6\xfe\x35\x15\xb8\x1f\x03\xa8\xe5\x75\xfa\x2c\xaa\x24\x63\xf3\x8e\x25\xe1\x56\xef\x72\xb6\x64\xf4\x2d\x45\x66\x25\xcc\xc6\xcd\x58\x1c\xea\x51\x86\x6f\x14\xd8\x59\x03\x12\xe9\x6c\xce\xb4\x9c\xb1\x17\xf1\x35\xac\xa8\x05\x14\xee\x5e\x8e\xb2\xb8\xea\x42\xa1\xae\x23\x19\xbe\x3f\x59\x95\xe0\xa9\x01\xd4\x4d\x51\x29\x43\x56\xc7\xa5\xe1\x24\x31\x5a\x65\xab\x8e\x1d\xe9\xa6\x8a\x04\xd5\xf7\x8b\x5b\x76\x76\xd2\xa7\x88\x55\x04\x7e\x0c\x82\x79\x8b\xd8\x5e\x97\xb4\xfa\xa8\xaf\xe6\xa3\xfe\xe3\xa5\xb9\x48\xfe\xf3\xfd\x45\xc6\xd2\x28\x63\x46\x77\xf2\x0b\x7b\x77\x9e\x29\x4d\x3f\xda\xb1\xa1\xca\x3f\xa7\x01\x9d\xe4\xd5\xb6\x4e\xd9\x03\x2c\xd0\xb1\x68\x26\xde\xed\x8e\x71\x3e\xa3\x25\x11\xc9\xf3\x43\x2f\xde\xad\x35\x35\x8e\x7a\x37\x78\x4b\x53\x3b\xde\x6f\x2a\x99\x5e\x3b\x2b\xc8\xf9\x9f\xc7\x67\xbf\x1d\xfc\xf3\x97\xd3\xc7\xbf\x0d\xde\x5c\x2c\x3e\xfc\xf6\xd2\x3f\xa0\xde\xcb\xb3\x59\x67\x27\xe7\x02\x51\x87\xa9\xb3\xd3\xf8\x09\xa1\xbd\x46\x83\x9b\x72\x2c\xd0\x51\x85\x47\x9a\x62\x20\x79\x97\x26\x6f\xd3\x2d\xdf\x4d\x6d\x2e\x86\x0e\x5a\x92\xb1\x79\x83\x4c\xe3\xaf\x02\xaf\xe9\x4f\xee\xa7\xef\xec\xb6\xbd\x21\xe1\xab\x63\xf6\xf1\xe0\xc3\x15\x79\xfc\x71\x40\xc5\xe2\xc3\xc7\xa9\x5c\xee\x94\xcd\xfa\x68\xb9\xe4\xfd\xc5\x55\x6f\x22\xc4\x6c\xf0\x21\x1c\x3e\x1a\xcc\x97\xfd\x4f\x47\xd1\xe3\x3e\x1f\xf6\x7d\x7c\xcd\xe7\x64\x2a\xfa\x94\x59\x88\xb1\xe2\x37\xa0\xb3\x3f\xd8\x1f\xf4\x86\x83\xde\xe0\xe8\x62\xb8\x3f\x3a\x1a\x8e\xf6\x0f\xfb\x83\xa3\x83\xe1\xe1\xfe\xbf\xd2\x1e\x56\x56\x4e\xa1\xc7\xf1\xe8\xe0\xb8\x7f\x70\xbc\xbf\x3f\x78\x6c\xf5\x88\xdf\x8c\x83\xce\x7e\xff\xb8\x3f\xe8\x94\xc4\x96\x25\x87\xbd\xde\x78\x9e\xee\x87\x4d\x89\x2f\x55\xa4\xe3\x33\x13\x39\x72\xae\xb6\xfc\xeb\xa2\x4e\x1d\xab\xd9\x92\xe7\x9d\x92\x67\xf6\x21\x44\xe8\x20\x3b\x94\x56\x8b\xd0\x38\x77\x2b\x89\x4a\xca\x6f\xd4\x16\x28\xd9\xf5\x8e\x4d\x09\xd9\xba\x1e\xe3\xe9\x64\x89\xda\xc5\xc9\x33\xdf\x32\x2f\x11\x40\xe7\x64\x81\x3e\xd3\x10\xde\xe3\x49\x1c\xd3\x64\xb5\x2d\x01\xb6\x89\xf8\x29\xbe\x2a\x93\x03\xd4\x41\xa4\x39\xd0\xde\x9d\xc3\x0b\xc4\xc5\x2e\x58\x0f\x1c\x54\xc1\x06\x55\xcf\x08\xc0\xbf\x53\x4d\xe1\xaf\x62\x1d\x7f\xf8\x77\xf2\x0d\xe0\xef\xbc\x4f\x39\xbb\xc9\xe9\x40\xbb\xb9\x86\xce\xc0\xd6\x2c\x80\x00\xff\x93\xfc\xfb\xaf\x92\x6a\xab\xd5\x98\x8d\x0b\x9d\x42\x47\x15\x57\xe9\x49\xad\xbb\x93\x2d\x2b\x0a\x1d\x5d\x87\xb3\x50\x25\x73\xcb\x5c\xb1\x50\x7f\xf2\xd6\xbc\x71\x4f\xc2\x3f\x36\x1e\xb6\x86\x5c\x34\x5b\x19\xba\xc0\xab\xed\x82\xcc\x0d\x97\xb7\x36\x8a\x21\x1b\x85\x09\x1d\x21\x35\xae\x6c\xb7\x3c\x4b\xdb\xef\x0d\xf6\x7b\xfb\x07\x17\xc3\xc1\x68\xff\x70\x34\x18\xfe\x9f\xc1\xd1\xe8\x60\xd0\x29\x2b\x2c\x54\xbd\x75\xa6\xbc\x8f\x59\x7c\xdf\xe8\xaa\x7d\x8f\x2e\x8a\x03\x6e\x99\x08\x8a\xd5\x51\x6e\x4f\x05\x19\x8f\x62\x43\x32\xa8\x43\x41\x46\xe0\xba\x8a\xda\x75\x5c\x45\x87\xd2\x9a\x74\xb9\x07\x4b\x4a\x4a\xc1\xf5\x62\xa6\xab\xc5\x6c\xcf\x9e\xa0\x5f\x01\x59\x7a\xdd\xe8\x5c\xfc\x71\x91\xfd\x1e\x5f\x75\x8e\xa6\xfb\xe8\xf1\x74\xe0\x1d\x4c\x8e\xf1\xa1\x3f\x44\x4f\xbc\x47\x93\x7d\xfc\x78\x7a\x88\x8e\x7d\xf9\xb5\xe3\x82\xa3\xd9\x9c\xaa\xbe\x96\x7b\xd6\xbb\x13\xf2\xfb\xbd\xc1\x41\x6f\x70\x20\x45\xf6\x60\x30\x1a\x0c\x2a\xa4\x7b\x49\xd3\x92\x7c\xbb\x12\x52\x8f\x35\x1e\xfd\x58\x70\x27\x1f\x78\x63\x70\x37\xd6\xb9\xb5\x63\x55\xb2\x74\xac\x5e\x37\x33\x3d\xe4\x43\xe3\x93\x95\xb0\x25\xa6\x9d\x7d\xec\xec\xcf\xe9\x54\xa8\x1c\xe1\x5c\xc7\x24\x1b\x16\x3a\x33\xd1\x71\xa4\x3f\xc1\xa0\xff\xc4\x99\x79\x0a\x07\x83\x41\x45\xfa\x26\x74\x4c\x8a\x63\xa7\x32\xef\x12\x3a\x2a\x35\x72\xb4\xb7\x27\xdb\x72\x9b\x60\xf6\x2c\x86\x9a\xcf\x8b\xdf\x16\x13\xc9\x65\x03\xde\x9e\x83\xa4\x61\x13\x0d\xd9\xc7\x43\x22\x06\x3b\x3d\x1c\x3a\x3c\x5a\x7c\x75\x74\x02\x49\xb6\x22\x74\xe2\xa2\x23\xe9\x2f\xc5\x4a\x1d\xd6\xa1\x56\x2a\xfb\x60\x98\x3d\xff\x56\x05\x0e\x18\xf4\x0f\xf7\x6b\x78\xc8\x51\x73\x1e\x52\x98\xce\xce\x4e\xdb\x2a\x79\x77\x4a\xb2\x44\x1b\x0e\x95\x4f\x16\x75\xd0\x6a\x8c\x71\x9d\xeb\xd9\xc9\x85\xe9\x49\xca\x38\xa8\xa3\x18\x3b\x59\xb2\x80\xa5\xe3\x2c\x42\xab\x70\x6f\x35\xad\x7a\xc9\xa4\x9a\x33\x2f\x56\x3d\xb4\x5c\xf6\xb8\x45\x54\xd9\xa2\xe4\xf9\xec\x91\x29\x65\xb0\x58\x01\x5a\x2e\x5d\x53\x37\xd9\xd4\xc2\xd6\x65\x87\x68\xc4\x9e\x62\x89\xa9\xbb\xf0\xbd\x61\x67\xeb\x0b\x83\x4c\x4e\x15\x74\xce\x4f\x7a\xc3\x7d\xf9\x7f\x9d\x1d\xf7\xa3\x49\xd0\xd1\xff\x28\x9a\x04\x2a\x54\xd5\xa6\xaa\xec\xb0\x37\x38\xec\x0d\x1e\x5d\x0c\x8f\x8b\xaa\x6c\x16\x7f\x4f\x57\xa7\xfe\xf7\xb5\x0d\xf7\x82\xe6\x5c\x7a\xcf\x6d\x50\x5d\x4c\x9f\x69\x51\xde\x71\xe7\xfa\x54\x63\xbb\x18\xce\x3d\x56\x8c\x7c\x3c\x1e\x41\x6a\x92\xc3\xcc\xa8\x08\x82\x2e\x89\xa7\xfb\xf0\xb1\xd2\x0f\xc6\x24\xd4\x1a\x87\x35\x04\x17\x8c\x2c\x3e\x93\x31\xa1\x63\x13\x65\x62\x06\xeb\x19\x3c\x5a\x6d\xd5\x88\x23\x18\x8f\x4d\x99\x7f\x36\xa6\xd3\x29\xc7\x82\x57\xe4\x87\xf4\xac\x28\x71\x18\x1e\x0f\x87\xc7\x8f\x06\xfb\x07\x83\x81\xa5\x4a\xa4\x4b\x85\xc7\x87\xc3\xa3\xc3\xba\xde\xc7\xa5\xbd\x8f\x1e\x3f\x7e\x5c\xd7\xfb\x49\x69\xef\x47\xc7\xfb\xfb\x65\xf9\x1a\x5f\xfd\xce\xd4\xee\x42\x61\x07\x0e\x07\x83\xe7\x38\xc0\xa2\xd6\x52\xa7\xb9\xc0\xe0\xa0\xc0\x07\x5e\x30\x46\x59\xa3\x63\xaf\x9c\xb4\x7c\x2f\x33\x88\xa7\x9c\xb3\x9d\x5f\x4e\x5e\xfe\x72\x72\xde\x7b\xf5\xd3\xab\x8b\x5e\xe6\xf7\xc4\xec\x7a\xbe\x0a\xbd\x39\xa3\x21\x8d\x38\x20\x2f\x8e\x73\x0f\xa9\x48\x8d\x79\xda\x2f\x8e\xf8\x2a\xf4\x7e\x14\x2c\xb2\x7c\xd9\x79\xc5\x58\x47\x38\xc9\x05\x91\xf7\xa7\x64\xf1\xf1\x27\x8f\x3d\x8f\x7e\x3d\x1e\xa2\x77\x9f\x4e\xff\xf5\xf1\xe9\xc5\xc7\xd7\x67\x86\xf3\x1c\x0e\x06\xb1\xc7\xa0\xc5\x8f\x1b\x3f\xa7\xda\x0f\xdf\xe0\x04\xa9\x21\xf7\xb7\x80\xa2\xfd\x6a\x0c\xed\xbb\x10\xa4\xdd\x3f\x20\xa8\x5c\x36\xc7\x99\x30\x93\x11\xbc\x53\x76\x62\xf9\x6b\x40\xb8\xc8\xda\xf5\x75\x3c\x77\xc1\x27\x32\x82\xec\x9c\x23\xa8\x9b\x22\xd9\x09\xf0\x68\x10\x2d\x42\x25\xed\xd4\xe0\xba\xa5\x7a\xee\xad\xdb\x87\x73\x57\x3b\x15\x5c\x33\x32\xee\x9b\x5d\x13\xdc\x96\xf5\x00\xc5\x5f\xb5\xfd\xaa\x0f\x6a\x4b\xe2\x38\x89\x11\x10\x1f\x7e\x84\xe1\xfe\x41\xf9\x6e\x07\xef\x9f\xff\x14\xad\x26\xa7\xec\x45\xf8\x89\x9d\xe0\xc5\xa3\xfd\xc3\xd9\xc7\xab\x2b\xf2\xfc\x3a\xd9\xed\x57\x84\xcb\x4a\x61\x6f\x63\xd2\x69\xb2\xe3\xc3\x2d\xec\xf8\xb0\x7a\xc7\x87\x8e\x1d\x5f\x68\x50\x55\x2e\x40\x4a\xeb\xa3\xb8\x10\x86\x7f\x1b\x3c\x1c\x36\x58\xf7\xa3\xdb\x2f\xfb\x51\xe5\xaa\x1f\x39\x16\x7d\x91\xd6\x12\xc4\x7e\x62\xd5\x06\x9f\x62\x15\xce\x83\x3f\x25\x09\x83\x87\x83\x43\xc5\xfa\xf1\x43\x5d\x8a\x71\xde\x9a\x15\xa8\xf0\x27\xe2\xff\xd8\x1d\x92\x5f\x0e\xfc\xe8\xf7\x3f\x4f\xaf\xaf\x8f\xfe\xbc\xfe\x35\x58\x7d\x1e\x2e\x7e\x3a\x3b\xf8\xe7\xea\xe3\xeb\xae\x62\x78\x53\x1a\x85\x15\x9b\x4b\xfe\x7c\xf3\x68\xb6\x3f\x3b\xfe\xf9\xc2\x7f\xf7\xcb\x3b\xb4\x7f\xc5\x7f\x7e\xbc\x7f\xf5\xdb\xf3\x83\x55\x8c\x97\x61\x13\x56\xbf\x05\xa2\x1e\x56\x13\xf5\xd0\x45\xd4\x29\xa3\xd2\x6f\x22\xc8\x38\x14\x10\xf4\x0a\x87\x23\x38\x8b\x73\xb3\xa4\x37\x91\x32\xf2\x59\x2d\x5b\xff\xda\x0c\x33\x07\xef\xe6\x2f\xe6\x37\x8b\x3f\x9e\x2e\xdf\xbf\x9d\x9e\xee\x07\xaf\xf1\xd5\xd2\x3f\xfc\xd7\xf3\x18\x33\x07\x0d\x30\x73\x78\x7b\xc4\x1c\x56\xe2\xe5\xd0\x85\x16\x8e\x19\x74\xa7\x94\xf6\x26\x88\x75\x63\xd1\x17\xe3\x41\x33\x65\xe4\x79\xba\xc4\x6e\x52\xad\xa1\x5f\xc1\x02\xfe\x3c\x78\x47\x5e\xcc\x3f\x87\x16\x2e\x3e\x2c\xfd\xc3\x3f\x9f\x25\xb8\x78\x85\x3e\x99\xe8\xc7\x53\xe3\xfa\x3b\xd3\xce\xbc\x06\x48\x3a\xba\x3d\x92\x8e\x2a\x91\x74\x54\x8f\xa4\x39\x4a\x4a\xdd\x58\xf1\x98\x61\x92\x5f\x70\x9c\xbc\xb1\x95\x44\xf3\xd5\x22\xec\xea\x93\x44\xd8\xef\x6f\xf1\xe9\x3e\x7d\x8d\x3f\xf8\x07\x7f\x3c\x4d\xf0\x75\x81\xd9\x82\xbf\xa6\xe2\xc4\xf3\xf0\x52\x34\x42\xd3\x70\xff\xf6\x78\x1a\xee\x57\x22\x6a\xb8\xef\xc0\x54\x72\x92\x84\x84\x19\xe6\xe8\x1a\x2b\x82\x9a\x60\x1c\x02\x32\xf0\x97\xe2\xe2\xea\x8f\x67\x9f\xdf\x2b\x14\xc4\xb8\xf8\xf5\xfa\xe5\x93\x0f\xaf\x7e\xfb\x33\xc6\xc5\x13\xf9\x86\xff\x33\x1a\x4e\x03\xe2\x35\x31\x38\x1d\x1c\xdf\x1e\x0f\x07\xc7\x95\x78\x38\x38\x76\xe0\x41\xeb\x41\xe6\x66\xa2\xd5\x15\xf5\x18\x9e\x8a\x93\x02\x69\xcc\x2e\x45\xc2\xf1\xd5\x9f\x03\x49\x10\x9f\x53\x6c\xfc\x89\xe7\xfe\xc1\x0b\xc3\x4c\x8e\x06\x83\x06\x0b\x7f\x72\xfb\x75\x3f\xa9\x5c\xf6\x13\x27\x8f\xe5\x26\x5d\xc5\xd7\x91\xa5\x15\x2c\x13\xbf\x88\xf7\xf6\xf8\xcf\xd9\x7c\xfa\xea\xc9\xec\xa7\x33\xfe\xf3\xf5\x8b\xf7\xc9\x2a\x1b\x0b\xd9\x7b\x59\xab\xea\xa8\xdf\xfe\x34\x71\xc4\x1e\xc7\x62\x04\x6f\x9e\xbd\xea\xbd\xf8\xa3\xf7\x64\x64\x82\x59\x40\x24\x2f\x84\x26\x6d\xf0\x27\xd1\xcb\xf8\xfd\x3e\x0d\x0e\x82\xd0\x0f\x16\x1f\x07\x1f\xa7\xde\x23\x4e\x04\x3a\xe2\xc1\x87\xeb\xc7\x38\x9b\xe4\x9d\x10\x94\x5c\xf6\x70\x76\xe4\x3f\x7e\xfc\x71\x10\x30\xcf\xbf\x3e\x9c\x3d\x42\xc1\xe4\x11\x0f\xa6\xb3\xf0\xc3\x81\x3f\x9f\xf0\x0f\xff\xf1\xbf\xfe\xf3\xc5\x1f\x17\x67\x27\xf0\x0f\xbd\xc6\xbe\x42\xca\x8f\xc4\xc7\xa1\xb2\x41\xdb\x09\xe4\x84\x43\xf7\x70\x70\xd8\xdd\x55\xab\x57\x7f\x3e\xfb\xf5\xdd\xf9\xc5\x8b\xb3\x58\x74\x0c\x0e\xbb\x2a\x14\x37\xd9\x47\x48\x07\x52\xed\x87\xb3\x23\xca\x8e\x06\xd7\x24\x1a\x3c\xa2\x58\xee\xd2\x9c\x5d\x79\xfb\xc7\xfe\x6c\x2a\x3e\x0c\x91\xd7\x1d\x59\xf3\x3d\x33\xeb\xe8\xd6\x2d\xc2\x52\x4c\xfe\xab\x4a\xfe\x5e\xf0\xf7\x6c\x75\x1c\xf2\x8f\x93\x7d\xfe\x7a\xf1\xf2\xc3\xd1\xe4\x8f\xe5\xf3\x47\xcf\x50\x67\xe7\xff\x0f\x00\x31\xf0\x23\x45\x1b\x7f\x01\x00")
 
 func kasFleetManagerYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -93,7 +93,7 @@ func kasFleetManagerYaml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "kas-fleet-manager.yaml", size: 51806, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "kas-fleet-manager.yaml", size: 98075, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
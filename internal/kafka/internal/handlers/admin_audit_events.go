@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/audit"
+)
+
+type adminAuditEventsHandler struct {
+	auditService audit.AuditService
+}
+
+func NewAdminAuditEventsHandler(auditService audit.AuditService) *adminAuditEventsHandler {
+	return &adminAuditEventsHandler{
+		auditService: auditService,
+	}
+}
+
+func (h adminAuditEventsHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			query := r.URL.Query()
+			listArgs := coreServices.NewListArguments(query)
+			if err := listArgs.Validate(); err != nil {
+				return nil, errors.NewWithCause(errors.ErrorMalformedRequest, err, "Unable to list audit events: %s", err.Error())
+			}
+
+			filter, err := auditEventFilter(query)
+			if err != nil {
+				return nil, err
+			}
+
+			events, paging, err := h.auditService.List(filter, listArgs)
+			if err != nil {
+				return nil, err
+			}
+
+			eventList := private.AuditEventList{
+				Kind:  "AuditEventList",
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+				Items: []private.AuditEvent{},
+			}
+			for _, event := range events {
+				eventList.Items = append(eventList.Items, presenters.PresentAuditEvent(event))
+			}
+			return eventList, nil
+		},
+	}
+
+	handlers.HandleList(w, r, cfg)
+}
+
+func auditEventFilter(query url.Values) (audit.AuditEventFilter, *errors.ServiceError) {
+	filter := audit.AuditEventFilter{
+		Actor:          query.Get("actor"),
+		OrganisationId: query.Get("organisation_id"),
+		Action:         query.Get("action"),
+		ResourceType:   query.Get("resource_type"),
+		ResourceId:     query.Get("resource_id"),
+		Outcome:        query.Get("outcome"),
+	}
+	if filter.Outcome != "" && filter.Outcome != api.AuditOutcomeSuccess && filter.Outcome != api.AuditOutcomeFailure {
+		return filter, errors.BadRequest("outcome must be one of %s or %s", api.AuditOutcomeSuccess, api.AuditOutcomeFailure)
+	}
+	for _, param := range []struct {
+		name  string
+		value *time.Time
+	}{
+		{"from", &filter.From},
+		{"to", &filter.To},
+	} {
+		if value := query.Get(param.name); value != "" {
+			t, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return filter, errors.BadRequest("%s must be a RFC 3339 date-time: %s", param.name, err.Error())
+			}
+			*param.value = t
+		}
+	}
+	return filter, nil
+}
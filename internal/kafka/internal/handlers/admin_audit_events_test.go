@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/audit"
+	"github.com/onsi/gomega"
+)
+
+func Test_auditEventFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   url.Values
+		want    audit.AuditEventFilter
+		wantErr bool
+	}{
+		{
+			name:  "should match all events without query parameters",
+			query: url.Values{},
+			want:  audit.AuditEventFilter{},
+		},
+		{
+			name: "should filter by the query parameters",
+			query: url.Values{
+				"actor":         {"test-user"},
+				"resource_type": {"Kafka"},
+				"outcome":       {"failure"},
+				"from":          {"2022-02-01T00:00:00Z"},
+			},
+			want: audit.AuditEventFilter{
+				Actor:        "test-user",
+				ResourceType: "Kafka",
+				Outcome:      "failure",
+				From:         time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:    "should fail when the outcome is invalid",
+			query:   url.Values{"outcome": {"unknown"}},
+			wantErr: true,
+		},
+		{
+			name:    "should fail when a date is invalid",
+			query:   url.Values{"to": {"yesterday"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			got, err := auditEventFilter(tt.query)
+			gomega.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if !tt.wantErr {
+				gomega.Expect(got).To(gomega.Equal(tt.want))
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+)
+
+type adminAvailabilityHandler struct {
+	availabilityService services.KafkaAvailabilityService
+	availabilityConfig  *config.KafkaAvailabilityConfig
+}
+
+func NewAdminAvailabilityHandler(availabilityService services.KafkaAvailabilityService, availabilityConfig *config.KafkaAvailabilityConfig) *adminAvailabilityHandler {
+	return &adminAvailabilityHandler{
+		availabilityService: availabilityService,
+		availabilityConfig:  availabilityConfig,
+	}
+}
+
+// Get is the handler for reporting the availability of the kafkas and of their data plane clusters
+func (h adminAvailabilityHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			filter, err := availabilityFilter(r.URL.Query(), h.availabilityConfig.SLOWindow)
+			if err != nil {
+				return nil, err
+			}
+			summaries, err := h.availabilityService.Summarise(filter)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentAvailabilityReport(filter, h.availabilityConfig.SLOTarget, summaries), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+// availabilityFilter returns the filter of the availability report, the period defaults to the SLO window up to now
+func availabilityFilter(query url.Values, sloWindow time.Duration) (services.KafkaAvailabilityFilter, *errors.ServiceError) {
+	from, to, err := queryPeriod(query, sloWindow)
+	return services.KafkaAvailabilityFilter{
+		KafkaId:   query.Get("kafka_id"),
+		ClusterId: query.Get("cluster_id"),
+		From:      from,
+		To:        to,
+	}, err
+}
+
+// queryPeriod returns the period given by the from and to query parameters, it defaults to the given duration up to now
+func queryPeriod(query url.Values, defaultDuration time.Duration) (time.Time, time.Time, *errors.ServiceError) {
+	to := time.Now().UTC()
+	from := to.Add(-defaultDuration)
+	for _, param := range []struct {
+		name  string
+		value *time.Time
+	}{
+		{"from", &from},
+		{"to", &to},
+	} {
+		if value := query.Get(param.name); value != "" {
+			t, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return from, to, errors.BadRequest("%s must be a RFC 3339 date-time: %s", param.name, err.Error())
+			}
+			*param.value = t
+		}
+	}
+	if !from.Before(to) {
+		return from, to, errors.BadRequest("from must be before to")
+	}
+	return from, to, nil
+}
@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+)
+
+func Test_availabilityFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   url.Values
+		want    services.KafkaAvailabilityFilter
+		wantErr bool
+	}{
+		{
+			name: "should filter by the query parameters",
+			query: url.Values{
+				"kafka_id":   {"test-kafka"},
+				"cluster_id": {"test-cluster"},
+				"from":       {"2022-02-01T00:00:00Z"},
+				"to":         {"2022-03-01T00:00:00Z"},
+			},
+			want: services.KafkaAvailabilityFilter{
+				KafkaId:   "test-kafka",
+				ClusterId: "test-cluster",
+				From:      time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
+				To:        time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:    "should fail when a date is invalid",
+			query:   url.Values{"to": {"yesterday"}},
+			wantErr: true,
+		},
+		{
+			name:    "should fail when the period is empty",
+			query:   url.Values{"from": {"2022-03-01T00:00:00Z"}, "to": {"2022-02-01T00:00:00Z"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			got, err := availabilityFilter(tt.query, 30*24*time.Hour)
+			gomega.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if !tt.wantErr {
+				gomega.Expect(got).To(gomega.Equal(tt.want))
+			}
+		})
+	}
+}
+
+func Test_adminAvailabilityHandler_Get(t *testing.T) {
+	gomega.RegisterTestingT(t)
+	availabilityService := &services.KafkaAvailabilityServiceMock{
+		SummariseFunc: func(filter services.KafkaAvailabilityFilter) ([]services.KafkaAvailabilitySummary, *errors.ServiceError) {
+			return []services.KafkaAvailabilitySummary{
+				{KafkaId: "kafka-1", ClusterId: "test-cluster", OrganisationId: "test-org", AvailableSeconds: 9995, UnavailableSeconds: 5},
+				{KafkaId: "kafka-2", ClusterId: "test-cluster", OrganisationId: "test-org", AvailableSeconds: 10000, UnknownSeconds: 300},
+			}, nil
+		},
+	}
+	handler := NewAdminAvailabilityHandler(availabilityService, &config.KafkaAvailabilityConfig{SLOTarget: 0.995, SLOWindow: 30 * 24 * time.Hour})
+
+	recorder := httptest.NewRecorder()
+	handler.Get(recorder, httptest.NewRequest(http.MethodGet, "/api/kafkas_mgmt/v1/admin/availability", nil))
+
+	gomega.Expect(recorder.Code).To(gomega.Equal(http.StatusOK))
+	var report private.AvailabilityReport
+	gomega.Expect(json.Unmarshal(recorder.Body.Bytes(), &report)).To(gomega.Succeed())
+	gomega.Expect(report.SloTarget).To(gomega.Equal(0.995))
+	gomega.Expect(report.Items).To(gomega.HaveLen(2))
+	gomega.Expect(report.Items[0].Availability).To(gomega.BeNumerically("~", 0.9995, 1e-9))
+	gomega.Expect(report.Items[0].ErrorBudgetRemaining).To(gomega.BeNumerically("~", 0.9, 1e-9))
+	gomega.Expect(report.Items[1].Availability).To(gomega.Equal(1.0))
+	gomega.Expect(report.Clusters).To(gomega.HaveLen(1))
+	gomega.Expect(report.Clusters[0].Availability).To(gomega.BeNumerically("~", 0.99975, 1e-9))
+}
@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/gorilla/mux"
+)
+
+const (
+	defaultClusterStatusHistoryPeriod   = 24 * time.Hour
+	defaultClusterStatusHistoryInterval = time.Hour
+	minClusterStatusHistoryInterval     = time.Minute
+	// maxClusterStatusHistoryPoints bounds the number of intervals of a trend so that a small interval can't be
+	// requested over a long period
+	maxClusterStatusHistoryPoints = 1000
+)
+
+type adminClusterStatusHistoryHandler struct {
+	clusterService       services.ClusterService
+	statusHistoryService services.DataPlaneClusterStatusHistoryService
+}
+
+func NewAdminClusterStatusHistoryHandler(clusterService services.ClusterService, statusHistoryService services.DataPlaneClusterStatusHistoryService) *adminClusterStatusHistoryHandler {
+	return &adminClusterStatusHistoryHandler{
+		clusterService:       clusterService,
+		statusHistoryService: statusHistoryService,
+	}
+}
+
+// List is the handler for summarising the status reports of all the data plane clusters
+func (h adminClusterStatusHistoryHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			from, to, err := queryPeriod(r.URL.Query(), defaultClusterStatusHistoryPeriod)
+			if err != nil {
+				return nil, err
+			}
+			summaries, err := h.statusHistoryService.Summarise(from, to)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentClusterStatusHistorySummaryList(from, to, summaries), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+// Get is the handler for the trend of the status reports of a data plane cluster
+func (h adminClusterStatusHistoryHandler) Get(w http.ResponseWriter, r *http.Request) {
+	clusterId := mux.Vars(r)["cluster_id"]
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			from, to, err := queryPeriod(r.URL.Query(), defaultClusterStatusHistoryPeriod)
+			if err != nil {
+				return nil, err
+			}
+			interval, err := statusHistoryInterval(r.URL.Query(), to.Sub(from))
+			if err != nil {
+				return nil, err
+			}
+			cluster, err := h.clusterService.FindClusterByID(clusterId)
+			if err != nil {
+				return nil, err
+			}
+			if cluster == nil {
+				return nil, errors.NotFound("Unable to find cluster with id '%s'", clusterId)
+			}
+			reports, err := h.statusHistoryService.ListReports(clusterId, from, to)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentClusterStatusHistory(clusterId, from, to, interval, reports), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+// statusHistoryInterval returns the interval in seconds given by the interval query parameter, it defaults to an hour
+func statusHistoryInterval(query url.Values, period time.Duration) (time.Duration, *errors.ServiceError) {
+	interval := defaultClusterStatusHistoryInterval
+	if value := query.Get("interval"); value != "" {
+		seconds, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return 0, errors.BadRequest("interval must be a number of seconds: %s", err.Error())
+		}
+		interval = time.Duration(seconds) * time.Second
+	}
+	if interval < minClusterStatusHistoryInterval {
+		return 0, errors.BadRequest("interval must be at least %d seconds", int64(minClusterStatusHistoryInterval/time.Second))
+	}
+	if period/interval > maxClusterStatusHistoryPoints {
+		return 0, errors.BadRequest("the period spans more than %d intervals, use a longer interval", maxClusterStatusHistoryPoints)
+	}
+	return interval, nil
+}
@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+)
+
+func Test_statusHistoryInterval(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   url.Values
+		period  time.Duration
+		want    time.Duration
+		wantErr bool
+	}{
+		{
+			name:   "should default to an hour",
+			period: 24 * time.Hour,
+			want:   time.Hour,
+		},
+		{
+			name:   "should parse the interval in seconds",
+			query:  url.Values{"interval": {"300"}},
+			period: 24 * time.Hour,
+			want:   5 * time.Minute,
+		},
+		{
+			name:    "should fail when the interval is not a number",
+			query:   url.Values{"interval": {"1h"}},
+			period:  24 * time.Hour,
+			wantErr: true,
+		},
+		{
+			name:    "should fail when the interval is too short",
+			query:   url.Values{"interval": {"10"}},
+			period:  24 * time.Hour,
+			wantErr: true,
+		},
+		{
+			name:    "should fail when the period spans too many intervals",
+			query:   url.Values{"interval": {"60"}},
+			period:  7 * 24 * time.Hour,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			got, err := statusHistoryInterval(tt.query, tt.period)
+			gomega.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			gomega.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func Test_adminClusterStatusHistoryHandler_Get(t *testing.T) {
+	from := time.Date(2022, 3, 6, 0, 0, 0, 0, time.UTC)
+	statusHistoryService := &services.DataPlaneClusterStatusHistoryServiceMock{
+		ListReportsFunc: func(clusterId string, from, to time.Time) (dbapi.DataPlaneClusterStatusReportList, *errors.ServiceError) {
+			return dbapi.DataPlaneClusterStatusReportList{
+				{ClusterId: clusterId, ReportedAt: from.Add(10 * time.Minute), NodesCurrent: 3, ScalingAction: dbapi.DataPlaneClusterScalingUp},
+				{ClusterId: clusterId, ReportedAt: from.Add(70 * time.Minute), NodesCurrent: 6, ScalingAction: dbapi.DataPlaneClusterScalingDown, Flapping: true},
+			}, nil
+		},
+	}
+
+	tests := []struct {
+		name     string
+		cluster  *api.Cluster
+		wantCode int
+	}{
+		{
+			name:     "should return the trend of the status reports of the cluster",
+			cluster:  &api.Cluster{ClusterID: "test-cluster"},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "should return not found when the cluster doesn't exist",
+			wantCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			clusterService := &services.ClusterServiceMock{
+				FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+					return tt.cluster, nil
+				},
+			}
+			handler := NewAdminClusterStatusHistoryHandler(clusterService, statusHistoryService)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(http.MethodGet, "/api/kafkas_mgmt/v1/admin/clusters/test-cluster/status_history?from=2022-03-06T00:00:00Z&to=2022-03-06T03:00:00Z", nil)
+			handler.Get(recorder, mux.SetURLVars(request, map[string]string{"cluster_id": "test-cluster"}))
+
+			gomega.Expect(recorder.Code).To(gomega.Equal(tt.wantCode))
+			if tt.wantCode != http.StatusOK {
+				return
+			}
+			var history private.ClusterStatusHistory
+			gomega.Expect(json.Unmarshal(recorder.Body.Bytes(), &history)).To(gomega.Succeed())
+			gomega.Expect(history.ClusterId).To(gomega.Equal("test-cluster"))
+			gomega.Expect(history.Interval).To(gomega.Equal(int64(3600)))
+			gomega.Expect(history.ScalingDirectionChanges).To(gomega.Equal(int32(1)))
+			gomega.Expect(history.Flapping).To(gomega.BeTrue())
+			gomega.Expect(history.Items).To(gomega.HaveLen(2))
+			gomega.Expect(history.Items[1].Timestamp).To(gomega.Equal(from.Add(time.Hour)))
+			gomega.Expect(history.Items[1].ScaleDowns).To(gomega.Equal(int32(1)))
+		})
+	}
+}
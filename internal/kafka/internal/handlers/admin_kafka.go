@@ -1,19 +1,23 @@
 package handlers
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/account"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
 	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/audit"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 	"github.com/gorilla/mux"
 )
@@ -33,16 +37,21 @@ func NewAdminKafkaHandler(service services.KafkaService, accountService account.
 }
 
 func (h adminKafkaHandler) Get(w http.ResponseWriter, r *http.Request) {
+	var kafkaRequest *dbapi.KafkaRequest
 	cfg := &handlers.HandlerConfig{
 		Action: func() (i interface{}, serviceError *errors.ServiceError) {
 			id := mux.Vars(r)["id"]
 			ctx := r.Context()
-			kafkaRequest, err := h.service.Get(ctx, id)
+			var err *errors.ServiceError
+			kafkaRequest, err = h.service.Get(ctx, id)
 			if err != nil {
 				return nil, err
 			}
 			return presenters.PresentKafkaRequestAdminEndpoint(kafkaRequest, h.accountService)
 		},
+		ETag: func() (string, *errors.ServiceError) {
+			return handlers.ETagFromTime(kafkaRequest.UpdatedAt), nil
+		},
 	}
 	handlers.HandleGet(w, r, cfg)
 }
@@ -87,6 +96,8 @@ func (h adminKafkaHandler) List(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h adminKafkaHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	// update time of the kafka the If-Match precondition was checked against
+	var updatedAt time.Time
 	cfg := &handlers.HandlerConfig{
 		Validate: []handlers.Validate{
 			handlers.ValidateAsyncEnabled(r, "deleting kafka requests"),
@@ -95,9 +106,15 @@ func (h adminKafkaHandler) Delete(w http.ResponseWriter, r *http.Request) {
 			id := mux.Vars(r)["id"]
 			ctx := r.Context()
 
-			err := h.service.RegisterKafkaDeprovisionJob(ctx, id)
+			if audit.IsAudited(ctx) {
+				if kafkaRequest, err := h.service.Get(ctx, id); err == nil {
+					h.setPreviousState(ctx, kafkaRequest)
+				}
+			}
+			err := h.service.RegisterKafkaDeprovisionJob(ctx, id, updatedAt)
 			return nil, err
 		},
+		ETag: kafkaETag(r, h.service, &updatedAt),
 	}
 
 	handlers.HandleDelete(w, r, cfg, http.StatusAccepted)
@@ -157,6 +174,7 @@ func (h adminKafkaHandler) Update(w http.ResponseWriter, r *http.Request) {
 			if err != nil {
 				return nil, err
 			}
+			h.setPreviousState(ctx, kafkaRequest)
 
 			update := func(val1 *string, val2 string) bool {
 				if val2 != "" && *val1 != val2 {
@@ -179,6 +197,25 @@ func (h adminKafkaHandler) Update(w http.ResponseWriter, r *http.Request) {
 			}
 			return presenters.PresentKafkaRequestAdminEndpoint(kafkaRequest, h.accountService)
 		},
+		ETag: func() (string, *errors.ServiceError) {
+			if err != nil {
+				return "", err
+			}
+			if kafkaRequest == nil {
+				return "", errors.NotFound("Unable to find kafka with id '%s'", id)
+			}
+			return handlers.ETagFromTime(kafkaRequest.UpdatedAt), nil
+		},
 	}
 	handlers.Handle(w, r, cfg, http.StatusOK)
 }
+
+// setPreviousState records the kafka request as returned by the admin endpoints before it is mutated by the request
+func (h adminKafkaHandler) setPreviousState(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) {
+	if !audit.IsAudited(ctx) {
+		return
+	}
+	if previous, err := presenters.PresentKafkaRequestAdminEndpoint(kafkaRequest, h.accountService); err == nil {
+		audit.SetPreviousState(ctx, previous)
+	}
+}
@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/audit"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/authorization"
+	"github.com/gorilla/mux"
+)
+
+type adminLocalUsersHandler struct {
+	localUserService authorization.LocalUserService
+}
+
+func NewAdminLocalUsersHandler(localUserService authorization.LocalUserService) *adminLocalUsersHandler {
+	return &adminLocalUsersHandler{
+		localUserService: localUserService,
+	}
+}
+
+func (h adminLocalUsersHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			listArgs := coreServices.NewListArguments(r.URL.Query())
+			if err := listArgs.Validate(); err != nil {
+				return nil, errors.NewWithCause(errors.ErrorMalformedRequest, err, "Unable to list local users: %s", err.Error())
+			}
+
+			users, paging, err := h.localUserService.List(listArgs)
+			if err != nil {
+				return nil, err
+			}
+
+			userList := private.LocalUserList{
+				Kind:  "LocalUserList",
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+				Items: []private.LocalUser{},
+			}
+			for _, user := range users {
+				userList.Items = append(userList.Items, presenters.PresentLocalUser(user))
+			}
+			return userList, nil
+		},
+	}
+
+	handlers.HandleList(w, r, cfg)
+}
+
+func (h adminLocalUsersHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			user, err := h.localUserService.Get(mux.Vars(r)["username"])
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentLocalUser(user), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+func (h adminLocalUsersHandler) Update(w http.ResponseWriter, r *http.Request) {
+	var userRequest private.LocalUserRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &userRequest,
+		Validate: []handlers.Validate{
+			handlers.ValidateMinLength(&userRequest.OrganisationId, "organisation_id", handlers.MinRequiredFieldLength),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			h.setPreviousState(r)
+			user, err := h.localUserService.Save(presenters.ConvertLocalUserRequest(mux.Vars(r)["username"], userRequest))
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentLocalUser(user), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+func (h adminLocalUsersHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			h.setPreviousState(r)
+			return nil, h.localUserService.Delete(mux.Vars(r)["username"])
+		},
+	}
+	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
+}
+
+// setPreviousState records the local user of the request, if it exists, before it is replaced or deleted
+func (h adminLocalUsersHandler) setPreviousState(r *http.Request) {
+	if !audit.IsAudited(r.Context()) {
+		return
+	}
+	if user, err := h.localUserService.Get(mux.Vars(r)["username"]); err == nil {
+		audit.SetPreviousState(r.Context(), presenters.PresentLocalUser(user))
+	}
+}
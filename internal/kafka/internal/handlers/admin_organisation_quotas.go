@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/audit"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/quota"
+	"github.com/gorilla/mux"
+)
+
+type adminOrganisationQuotasHandler struct {
+	organisationQuotaService quota.OrganisationQuotaService
+}
+
+func NewAdminOrganisationQuotasHandler(organisationQuotaService quota.OrganisationQuotaService) *adminOrganisationQuotasHandler {
+	return &adminOrganisationQuotasHandler{
+		organisationQuotaService: organisationQuotaService,
+	}
+}
+
+func (h adminOrganisationQuotasHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			quotas, err := h.organisationQuotaService.List(mux.Vars(r)["org_id"])
+			if err != nil {
+				return nil, err
+			}
+
+			quotaList := private.OrganisationQuotaList{
+				Kind:  "OrganisationQuotaList",
+				Page:  1,
+				Size:  int32(len(quotas)),
+				Total: int32(len(quotas)),
+				Items: []private.OrganisationQuota{},
+			}
+			for _, organisationQuota := range quotas {
+				quotaList.Items = append(quotaList.Items, presenters.PresentOrganisationQuota(organisationQuota))
+			}
+			return quotaList, nil
+		},
+	}
+
+	handlers.HandleList(w, r, cfg)
+}
+
+func (h adminOrganisationQuotasHandler) Update(w http.ResponseWriter, r *http.Request) {
+	var quotaRequest private.OrganisationQuotaRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &quotaRequest,
+		Action: func() (interface{}, *errors.ServiceError) {
+			vars := mux.Vars(r)
+			h.setPreviousState(r)
+			organisationQuota, err := h.organisationQuotaService.Save(presenters.ConvertOrganisationQuotaRequest(vars["org_id"], vars["resource"], quotaRequest))
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentOrganisationQuota(organisationQuota), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+func (h adminOrganisationQuotasHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			vars := mux.Vars(r)
+			h.setPreviousState(r)
+			return nil, h.organisationQuotaService.Delete(vars["org_id"], vars["resource"])
+		},
+	}
+	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
+}
+
+// setPreviousState records the quota of the request, if it exists, before it is replaced or deleted
+func (h adminOrganisationQuotasHandler) setPreviousState(r *http.Request) {
+	if !audit.IsAudited(r.Context()) {
+		return
+	}
+	vars := mux.Vars(r)
+	if organisationQuota, err := h.organisationQuotaService.Get(vars["org_id"], vars["resource"]); err == nil && organisationQuota != nil {
+		audit.SetPreviousState(r.Context(), presenters.PresentOrganisationQuota(organisationQuota))
+	}
+}
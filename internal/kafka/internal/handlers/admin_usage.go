@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/metering"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/golang/glog"
+)
+
+const (
+	usageReportFormatJSON = "json"
+	usageReportFormatCSV  = "csv"
+)
+
+var usageReportCSVHeader = []string{"organisation_id", "resource_type", "instance_type", "cloud_provider", "region", "multi_az", "storage_size", "instances", "hours"}
+
+type adminUsageHandler struct {
+	meteringService metering.MeteringService
+}
+
+func NewAdminUsageHandler(meteringService metering.MeteringService) *adminUsageHandler {
+	return &adminUsageHandler{
+		meteringService: meteringService,
+	}
+}
+
+// Get is the handler for exporting the usage of the kafkas and connectors as JSON or CSV
+func (h adminUsageHandler) Get(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	format := query.Get("format")
+	if format == "" {
+		format = usageReportFormatJSON
+	}
+	if format != usageReportFormatJSON && format != usageReportFormatCSV {
+		shared.HandleError(r, w, errors.BadRequest("format must be one of %s or %s", usageReportFormatJSON, usageReportFormatCSV))
+		return
+	}
+
+	if format == usageReportFormatJSON {
+		cfg := &handlers.HandlerConfig{
+			Action: func() (interface{}, *errors.ServiceError) {
+				filter, aggregates, err := h.aggregate(query)
+				if err != nil {
+					return nil, err
+				}
+				return presenters.PresentUsageReport(filter, aggregates), nil
+			},
+		}
+		handlers.HandleGet(w, r, cfg)
+		return
+	}
+
+	_, aggregates, err := h.aggregate(query)
+	if err != nil {
+		shared.HandleError(r, w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "text/csv")
+	w.WriteHeader(http.StatusOK)
+	writer := csv.NewWriter(w)
+	rows := [][]string{usageReportCSVHeader}
+	for _, aggregate := range aggregates {
+		rows = append(rows, []string{
+			aggregate.OrganisationId,
+			aggregate.ResourceType,
+			aggregate.InstanceType,
+			aggregate.CloudProvider,
+			aggregate.Region,
+			strconv.FormatBool(aggregate.MultiAZ),
+			aggregate.StorageSize,
+			strconv.Itoa(aggregate.Instances),
+			strconv.FormatFloat(aggregate.Hours, 'f', -1, 64),
+		})
+	}
+	if err := writer.WriteAll(rows); err != nil {
+		glog.Errorf("unable to write usage report: %v", err)
+	}
+}
+
+func (h adminUsageHandler) aggregate(query url.Values) (metering.UsageFilter, []metering.UsageAggregate, *errors.ServiceError) {
+	filter, err := usageFilter(query)
+	if err != nil {
+		return filter, nil, err
+	}
+	aggregates, err := h.meteringService.Aggregate(filter)
+	return filter, aggregates, err
+}
+
+// usageFilter returns the filter of the usage report, the period defaults to the current month up to now
+func usageFilter(query url.Values) (metering.UsageFilter, *errors.ServiceError) {
+	now := time.Now().UTC()
+	filter := metering.UsageFilter{
+		OrganisationId: query.Get("organisation_id"),
+		From:           time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC),
+		To:             now,
+	}
+	for _, param := range []struct {
+		name  string
+		value *time.Time
+	}{
+		{"from", &filter.From},
+		{"to", &filter.To},
+	} {
+		if value := query.Get(param.name); value != "" {
+			t, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return filter, errors.BadRequest("%s must be a RFC 3339 date-time: %s", param.name, err.Error())
+			}
+			*param.value = t
+		}
+	}
+	if !filter.From.Before(filter.To) {
+		return filter, errors.BadRequest("from must be before to")
+	}
+	return filter, nil
+}
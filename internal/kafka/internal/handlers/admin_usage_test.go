@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/metering"
+	"github.com/onsi/gomega"
+)
+
+func Test_usageFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   url.Values
+		want    metering.UsageFilter
+		wantErr bool
+	}{
+		{
+			name: "should filter by the query parameters",
+			query: url.Values{
+				"organisation_id": {"test-org"},
+				"from":            {"2022-02-01T00:00:00Z"},
+				"to":              {"2022-03-01T00:00:00Z"},
+			},
+			want: metering.UsageFilter{
+				OrganisationId: "test-org",
+				From:           time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
+				To:             time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:    "should fail when a date is invalid",
+			query:   url.Values{"from": {"last month"}},
+			wantErr: true,
+		},
+		{
+			name:    "should fail when the period is empty",
+			query:   url.Values{"from": {"2022-03-01T00:00:00Z"}, "to": {"2022-02-01T00:00:00Z"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			got, err := usageFilter(tt.query)
+			gomega.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if !tt.wantErr {
+				gomega.Expect(got).To(gomega.Equal(tt.want))
+			}
+		})
+	}
+}
+
+func Test_adminUsageHandler_Get_CSV(t *testing.T) {
+	gomega.RegisterTestingT(t)
+	meteringService := &metering.MeteringServiceMock{
+		AggregateFunc: func(filter metering.UsageFilter) ([]metering.UsageAggregate, *errors.ServiceError) {
+			return []metering.UsageAggregate{
+				{OrganisationId: "test-org", ResourceType: "kafka", InstanceType: "standard", CloudProvider: "aws", Region: "us-east-1", MultiAZ: true, StorageSize: "1000Gi", Instances: 2, Hours: 1.5},
+			}, nil
+		},
+	}
+	handler := NewAdminUsageHandler(meteringService)
+
+	recorder := httptest.NewRecorder()
+	handler.Get(recorder, httptest.NewRequest(http.MethodGet, "/api/kafkas_mgmt/v1/admin/usage?format=csv", nil))
+
+	gomega.Expect(recorder.Code).To(gomega.Equal(http.StatusOK))
+	gomega.Expect(recorder.Header().Get("Content-Type")).To(gomega.Equal("text/csv"))
+	gomega.Expect(recorder.Body.String()).To(gomega.Equal(
+		"organisation_id,resource_type,instance_type,cloud_provider,region,multi_az,storage_size,instances,hours\n" +
+			"test-org,kafka,standard,aws,us-east-1,true,1000Gi,2,1.5\n"))
+}
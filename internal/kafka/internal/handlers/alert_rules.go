@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/audit"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/rbac"
+	"github.com/gorilla/mux"
+)
+
+type alertRulesHandler struct {
+	service            services.KafkaService
+	alertService       services.KafkaAlertService
+	roleBindingService rbac.RoleBindingService
+}
+
+func NewAlertRulesHandler(service services.KafkaService, alertService services.KafkaAlertService, roleBindingService rbac.RoleBindingService) *alertRulesHandler {
+	return &alertRulesHandler{
+		service:            service,
+		alertService:       alertService,
+		roleBindingService: roleBindingService,
+	}
+}
+
+// List is the handler for listing the alert rules of a kafka request
+func (h alertRulesHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			kafkaRequest, err := h.service.Get(r.Context(), mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+			rules, err := h.alertService.List(kafkaRequest.ID)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentAlertRuleList(rules), nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+// Get is the handler for getting an alert rule of a kafka request
+func (h alertRulesHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			kafkaRequest, err := h.service.Get(r.Context(), mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+			rule, err := h.alertService.Get(kafkaRequest.ID, mux.Vars(r)["alert_rule_id"])
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentAlertRule(rule), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+// Create is the handler for creating an alert rule for a kafka request
+func (h alertRulesHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var alertRuleRequest public.AlertRuleRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &alertRuleRequest,
+		Validate: []handlers.Validate{
+			handlers.ValidateMinLength(&alertRuleRequest.Name, "name", handlers.MinRequiredFieldLength),
+			handlers.ValidateMinLength(&alertRuleRequest.Metric, "metric", handlers.MinRequiredFieldLength),
+			handlers.ValidateMinLength(&alertRuleRequest.Operator, "operator", handlers.MinRequiredFieldLength),
+			handlers.ValidateMinLength(&alertRuleRequest.NotificationType, "notification_type", handlers.MinRequiredFieldLength),
+			handlers.ValidateMinLength(&alertRuleRequest.NotificationTarget, "notification_target", handlers.MinRequiredFieldLength),
+			ValidateAlertRuleNotificationTarget(r.Context(), &alertRuleRequest),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			kafkaRequest, err := editableKafka(r, h.service, h.roleBindingService)
+			if err != nil {
+				return nil, err
+			}
+			rule, err := h.alertService.Create(kafkaRequest, presenters.ConvertAlertRuleRequest(alertRuleRequest))
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentAlertRule(rule), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusCreated)
+}
+
+// Delete is the handler for deleting an alert rule of a kafka request
+func (h alertRulesHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			kafkaRequest, err := editableKafka(r, h.service, h.roleBindingService)
+			if err != nil {
+				return nil, err
+			}
+			if audit.IsAudited(r.Context()) {
+				if rule, err := h.alertService.Get(kafkaRequest.ID, mux.Vars(r)["alert_rule_id"]); err == nil {
+					audit.SetPreviousState(r.Context(), presenters.PresentAlertRule(rule))
+				}
+			}
+			return nil, h.alertService.Delete(kafkaRequest.ID, mux.Vars(r)["alert_rule_id"])
+		},
+	}
+	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
+}
+
+// ListAlerts is the handler for listing the alert history of a kafka request
+func (h alertRulesHandler) ListAlerts(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			kafkaRequest, err := h.service.Get(r.Context(), mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+			listArgs := coreServices.NewListArguments(r.URL.Query())
+			if err := listArgs.Validate(); err != nil {
+				return nil, errors.NewWithCause(errors.ErrorMalformedRequest, err, "Unable to list alerts: %s", err.Error())
+			}
+			alerts, paging, err := h.alertService.ListAlerts(kafkaRequest.ID, listArgs)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentAlertList(alerts, paging), nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
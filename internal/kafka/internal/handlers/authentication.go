@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/routes"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/keycloak"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/server"
@@ -13,7 +14,7 @@ import (
 	pkgErrors "github.com/pkg/errors"
 )
 
-func NewAuthenticationBuilder(ServerConfig *server.ServerConfig, KeycloakConfig *keycloak.KeycloakConfig) (*authentication.HandlerBuilder, error) {
+func NewAuthenticationBuilder(ServerConfig *server.ServerConfig, KeycloakConfig *keycloak.KeycloakConfig, OIDCConfig *auth.OIDCConfig) (*auth.AuthenticationBuilder, error) {
 
 	authnLogger, err := sdk.NewGlogLoggerBuilder().
 		InfoV(glog.Level(1)).
@@ -24,18 +25,29 @@ func NewAuthenticationBuilder(ServerConfig *server.ServerConfig, KeycloakConfig
 		return nil, pkgErrors.Wrap(err, "unable to create authentication logger")
 	}
 
-	return authentication.NewHandler().
+	newBuilder := func() *authentication.HandlerBuilder {
+		return authentication.NewHandler().
 			Logger(authnLogger).
 			KeysInsecure(ServerConfig.VerifyInsecure).
-			KeysURL(ServerConfig.JwksURL).                              //ocm JWK JSON web token signing certificates URL
-			KeysFile(ServerConfig.JwksFile).                            //ocm JWK backup JSON web token signing certificates
-			KeysURL(KeycloakConfig.KafkaRealm.JwksEndpointURI).         // mas-sso JWK Cert URL
-			KeysURL(KeycloakConfig.OSDClusterIDPRealm.JwksEndpointURI). // mas-sso SRE realm cert URL
 			Error(fmt.Sprint(errors.ErrorUnauthenticated)).
 			Service(errors.ERROR_CODE_PREFIX).
 			Public(fmt.Sprintf("^%s/%s/?$", routes.ApiEndpoint, routes.KafkasFleetManagementApiPrefix)).
 			Public(fmt.Sprintf("^%s/%s/%s/?$", routes.ApiEndpoint, routes.KafkasFleetManagementApiPrefix, routes.Version)).
 			Public(fmt.Sprintf("^%s/%s/%s/openapi/?$", routes.ApiEndpoint, routes.KafkasFleetManagementApiPrefix, routes.Version)).
-			Public(fmt.Sprintf("^%s/%s/%s/errors/?[0-9]*", routes.ApiEndpoint, routes.KafkasFleetManagementApiPrefix, routes.Version)),
-		nil
+			Public(fmt.Sprintf("^%s/%s/%s/errors/?[0-9]*", routes.ApiEndpoint, routes.KafkasFleetManagementApiPrefix, routes.Version))
+	}
+
+	builder := &auth.AuthenticationBuilder{
+		Default: newBuilder().
+			KeysURL(ServerConfig.JwksURL).                              //ocm JWK JSON web token signing certificates URL
+			KeysFile(ServerConfig.JwksFile).                            //ocm JWK backup JSON web token signing certificates
+			KeysURL(KeycloakConfig.KafkaRealm.JwksEndpointURI).         // mas-sso JWK Cert URL
+			KeysURL(KeycloakConfig.OSDClusterIDPRealm.JwksEndpointURI), // mas-sso SRE realm cert URL
+		Issuers: map[string]*authentication.HandlerBuilder{},
+	}
+	// the OpenID Connect providers configured with --oidc-issuer-url only verify the tokens of their own issuer
+	for _, provider := range OIDCConfig.Providers {
+		builder.Issuers[provider.Issuer] = newBuilder().KeysURL(provider.JwksURI)
+	}
+	return builder, nil
 }
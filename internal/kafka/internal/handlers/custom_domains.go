@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/audit"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/rbac"
+	"github.com/gorilla/mux"
+)
+
+type customDomainsHandler struct {
+	service             services.KafkaService
+	customDomainService services.KafkaCustomDomainService
+	roleBindingService  rbac.RoleBindingService
+}
+
+func NewCustomDomainsHandler(service services.KafkaService, customDomainService services.KafkaCustomDomainService, roleBindingService rbac.RoleBindingService) *customDomainsHandler {
+	return &customDomainsHandler{
+		service:             service,
+		customDomainService: customDomainService,
+		roleBindingService:  roleBindingService,
+	}
+}
+
+// List is the handler for listing the custom domains of a kafka request
+func (h customDomainsHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			kafkaRequest, err := h.service.Get(r.Context(), mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+			customDomains, err := h.customDomainService.List(kafkaRequest.ID)
+			if err != nil {
+				return nil, err
+			}
+			items := make([]public.CustomDomain, 0, len(customDomains))
+			for _, customDomain := range customDomains {
+				items = append(items, presenters.PresentCustomDomain(customDomain, h.customDomainService.Records(kafkaRequest, customDomain)))
+			}
+			return presenters.PresentCustomDomainList(items), nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+// Get is the handler for getting a custom domain of a kafka request
+func (h customDomainsHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			kafkaRequest, err := h.service.Get(r.Context(), mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+			customDomain, err := h.customDomainService.Get(kafkaRequest.ID, mux.Vars(r)["custom_domain_id"])
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentCustomDomain(customDomain, h.customDomainService.Records(kafkaRequest, customDomain)), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+// Create is the handler for registering a custom domain for a kafka request
+func (h customDomainsHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var customDomainRequest public.CustomDomainRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &customDomainRequest,
+		Validate: []handlers.Validate{
+			handlers.ValidateMinLength(&customDomainRequest.Domain, "domain", handlers.MinRequiredFieldLength),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			kafkaRequest, err := editableKafka(r, h.service, h.roleBindingService)
+			if err != nil {
+				return nil, err
+			}
+			customDomain, err := h.customDomainService.Create(kafkaRequest, customDomainRequest.Domain, customDomainRequest.Certificate, customDomainRequest.PrivateKey)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentCustomDomain(customDomain, h.customDomainService.Records(kafkaRequest, customDomain)), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusCreated)
+}
+
+// Delete is the handler for deleting a custom domain of a kafka request
+func (h customDomainsHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			kafkaRequest, err := editableKafka(r, h.service, h.roleBindingService)
+			if err != nil {
+				return nil, err
+			}
+			if audit.IsAudited(r.Context()) {
+				if customDomain, err := h.customDomainService.Get(kafkaRequest.ID, mux.Vars(r)["custom_domain_id"]); err == nil {
+					audit.SetPreviousState(r.Context(), presenters.PresentCustomDomain(customDomain, h.customDomainService.Records(kafkaRequest, customDomain)))
+				}
+			}
+			return nil, h.customDomainService.Delete(kafkaRequest.ID, mux.Vars(r)["custom_domain_id"])
+		},
+	}
+	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
+}
+
+// editableKafka returns the kafka request identified by the id path variable when the user is an editor of it. Kafka
+// requests the user can't see are not found.
+func editableKafka(r *http.Request, service services.KafkaService, roleBindingService rbac.RoleBindingService) (*dbapi.KafkaRequest, *errors.ServiceError) {
+	kafkaRequest, err := service.Get(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		return nil, err
+	}
+	if err := roleBindingService.CheckRole(r.Context(), kafkaResource(kafkaRequest), rbac.RoleEditor); err != nil {
+		if err.IsForbidden() {
+			return nil, errors.New(errors.ErrorUnauthorized, "User not authorized to perform this action")
+		}
+		return nil, err
+	}
+	return kafkaRequest, nil
+}
@@ -3,13 +3,17 @@ package handlers
 import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"net/http"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/audit"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/authorization"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/rbac"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/tracing"
 
 	"github.com/gorilla/mux"
 
@@ -18,16 +22,18 @@ import (
 )
 
 type kafkaHandler struct {
-	service        services.KafkaService
-	providerConfig *config.ProviderConfig
-	authService    authorization.Authorization
+	service            services.KafkaService
+	providerConfig     *config.ProviderConfig
+	authService        authorization.Authorization
+	roleBindingService rbac.RoleBindingService
 }
 
-func NewKafkaHandler(service services.KafkaService, providerConfig *config.ProviderConfig, authService authorization.Authorization) *kafkaHandler {
+func NewKafkaHandler(service services.KafkaService, providerConfig *config.ProviderConfig, authService authorization.Authorization, roleBindingService rbac.RoleBindingService) *kafkaHandler {
 	return &kafkaHandler{
-		service:        service,
-		providerConfig: providerConfig,
-		authService:    authService,
+		service:            service,
+		providerConfig:     providerConfig,
+		authService:        authService,
+		roleBindingService: roleBindingService,
 	}
 }
 
@@ -48,10 +54,16 @@ func (h kafkaHandler) Create(w http.ResponseWriter, r *http.Request) {
 			handlers.ValidateMultiAZEnabled(&kafkaRequest.MultiAz, "creating kafka requests"),
 		},
 		Action: func() (interface{}, *errors.ServiceError) {
-			svcErr := h.service.RegisterKafkaJob(convKafka)
+			spanCtx, span := tracing.Start(ctx, "RegisterKafkaJob")
+			svcErr := h.service.RegisterKafkaJob(spanCtx, convKafka)
 			if svcErr != nil {
+				tracing.End(span, svcErr)
 				return nil, svcErr
 			}
+			// the id is generated when registering, it identifies the spans of the kafka in the workers
+			tracing.SetAttributes(ctx, tracing.KafkaIDKey.String(convKafka.ID))
+			span.SetAttributes(tracing.KafkaIDKey.String(convKafka.ID))
+			tracing.End(span, nil)
 			return presenters.PresentKafkaRequest(convKafka), nil
 		},
 	}
@@ -61,22 +73,29 @@ func (h kafkaHandler) Create(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h kafkaHandler) Get(w http.ResponseWriter, r *http.Request) {
+	var kafkaRequest *dbapi.KafkaRequest
 	cfg := &handlers.HandlerConfig{
 		Action: func() (i interface{}, serviceError *errors.ServiceError) {
 			id := mux.Vars(r)["id"]
 			ctx := r.Context()
-			kafkaRequest, err := h.service.Get(ctx, id)
+			var err *errors.ServiceError
+			kafkaRequest, err = h.service.Get(ctx, id)
 			if err != nil {
 				return nil, err
 			}
 			return presenters.PresentKafkaRequest(kafkaRequest), nil
 		},
+		ETag: func() (string, *errors.ServiceError) {
+			return handlers.ETagFromTime(kafkaRequest.UpdatedAt), nil
+		},
 	}
 	handlers.HandleGet(w, r, cfg)
 }
 
 // Delete is the handler for deleting a kafka request
 func (h kafkaHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	// update time of the kafka the If-Match precondition was checked against
+	var updatedAt time.Time
 	cfg := &handlers.HandlerConfig{
 		Validate: []handlers.Validate{
 			handlers.ValidateAsyncEnabled(r, "deleting kafka requests"),
@@ -85,9 +104,15 @@ func (h kafkaHandler) Delete(w http.ResponseWriter, r *http.Request) {
 			id := mux.Vars(r)["id"]
 			ctx := r.Context()
 
-			err := h.service.RegisterKafkaDeprovisionJob(ctx, id)
+			if audit.IsAudited(ctx) {
+				if kafkaRequest, err := h.service.Get(ctx, id); err == nil {
+					audit.SetPreviousState(ctx, presenters.PresentKafkaRequest(kafkaRequest))
+				}
+			}
+			err := h.service.RegisterKafkaDeprovisionJob(ctx, id, updatedAt)
 			return nil, err
 		},
+		ETag: kafkaETag(r, h.service, &updatedAt),
 	}
 	handlers.HandleDelete(w, r, cfg, http.StatusAccepted)
 }
@@ -143,9 +168,10 @@ func (h kafkaHandler) Update(w http.ResponseWriter, r *http.Request) {
 		MarshalInto: &kafkaUpdateReq,
 		Validate: []handlers.Validate{
 			validateKafkaFound(),
-			ValidateKafkaUserFacingUpdateFields(ctx, h.authService, kafkaRequest, &kafkaUpdateReq),
+			ValidateKafkaUserFacingUpdateFields(ctx, h.authService, h.roleBindingService, kafkaRequest, &kafkaUpdateReq),
 		},
 		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			audit.SetPreviousState(ctx, presenters.PresentKafkaRequest(kafkaRequest))
 			updatedNeeded := false
 			if kafkaUpdateReq.ReauthenticationEnabled != nil && kafkaRequest.ReauthenticationEnabled != *kafkaUpdateReq.ReauthenticationEnabled {
 				kafkaRequest.ReauthenticationEnabled = *kafkaUpdateReq.ReauthenticationEnabled
@@ -158,7 +184,7 @@ func (h kafkaHandler) Update(w http.ResponseWriter, r *http.Request) {
 			}
 
 			if updatedNeeded {
-				updateErr := h.service.Updates(kafkaRequest, map[string]interface{}{
+				updateErr := h.service.UpdatesIfUnmodified(kafkaRequest, map[string]interface{}{
 					"reauthentication_enabled": kafkaRequest.ReauthenticationEnabled,
 					"owner":                    kafkaRequest.Owner,
 				})
@@ -170,6 +196,25 @@ func (h kafkaHandler) Update(w http.ResponseWriter, r *http.Request) {
 
 			return presenters.PresentKafkaRequest(kafkaRequest), nil
 		},
+		ETag: func() (string, *errors.ServiceError) {
+			if kafkaGetError != nil {
+				return "", kafkaGetError
+			}
+			return handlers.ETagFromTime(kafkaRequest.UpdatedAt), nil
+		},
 	}
 	handlers.Handle(w, r, cfg, http.StatusOK)
 }
+
+// kafkaETag returns the entity tag of the kafka request identified by the id path variable, and keeps its update
+// time so that the write can be made conditional on it.
+func kafkaETag(r *http.Request, service services.KafkaService, updatedAt *time.Time) func() (string, *errors.ServiceError) {
+	return func() (string, *errors.ServiceError) {
+		kafkaRequest, err := service.Get(r.Context(), mux.Vars(r)["id"])
+		if err != nil {
+			return "", err
+		}
+		*updatedAt = kafkaRequest.UpdatedAt
+		return handlers.ETagFromTime(kafkaRequest.UpdatedAt), nil
+	}
+}
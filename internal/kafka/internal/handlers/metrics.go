@@ -153,6 +153,9 @@ func extractMetricsQueryParams(r *http.Request, q *observatorium.MetricsReqParam
 	}
 	if filters, ok := queryParams["filters"]; ok && len(filters) > 0 {
 		q.Filters = filters
+	} else {
+		// the metrics API returns the default metric set when no metrics are filtered, unlike the federate endpoint
+		q.Filters = []string{observatorium.DefaultMetricSet}
 	}
 
 }
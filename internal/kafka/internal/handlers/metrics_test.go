@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/observatorium"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+)
+
+func Test_metricsHandler_Filters(t *testing.T) {
+	tests := []struct {
+		name        string
+		target      string
+		handler     func(h *metricsHandler) http.HandlerFunc
+		wantFilters []string
+	}{
+		{
+			name:   "should federate all the metrics whatever the default metric set",
+			target: "/kafkas/test-kafka/metrics/federate",
+			handler: func(h *metricsHandler) http.HandlerFunc {
+				return h.FederateMetrics
+			},
+			wantFilters: nil,
+		},
+		{
+			name:   "should query the default metric set when no metrics are filtered",
+			target: "/kafkas/test-kafka/metrics/query",
+			handler: func(h *metricsHandler) http.HandlerFunc {
+				return h.GetMetricsByInstantQuery
+			},
+			wantFilters: []string{observatorium.DefaultMetricSet},
+		},
+		{
+			name:   "should query the filtered metrics",
+			target: "/kafkas/test-kafka/metrics/query_range?duration=5&interval=30&filters=kafka_broker_quota_softlimitbytes",
+			handler: func(h *metricsHandler) http.HandlerFunc {
+				return h.GetMetricsByRangeQuery
+			},
+			wantFilters: []string{"kafka_broker_quota_softlimitbytes"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			service := &services.ObservatoriumServiceMock{
+				GetMetricsByKafkaIdFunc: func(ctx context.Context, csMetrics *observatorium.KafkaMetrics, id string, query observatorium.MetricsReqParams) (string, *errors.ServiceError) {
+					return id, nil
+				},
+			}
+			req := mux.SetURLVars(httptest.NewRequest(http.MethodGet, tt.target, nil), map[string]string{"id": "test-kafka"})
+			rw := httptest.NewRecorder()
+			tt.handler(NewMetricsHandler(service))(rw, req)
+
+			gomega.Expect(rw.Code).To(gomega.Equal(http.StatusOK))
+			gomega.Expect(service.GetMetricsByKafkaIdCalls()).To(gomega.HaveLen(1))
+			gomega.Expect(service.GetMetricsByKafkaIdCalls()[0].Query.Filters).To(gomega.Equal(tt.wantFilters))
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/quota"
+)
+
+type quotaUsageHandler struct {
+	organisationQuotaService quota.OrganisationQuotaService
+}
+
+func NewQuotaUsageHandler(organisationQuotaService quota.OrganisationQuotaService) *quotaUsageHandler {
+	return &quotaUsageHandler{
+		organisationQuotaService: organisationQuotaService,
+	}
+}
+
+// Get is the handler for reporting the quota usage of the organisation of the user
+func (h quotaUsageHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			claims, err := auth.GetClaimsFromContext(r.Context())
+			if err != nil {
+				return nil, errors.NewWithCause(errors.ErrorUnauthenticated, err, "user not authenticated")
+			}
+			usage, svcErr := h.organisationQuotaService.Usage(auth.GetOrgIdFromClaims(claims))
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			return presenters.PresentQuotaUsageList(usage), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
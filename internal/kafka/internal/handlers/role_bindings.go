@@ -0,0 +1,155 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/audit"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/rbac"
+	"github.com/gorilla/mux"
+)
+
+type roleBindingsHandler struct {
+	service            services.KafkaService
+	roleBindingService rbac.RoleBindingService
+}
+
+func NewRoleBindingsHandler(service services.KafkaService, roleBindingService rbac.RoleBindingService) *roleBindingsHandler {
+	return &roleBindingsHandler{
+		service:            service,
+		roleBindingService: roleBindingService,
+	}
+}
+
+// ListKafkaRoleBindings is the handler for listing the role bindings of a kafka request
+func (h roleBindingsHandler) ListKafkaRoleBindings(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			resource, err := h.kafkaResource(r)
+			if err != nil {
+				return nil, err
+			}
+			bindings, err := h.roleBindingService.List(r.Context(), resource)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentRoleBindingList(bindings), nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+// CreateKafkaRoleBinding is the handler for granting a user a role on a kafka request
+func (h roleBindingsHandler) CreateKafkaRoleBinding(w http.ResponseWriter, r *http.Request) {
+	h.grant(w, r, h.kafkaResource)
+}
+
+// DeleteKafkaRoleBinding is the handler for revoking a role granted on a kafka request
+func (h roleBindingsHandler) DeleteKafkaRoleBinding(w http.ResponseWriter, r *http.Request) {
+	h.revoke(w, r, h.kafkaResource)
+}
+
+// ListRoleBindings is the handler for listing the organisation wide role bindings of the organisation of the user
+func (h roleBindingsHandler) ListRoleBindings(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			resource, err := organisationResource(r)
+			if err != nil {
+				return nil, err
+			}
+			bindings, err := h.roleBindingService.List(r.Context(), resource)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentRoleBindingList(bindings), nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+// CreateRoleBinding is the handler for granting a user a role on all the resources of the organisation of the user
+func (h roleBindingsHandler) CreateRoleBinding(w http.ResponseWriter, r *http.Request) {
+	h.grant(w, r, organisationResource)
+}
+
+// DeleteRoleBinding is the handler for revoking an organisation wide role
+func (h roleBindingsHandler) DeleteRoleBinding(w http.ResponseWriter, r *http.Request) {
+	h.revoke(w, r, organisationResource)
+}
+
+func (h roleBindingsHandler) grant(w http.ResponseWriter, r *http.Request, getResource func(r *http.Request) (rbac.Resource, *errors.ServiceError)) {
+	var roleBindingRequest public.RoleBindingRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &roleBindingRequest,
+		Validate: []handlers.Validate{
+			handlers.ValidateMinLength(&roleBindingRequest.Subject, "subject", handlers.MinRequiredFieldLength),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			resource, err := getResource(r)
+			if err != nil {
+				return nil, err
+			}
+			binding, err := h.roleBindingService.Grant(r.Context(), resource, roleBindingRequest.Subject, rbac.Role(roleBindingRequest.Role))
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentRoleBinding(binding), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusCreated)
+}
+
+func (h roleBindingsHandler) revoke(w http.ResponseWriter, r *http.Request, getResource func(r *http.Request) (rbac.Resource, *errors.ServiceError)) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			resource, err := getResource(r)
+			if err != nil {
+				return nil, err
+			}
+			if audit.IsAudited(r.Context()) {
+				if bindings, err := h.roleBindingService.List(r.Context(), resource); err == nil {
+					for _, binding := range bindings {
+						if binding.ID == mux.Vars(r)["binding_id"] {
+							audit.SetPreviousState(r.Context(), presenters.PresentRoleBinding(binding))
+						}
+					}
+				}
+			}
+			return nil, h.roleBindingService.Revoke(r.Context(), resource, mux.Vars(r)["binding_id"])
+		},
+	}
+	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
+}
+
+// kafkaResource returns the kafka request identified by the id path variable. Kafka requests the user can't see are
+// not found.
+func (h roleBindingsHandler) kafkaResource(r *http.Request) (rbac.Resource, *errors.ServiceError) {
+	kafkaRequest, err := h.service.Get(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		return rbac.Resource{}, err
+	}
+	return kafkaResource(kafkaRequest), nil
+}
+
+func organisationResource(r *http.Request) (rbac.Resource, *errors.ServiceError) {
+	claims, err := auth.GetClaimsFromContext(r.Context())
+	if err != nil {
+		return rbac.Resource{}, errors.NewWithCause(errors.ErrorUnauthenticated, err, "user not authenticated")
+	}
+	return rbac.OrganisationResource(auth.GetOrgIdFromClaims(claims)), nil
+}
+
+func kafkaResource(kafkaRequest *dbapi.KafkaRequest) rbac.Resource {
+	return rbac.Resource{
+		Type:           rbac.ResourceTypeKafka,
+		ID:             kafkaRequest.ID,
+		Owner:          kafkaRequest.Owner,
+		OrganisationId: kafkaRequest.OrganisationId,
+	}
+}
@@ -0,0 +1,167 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/rbac"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+)
+
+func newTestRoleBindingsHandler(roleBindingService rbac.RoleBindingService) *roleBindingsHandler {
+	return NewRoleBindingsHandler(&services.KafkaServiceMock{
+		GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+			if id != "kafka-1" {
+				return nil, errors.NotFound("Kafka Request with id='%v' not found", id)
+			}
+			return &dbapi.KafkaRequest{Meta: api.Meta{ID: id}, Owner: "owner", OrganisationId: "test-org"}, nil
+		},
+	}, roleBindingService)
+}
+
+// newTestRoleBindingsRequest returns a request of a user of the test organisation
+func newTestRoleBindingsRequest(method string, target string, body string, vars map[string]string) *http.Request {
+	r := httptest.NewRequest(method, target, strings.NewReader(body))
+	ctx := auth.SetTokenInContext(r.Context(), &jwt.Token{Claims: jwt.MapClaims{"username": "test-user", "org_id": "test-org"}})
+	return mux.SetURLVars(r.WithContext(ctx), vars)
+}
+
+func Test_roleBindingsHandler_CreateKafkaRoleBinding(t *testing.T) {
+	tests := []struct {
+		name       string
+		kafkaId    string
+		body       string
+		grantErr   *errors.ServiceError
+		wantCode   int
+		wantGrants int
+	}{
+		{
+			name:       "should grant the role on the kafka",
+			kafkaId:    "kafka-1",
+			body:       `{"subject": "other-user", "role": "editor"}`,
+			wantCode:   http.StatusCreated,
+			wantGrants: 1,
+		},
+		{
+			name:     "should not grant a role on a kafka the user can't see",
+			kafkaId:  "kafka-2",
+			body:     `{"subject": "other-user", "role": "editor"}`,
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:     "should fail without subject",
+			kafkaId:  "kafka-1",
+			body:     `{"role": "editor"}`,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:       "should fail when the user isn't allowed to grant roles on the kafka",
+			kafkaId:    "kafka-1",
+			body:       `{"subject": "other-user", "role": "editor"}`,
+			grantErr:   errors.Forbidden("admin role is required"),
+			wantCode:   http.StatusForbidden,
+			wantGrants: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			roleBindingService := &rbac.RoleBindingServiceMock{
+				GrantFunc: func(ctx context.Context, resource rbac.Resource, subject string, role rbac.Role) (*api.RoleBinding, *errors.ServiceError) {
+					if tt.grantErr != nil {
+						return nil, tt.grantErr
+					}
+					return &api.RoleBinding{Meta: api.Meta{ID: "binding-1"}, ResourceType: string(resource.Type), ResourceId: resource.ID, Subject: subject, Role: string(role)}, nil
+				},
+			}
+			handler := newTestRoleBindingsHandler(roleBindingService)
+
+			recorder := httptest.NewRecorder()
+			handler.CreateKafkaRoleBinding(recorder, newTestRoleBindingsRequest(http.MethodPost, "/api/kafkas_mgmt/v1/kafkas/"+tt.kafkaId+"/role_bindings", tt.body, map[string]string{"id": tt.kafkaId}))
+
+			gomega.Expect(recorder.Code).To(gomega.Equal(tt.wantCode))
+			gomega.Expect(roleBindingService.GrantCalls()).To(gomega.HaveLen(tt.wantGrants))
+			if tt.wantGrants == 0 {
+				return
+			}
+			call := roleBindingService.GrantCalls()[0]
+			gomega.Expect(call.Resource).To(gomega.Equal(rbac.Resource{Type: rbac.ResourceTypeKafka, ID: "kafka-1", Owner: "owner", OrganisationId: "test-org"}))
+			gomega.Expect(call.Subject).To(gomega.Equal("other-user"))
+			gomega.Expect(call.Role).To(gomega.Equal(rbac.RoleEditor))
+			if tt.wantCode == http.StatusCreated {
+				var binding public.RoleBinding
+				gomega.Expect(json.Unmarshal(recorder.Body.Bytes(), &binding)).To(gomega.Succeed())
+				gomega.Expect(binding.Id).To(gomega.Equal("binding-1"))
+				gomega.Expect(binding.ResourceId).To(gomega.Equal("kafka-1"))
+			}
+		})
+	}
+}
+
+func Test_roleBindingsHandler_CreateRoleBinding(t *testing.T) {
+	gomega.RegisterTestingT(t)
+	roleBindingService := &rbac.RoleBindingServiceMock{
+		GrantFunc: func(ctx context.Context, resource rbac.Resource, subject string, role rbac.Role) (*api.RoleBinding, *errors.ServiceError) {
+			return nil, errors.Forbidden("user test-user is not allowed to manage the role bindings of organisation test-org")
+		},
+	}
+	handler := newTestRoleBindingsHandler(roleBindingService)
+
+	recorder := httptest.NewRecorder()
+	handler.CreateRoleBinding(recorder, newTestRoleBindingsRequest(http.MethodPost, "/api/kafkas_mgmt/v1/role_bindings", `{"subject": "other-user", "role": "admin"}`, nil))
+
+	gomega.Expect(recorder.Code).To(gomega.Equal(http.StatusForbidden))
+	gomega.Expect(roleBindingService.GrantCalls()).To(gomega.HaveLen(1))
+	gomega.Expect(roleBindingService.GrantCalls()[0].Resource).To(gomega.Equal(rbac.OrganisationResource("test-org")))
+}
+
+func Test_roleBindingsHandler_ListKafkaRoleBindings(t *testing.T) {
+	gomega.RegisterTestingT(t)
+	roleBindingService := &rbac.RoleBindingServiceMock{
+		ListFunc: func(ctx context.Context, resource rbac.Resource) (api.RoleBindingList, *errors.ServiceError) {
+			return api.RoleBindingList{
+				{Meta: api.Meta{ID: "binding-1"}, ResourceType: string(resource.Type), ResourceId: resource.ID, Subject: "other-user", Role: "viewer"},
+			}, nil
+		},
+	}
+	handler := newTestRoleBindingsHandler(roleBindingService)
+
+	recorder := httptest.NewRecorder()
+	handler.ListKafkaRoleBindings(recorder, newTestRoleBindingsRequest(http.MethodGet, "/api/kafkas_mgmt/v1/kafkas/kafka-1/role_bindings", "", map[string]string{"id": "kafka-1"}))
+
+	gomega.Expect(recorder.Code).To(gomega.Equal(http.StatusOK))
+	var list public.RoleBindingList
+	gomega.Expect(json.Unmarshal(recorder.Body.Bytes(), &list)).To(gomega.Succeed())
+	gomega.Expect(list.Items).To(gomega.HaveLen(1))
+	gomega.Expect(list.Items[0].Subject).To(gomega.Equal("other-user"))
+}
+
+func Test_roleBindingsHandler_DeleteKafkaRoleBinding(t *testing.T) {
+	gomega.RegisterTestingT(t)
+	roleBindingService := &rbac.RoleBindingServiceMock{
+		RevokeFunc: func(ctx context.Context, resource rbac.Resource, id string) *errors.ServiceError {
+			return nil
+		},
+	}
+	handler := newTestRoleBindingsHandler(roleBindingService)
+
+	recorder := httptest.NewRecorder()
+	handler.DeleteKafkaRoleBinding(recorder, newTestRoleBindingsRequest(http.MethodDelete, "/api/kafkas_mgmt/v1/kafkas/kafka-1/role_bindings/binding-1", "", map[string]string{"id": "kafka-1", "binding_id": "binding-1"}))
+
+	gomega.Expect(recorder.Code).To(gomega.Equal(http.StatusNoContent))
+	gomega.Expect(roleBindingService.RevokeCalls()).To(gomega.HaveLen(1))
+	gomega.Expect(roleBindingService.RevokeCalls()[0].ID).To(gomega.Equal("binding-1"))
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/audit"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -93,6 +94,11 @@ func (s serviceAccountsHandler) DeleteServiceAccount(w http.ResponseWriter, r *h
 		},
 		Action: func() (interface{}, *errors.ServiceError) {
 			ctx := r.Context()
+			if audit.IsAudited(ctx) {
+				if sa, err := s.service.GetServiceAccountById(ctx, id); err == nil {
+					audit.SetPreviousState(ctx, presenters.PresentServiceAccount(sa))
+				}
+			}
 			err := s.service.DeleteServiceAccount(ctx, id)
 			return nil, err
 		},
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
 	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/authorization"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/rbac"
 	resource "k8s.io/apimachinery/pkg/api/resource"
 )
 
@@ -109,20 +111,25 @@ func stringNotSet(value *string) bool {
 	return value == nil || len(*value) < 1
 }
 
-func ValidateKafkaUserFacingUpdateFields(ctx context.Context, authService authorization.Authorization, kafkaRequest *dbapi.KafkaRequest, kafkaUpdateReq *public.KafkaUpdateRequest) handlers.Validate {
+func ValidateKafkaUserFacingUpdateFields(ctx context.Context, authService authorization.Authorization, roleBindingService rbac.RoleBindingService, kafkaRequest *dbapi.KafkaRequest, kafkaUpdateReq *public.KafkaUpdateRequest) handlers.Validate {
 	return func() *errors.ServiceError {
 		claims, claimsErr := auth.GetClaimsFromContext(ctx)
 		if claimsErr != nil {
 			return errors.NewWithCause(errors.ErrorUnauthenticated, claimsErr, "User not authenticated")
 		}
 
-		username := auth.GetUsernameFromClaims(claims)
 		orgId := auth.GetOrgIdFromClaims(claims)
-		isOrgAdmin := auth.GetIsOrgAdminFromClaims(claims)
-		// only Kafka owner or organisation admin is allowed to perform the action
-		isOwner := (isOrgAdmin || kafkaRequest.Owner == username) && kafkaRequest.OrganisationId == orgId
-		if !isOwner {
-			return errors.New(errors.ErrorUnauthorized, "User not authorized to perform this action")
+		// editors can update the kafka, only admins (the owner, organisation admins or users granted the admin
+		// role) are allowed to transfer it to another owner
+		requiredRole := rbac.RoleEditor
+		if kafkaUpdateReq.Owner != nil {
+			requiredRole = rbac.RoleAdmin
+		}
+		if err := roleBindingService.CheckRole(ctx, kafkaResource(kafkaRequest), requiredRole); err != nil {
+			if err.IsForbidden() {
+				return errors.New(errors.ErrorUnauthorized, "User not authorized to perform this action")
+			}
+			return err
 		}
 
 		if kafkaUpdateReq.Owner != nil {
@@ -159,6 +166,25 @@ func ValidateKafkaClaims(ctx context.Context, kafkaRequestPayload *public.KafkaR
 	}
 }
 
+// ValidateAlertRuleNotificationTarget only allows the email notifications of an alert rule to be sent to the verified
+// email address of the user creating it, so that the alerts can not be used to send emails to arbitrary recipients
+func ValidateAlertRuleNotificationTarget(ctx context.Context, alertRuleRequest *public.AlertRuleRequest) handlers.Validate {
+	return func() *errors.ServiceError {
+		if alertRuleRequest.NotificationType != dbapi.AlertNotificationEmail {
+			return nil
+		}
+		claims, err := auth.GetClaimsFromContext(ctx)
+		if err != nil {
+			return errors.Unauthenticated("user not authenticated")
+		}
+		email := auth.GetVerifiedEmailFromClaims(claims)
+		if email == "" || !strings.EqualFold(strings.TrimSpace(alertRuleRequest.NotificationTarget), email) {
+			return errors.Validation("notification_target must be the verified email address of the user creating the alert rule")
+		}
+		return nil
+	}
+}
+
 func ValidateKafkaStorageSize(kafkaRequest *dbapi.KafkaRequest, kafkaUpdateReq *private.KafkaUpdateRequest) handlers.Validate {
 	return func() *errors.ServiceError {
 		if !stringNotSet(&kafkaUpdateReq.KafkaStorageSize) {
@@ -13,11 +13,14 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/authorization"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/rbac"
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
 )
 
 func Test_Validation_validateKafkaClusterNameIsUnique(t *testing.T) {
@@ -356,6 +359,7 @@ func Test_Validation_ValidateKafkaUserFacingUpdateFields(t *testing.T) {
 		ctx                context.Context
 		authService        authorization.Authorization
 		kafka              *dbapi.KafkaRequest
+		roleBindings       []map[string]interface{}
 	}
 
 	type result struct {
@@ -396,6 +400,43 @@ func Test_Validation_ValidateKafkaUserFacingUpdateFields(t *testing.T) {
 				reason:  "User not authorized to perform this action",
 			},
 		},
+		{
+			name: "do not throw an error when the user was granted the editor role on the kafka",
+			arg: args{
+				ctx: auth.SetTokenInContext(context.TODO(), token),
+				kafka: &dbapi.KafkaRequest{
+					Owner:          "other-user",
+					OrganisationId: orgId,
+				},
+				kafkaUpdateRequest: public.KafkaUpdateRequest{
+					ReauthenticationEnabled: &reauthenticationEnabled,
+				},
+				authService:  authorization.NewMockAuthorization(),
+				roleBindings: []map[string]interface{}{{"role": "editor"}},
+			},
+			want: result{
+				wantErr: false,
+			},
+		},
+		{
+			name: "throw an error when a user granted the editor role tries to change the owner",
+			arg: args{
+				ctx: auth.SetTokenInContext(context.TODO(), token),
+				kafka: &dbapi.KafkaRequest{
+					Owner:          "other-user",
+					OrganisationId: orgId,
+				},
+				kafkaUpdateRequest: public.KafkaUpdateRequest{
+					Owner: &newOwner,
+				},
+				authService:  authorization.NewMockAuthorization(),
+				roleBindings: []map[string]interface{}{{"role": "editor"}},
+			},
+			want: result{
+				wantErr: true,
+				reason:  "User not authorized to perform this action",
+			},
+		},
 		{
 			name: "throw an error when empty owner passed",
 			arg: args{
@@ -500,7 +541,9 @@ func Test_Validation_ValidateKafkaUserFacingUpdateFields(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			gomega.RegisterTestingT(t)
-			validateFn := ValidateKafkaUserFacingUpdateFields(tt.arg.ctx, tt.arg.authService, tt.arg.kafka, &tt.arg.kafkaUpdateRequest)
+			mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "role_bindings"`).WithReply(tt.arg.roleBindings)
+			roleBindingService := rbac.NewRoleBindingService(db.NewMockConnectionFactory(nil), tt.arg.authService)
+			validateFn := ValidateKafkaUserFacingUpdateFields(tt.arg.ctx, tt.arg.authService, roleBindingService, tt.arg.kafka, &tt.arg.kafkaUpdateRequest)
 			err := validateFn()
 			gomega.Expect(tt.want.wantErr).To(gomega.Equal(err != nil))
 			if !tt.want.wantErr && err != nil {
@@ -512,3 +555,52 @@ func Test_Validation_ValidateKafkaUserFacingUpdateFields(t *testing.T) {
 		})
 	}
 }
+
+func Test_Validation_ValidateAlertRuleNotificationTarget(t *testing.T) {
+	tokenWithEmail := func(email string, verified interface{}) *jwt.Token {
+		return &jwt.Token{
+			Claims: jwt.MapClaims{
+				"username":       "username",
+				"email":          email,
+				"email_verified": verified,
+			},
+		}
+	}
+	tests := []struct {
+		name    string
+		token   *jwt.Token
+		request public.AlertRuleRequest
+		wantErr bool
+	}{
+		{
+			name:    "should accept webhook targets",
+			token:   tokenWithEmail("user@example.com", true),
+			request: public.AlertRuleRequest{NotificationType: dbapi.AlertNotificationWebhook, NotificationTarget: "https://hooks.example.com/kafka"},
+		},
+		{
+			name:    "should accept the verified email address of the user",
+			token:   tokenWithEmail("User@Example.com", true),
+			request: public.AlertRuleRequest{NotificationType: dbapi.AlertNotificationEmail, NotificationTarget: "user@example.com"},
+		},
+		{
+			name:    "should reject another email address",
+			token:   tokenWithEmail("user@example.com", true),
+			request: public.AlertRuleRequest{NotificationType: dbapi.AlertNotificationEmail, NotificationTarget: "someone@example.org"},
+			wantErr: true,
+		},
+		{
+			name:    "should reject an email address that is not verified",
+			token:   tokenWithEmail("user@example.com", false),
+			request: public.AlertRuleRequest{NotificationType: dbapi.AlertNotificationEmail, NotificationTarget: "user@example.com"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			ctx := auth.SetTokenInContext(context.TODO(), tt.token)
+			err := ValidateAlertRuleNotificationTarget(ctx, &tt.request)()
+			gomega.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
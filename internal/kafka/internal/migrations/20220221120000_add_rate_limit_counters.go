@@ -0,0 +1,32 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addRateLimitCounters() *gormigrate.Migration {
+	type RateLimitCounter struct {
+		Key         string    `gorm:"primaryKey"`
+		WindowStart time.Time `gorm:"primaryKey"`
+		ExpiresAt   time.Time `gorm:"index"`
+		Count       int       `gorm:"not null;default:0"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "20220221120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&RateLimitCounter{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&RateLimitCounter{})
+		},
+	}
+}
@@ -0,0 +1,35 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addIdempotencyKeys() *gormigrate.Migration {
+	type IdempotencyKey struct {
+		Key         string `gorm:"primaryKey"`
+		Owner       string `gorm:"primaryKey"`
+		RequestHash string
+		StatusCode  int
+		Response    []byte
+		CreatedAt   time.Time `gorm:"index"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "20220222120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&IdempotencyKey{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			// The idempotency keys table is shared with the connector service, so we don't drop it on rollback.
+			return nil
+		},
+	}
+}
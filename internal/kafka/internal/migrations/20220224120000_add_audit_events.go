@@ -0,0 +1,52 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addAuditEvents() *gormigrate.Migration {
+	type AuditEvent struct {
+		ID             string    `gorm:"primarykey"`
+		CreatedAt      time.Time `gorm:"index"`
+		UpdatedAt      time.Time
+		DeletedAt      gorm.DeletedAt `gorm:"index"`
+		Actor          string         `gorm:"index"`
+		OrganisationId string         `gorm:"index"`
+		Action         string
+		ResourceType   string `gorm:"index:idx_audit_events_resource"`
+		ResourceId     string `gorm:"index:idx_audit_events_resource"`
+		Method         string
+		Path           string
+		RemoteAddr     string
+		StatusCode     int
+		Outcome        string
+		Diff           string `gorm:"type:jsonb"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "20220224120000",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&AuditEvent{}); err != nil {
+				return err
+			}
+			// the lease is kept on rollback, so it's only created if the migration never ran before
+			return tx.Where("lease_type = ?", "audit_events").
+				FirstOrCreate(&api.LeaderLease{Expires: &db.KafkaAdditionalLeasesExpireTime, LeaseType: "audit_events", Leader: api.NewID()}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			// The audit events table is shared with the connector service, so we don't drop it, or the lease of the
+			// audit events manager, on rollback.
+			return nil
+		},
+	}
+}
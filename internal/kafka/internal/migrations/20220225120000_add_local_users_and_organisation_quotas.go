@@ -0,0 +1,48 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addLocalUsersAndOrganisationQuotas() *gormigrate.Migration {
+	type LocalUser struct {
+		ID             string `gorm:"primarykey"`
+		CreatedAt      time.Time
+		UpdatedAt      time.Time
+		DeletedAt      gorm.DeletedAt `gorm:"index"`
+		Username       string         `gorm:"index"`
+		OrganisationId string         `gorm:"index"`
+		Banned         bool
+		TermsAccepted  bool
+	}
+
+	type OrganisationQuota struct {
+		ID             string `gorm:"primarykey"`
+		CreatedAt      time.Time
+		UpdatedAt      time.Time
+		DeletedAt      gorm.DeletedAt `gorm:"index"`
+		OrganisationId string         `gorm:"index"`
+		Resource       string
+		Allowed        int
+	}
+
+	return &gormigrate.Migration{
+		ID: "20220225120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&LocalUser{}, &OrganisationQuota{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			// The local users and organisation quotas tables are shared with the connector service, so we don't drop
+			// them on rollback.
+			return nil
+		},
+	}
+}
@@ -0,0 +1,56 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addKafkaCustomDomains() *gormigrate.Migration {
+	type KafkaCustomDomain struct {
+		ID                  string `gorm:"primarykey"`
+		CreatedAt           time.Time
+		UpdatedAt           time.Time
+		DeletedAt           gorm.DeletedAt `gorm:"index"`
+		KafkaId             string         `gorm:"index"`
+		Domain              string         `gorm:"index"`
+		VerificationToken   string
+		Status              string `gorm:"index"`
+		VerifiedAt          *time.Time
+		CertificateProvided bool
+		Hosts               string
+		Certificate         string
+		Key                 string
+		NotAfter            *time.Time
+	}
+	type KafkaRequest struct {
+		CustomBootstrapServerHost string `gorm:"default:''"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "20220303120000",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&KafkaCustomDomain{}, &KafkaRequest{}); err != nil {
+				return err
+			}
+			return tx.Create(&api.LeaderLease{Expires: &db.KafkaAdditionalLeasesExpireTime, LeaseType: "kafka_custom_domain", Leader: api.NewID()}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Unscoped().Where("lease_type = ?", "kafka_custom_domain").Delete(&api.LeaderLease{}).Error; err != nil {
+				return err
+			}
+			if err := tx.Migrator().DropColumn(&KafkaRequest{}, "custom_bootstrap_server_host"); err != nil {
+				return err
+			}
+			return tx.Migrator().DropTable(&KafkaCustomDomain{})
+		},
+	}
+}
@@ -0,0 +1,58 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addKafkaAvailability() *gormigrate.Migration {
+	type KafkaReadinessReport struct {
+		KafkaId    string `gorm:"primarykey"`
+		ClusterId  string
+		Ready      bool
+		Reason     string
+		ReportedAt time.Time
+	}
+	type KafkaAvailabilitySample struct {
+		ID             string `gorm:"primarykey"`
+		CreatedAt      time.Time
+		UpdatedAt      time.Time
+		DeletedAt      gorm.DeletedAt `gorm:"index"`
+		KafkaId        string         `gorm:"index"`
+		ClusterId      string         `gorm:"index"`
+		OrganisationId string
+		SampledAt      time.Time `gorm:"index"`
+		Seconds        float64
+		Status         string
+		Source         string
+		Reason         string
+	}
+
+	return &gormigrate.Migration{
+		ID: "20220304120000",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&KafkaReadinessReport{}, &KafkaAvailabilitySample{}); err != nil {
+				return err
+			}
+			return tx.Create(&api.LeaderLease{Expires: &db.KafkaAdditionalLeasesExpireTime, LeaseType: "kafka_availability", Leader: api.NewID()}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Unscoped().Where("lease_type = ?", "kafka_availability").Delete(&api.LeaderLease{}).Error; err != nil {
+				return err
+			}
+			if err := tx.Migrator().DropTable(&KafkaAvailabilitySample{}); err != nil {
+				return err
+			}
+			return tx.Migrator().DropTable(&KafkaReadinessReport{})
+		},
+	}
+}
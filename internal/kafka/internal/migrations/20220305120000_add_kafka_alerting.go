@@ -0,0 +1,71 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addKafkaAlerting() *gormigrate.Migration {
+	type KafkaAlertRule struct {
+		ID                 string `gorm:"primarykey"`
+		CreatedAt          time.Time
+		UpdatedAt          time.Time
+		DeletedAt          gorm.DeletedAt `gorm:"index"`
+		KafkaId            string         `gorm:"index"`
+		Owner              string
+		OrganisationId     string
+		Name               string
+		Metric             string
+		RelativeTo         string
+		Aggregation        string
+		Operator           string
+		Threshold          float64
+		ForSeconds         int64
+		NotificationType   string
+		NotificationTarget string
+		State              string `gorm:"index"`
+		ActiveSince        *time.Time
+		LastEvaluatedAt    *time.Time
+		LastValue          *float64
+		LastError          string
+	}
+	type KafkaAlert struct {
+		ID                string `gorm:"primarykey"`
+		CreatedAt         time.Time
+		UpdatedAt         time.Time
+		DeletedAt         gorm.DeletedAt `gorm:"index"`
+		RuleId            string         `gorm:"index"`
+		RuleName          string
+		KafkaId           string `gorm:"index"`
+		State             string
+		Value             float64
+		Threshold         float64
+		NotifiedAt        *time.Time
+		NotificationError string
+	}
+
+	return &gormigrate.Migration{
+		ID: "20220305120000",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&KafkaAlertRule{}, &KafkaAlert{}); err != nil {
+				return err
+			}
+			return tx.Create(&api.LeaderLease{Expires: &db.KafkaAdditionalLeasesExpireTime, LeaseType: "kafka_alerting", Leader: api.NewID()}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Unscoped().Where("lease_type = ?", "kafka_alerting").Delete(&api.LeaderLease{}).Error; err != nil {
+				return err
+			}
+			return tx.Migrator().DropTable(&KafkaAlertRule{}, &KafkaAlert{})
+		},
+	}
+}
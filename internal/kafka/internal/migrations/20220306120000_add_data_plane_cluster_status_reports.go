@@ -0,0 +1,46 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addDataPlaneClusterStatusReports() *gormigrate.Migration {
+	type DataPlaneClusterStatusReport struct {
+		ID                                     string `gorm:"primarykey"`
+		CreatedAt                              time.Time
+		UpdatedAt                              time.Time
+		DeletedAt                              gorm.DeletedAt `gorm:"index"`
+		ClusterId                              string         `gorm:"index"`
+		ReportedAt                             time.Time      `gorm:"index"`
+		NodesCurrent                           int
+		NodesCeiling                           int
+		NodesFloor                             int
+		NodesWorkloadMinimum                   int
+		ResizeNodeDelta                        int
+		RemainingConnections                   int
+		RemainingPartitions                    int
+		RemainingIngressEgressThroughputPerSec string
+		RemainingDataRetentionSize             string
+		ScalingAction                          string
+		DesiredNodes                           int
+		Flapping                               bool
+	}
+
+	return &gormigrate.Migration{
+		ID: "20220306120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&DataPlaneClusterStatusReport{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&DataPlaneClusterStatusReport{})
+		},
+	}
+}
@@ -0,0 +1,34 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// addKafkaCustomDomainUniqueIndexes allows a single custom domain per kafka, and a single kafka per verified custom
+// domain. The domains pending their verification aren't unique so that registering a domain without owning it doesn't
+// prevent its owner from registering it.
+func addKafkaCustomDomainUniqueIndexes() *gormigrate.Migration {
+	type KafkaCustomDomain struct {
+		KafkaId string `gorm:"uniqueIndex:uix_kafka_custom_domains_kafka_id,where:deleted_at IS NULL"`
+		Domain  string `gorm:"uniqueIndex:uix_kafka_custom_domains_verified_domain,where:status <> 'pending_verification' AND deleted_at IS NULL"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "20220307120000",
+		Migrate: func(tx *gorm.DB) error {
+			migrator := tx.Migrator()
+			if err := migrator.CreateIndex(&KafkaCustomDomain{}, "KafkaId"); err != nil {
+				return err
+			}
+			return migrator.CreateIndex(&KafkaCustomDomain{}, "Domain")
+		},
+		Rollback: func(tx *gorm.DB) error {
+			migrator := tx.Migrator()
+			if err := migrator.DropIndex(&KafkaCustomDomain{}, "KafkaId"); err != nil {
+				return err
+			}
+			return migrator.DropIndex(&KafkaCustomDomain{}, "Domain")
+		},
+	}
+}
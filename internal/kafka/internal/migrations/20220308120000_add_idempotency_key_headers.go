@@ -0,0 +1,29 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// addIdempotencyKeyHeaders stores the response headers replayed with the responses of the idempotency keys
+func addIdempotencyKeyHeaders() *gormigrate.Migration {
+	type IdempotencyKey struct {
+		Headers []byte
+	}
+
+	return &gormigrate.Migration{
+		ID: "20220308120000",
+		Migrate: func(tx *gorm.DB) error {
+			// the idempotency keys table is shared with the connector service, which may have added the column
+			return tx.AutoMigrate(&IdempotencyKey{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropColumn(&IdempotencyKey{}, "Headers")
+		},
+	}
+}
@@ -74,13 +74,31 @@ var migrations = []*gormigrate.Migration{
 	addKafkaRoutesCreationIdColumn(),
 	addKafkaStorageSize(),
 	addClusterServiceAccountId(),
+	addRateLimitCounters(),
+	addIdempotencyKeys(),
+	addRoleBindings(),
+	addAuditEvents(),
+	addLocalUsersAndOrganisationQuotas(),
+	addUsageRecords(),
+	addKafkaCertificates(),
+	addKafkaCustomDomains(),
+	addKafkaAvailability(),
+	addKafkaAlerting(),
+	addDataPlaneClusterStatusReports(),
+	addKafkaCustomDomainUniqueIndexes(),
+	addIdempotencyKeyHeaders(),
 }
 
 func New(dbConfig *db.DatabaseConfig) (*db.Migration, func(), error) {
-	return db.NewMigration(dbConfig, &gormigrate.Options{
+	migration, cleanup, err := db.NewMigration(dbConfig, &gormigrate.Options{
 		TableName:      "migrations",
 		IDColumnName:   "id",
 		IDColumnSize:   255,
 		UseTransaction: false,
 	}, migrations)
+	if err != nil {
+		return nil, nil, err
+	}
+	migration.Models = models()
+	return migration, cleanup, nil
 }
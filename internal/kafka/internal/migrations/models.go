@@ -0,0 +1,28 @@
+package migrations
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+)
+
+// Unlike the migrations, the models the schema is checked against by `migrate check` MUST be the types of the
+// service: the check is there to catch changes to the types that are not backed by a migration.
+func models() []interface{} {
+	return []interface{}{
+		&dbapi.KafkaRequest{},
+		&dbapi.KafkaCertificate{},
+		&dbapi.KafkaCustomDomain{},
+		&dbapi.KafkaReadinessReport{},
+		&dbapi.KafkaAvailabilitySample{},
+		&dbapi.KafkaAlertRule{},
+		&dbapi.KafkaAlert{},
+		&dbapi.DataPlaneClusterStatusReport{},
+		&api.Cluster{},
+		&api.LeaderLease{},
+		&api.RoleBinding{},
+		&api.AuditEvent{},
+		&api.LocalUser{},
+		&api.OrganisationQuota{},
+		&api.UsageRecord{},
+	}
+}
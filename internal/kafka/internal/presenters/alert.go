@@ -0,0 +1,100 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+)
+
+func ConvertAlertRuleRequest(request public.AlertRuleRequest) *dbapi.KafkaAlertRule {
+	return &dbapi.KafkaAlertRule{
+		Name:               request.Name,
+		Metric:             request.Metric,
+		RelativeTo:         request.RelativeTo,
+		Aggregation:        request.Aggregation,
+		Operator:           request.Operator,
+		Threshold:          request.Threshold,
+		ForSeconds:         request.ForSeconds,
+		NotificationType:   request.NotificationType,
+		NotificationTarget: request.NotificationTarget,
+	}
+}
+
+func PresentAlertRule(rule *dbapi.KafkaAlertRule) public.AlertRule {
+	reference := PresentReference(rule.ID, rule)
+	result := public.AlertRule{
+		Id:                 reference.Id,
+		Kind:               reference.Kind,
+		Href:               reference.Href,
+		Name:               rule.Name,
+		Metric:             rule.Metric,
+		RelativeTo:         rule.RelativeTo,
+		Aggregation:        rule.Aggregation,
+		Operator:           rule.Operator,
+		Threshold:          rule.Threshold,
+		ForSeconds:         rule.ForSeconds,
+		NotificationType:   rule.NotificationType,
+		NotificationTarget: rule.NotificationTarget,
+		State:              rule.State,
+		LastError:          rule.LastError,
+		CreatedAt:          rule.CreatedAt,
+		UpdatedAt:          rule.UpdatedAt,
+	}
+	if rule.ActiveSince != nil {
+		result.ActiveSince = *rule.ActiveSince
+	}
+	if rule.LastEvaluatedAt != nil {
+		result.LastEvaluatedAt = *rule.LastEvaluatedAt
+	}
+	if rule.LastValue != nil {
+		result.LastValue = *rule.LastValue
+	}
+	return result
+}
+
+func PresentAlertRuleList(rules dbapi.KafkaAlertRuleList) public.AlertRuleList {
+	items := make([]public.AlertRule, 0, len(rules))
+	for _, rule := range rules {
+		items = append(items, PresentAlertRule(rule))
+	}
+	return public.AlertRuleList{
+		Kind:  "AlertRuleList",
+		Page:  1,
+		Size:  int32(len(items)),
+		Total: int32(len(items)),
+		Items: items,
+	}
+}
+
+func PresentAlert(alert *dbapi.KafkaAlert) public.Alert {
+	reference := PresentReference(alert.ID, alert)
+	result := public.Alert{
+		Id:                reference.Id,
+		Kind:              reference.Kind,
+		AlertRuleId:       alert.RuleId,
+		AlertRuleName:     alert.RuleName,
+		State:             alert.State,
+		Value:             alert.Value,
+		Threshold:         alert.Threshold,
+		NotificationError: alert.NotificationError,
+		CreatedAt:         alert.CreatedAt,
+	}
+	if alert.NotifiedAt != nil {
+		result.NotifiedAt = *alert.NotifiedAt
+	}
+	return result
+}
+
+func PresentAlertList(alerts dbapi.KafkaAlertList, paging *api.PagingMeta) public.AlertList {
+	result := public.AlertList{
+		Kind:  "AlertList",
+		Page:  int32(paging.Page),
+		Size:  int32(paging.Size),
+		Total: int32(paging.Total),
+		Items: []public.Alert{},
+	}
+	for _, alert := range alerts {
+		result.Items = append(result.Items, PresentAlert(alert))
+	}
+	return result
+}
@@ -0,0 +1,27 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+)
+
+func PresentAuditEvent(event *api.AuditEvent) private.AuditEvent {
+	// the diff is written by the audit middleware, it is always a valid object
+	diff, _ := event.Diff.Object()
+	return private.AuditEvent{
+		Id:             event.ID,
+		Kind:           "AuditEvent",
+		CreatedAt:      event.CreatedAt,
+		Actor:          event.Actor,
+		OrganisationId: event.OrganisationId,
+		Action:         event.Action,
+		ResourceType:   event.ResourceType,
+		ResourceId:     event.ResourceId,
+		Method:         event.Method,
+		Path:           event.Path,
+		RemoteAddr:     event.RemoteAddr,
+		StatusCode:     int32(event.StatusCode),
+		Outcome:        event.Outcome,
+		Diff:           diff,
+	}
+}
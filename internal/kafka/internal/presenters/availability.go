@@ -0,0 +1,55 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+)
+
+func PresentAvailabilityReport(filter services.KafkaAvailabilityFilter, sloTarget float64, summaries []services.KafkaAvailabilitySummary) private.AvailabilityReport {
+	report := private.AvailabilityReport{
+		Kind:      "AvailabilityReport",
+		From:      filter.From,
+		To:        filter.To,
+		SloTarget: sloTarget,
+		Items:     []private.KafkaAvailability{},
+		Clusters:  []private.ClusterAvailability{},
+	}
+	for _, summary := range summaries {
+		report.Items = append(report.Items, PresentKafkaAvailability(summary, sloTarget))
+	}
+	for _, cluster := range services.SummariseByCluster(summaries) {
+		report.Clusters = append(report.Clusters, PresentClusterAvailability(cluster))
+	}
+	return report
+}
+
+func PresentKafkaAvailability(summary services.KafkaAvailabilitySummary, sloTarget float64) private.KafkaAvailability {
+	return private.KafkaAvailability{
+		KafkaId:              summary.KafkaId,
+		ClusterId:            summary.ClusterId,
+		OrganisationId:       summary.OrganisationId,
+		AvailableSeconds:     summary.AvailableSeconds,
+		UnavailableSeconds:   summary.UnavailableSeconds,
+		UnknownSeconds:       summary.UnknownSeconds,
+		Availability:         availabilityOrOne(summary),
+		ErrorBudgetRemaining: summary.ErrorBudgetRemaining(sloTarget),
+	}
+}
+
+func PresentClusterAvailability(summary services.KafkaAvailabilitySummary) private.ClusterAvailability {
+	return private.ClusterAvailability{
+		ClusterId:          summary.ClusterId,
+		AvailableSeconds:   summary.AvailableSeconds,
+		UnavailableSeconds: summary.UnavailableSeconds,
+		UnknownSeconds:     summary.UnknownSeconds,
+		Availability:       availabilityOrOne(summary),
+	}
+}
+
+// availabilityOrOne returns 1 when nothing was measured, like the error budget is whole until something is measured
+func availabilityOrOne(summary services.KafkaAvailabilitySummary) float64 {
+	if availability, measured := summary.Availability(); measured {
+		return availability
+	}
+	return 1
+}
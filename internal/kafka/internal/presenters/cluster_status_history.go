@@ -0,0 +1,77 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+)
+
+func PresentClusterStatusHistorySummaryList(from, to time.Time, summaries []services.DataPlaneClusterStatusSummary) private.ClusterStatusHistorySummaryList {
+	list := private.ClusterStatusHistorySummaryList{
+		Kind:  "ClusterStatusHistorySummaryList",
+		From:  from,
+		To:    to,
+		Items: []private.ClusterStatusHistorySummary{},
+	}
+	for _, summary := range summaries {
+		list.Items = append(list.Items, PresentClusterStatusHistorySummary(summary))
+	}
+	return list
+}
+
+func PresentClusterStatusHistorySummary(summary services.DataPlaneClusterStatusSummary) private.ClusterStatusHistorySummary {
+	result := private.ClusterStatusHistorySummary{
+		ClusterId:               summary.ClusterId,
+		Reports:                 int32(summary.Reports),
+		NodesMin:                int32(summary.NodesMin),
+		NodesMax:                int32(summary.NodesMax),
+		RemainingConnectionsMin: int32(summary.RemainingConnectionsMin),
+		RemainingPartitionsMin:  int32(summary.RemainingPartitionsMin),
+		ScaleUps:                int32(summary.ScaleUps),
+		ScaleDowns:              int32(summary.ScaleDowns),
+		ScalingDirectionChanges: int32(summary.ScalingDirectionChanges),
+	}
+	if summary.Latest != nil {
+		result.LastReportedAt = summary.Latest.ReportedAt
+		result.NodesCurrent = int32(summary.Latest.NodesCurrent)
+		result.Flapping = summary.Latest.Flapping
+	}
+	return result
+}
+
+func PresentClusterStatusHistory(clusterId string, from, to time.Time, interval time.Duration, reports dbapi.DataPlaneClusterStatusReportList) private.ClusterStatusHistory {
+	history := private.ClusterStatusHistory{
+		Kind:                    "ClusterStatusHistory",
+		ClusterId:               clusterId,
+		From:                    from,
+		To:                      to,
+		Interval:                int64(interval / time.Second),
+		ScalingDirectionChanges: int32(services.ScalingDirectionChanges(reports)),
+		Items:                   []private.ClusterStatusTrendPoint{},
+	}
+	if len(reports) > 0 {
+		history.Flapping = reports[len(reports)-1].Flapping
+	}
+	for _, point := range services.DataPlaneClusterStatusTrend(reports, from, interval) {
+		history.Items = append(history.Items, PresentClusterStatusTrendPoint(point))
+	}
+	return history
+}
+
+func PresentClusterStatusTrendPoint(point services.DataPlaneClusterStatusTrendPoint) private.ClusterStatusTrendPoint {
+	return private.ClusterStatusTrendPoint{
+		Timestamp:               point.Timestamp,
+		Reports:                 int32(point.Reports),
+		NodesMin:                int32(point.NodesMin),
+		NodesMax:                int32(point.NodesMax),
+		NodesCeiling:            int32(point.NodesCeiling),
+		NodesFloor:              int32(point.NodesFloor),
+		RemainingConnectionsMin: int32(point.RemainingConnectionsMin),
+		RemainingPartitionsMin:  int32(point.RemainingPartitionsMin),
+		ScaleUps:                int32(point.ScaleUps),
+		ScaleDowns:              int32(point.ScaleDowns),
+		Flapping:                point.Flapping,
+	}
+}
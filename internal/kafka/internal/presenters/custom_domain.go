@@ -0,0 +1,47 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/dns"
+)
+
+// PresentCustomDomain presents the custom domain along with the DNS records the customer must create in it
+func PresentCustomDomain(customDomain *dbapi.KafkaCustomDomain, records []dns.Record) public.CustomDomain {
+	reference := PresentReference(customDomain.ID, customDomain)
+	result := public.CustomDomain{
+		Id:                  reference.Id,
+		Kind:                reference.Kind,
+		Href:                reference.Href,
+		Domain:              customDomain.Domain,
+		Status:              customDomain.Status,
+		CertificateProvided: customDomain.CertificateProvided,
+		DnsRecords:          []public.CustomDomainDNSRecord{},
+		CreatedAt:           customDomain.CreatedAt,
+		UpdatedAt:           customDomain.UpdatedAt,
+	}
+	for _, record := range records {
+		result.DnsRecords = append(result.DnsRecords, public.CustomDomainDNSRecord{
+			Name:  record.Name,
+			Type:  string(record.Type),
+			Value: record.Value,
+		})
+	}
+	if customDomain.VerifiedAt != nil {
+		result.VerifiedAt = *customDomain.VerifiedAt
+	}
+	if customDomain.NotAfter != nil {
+		result.CertificateExpiresAt = *customDomain.NotAfter
+	}
+	return result
+}
+
+func PresentCustomDomainList(customDomains []public.CustomDomain) public.CustomDomainList {
+	return public.CustomDomainList{
+		Kind:  "CustomDomainList",
+		Page:  1,
+		Size:  int32(len(customDomains)),
+		Total: int32(len(customDomains)),
+		Items: customDomains,
+	}
+}
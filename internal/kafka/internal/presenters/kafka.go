@@ -35,23 +35,24 @@ func PresentKafkaRequest(kafkaRequest *dbapi.KafkaRequest) public.KafkaRequest {
 	reference := PresentReference(kafkaRequest.ID, kafkaRequest)
 
 	return public.KafkaRequest{
-		Id:                      reference.Id,
-		Kind:                    reference.Kind,
-		Href:                    reference.Href,
-		Region:                  kafkaRequest.Region,
-		Name:                    kafkaRequest.Name,
-		CloudProvider:           kafkaRequest.CloudProvider,
-		MultiAz:                 kafkaRequest.MultiAZ,
-		Owner:                   kafkaRequest.Owner,
-		BootstrapServerHost:     setBootstrapServerHost(kafkaRequest.BootstrapServerHost),
-		Status:                  kafkaRequest.Status,
-		CreatedAt:               kafkaRequest.CreatedAt,
-		UpdatedAt:               kafkaRequest.UpdatedAt,
-		FailedReason:            kafkaRequest.FailedReason,
-		Version:                 kafkaRequest.ActualKafkaVersion,
-		InstanceType:            kafkaRequest.InstanceType,
-		ReauthenticationEnabled: kafkaRequest.ReauthenticationEnabled,
-		KafkaStorageSize:        kafkaRequest.KafkaStorageSize,
+		Id:                        reference.Id,
+		Kind:                      reference.Kind,
+		Href:                      reference.Href,
+		Region:                    kafkaRequest.Region,
+		Name:                      kafkaRequest.Name,
+		CloudProvider:             kafkaRequest.CloudProvider,
+		MultiAz:                   kafkaRequest.MultiAZ,
+		Owner:                     kafkaRequest.Owner,
+		BootstrapServerHost:       setBootstrapServerHost(kafkaRequest.BootstrapServerHost),
+		CustomBootstrapServerHost: setBootstrapServerHost(kafkaRequest.CustomBootstrapServerHost),
+		Status:                    kafkaRequest.Status,
+		CreatedAt:                 kafkaRequest.CreatedAt,
+		UpdatedAt:                 kafkaRequest.UpdatedAt,
+		FailedReason:              kafkaRequest.FailedReason,
+		Version:                   kafkaRequest.ActualKafkaVersion,
+		InstanceType:              kafkaRequest.InstanceType,
+		ReauthenticationEnabled:   kafkaRequest.ReauthenticationEnabled,
+		KafkaStorageSize:          kafkaRequest.KafkaStorageSize,
 	}
 }
 
@@ -0,0 +1,28 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+)
+
+func ConvertLocalUserRequest(username string, request private.LocalUserRequest) *api.LocalUser {
+	return &api.LocalUser{
+		Username:       username,
+		OrganisationId: request.OrganisationId,
+		Banned:         request.Banned,
+		TermsAccepted:  request.TermsAccepted,
+	}
+}
+
+func PresentLocalUser(user *api.LocalUser) private.LocalUser {
+	return private.LocalUser{
+		Id:             user.ID,
+		Kind:           "LocalUser",
+		CreatedAt:      user.CreatedAt,
+		UpdatedAt:      user.UpdatedAt,
+		Username:       user.Username,
+		OrganisationId: user.OrganisationId,
+		Banned:         user.Banned,
+		TermsAccepted:  user.TermsAccepted,
+	}
+}
@@ -41,6 +41,7 @@ func PresentManagedKafka(from *v1.ManagedKafka) private.ManagedKafka {
 			Endpoint: private.ManagedKafkaAllOfSpecEndpoint{
 				Tls:                 getOpenAPIManagedKafkaEndpointTLS(from.Spec.Endpoint.Tls),
 				BootstrapServerHost: from.Spec.Endpoint.BootstrapServerHost,
+				CustomDomains:       getOpenAPIManagedKafkaEndpointCustomDomains(from.Spec.Endpoint.CustomDomains),
 			},
 			Versions: private.ManagedKafkaVersions{
 				Kafka:    from.Spec.Versions.Kafka,
@@ -67,6 +68,17 @@ func getOpenAPIManagedKafkaEndpointTLS(from *v1.TlsSpec) *private.ManagedKafkaAl
 	return res
 }
 
+func getOpenAPIManagedKafkaEndpointCustomDomains(from []v1.CustomDomainSpec) []private.ManagedKafkaAllOfSpecEndpointCustomDomains {
+	var res []private.ManagedKafkaAllOfSpecEndpointCustomDomains
+	for _, customDomain := range from {
+		res = append(res, private.ManagedKafkaAllOfSpecEndpointCustomDomains{
+			BootstrapServerHost: customDomain.BootstrapServerHost,
+			Tls:                 getOpenAPIManagedKafkaEndpointTLS(customDomain.Tls),
+		})
+	}
+	return res
+}
+
 func getOpenAPIManagedKafkaOAuthTLSTrustedCertificate(from *v1.OAuthSpec) *string {
 	var res *string
 	if from.TlsTrustedCertificate != nil {
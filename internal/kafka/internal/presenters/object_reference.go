@@ -18,6 +18,14 @@ const (
 	KindCloudProvider = "CloudProvider"
 	// KindError is a string identifier for the type api.ServiceError
 	KindError = "Error"
+	// KindRoleBinding is a string identifier for the type api.RoleBinding
+	KindRoleBinding = "RoleBinding"
+	// KindCustomDomain is a string identifier for the type dbapi.KafkaCustomDomain
+	KindCustomDomain = "CustomDomain"
+	// KindAlertRule is a string identifier for the type dbapi.KafkaAlertRule
+	KindAlertRule = "AlertRule"
+	// KindAlert is a string identifier for the type dbapi.KafkaAlert
+	KindAlert = "Alert"
 
 	BasePath = "/api/kafkas_mgmt/v1"
 )
@@ -36,6 +44,14 @@ func objectKind(i interface{}) string {
 		return KindCloudProvider
 	case errors.ServiceError, *errors.ServiceError:
 		return KindError
+	case api.RoleBinding, *api.RoleBinding:
+		return KindRoleBinding
+	case dbapi.KafkaCustomDomain, *dbapi.KafkaCustomDomain:
+		return KindCustomDomain
+	case dbapi.KafkaAlertRule, *dbapi.KafkaAlertRule:
+		return KindAlertRule
+	case dbapi.KafkaAlert, *dbapi.KafkaAlert:
+		return KindAlert
 	default:
 		return ""
 	}
@@ -49,6 +65,12 @@ func objectPath(id string, obj interface{}) string {
 		return fmt.Sprintf("%s/errors/%s", BasePath, id)
 	case api.ServiceAccount, *api.ServiceAccount:
 		return fmt.Sprintf("%s/service_accounts/%s", BasePath, id)
+	case *api.RoleBinding:
+		return roleBindingPath(id, obj.(*api.RoleBinding))
+	case *dbapi.KafkaCustomDomain:
+		return fmt.Sprintf("%s/kafkas/%s/custom_domains/%s", BasePath, obj.(*dbapi.KafkaCustomDomain).KafkaId, id)
+	case *dbapi.KafkaAlertRule:
+		return fmt.Sprintf("%s/kafkas/%s/alert_rules/%s", BasePath, obj.(*dbapi.KafkaAlertRule).KafkaId, id)
 	default:
 		return ""
 	}
@@ -0,0 +1,26 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+)
+
+func ConvertOrganisationQuotaRequest(orgId string, resource string, request private.OrganisationQuotaRequest) *api.OrganisationQuota {
+	return &api.OrganisationQuota{
+		OrganisationId: orgId,
+		Resource:       resource,
+		Allowed:        int(request.Allowed),
+	}
+}
+
+func PresentOrganisationQuota(quota *api.OrganisationQuota) private.OrganisationQuota {
+	return private.OrganisationQuota{
+		Id:             quota.ID,
+		Kind:           "OrganisationQuota",
+		CreatedAt:      quota.CreatedAt,
+		UpdatedAt:      quota.UpdatedAt,
+		OrganisationId: quota.OrganisationId,
+		Resource:       quota.Resource,
+		Allowed:        int32(quota.Allowed),
+	}
+}
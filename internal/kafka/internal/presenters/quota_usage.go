@@ -0,0 +1,26 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/quota"
+)
+
+func PresentQuotaUsageList(usage []quota.QuotaUsage) public.QuotaUsageList {
+	list := public.QuotaUsageList{
+		Kind:  "QuotaUsageList",
+		Page:  1,
+		Size:  int32(len(usage)),
+		Total: int32(len(usage)),
+		Items: []public.QuotaUsage{},
+	}
+	for _, u := range usage {
+		list.Items = append(list.Items, public.QuotaUsage{
+			Resource:  u.Resource,
+			Allowed:   int32(u.Allowed),
+			Consumed:  int32(u.Consumed),
+			Remaining: int32(u.Remaining),
+			Unlimited: u.Unlimited,
+		})
+	}
+	return list
+}
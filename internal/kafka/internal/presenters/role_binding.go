@@ -0,0 +1,46 @@
+package presenters
+
+import (
+	"fmt"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/rbac"
+)
+
+func PresentRoleBinding(binding *api.RoleBinding) public.RoleBinding {
+	reference := PresentReference(binding.ID, binding)
+	return public.RoleBinding{
+		Id:           reference.Id,
+		Kind:         reference.Kind,
+		Href:         reference.Href,
+		ResourceType: binding.ResourceType,
+		ResourceId:   binding.ResourceId,
+		Subject:      binding.Subject,
+		Role:         binding.Role,
+		CreatedBy:    binding.CreatedBy,
+		CreatedAt:    binding.CreatedAt,
+	}
+}
+
+func PresentRoleBindingList(bindings api.RoleBindingList) public.RoleBindingList {
+	list := public.RoleBindingList{
+		Kind:  "RoleBindingList",
+		Page:  1,
+		Size:  int32(len(bindings)),
+		Total: int32(len(bindings)),
+		Items: []public.RoleBinding{},
+	}
+	for _, binding := range bindings {
+		list.Items = append(list.Items, PresentRoleBinding(binding))
+	}
+	return list
+}
+
+// roleBindingPath returns the path of a role binding, which is nested in the path of the kafka it grants access to
+func roleBindingPath(id string, binding *api.RoleBinding) string {
+	if binding.ResourceType == string(rbac.ResourceTypeKafka) {
+		return fmt.Sprintf("%s/kafkas/%s/role_bindings/%s", BasePath, binding.ResourceId, id)
+	}
+	return fmt.Sprintf("%s/role_bindings/%s", BasePath, id)
+}
@@ -0,0 +1,33 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/metering"
+)
+
+func PresentUsageReport(filter metering.UsageFilter, aggregates []metering.UsageAggregate) private.UsageReport {
+	report := private.UsageReport{
+		Kind:  "UsageReport",
+		From:  filter.From,
+		To:    filter.To,
+		Items: []private.UsageAggregate{},
+	}
+	for _, aggregate := range aggregates {
+		report.Items = append(report.Items, PresentUsageAggregate(aggregate))
+	}
+	return report
+}
+
+func PresentUsageAggregate(aggregate metering.UsageAggregate) private.UsageAggregate {
+	return private.UsageAggregate{
+		OrganisationId: aggregate.OrganisationId,
+		ResourceType:   aggregate.ResourceType,
+		InstanceType:   aggregate.InstanceType,
+		CloudProvider:  aggregate.CloudProvider,
+		Region:         aggregate.Region,
+		MultiAz:        aggregate.MultiAZ,
+		StorageSize:    aggregate.StorageSize,
+		Instances:      int32(aggregate.Instances),
+		Hours:          aggregate.Hours,
+	}
+}
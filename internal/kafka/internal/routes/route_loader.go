@@ -7,7 +7,12 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/account"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/audit"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/authorization"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/metering"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/quota"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/rbac"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/tracing"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 
@@ -30,6 +35,7 @@ import (
 	gorillaHandlers "github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
 	pkgerrors "github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type options struct {
@@ -38,7 +44,7 @@ type options struct {
 	OCMConfig      *ocm.OCMConfig
 	ProviderConfig *config.ProviderConfig
 
-	AMSClient                ocm.AMSClient
+	TermsAcceptanceClient    auth.TermsAcceptanceClient
 	Kafka                    services.KafkaService
 	CloudProviders           services.CloudProvidersService
 	Observatorium            services.ObservatoriumService
@@ -47,12 +53,26 @@ type options struct {
 	DataPlaneKafkaService    services.DataPlaneKafkaService
 	AccountService           account.AccountService
 	AuthService              authorization.Authorization
+	LocalUserService         authorization.LocalUserService
+	OrganisationQuotaService quota.OrganisationQuotaService
+	RoleBindingService       rbac.RoleBindingService
+	CustomDomainService      services.KafkaCustomDomainService
+	AlertService             services.KafkaAlertService
+	AvailabilityService      services.KafkaAvailabilityService
+	AvailabilityConfig       *config.KafkaAvailabilityConfig
+	StatusHistoryService     services.DataPlaneClusterStatusHistoryService
 	DB                       *db.ConnectionFactory
 	ClusterPlacementStrategy services.ClusterPlacementStrategy
 	ClusterService           services.ClusterService
 
 	AccessControlListMiddleware *acl.AccessControlListMiddleware
 	AccessControlListConfig     *acl.AccessControlListConfig
+	OIDCConfig                  *auth.OIDCConfig
+	RateLimitMiddleware         *auth.RateLimitMiddleware
+	IdempotencyMiddleware       *coreHandlers.IdempotencyMiddleware
+	AuditMiddleware             *audit.AuditMiddleware
+	AuditService                audit.AuditService
+	MeteringService             metering.MeteringService
 }
 
 func NewRouteLoader(s options) environments.RouteLoader {
@@ -75,16 +95,23 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string, op
 		return pkgerrors.Wrapf(err, "can't load OpenAPI specification")
 	}
 
-	kafkaHandler := handlers.NewKafkaHandler(s.Kafka, s.ProviderConfig, s.AuthService)
+	kafkaHandler := handlers.NewKafkaHandler(s.Kafka, s.ProviderConfig, s.AuthService, s.RoleBindingService)
 	cloudProvidersHandler := handlers.NewCloudProviderHandler(s.CloudProviders, s.ProviderConfig, s.Kafka, s.ClusterPlacementStrategy)
 	errorsHandler := coreHandlers.NewErrorsHandler()
 	serviceAccountsHandler := handlers.NewServiceAccountHandler(s.Keycloak)
 	metricsHandler := handlers.NewMetricsHandler(s.Observatorium)
+	roleBindingsHandler := handlers.NewRoleBindingsHandler(s.Kafka, s.RoleBindingService)
+	customDomainsHandler := handlers.NewCustomDomainsHandler(s.Kafka, s.CustomDomainService, s.RoleBindingService)
+	alertRulesHandler := handlers.NewAlertRulesHandler(s.Kafka, s.AlertService, s.RoleBindingService)
+	quotaUsageHandler := handlers.NewQuotaUsageHandler(s.OrganisationQuotaService)
 
 	authorizeMiddleware := s.AccessControlListMiddleware.Authorize
+	rateLimitMiddleware := s.RateLimitMiddleware.RateLimit
 	requireOrgID := auth.NewRequireOrgIDMiddleware().RequireOrgID(errors.ErrorUnauthenticated)
-	requireIssuer := auth.NewRequireIssuerMiddleware().RequireIssuer([]string{s.ServerConfig.TokenIssuerURL}, errors.ErrorUnauthenticated)
-	requireTermsAcceptance := auth.NewRequireTermsAcceptanceMiddleware().RequireTermsAcceptance(s.ServerConfig.EnableTermsAcceptance, s.AMSClient, errors.ErrorTermsNotAccepted)
+	// tokens of the OpenID Connect providers configured with --oidc-issuer-url are accepted too
+	publicIssuers := append([]string{s.ServerConfig.TokenIssuerURL}, s.OIDCConfig.Issuers()...)
+	requireIssuer := auth.NewRequireIssuerMiddleware().RequireIssuer(publicIssuers, errors.ErrorUnauthenticated)
+	requireTermsAcceptance := auth.NewRequireTermsAcceptanceMiddleware().RequireTermsAcceptance(s.ServerConfig.EnableTermsAcceptance, s.TermsAcceptanceClient, errors.ErrorTermsNotAccepted)
 
 	// base path. Could be /api/kafkas_mgmt
 	apiRouter := mainRouter.PathPrefix(basePath).Subrouter()
@@ -120,9 +147,12 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string, op
 	apiV1KafkasRouter.HandleFunc("", kafkaHandler.List).
 		Name(logger.NewLogEvent("list-kafka", "list all kafkas").ToString()).
 		Methods(http.MethodGet)
+	apiV1KafkasRouter.Use(tracing.VarsMiddleware(map[string]attribute.Key{"id": tracing.KafkaIDKey}))
 	apiV1KafkasRouter.Use(requireIssuer)
 	apiV1KafkasRouter.Use(requireOrgID)
 	apiV1KafkasRouter.Use(authorizeMiddleware)
+	apiV1KafkasRouter.Use(rateLimitMiddleware)
+	apiV1KafkasRouter.Use(s.AuditMiddleware.Audit)
 
 	apiV1KafkasCreateRouter := apiV1KafkasRouter.NewRoute().Subrouter()
 	apiV1KafkasCreateRouter.HandleFunc("", kafkaHandler.Create).Methods(http.MethodPost)
@@ -137,6 +167,50 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string, op
 		Name(logger.NewLogEvent("get-metrics-instant", "get metrics by instant").ToString()).
 		Methods(http.MethodGet)
 
+	//  /kafkas/{id}/role_bindings
+	apiV1KafkasRouter.HandleFunc("/{id}/role_bindings", roleBindingsHandler.ListKafkaRoleBindings).
+		Name(logger.NewLogEvent("list-kafka-role-bindings", "list the role bindings of a kafka instance").ToString()).
+		Methods(http.MethodGet)
+	apiV1KafkasRouter.HandleFunc("/{id}/role_bindings", roleBindingsHandler.CreateKafkaRoleBinding).
+		Name(logger.NewLogEvent("create-kafka-role-binding", "grant a role on a kafka instance").ToString()).
+		Methods(http.MethodPost)
+	apiV1KafkasRouter.HandleFunc("/{id}/role_bindings/{binding_id}", roleBindingsHandler.DeleteKafkaRoleBinding).
+		Name(logger.NewLogEvent("delete-kafka-role-binding", "revoke a role granted on a kafka instance").ToString()).
+		Methods(http.MethodDelete)
+
+	//  /kafkas/{id}/custom_domains
+	apiV1KafkasRouter.HandleFunc("/{id}/custom_domains", customDomainsHandler.List).
+		Name(logger.NewLogEvent("list-kafka-custom-domains", "list the custom domains of a kafka instance").ToString()).
+		Methods(http.MethodGet)
+	apiV1KafkasRouter.HandleFunc("/{id}/custom_domains", customDomainsHandler.Create).
+		Name(logger.NewLogEvent("create-kafka-custom-domain", "register a custom domain for a kafka instance").ToString()).
+		Methods(http.MethodPost)
+	apiV1KafkasRouter.HandleFunc("/{id}/custom_domains/{custom_domain_id}", customDomainsHandler.Get).
+		Name(logger.NewLogEvent("get-kafka-custom-domain", "get a custom domain of a kafka instance").ToString()).
+		Methods(http.MethodGet)
+	apiV1KafkasRouter.HandleFunc("/{id}/custom_domains/{custom_domain_id}", customDomainsHandler.Delete).
+		Name(logger.NewLogEvent("delete-kafka-custom-domain", "delete a custom domain of a kafka instance").ToString()).
+		Methods(http.MethodDelete)
+
+	//  /kafkas/{id}/alert_rules
+	apiV1KafkasRouter.HandleFunc("/{id}/alert_rules", alertRulesHandler.List).
+		Name(logger.NewLogEvent("list-kafka-alert-rules", "list the alert rules of a kafka instance").ToString()).
+		Methods(http.MethodGet)
+	apiV1KafkasRouter.HandleFunc("/{id}/alert_rules", alertRulesHandler.Create).
+		Name(logger.NewLogEvent("create-kafka-alert-rule", "create an alert rule for a kafka instance").ToString()).
+		Methods(http.MethodPost)
+	apiV1KafkasRouter.HandleFunc("/{id}/alert_rules/{alert_rule_id}", alertRulesHandler.Get).
+		Name(logger.NewLogEvent("get-kafka-alert-rule", "get an alert rule of a kafka instance").ToString()).
+		Methods(http.MethodGet)
+	apiV1KafkasRouter.HandleFunc("/{id}/alert_rules/{alert_rule_id}", alertRulesHandler.Delete).
+		Name(logger.NewLogEvent("delete-kafka-alert-rule", "delete an alert rule of a kafka instance").ToString()).
+		Methods(http.MethodDelete)
+
+	//  /kafkas/{id}/alerts
+	apiV1KafkasRouter.HandleFunc("/{id}/alerts", alertRulesHandler.ListAlerts).
+		Name(logger.NewLogEvent("list-kafka-alerts", "list the alerts fired by the alert rules of a kafka instance").ToString()).
+		Methods(http.MethodGet)
+
 	// /kafkas/{id}/metrics/federate
 	// federate endpoint separated from the rest of the /kafkas endpoints as it needs to support auth from both sso.redhat.com and mas-sso
 	// NOTE: this is only a temporary solution. MAS SSO auth support should be removed once we migrate to sso.redhat.com (TODO: to be done as part of MGDSTRM-6159)
@@ -144,9 +218,11 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string, op
 	apiV1MetricsFederateRouter.HandleFunc("", metricsHandler.FederateMetrics).
 		Name(logger.NewLogEvent("get-federate-metrics", "get federate metrics by id").ToString()).
 		Methods(http.MethodGet)
-	apiV1MetricsFederateRouter.Use(auth.NewRequireIssuerMiddleware().RequireIssuer([]string{s.ServerConfig.TokenIssuerURL, s.Keycloak.GetConfig().KafkaRealm.ValidIssuerURI}, errors.ErrorUnauthenticated))
+	apiV1MetricsFederateRouter.Use(tracing.VarsMiddleware(map[string]attribute.Key{"id": tracing.KafkaIDKey}))
+	apiV1MetricsFederateRouter.Use(auth.NewRequireIssuerMiddleware().RequireIssuer(append([]string{s.Keycloak.GetConfig().KafkaRealm.ValidIssuerURI}, publicIssuers...), errors.ErrorUnauthenticated))
 	apiV1MetricsFederateRouter.Use(requireOrgID)
 	apiV1MetricsFederateRouter.Use(authorizeMiddleware)
+	apiV1MetricsFederateRouter.Use(rateLimitMiddleware)
 
 	//  /service_accounts
 	v1Collections = append(v1Collections, api.CollectionMetadata{
@@ -180,6 +256,38 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string, op
 	apiV1ServiceAccountsRouter.Use(requireIssuer)
 	apiV1ServiceAccountsRouter.Use(requireOrgID)
 	apiV1ServiceAccountsRouter.Use(authorizeMiddleware)
+	apiV1ServiceAccountsRouter.Use(rateLimitMiddleware)
+	apiV1ServiceAccountsRouter.Use(s.AuditMiddleware.Audit)
+
+	//  /role_bindings
+	v1Collections = append(v1Collections, api.CollectionMetadata{
+		ID:   "role_bindings",
+		Kind: "RoleBindingList",
+	})
+	apiV1RoleBindingsRouter := apiV1Router.PathPrefix("/role_bindings").Subrouter()
+	apiV1RoleBindingsRouter.HandleFunc("", roleBindingsHandler.ListRoleBindings).
+		Name(logger.NewLogEvent("list-role-bindings", "list the organisation wide role bindings").ToString()).
+		Methods(http.MethodGet)
+	apiV1RoleBindingsRouter.HandleFunc("", roleBindingsHandler.CreateRoleBinding).
+		Name(logger.NewLogEvent("create-role-binding", "grant an organisation wide role").ToString()).
+		Methods(http.MethodPost)
+	apiV1RoleBindingsRouter.HandleFunc("/{binding_id}", roleBindingsHandler.DeleteRoleBinding).
+		Name(logger.NewLogEvent("delete-role-binding", "revoke an organisation wide role").ToString()).
+		Methods(http.MethodDelete)
+	apiV1RoleBindingsRouter.Use(requireIssuer)
+	apiV1RoleBindingsRouter.Use(requireOrgID)
+	apiV1RoleBindingsRouter.Use(authorizeMiddleware)
+	apiV1RoleBindingsRouter.Use(rateLimitMiddleware)
+	apiV1RoleBindingsRouter.Use(s.AuditMiddleware.Audit)
+
+	//  /quota
+	apiV1QuotaRouter := apiV1Router.PathPrefix("/quota").Subrouter()
+	apiV1QuotaRouter.HandleFunc("", quotaUsageHandler.Get).
+		Name(logger.NewLogEvent("get-quota-usage", "get the quota usage of the organisation").ToString()).
+		Methods(http.MethodGet)
+	apiV1QuotaRouter.Use(requireIssuer)
+	apiV1QuotaRouter.Use(requireOrgID)
+	apiV1QuotaRouter.Use(authorizeMiddleware)
 
 	//  /cloud_providers
 	v1Collections = append(v1Collections, api.CollectionMetadata{
@@ -207,6 +315,7 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string, op
 	apiRouter.HandleFunc("", apiMetadata.ServeHTTP).Methods(http.MethodGet)
 	apiRouter.Use(coreHandlers.MetricsMiddleware)
 	apiRouter.Use(db.TransactionMiddleware(s.DB))
+	apiRouter.Use(s.IdempotencyMiddleware.Idempotency)
 	apiRouter.Use(gorillaHandlers.CompressHandler)
 
 	apiV1Router.HandleFunc("", v1Metadata.ServeHTTP).Methods(http.MethodGet)
@@ -227,19 +336,29 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string, op
 	apiV1DataPlaneRequestsRouter.HandleFunc("/{id}/kafkas", dataPlaneKafkaHandler.GetAll).
 		Name(logger.NewLogEvent("list-dataplane-kafkas", "list all dataplane kafkas").ToString()).
 		Methods(http.MethodGet)
+	apiV1DataPlaneRequestsRouter.Use(tracing.VarsMiddleware(map[string]attribute.Key{"id": tracing.ClusterIDKey}))
 	// deliberately returns 404 here if the request doesn't have the required role, so that it will appear as if the endpoint doesn't exist
 	auth.UseOperatorAuthorisationMiddleware(apiV1DataPlaneRequestsRouter, s.Keycloak.GetConfig().KafkaRealm.ValidIssuerURI, "id", s.ClusterService)
 
 	adminKafkaHandler := handlers.NewAdminKafkaHandler(s.Kafka, s.AccountService, s.ProviderConfig)
+	adminAuditEventsHandler := handlers.NewAdminAuditEventsHandler(s.AuditService)
+	adminLocalUsersHandler := handlers.NewAdminLocalUsersHandler(s.LocalUserService)
+	adminOrganisationQuotasHandler := handlers.NewAdminOrganisationQuotasHandler(s.OrganisationQuotaService)
+	adminUsageHandler := handlers.NewAdminUsageHandler(s.MeteringService)
+	adminAvailabilityHandler := handlers.NewAdminAvailabilityHandler(s.AvailabilityService, s.AvailabilityConfig)
+	adminClusterStatusHistoryHandler := handlers.NewAdminClusterStatusHistoryHandler(s.ClusterService, s.StatusHistoryService)
 	adminRouter := apiV1Router.PathPrefix("/admin").Subrouter()
 	rolesMapping := map[string][]string{
 		http.MethodGet:    {auth.KasFleetManagerAdminReadRole, auth.KasFleetManagerAdminWriteRole, auth.KasFleetManagerAdminFullRole},
 		http.MethodPatch:  {auth.KasFleetManagerAdminWriteRole, auth.KasFleetManagerAdminFullRole},
+		http.MethodPut:    {auth.KasFleetManagerAdminWriteRole, auth.KasFleetManagerAdminFullRole},
 		http.MethodDelete: {auth.KasFleetManagerAdminFullRole},
 	}
+	adminRouter.Use(tracing.VarsMiddleware(map[string]attribute.Key{"id": tracing.KafkaIDKey}))
 	adminRouter.Use(auth.NewRequireIssuerMiddleware().RequireIssuer([]string{s.Keycloak.GetConfig().OSDClusterIDPRealm.ValidIssuerURI}, errors.ErrorNotFound))
 	adminRouter.Use(auth.NewRolesAuhzMiddleware().RequireRolesForMethods(rolesMapping, errors.ErrorNotFound))
 	adminRouter.Use(auth.NewAuditLogMiddleware().AuditLog(errors.ErrorNotFound))
+	adminRouter.Use(s.AuditMiddleware.Audit)
 	adminRouter.HandleFunc("/kafkas", adminKafkaHandler.List).
 		Name(logger.NewLogEvent("admin-list-kafkas", "[admin] list all kafkas").ToString()).
 		Methods(http.MethodGet)
@@ -252,6 +371,42 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string, op
 	adminRouter.HandleFunc("/kafkas/{id}", adminKafkaHandler.Update).
 		Name(logger.NewLogEvent("admin-update-kafka", "[admin] update kafka by id").ToString()).
 		Methods(http.MethodPatch)
+	adminRouter.HandleFunc("/audit_events", adminAuditEventsHandler.List).
+		Name(logger.NewLogEvent("admin-list-audit-events", "[admin] list audit events").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/usage", adminUsageHandler.Get).
+		Name(logger.NewLogEvent("admin-get-usage-report", "[admin] export the usage report").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/availability", adminAvailabilityHandler.Get).
+		Name(logger.NewLogEvent("admin-get-availability-report", "[admin] get the availability report of the kafkas").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/clusters/status_history", adminClusterStatusHistoryHandler.List).
+		Name(logger.NewLogEvent("admin-list-cluster-status-history", "[admin] summarise the status reports of the data plane clusters").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/clusters/{cluster_id}/status_history", adminClusterStatusHistoryHandler.Get).
+		Name(logger.NewLogEvent("admin-get-cluster-status-history", "[admin] get the trend of the status reports of a data plane cluster").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/users", adminLocalUsersHandler.List).
+		Name(logger.NewLogEvent("admin-list-local-users", "[admin] list local users").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/users/{username}", adminLocalUsersHandler.Get).
+		Name(logger.NewLogEvent("admin-get-local-user", "[admin] get local user by username").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/users/{username}", adminLocalUsersHandler.Update).
+		Name(logger.NewLogEvent("admin-update-local-user", "[admin] register or update local user").ToString()).
+		Methods(http.MethodPut)
+	adminRouter.HandleFunc("/users/{username}", adminLocalUsersHandler.Delete).
+		Name(logger.NewLogEvent("admin-delete-local-user", "[admin] delete local user by username").ToString()).
+		Methods(http.MethodDelete)
+	adminRouter.HandleFunc("/organisations/{org_id}/quotas", adminOrganisationQuotasHandler.List).
+		Name(logger.NewLogEvent("admin-list-organisation-quotas", "[admin] list organisation quotas").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/organisations/{org_id}/quotas/{resource}", adminOrganisationQuotasHandler.Update).
+		Name(logger.NewLogEvent("admin-update-organisation-quota", "[admin] set organisation quota of an instance type").ToString()).
+		Methods(http.MethodPut)
+	adminRouter.HandleFunc("/organisations/{org_id}/quotas/{resource}", adminOrganisationQuotasHandler.Delete).
+		Name(logger.NewLogEvent("admin-delete-organisation-quota", "[admin] delete organisation quota of an instance type").ToString()).
+		Methods(http.MethodDelete)
 
 	return nil
 }
@@ -38,6 +38,7 @@ type dataPlaneClusterService struct {
 	KafkaConfig            *config.KafkaConfig
 	ObservabilityConfig    *observatorium.ObservabilityConfiguration
 	DataplaneClusterConfig *config.DataplaneClusterConfig
+	StatusHistoryService   DataPlaneClusterStatusHistoryService
 }
 
 type dataPlaneComputeNodesKafkaCapacityAttributes struct {
@@ -100,6 +101,8 @@ func (d *dataPlaneClusterService) UpdateDataPlaneClusterStatus(ctx context.Conte
 		return nil
 	}
 
+	report := dbapi.NewDataPlaneClusterStatusReport(cluster.ClusterID, status)
+
 	// We calculate the status based on the stats received by the KAS Fleet operator
 	// BEFORE performing the scaling actions. If scaling actions are performed later
 	// then it will be reflected on the next data plane cluster status report
@@ -115,18 +118,36 @@ func (d *dataPlaneClusterService) UpdateDataPlaneClusterStatus(ctx context.Conte
 		}
 		if computeNodeScalingInProgress {
 			glog.V(10).Infof("Cluster '%s' compute nodes scaling currently in progress. Omitting scaling actions evaluation...", cluster.ClusterID)
+			d.recordStatusReport(report)
 			return nil
 		}
 
-		_, err = d.updateDataPlaneClusterNodes(cluster, status)
+		desiredNodes, err := d.updateDataPlaneClusterNodes(cluster, status)
 		if err != nil {
 			return errors.ToServiceError(err)
 		}
+		// the desired nodes only differ from the current ones when the compute nodes were set, the report records no
+		// scaling action otherwise
+		report.DesiredNodes = desiredNodes
+		if desiredNodes > status.NodeInfo.Current {
+			report.ScalingAction = dbapi.DataPlaneClusterScalingUp
+		} else if desiredNodes < status.NodeInfo.Current {
+			report.ScalingAction = dbapi.DataPlaneClusterScalingDown
+		}
 	}
 
+	d.recordStatusReport(report)
 	return nil
 }
 
+// recordStatusReport adds the report to the status history of the cluster. A failure is only logged as the status of
+// the cluster and its scaling have already been processed, and the next report will be recorded anyway
+func (d *dataPlaneClusterService) recordStatusReport(report *dbapi.DataPlaneClusterStatusReport) {
+	if err := d.StatusHistoryService.Record(report); err != nil {
+		glog.Errorf("failed to record the status report of cluster '%s': %v", report.ClusterId, err)
+	}
+}
+
 func (d *dataPlaneClusterService) computeNodeScalingActionInProgress(cluster *api.Cluster, status *dbapi.DataPlaneClusterStatus) (bool, error) {
 	nodesInfo, err := d.ClusterService.GetComputeNodes(cluster.ClusterID)
 	if err != nil {
@@ -141,7 +162,8 @@ func (d *dataPlaneClusterService) computeNodeScalingActionInProgress(cluster *ap
 // updateDataPlaneClusterNodes performs node scale-up and scale-down actions on
 // the data plane cluster based on the reported status by the kas fleet shard
 // operator. The state of the number of desired nodes after the scaling
-// actions is returned
+// actions is returned, it only differs from the reported current number of
+// nodes when the compute nodes of the cluster were set
 func (d *dataPlaneClusterService) updateDataPlaneClusterNodes(cluster *api.Cluster, status *dbapi.DataPlaneClusterStatus) (int, error) {
 	// Current assumptions of how the kas-fleet-shard-operator works:
 	// 1 - Reported "current" will always be equal or higher than
@@ -191,8 +213,10 @@ func (d *dataPlaneClusterService) updateDataPlaneClusterNodes(cluster *api.Clust
 	}
 	if scaleDownNeeded {
 		nodesToScaleDown := d.calculateDesiredNodesToScaleDown(cluster, status)
-		desiredNodesAfterScaleActions = currentNodes - nodesToScaleDown
-		if desiredNodesAfterScaleActions > 0 {
+		// the compute nodes are left untouched, and so is the number of nodes returned, when scaling down would
+		// leave no node
+		if currentNodes-nodesToScaleDown > 0 {
+			desiredNodesAfterScaleActions = currentNodes - nodesToScaleDown
 			glog.V(10).Infof("Decreasing reported current number of nodes '%v' by '%v'", currentNodes, nodesToScaleDown)
 			_, err := d.ClusterService.SetComputeNodes(cluster.ClusterID, desiredNodesAfterScaleActions)
 			if err != nil {
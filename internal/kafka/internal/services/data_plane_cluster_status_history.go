@@ -0,0 +1,248 @@
+package services
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
+	"github.com/golang/glog"
+)
+
+// DataPlaneClusterStatusSummary summarises the status reports of a data plane cluster received over a period
+type DataPlaneClusterStatusSummary struct {
+	ClusterId               string
+	Reports                 int
+	NodesMin                int
+	NodesMax                int
+	RemainingConnectionsMin int
+	RemainingPartitionsMin  int
+	ScaleUps                int
+	ScaleDowns              int
+	FlappingReports         int
+	Latest                  *dbapi.DataPlaneClusterStatusReport `gorm:"-"`
+	ScalingDirectionChanges int                                 `gorm:"-"`
+}
+
+// DataPlaneClusterStatusTrendPoint aggregates the status reports of a data plane cluster received over the interval
+// starting at Timestamp
+type DataPlaneClusterStatusTrendPoint struct {
+	Timestamp               time.Time
+	Reports                 int
+	NodesMin                int
+	NodesMax                int
+	NodesCeiling            int
+	NodesFloor              int
+	RemainingConnectionsMin int
+	RemainingPartitionsMin  int
+	ScaleUps                int
+	ScaleDowns              int
+	Flapping                bool
+}
+
+// ScalingDirectionChanges counts how many times the scaling of the compute nodes changed direction over the reports,
+// the reports that didn't scale the cluster are ignored
+func ScalingDirectionChanges(reports dbapi.DataPlaneClusterStatusReportList) int {
+	actions := make([]string, 0, len(reports))
+	for _, report := range reports {
+		actions = append(actions, report.ScalingAction)
+	}
+	return countScalingDirectionChanges(actions)
+}
+
+func countScalingDirectionChanges(actions []string) int {
+	changes := 0
+	previous := ""
+	for _, action := range actions {
+		if action == "" {
+			continue
+		}
+		if previous != "" && action != previous {
+			changes++
+		}
+		previous = action
+	}
+	return changes
+}
+
+// DataPlaneClusterStatusTrend aggregates the status reports of a cluster, ordered by the time they were received at,
+// by interval starting from the given time. The intervals without reports are omitted.
+func DataPlaneClusterStatusTrend(reports dbapi.DataPlaneClusterStatusReportList, from time.Time, interval time.Duration) []DataPlaneClusterStatusTrendPoint {
+	points := []DataPlaneClusterStatusTrendPoint{}
+	var point *DataPlaneClusterStatusTrendPoint
+	for _, report := range reports {
+		timestamp := from.Add(report.ReportedAt.Sub(from).Truncate(interval))
+		if report.ReportedAt.Sub(from) < 0 {
+			timestamp = from
+		}
+		if point == nil || !point.Timestamp.Equal(timestamp) {
+			points = append(points, DataPlaneClusterStatusTrendPoint{
+				Timestamp:               timestamp,
+				NodesMin:                report.NodesCurrent,
+				NodesMax:                report.NodesCurrent,
+				RemainingConnectionsMin: report.RemainingConnections,
+				RemainingPartitionsMin:  report.RemainingPartitions,
+			})
+			point = &points[len(points)-1]
+		}
+		point.Reports++
+		point.NodesMin = minInt(point.NodesMin, report.NodesCurrent)
+		point.NodesMax = maxInt(point.NodesMax, report.NodesCurrent)
+		point.RemainingConnectionsMin = minInt(point.RemainingConnectionsMin, report.RemainingConnections)
+		point.RemainingPartitionsMin = minInt(point.RemainingPartitionsMin, report.RemainingPartitions)
+		// the limits of the cluster are the ones of the latest report of the interval
+		point.NodesCeiling = report.NodesCeiling
+		point.NodesFloor = report.NodesFloor
+		switch report.ScalingAction {
+		case dbapi.DataPlaneClusterScalingUp:
+			point.ScaleUps++
+		case dbapi.DataPlaneClusterScalingDown:
+			point.ScaleDowns++
+		}
+		point.Flapping = point.Flapping || report.Flapping
+	}
+	return points
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// DataPlaneClusterStatusHistoryService keeps the status reports of the data plane clusters over the retention period,
+// and flags the clusters whose compute nodes keep being scaled up and down
+//go:generate moq -out data_plane_cluster_status_history_moq.go . DataPlaneClusterStatusHistoryService
+type DataPlaneClusterStatusHistoryService interface {
+	// Record persists the status report of a cluster, flagged as flapping when the scaling of the cluster changed
+	// direction too often over the flapping window, and deletes the reports of the cluster older than the retention
+	Record(report *dbapi.DataPlaneClusterStatusReport) *errors.ServiceError
+	// ListReports returns the status reports of a cluster received in (from, to], oldest first
+	ListReports(clusterId string, from, to time.Time) (dbapi.DataPlaneClusterStatusReportList, *errors.ServiceError)
+	// Summarise returns the summaries of the status reports of the clusters received in (from, to], sorted by
+	// cluster id
+	Summarise(from, to time.Time) ([]DataPlaneClusterStatusSummary, *errors.ServiceError)
+}
+
+type dataPlaneClusterStatusHistoryService struct {
+	connectionFactory *db.ConnectionFactory
+	config            *config.DataplaneClusterStatusHistoryConfig
+}
+
+var _ DataPlaneClusterStatusHistoryService = &dataPlaneClusterStatusHistoryService{}
+
+func NewDataPlaneClusterStatusHistoryService(connectionFactory *db.ConnectionFactory, config *config.DataplaneClusterStatusHistoryConfig) DataPlaneClusterStatusHistoryService {
+	return &dataPlaneClusterStatusHistoryService{
+		connectionFactory: connectionFactory,
+		config:            config,
+	}
+}
+
+func (s *dataPlaneClusterStatusHistoryService) Record(report *dbapi.DataPlaneClusterStatusReport) *errors.ServiceError {
+	if !s.config.IsHistoryEnabled() {
+		return nil
+	}
+	dbConn := s.connectionFactory.New()
+
+	var actions []string
+	if err := dbConn.Model(&dbapi.DataPlaneClusterStatusReport{}).
+		Where("cluster_id = ? AND reported_at > ? AND scaling_action <> ''", report.ClusterId, report.ReportedAt.Add(-s.config.FlappingWindow)).
+		Order("reported_at").
+		Pluck("scaling_action", &actions).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to get the scaling history of cluster %s", report.ClusterId)
+	}
+	wasFlapping := countScalingDirectionChanges(actions) >= s.config.FlappingThreshold
+	report.Flapping = countScalingDirectionChanges(append(actions, report.ScalingAction)) >= s.config.FlappingThreshold
+	if report.Flapping && !wasFlapping {
+		glog.Warningf("compute nodes of cluster %s changed scaling direction at least %d times over the last %s", report.ClusterId, s.config.FlappingThreshold, s.config.FlappingWindow)
+	}
+
+	if err := dbConn.Create(report).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to record the status report of cluster %s", report.ClusterId)
+	}
+	// the reports are hard deleted, they would otherwise keep growing the table
+	if err := dbConn.Unscoped().
+		Where("cluster_id = ? AND reported_at < ?", report.ClusterId, report.ReportedAt.Add(-s.config.Retention)).
+		Delete(&dbapi.DataPlaneClusterStatusReport{}).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to delete the expired status reports of cluster %s", report.ClusterId)
+	}
+	metrics.UpdateClusterScalingFlappingMetric(report.ClusterId, report.Flapping)
+	return nil
+}
+
+func (s *dataPlaneClusterStatusHistoryService) ListReports(clusterId string, from, to time.Time) (dbapi.DataPlaneClusterStatusReportList, *errors.ServiceError) {
+	reports := dbapi.DataPlaneClusterStatusReportList{}
+	if err := s.connectionFactory.New().
+		Where("cluster_id = ? AND reported_at > ? AND reported_at <= ?", clusterId, from, to).
+		Order("reported_at").
+		Find(&reports).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list the status reports of cluster %s", clusterId)
+	}
+	return reports, nil
+}
+
+func (s *dataPlaneClusterStatusHistoryService) Summarise(from, to time.Time) ([]DataPlaneClusterStatusSummary, *errors.ServiceError) {
+	dbConn := s.connectionFactory.New()
+
+	summaries := []DataPlaneClusterStatusSummary{}
+	if err := dbConn.Model(&dbapi.DataPlaneClusterStatusReport{}).
+		Select("cluster_id"+
+			", count(*) as reports"+
+			", min(nodes_current) as nodes_min"+
+			", max(nodes_current) as nodes_max"+
+			", min(remaining_connections) as remaining_connections_min"+
+			", min(remaining_partitions) as remaining_partitions_min"+
+			", sum(case when scaling_action = ? then 1 else 0 end) as scale_ups"+
+			", sum(case when scaling_action = ? then 1 else 0 end) as scale_downs"+
+			", sum(case when flapping then 1 else 0 end) as flapping_reports",
+			dbapi.DataPlaneClusterScalingUp, dbapi.DataPlaneClusterScalingDown).
+		Where("reported_at > ? AND reported_at <= ?", from, to).
+		Group("cluster_id").
+		Order("cluster_id").
+		Scan(&summaries).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to summarise the data plane cluster status reports")
+	}
+	if len(summaries) == 0 {
+		return summaries, nil
+	}
+
+	var latest dbapi.DataPlaneClusterStatusReportList
+	if err := dbConn.Select("DISTINCT ON (cluster_id) *").
+		Where("reported_at > ? AND reported_at <= ?", from, to).
+		Order("cluster_id, reported_at desc").
+		Find(&latest).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to get the latest data plane cluster status reports")
+	}
+	latestByCluster := map[string]*dbapi.DataPlaneClusterStatusReport{}
+	for _, report := range latest {
+		latestByCluster[report.ClusterId] = report
+	}
+
+	var scalings dbapi.DataPlaneClusterStatusReportList
+	if err := dbConn.Select("cluster_id, scaling_action").
+		Where("reported_at > ? AND reported_at <= ? AND scaling_action <> ''", from, to).
+		Order("cluster_id, reported_at").
+		Find(&scalings).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to get the scaling history of the data plane clusters")
+	}
+	scalingsByCluster := map[string]dbapi.DataPlaneClusterStatusReportList{}
+	for _, report := range scalings {
+		scalingsByCluster[report.ClusterId] = append(scalingsByCluster[report.ClusterId], report)
+	}
+
+	for i := range summaries {
+		summaries[i].Latest = latestByCluster[summaries[i].ClusterId]
+		summaries[i].ScalingDirectionChanges = ScalingDirectionChanges(scalingsByCluster[summaries[i].ClusterId])
+	}
+	return summaries, nil
+}
@@ -0,0 +1,92 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/onsi/gomega"
+)
+
+func TestScalingDirectionChanges(t *testing.T) {
+	tests := []struct {
+		name    string
+		actions []string
+		want    int
+	}{
+		{
+			name: "should not count a cluster that never scaled",
+			want: 0,
+		},
+		{
+			name:    "should not count successive scalings in the same direction",
+			actions: []string{dbapi.DataPlaneClusterScalingUp, "", dbapi.DataPlaneClusterScalingUp},
+			want:    0,
+		},
+		{
+			name:    "should count each change of direction ignoring the reports without scaling",
+			actions: []string{dbapi.DataPlaneClusterScalingUp, "", dbapi.DataPlaneClusterScalingDown, dbapi.DataPlaneClusterScalingDown, "", dbapi.DataPlaneClusterScalingUp},
+			want:    2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			var reports dbapi.DataPlaneClusterStatusReportList
+			for _, action := range tt.actions {
+				reports = append(reports, &dbapi.DataPlaneClusterStatusReport{ScalingAction: action})
+			}
+			gomega.Expect(ScalingDirectionChanges(reports)).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func TestDataPlaneClusterStatusTrend(t *testing.T) {
+	gomega.RegisterTestingT(t)
+	from := time.Date(2022, 3, 6, 12, 0, 0, 0, time.UTC)
+	report := func(after time.Duration, nodes, connections int, action string, flapping bool) *dbapi.DataPlaneClusterStatusReport {
+		return &dbapi.DataPlaneClusterStatusReport{
+			ReportedAt:           from.Add(after),
+			NodesCurrent:         nodes,
+			NodesCeiling:         12,
+			NodesFloor:           3,
+			RemainingConnections: connections,
+			RemainingPartitions:  connections / 10,
+			ScalingAction:        action,
+			Flapping:             flapping,
+		}
+	}
+
+	points := DataPlaneClusterStatusTrend(dbapi.DataPlaneClusterStatusReportList{
+		report(time.Minute, 3, 100, dbapi.DataPlaneClusterScalingUp, false),
+		report(30*time.Minute, 6, 50, "", false),
+		report(2*time.Hour+time.Minute, 6, 400, dbapi.DataPlaneClusterScalingDown, true),
+	}, from, time.Hour)
+
+	gomega.Expect(points).To(gomega.Equal([]DataPlaneClusterStatusTrendPoint{
+		{
+			Timestamp:               from,
+			Reports:                 2,
+			NodesMin:                3,
+			NodesMax:                6,
+			NodesCeiling:            12,
+			NodesFloor:              3,
+			RemainingConnectionsMin: 50,
+			RemainingPartitionsMin:  5,
+			ScaleUps:                1,
+		},
+		{
+			Timestamp:               from.Add(2 * time.Hour),
+			Reports:                 1,
+			NodesMin:                6,
+			NodesMax:                6,
+			NodesCeiling:            12,
+			NodesFloor:              3,
+			RemainingConnectionsMin: 400,
+			RemainingPartitionsMin:  40,
+			ScaleDowns:              1,
+			Flapping:                true,
+		},
+	}))
+}
@@ -89,6 +89,111 @@ func Test_DataPlaneCluster_UpdateDataPlaneClusterStatus(t *testing.T) {
 	}
 }
 
+func Test_DataPlaneCluster_UpdateDataPlaneClusterStatus_RecordsStatusReport(t *testing.T) {
+	kafkaConfig := sampleValidApplicationConfigForDataPlaneClusterTest(nil).KafkaConfig
+	scaleDownThresholdsCrossed := func(status *dbapi.DataPlaneClusterStatus) {
+		status.ResizeInfo.Delta.Connections = kafkaConfig.KafkaCapacity.TotalMaxConnections * 10
+		status.ResizeInfo.Delta.Partitions = kafkaConfig.KafkaCapacity.MaxPartitions * 10
+		status.Remaining.Connections = kafkaConfig.KafkaCapacity.TotalMaxConnections * 1000
+		status.Remaining.Partitions = kafkaConfig.KafkaCapacity.MaxPartitions * 1000
+	}
+	tests := []struct {
+		name              string
+		current           int
+		desired           int
+		workloadMinimum   int
+		setStatus         func(status *dbapi.DataPlaneClusterStatus)
+		wantScalingAction string
+		wantDesiredNodes  int
+	}{
+		{
+			name:              "records the scale up of the compute nodes",
+			current:           3,
+			desired:           3,
+			wantScalingAction: dbapi.DataPlaneClusterScalingUp,
+			wantDesiredNodes:  6,
+		},
+		{
+			name:             "records the report without scaling when a scaling is in progress",
+			current:          3,
+			desired:          6,
+			wantDesiredNodes: 3,
+		},
+		{
+			name:              "records the scale down of the compute nodes",
+			current:           6,
+			desired:           6,
+			workloadMinimum:   3,
+			setStatus:         scaleDownThresholdsCrossed,
+			wantScalingAction: dbapi.DataPlaneClusterScalingDown,
+			wantDesiredNodes:  3,
+		},
+		{
+			name:             "records the report without scaling when the nodes can not be scaled down",
+			current:          6,
+			desired:          6,
+			workloadMinimum:  6,
+			setStatus:        scaleDownThresholdsCrossed,
+			wantDesiredNodes: 6,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := sampleValidBaseDataPlaneClusterStatusRequest()
+			status.NodeInfo.Current = tt.current
+			status.NodeInfo.Ceiling = 10000
+			status.NodeInfo.CurrentWorkLoadMinimum = tt.current
+			if tt.workloadMinimum > 0 {
+				status.NodeInfo.CurrentWorkLoadMinimum = tt.workloadMinimum
+			}
+			status.Remaining.Partitions = 10
+			if tt.setStatus != nil {
+				tt.setStatus(status)
+			}
+			clusterService := &ClusterServiceMock{
+				FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+					return &api.Cluster{ClusterID: clusterID, MultiAZ: true, Status: api.ClusterReady}, nil
+				},
+				UpdateStatusFunc: func(cluster api.Cluster, status api.ClusterStatus) error {
+					return nil
+				},
+				GetComputeNodesFunc: func(clusterID string) (*types.ComputeNodesInfo, *errors.ServiceError) {
+					return &types.ComputeNodesInfo{Actual: tt.current, Desired: tt.desired}, nil
+				},
+				SetComputeNodesFunc: func(clusterID string, numNodes int) (*types.ClusterSpec, *errors.ServiceError) {
+					return nil, nil
+				},
+			}
+			c := sampleValidApplicationConfigForDataPlaneClusterTest(clusterService)
+			historyService := &DataPlaneClusterStatusHistoryServiceMock{
+				RecordFunc: func(report *dbapi.DataPlaneClusterStatusReport) *errors.ServiceError {
+					return errors.GeneralError("history failures don't fail the status update")
+				},
+			}
+			c.StatusHistoryService = historyService
+
+			if svcErr := NewDataPlaneClusterService(c).UpdateDataPlaneClusterStatus(context.Background(), "test-cluster-id", status); svcErr != nil {
+				t.Fatalf("unexpected error %v", svcErr)
+			}
+			calls := historyService.RecordCalls()
+			if len(calls) != 1 {
+				t.Fatalf("expected a status report to be recorded, got %d", len(calls))
+			}
+			report := calls[0].Report
+			if report.ClusterId != "test-cluster-id" || report.NodesCurrent != tt.current || report.RemainingPartitions != status.Remaining.Partitions {
+				t.Errorf("unexpected status report %+v", report)
+			}
+			if report.ScalingAction != tt.wantScalingAction || report.DesiredNodes != tt.wantDesiredNodes {
+				t.Errorf("scaling action = %q to %d nodes, want %q to %d nodes", report.ScalingAction, report.DesiredNodes, tt.wantScalingAction, tt.wantDesiredNodes)
+			}
+			if scaled := len(clusterService.SetComputeNodesCalls()) > 0; scaled != (tt.wantScalingAction != "") {
+				t.Errorf("compute nodes set = %t, want scaling action %q", scaled, tt.wantScalingAction)
+			}
+		})
+	}
+}
+
 func Test_DataPlaneCluster_updateDataPlaneClusterNodes(t *testing.T) {
 	testClusterID := "test-cluster-id"
 
@@ -1019,5 +1124,10 @@ func sampleValidApplicationConfigForDataPlaneClusterTest(clusterService ClusterS
 			},
 		},
 		DataplaneClusterConfig: dataplaneClusterConfig,
+		StatusHistoryService: &DataPlaneClusterStatusHistoryServiceMock{
+			RecordFunc: func(report *dbapi.DataPlaneClusterStatusReport) *errors.ServiceError {
+				return nil
+			},
+		},
 	}
 }
@@ -37,16 +37,18 @@ type DataPlaneKafkaService interface {
 }
 
 type dataPlaneKafkaService struct {
-	kafkaService   KafkaService
-	clusterService ClusterService
-	kafkaConfig    *config.KafkaConfig
+	kafkaService        KafkaService
+	clusterService      ClusterService
+	availabilityService KafkaAvailabilityService
+	kafkaConfig         *config.KafkaConfig
 }
 
-func NewDataPlaneKafkaService(kafkaSrv KafkaService, clusterSrv ClusterService, kafkaConfig *config.KafkaConfig) *dataPlaneKafkaService {
+func NewDataPlaneKafkaService(kafkaSrv KafkaService, clusterSrv ClusterService, availabilitySrv KafkaAvailabilityService, kafkaConfig *config.KafkaConfig) *dataPlaneKafkaService {
 	return &dataPlaneKafkaService{
-		kafkaService:   kafkaSrv,
-		clusterService: clusterSrv,
-		kafkaConfig:    kafkaConfig,
+		kafkaService:        kafkaSrv,
+		clusterService:      clusterSrv,
+		availabilityService: availabilitySrv,
+		kafkaConfig:         kafkaConfig,
 	}
 }
 
@@ -104,10 +106,30 @@ func (d *dataPlaneKafkaService) UpdateDataPlaneKafkaService(ctx context.Context,
 		if e != nil {
 			log.Error(errors.Wrapf(e, "Error updating kafka '%s' version fields", ks.KafkaClusterId))
 		}
+
+		e = d.recordKafkaReadiness(kafka, ks)
+		if e != nil {
+			log.Error(errors.Wrapf(e, "Error recording kafka '%s' readiness", ks.KafkaClusterId))
+		}
 	}
 	return nil
 }
 
+// recordKafkaReadiness keeps the readiness reported for the kafka for its availability to be sampled
+func (d *dataPlaneKafkaService) recordKafkaReadiness(kafka *dbapi.KafkaRequest, status *dbapi.DataPlaneKafkaStatus) *serviceError.ServiceError {
+	readyCondition, found := status.GetReadyCondition()
+	if !found {
+		return nil
+	}
+	return d.availabilityService.RecordReadiness(&dbapi.KafkaReadinessReport{
+		KafkaId:    kafka.ID,
+		ClusterId:  kafka.ClusterID,
+		Ready:      strings.EqualFold(readyCondition.Status, "True"),
+		Reason:     readyCondition.Reason,
+		ReportedAt: time.Now(),
+	})
+}
+
 func (d *dataPlaneKafkaService) setKafkaClusterReady(kafka *dbapi.KafkaRequest) *serviceError.ServiceError {
 	if !kafka.RoutesCreated {
 		logger.Logger.V(10).Infof("routes for kafka %s are not created", kafka.ID)
@@ -470,7 +470,7 @@ func TestDataPlaneKafkaService_UpdateDataPlaneKafkaService(t *testing.T) {
 				"deleting": 0,
 				"rejected": 0,
 			}
-			s := NewDataPlaneKafkaService(tt.kafkaService(counter), tt.clusterService, &config.KafkaConfig{})
+			s := NewDataPlaneKafkaService(tt.kafkaService(counter), tt.clusterService, availabilityServiceMock(), &config.KafkaConfig{})
 			err := s.UpdateDataPlaneKafkaService(context.TODO(), tt.clusterId, tt.status)
 			if err != nil && !tt.wantErr {
 				t.Errorf("unexpected error %v", err)
@@ -482,6 +482,66 @@ func TestDataPlaneKafkaService_UpdateDataPlaneKafkaService(t *testing.T) {
 	}
 }
 
+func TestDataPlaneKafkaService_RecordsReadiness(t *testing.T) {
+	clusterService := &ClusterServiceMock{
+		FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+			return &api.Cluster{}, nil
+		},
+	}
+	kafkaService := &KafkaServiceMock{
+		GetByIdFunc: func(id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+			return &dbapi.KafkaRequest{
+				Meta:      api.Meta{ID: id},
+				ClusterID: "test-cluster-id",
+				Status:    constants2.KafkaRequestStatusReady.String(),
+			}, nil
+		},
+		UpdateFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
+			return nil
+		},
+		UpdatesFunc: func(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *errors.ServiceError {
+			return nil
+		},
+	}
+	availabilityService := availabilityServiceMock()
+	s := NewDataPlaneKafkaService(kafkaService, clusterService, availabilityService, &config.KafkaConfig{})
+	err := s.UpdateDataPlaneKafkaService(context.TODO(), "test-cluster-id", []*dbapi.DataPlaneKafkaStatus{
+		{
+			KafkaClusterId: "ready-kafka",
+			Conditions:     []dbapi.DataPlaneKafkaStatusCondition{{Type: "Ready", Status: "True"}},
+		},
+		{
+			KafkaClusterId: "failed-kafka",
+			Conditions:     []dbapi.DataPlaneKafkaStatusCondition{{Type: "Ready", Status: "False", Reason: "Error", Message: "test failed message"}},
+		},
+		{
+			KafkaClusterId: "no-condition-kafka",
+		},
+	})
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	calls := availabilityService.RecordReadinessCalls()
+	if len(calls) != 2 {
+		t.Fatalf("expected the readiness of 2 kafkas to be recorded, got %d", len(calls))
+	}
+	if r := calls[0].Report; r.KafkaId != "ready-kafka" || r.ClusterId != "test-cluster-id" || !r.Ready {
+		t.Errorf("unexpected readiness report %+v", r)
+	}
+	if r := calls[1].Report; r.KafkaId != "failed-kafka" || r.Ready || r.Reason != "Error" {
+		t.Errorf("unexpected readiness report %+v", r)
+	}
+}
+
+func availabilityServiceMock() *KafkaAvailabilityServiceMock {
+	return &KafkaAvailabilityServiceMock{
+		RecordReadinessFunc: func(report *dbapi.KafkaReadinessReport) *errors.ServiceError {
+			return nil
+		},
+	}
+}
+
 func TestDataPlaneKafkaService_UpdateVersions(t *testing.T) {
 	type versions struct {
 		actualKafkaVersion    string
@@ -750,7 +810,7 @@ func TestDataPlaneKafkaService_UpdateVersions(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			v := versions{}
-			s := NewDataPlaneKafkaService(tt.kafkaService(&v), tt.clusterService, &config.KafkaConfig{})
+			s := NewDataPlaneKafkaService(tt.kafkaService(&v), tt.clusterService, availabilityServiceMock(), &config.KafkaConfig{})
 			err := s.UpdateDataPlaneKafkaService(context.TODO(), tt.clusterId, tt.status)
 			if err != nil && !tt.wantErr {
 				t.Errorf("unexpected error %v", err)
@@ -0,0 +1,39 @@
+package services
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/dns"
+	"github.com/pkg/errors"
+)
+
+// NewDNSProvider returns the DNS provider selected by the configuration to manage the records of the kafka domain
+func NewDNSProvider(dnsConfig *config.DNSConfig, awsConfig *config.AWSConfig, awsClientFactory aws.ClientFactory) (dns.Provider, error) {
+	switch dnsConfig.Provider {
+	case dns.ProviderRoute53:
+		return dns.NewRoute53Provider(awsClientFactory, aws.Config{
+			AccessKeyID:     awsConfig.Route53AccessKey,
+			SecretAccessKey: awsConfig.Route53SecretAccessKey,
+		}), nil
+	case dns.ProviderCloudDNS:
+		return dns.NewCloudDNSProvider(dns.CloudDNSConfig{
+			Project:     dnsConfig.CloudDNSProject,
+			ManagedZone: dnsConfig.CloudDNSManagedZone,
+			Credentials: dnsConfig.CloudDNSCredentials,
+		})
+	case dns.ProviderRFC2136:
+		return dns.NewRFC2136Provider(dns.RFC2136Config{
+			Server:        dnsConfig.RFC2136Server,
+			Zone:          dnsConfig.RFC2136Zone,
+			TSIGKeyName:   dnsConfig.RFC2136TSIGKeyName,
+			TSIGAlgorithm: dnsConfig.RFC2136TSIGAlgorithm,
+			TSIGSecret:    dnsConfig.RFC2136TSIGSecret,
+		})
+	case dns.ProviderExternalDNS:
+		return dns.NewExternalDNSProvider(dns.ExternalDNSConfig{
+			Kubeconfig: dnsConfig.ExternalDNSKubeconfig,
+			Namespace:  dnsConfig.ExternalDNSNamespace,
+		})
+	}
+	return nil, errors.Errorf("unsupported DNS provider %q", dnsConfig.Provider)
+}
@@ -16,21 +16,24 @@ import (
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/authorization"
 	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/queryparser"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/rbac"
 
 	"time"
 
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/golang/glog"
+	"gorm.io/gorm"
 
 	managedkafka "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api/managedkafkas.managedkafka.bf2.org/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
-	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
-	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/dns"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 )
 
 var kafkaDeletionStatuses = []string{constants2.KafkaRequestStatusDeleting.String(), constants2.KafkaRequestStatusDeprovision.String()}
@@ -42,11 +45,6 @@ const KafkaRoutesActionCreate KafkaRoutesAction = "CREATE"
 const KafkaRoutesActionDelete KafkaRoutesAction = "DELETE"
 const CanaryServiceAccountPrefix = "canary"
 
-type CNameRecordStatus struct {
-	Id     *string
-	Status *string
-}
-
 //go:generate moq -out kafkaservice_moq.go . KafkaService
 type KafkaService interface {
 	// PrepareKafkaRequest sets any required information (i.e. bootstrap server host, sso client id and secret)
@@ -64,7 +62,7 @@ type KafkaService interface {
 	Delete(*dbapi.KafkaRequest) *errors.ServiceError
 	List(ctx context.Context, listArgs *services.ListArguments) (dbapi.KafkaList, *api.PagingMeta, *errors.ServiceError)
 	GetManagedKafkaByClusterID(clusterID string) ([]managedkafka.ManagedKafka, *errors.ServiceError)
-	RegisterKafkaJob(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError
+	RegisterKafkaJob(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError
 	ListByStatus(status ...constants2.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError)
 	// UpdateStatus change the status of the Kafka cluster
 	// The returned boolean is to be used to know if the update has been tried or not. An update is not tried if the
@@ -77,10 +75,17 @@ type KafkaService interface {
 	// Use this only when you want to update the multiple columns that may contain zero-fields, otherwise use the `KafkaService.Update()` method.
 	// See https://gorm.io/docs/update.html#Updates-multiple-columns for more info
 	Updates(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *errors.ServiceError
-	ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError)
-	GetCNAMERecordStatus(kafkaRequest *dbapi.KafkaRequest) (*CNameRecordStatus, error)
+	// UpdatesIfUnmodified updates the given fields of a kafka like Updates(), unless the kafka was modified since it
+	// was read, i.e. its update time changed. A precondition failed error is returned in that case.
+	UpdatesIfUnmodified(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *errors.ServiceError
+	// ChangeKafkaCNAMErecords creates or deletes the CNAME records of the routes of the kafka with the DNS provider
+	ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*dns.Change, *errors.ServiceError)
+	// GetCNAMERecordStatus returns the status of the change of the CNAME records of the kafka
+	GetCNAMERecordStatus(kafkaRequest *dbapi.KafkaRequest) (*dns.Change, error)
 	DetectInstanceType(kafkaRequest *dbapi.KafkaRequest) (types.KafkaInstanceType, *errors.ServiceError)
-	RegisterKafkaDeprovisionJob(ctx context.Context, id string) *errors.ServiceError
+	// RegisterKafkaDeprovisionJob registers the kafka for deprovisioning. If updatedAt is set, the kafka is only
+	// deprovisioned if it wasn't modified since then, otherwise a precondition failed error is returned.
+	RegisterKafkaDeprovisionJob(ctx context.Context, id string, updatedAt time.Time) *errors.ServiceError
 	// DeprovisionKafkaForUsers registers all kafkas for deprovisioning given the list of owners
 	DeprovisionKafkaForUsers(users []string) *errors.ServiceError
 	DeprovisionExpiredKafkas(kafkaAgeInHours int) *errors.ServiceError
@@ -99,29 +104,35 @@ type kafkaService struct {
 	clusterService           ClusterService
 	keycloakService          services.KeycloakService
 	kafkaConfig              *config.KafkaConfig
-	awsConfig                *config.AWSConfig
 	quotaServiceFactory      QuotaServiceFactory
 	mu                       sync.Mutex
-	awsClientFactory         aws.ClientFactory
+	dnsProvider              dns.Provider
 	authService              authorization.Authorization
 	dataplaneClusterConfig   *config.DataplaneClusterConfig
 	providerConfig           *config.ProviderConfig
 	clusterPlacementStrategy ClusterPlacementStrategy
+	roleBindingService       rbac.RoleBindingService
+	certificateService       KafkaCertificateService
+	customDomainService      KafkaCustomDomainService
+	alertService             KafkaAlertService
 }
 
-func NewKafkaService(connectionFactory *db.ConnectionFactory, clusterService ClusterService, keycloakService services.KafkaKeycloakService, kafkaConfig *config.KafkaConfig, dataplaneClusterConfig *config.DataplaneClusterConfig, awsConfig *config.AWSConfig, quotaServiceFactory QuotaServiceFactory, awsClientFactory aws.ClientFactory, authorizationService authorization.Authorization, providerConfig *config.ProviderConfig, clusterPlacementStrategy ClusterPlacementStrategy) *kafkaService {
+func NewKafkaService(connectionFactory *db.ConnectionFactory, clusterService ClusterService, keycloakService services.KafkaKeycloakService, kafkaConfig *config.KafkaConfig, dataplaneClusterConfig *config.DataplaneClusterConfig, quotaServiceFactory QuotaServiceFactory, dnsProvider dns.Provider, authorizationService authorization.Authorization, providerConfig *config.ProviderConfig, clusterPlacementStrategy ClusterPlacementStrategy, roleBindingService rbac.RoleBindingService, certificateService KafkaCertificateService, customDomainService KafkaCustomDomainService, alertService KafkaAlertService) *kafkaService {
 	return &kafkaService{
 		connectionFactory:        connectionFactory,
 		clusterService:           clusterService,
 		keycloakService:          keycloakService,
 		kafkaConfig:              kafkaConfig,
-		awsConfig:                awsConfig,
 		quotaServiceFactory:      quotaServiceFactory,
-		awsClientFactory:         awsClientFactory,
+		dnsProvider:              dnsProvider,
 		authService:              authorizationService,
 		dataplaneClusterConfig:   dataplaneClusterConfig,
 		providerConfig:           providerConfig,
 		clusterPlacementStrategy: clusterPlacementStrategy,
+		roleBindingService:       roleBindingService,
+		certificateService:       certificateService,
+		customDomainService:      customDomainService,
+		alertService:             alertService,
 	}
 }
 
@@ -207,8 +218,8 @@ func (k *kafkaService) reserveQuota(kafkaRequest *dbapi.KafkaRequest) (subscript
 	return subscriptionId, err
 }
 
-// RegisterKafkaJob registers a new job in the kafka table
-func (k *kafkaService) RegisterKafkaJob(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
+// RegisterKafkaJob registers a new job in the kafka table, the kafka request is inserted with ctx
+func (k *kafkaService) RegisterKafkaJob(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
 	k.mu.Lock()
 	defer k.mu.Unlock()
 	// we need to pre-populate the ID to be able to reserve the quota
@@ -249,7 +260,7 @@ func (k *kafkaService) RegisterKafkaJob(kafkaRequest *dbapi.KafkaRequest) *error
 		return err
 	}
 
-	dbConn := k.connectionFactory.New()
+	dbConn := k.connectionFactory.New().WithContext(ctx)
 	kafkaRequest.SubscriptionId = subscriptionId
 	kafkaRequest.Status = constants2.KafkaRequestStatusAccepted.String()
 	// when creating new kafka - default storage size is assigned
@@ -370,14 +381,10 @@ func (k *kafkaService) Get(ctx context.Context, id string) (*dbapi.KafkaRequest,
 			return nil, errors.Unauthenticated("user not authenticated")
 		}
 
-		orgId := auth.GetOrgIdFromClaims(claims)
-		filterByOrganisationId := auth.GetFilterByOrganisationFromContext(ctx)
-
-		// filter by organisationId if a user is part of an organisation and is not allowed as a service account
-		if filterByOrganisationId {
-			dbConn = dbConn.Where("organisation_id = ?", orgId)
-		} else {
-			dbConn = dbConn.Where("owner = ?", user)
+		var serviceErr *errors.ServiceError
+		dbConn, serviceErr = k.filterByUserAccess(ctx, dbConn, claims, rbac.RoleViewer)
+		if serviceErr != nil {
+			return nil, serviceErr
 		}
 	}
 
@@ -392,6 +399,32 @@ func (k *kafkaService) Get(ctx context.Context, id string) (*dbapi.KafkaRequest,
 	return &kafkaRequest, nil
 }
 
+// filterByUserAccess restricts the query to the kafkas the user has at least the given role on. Users are admins of
+// the kafkas they own, organisation admins are admins of the kafkas of their organisation and organisation members
+// can view them if kafkas are filtered by organisation. Other kafkas are only accessible if they were shared with the
+// user through role bindings.
+func (k *kafkaService) filterByUserAccess(ctx context.Context, dbConn *gorm.DB, claims jwt.MapClaims, role rbac.Role) (*gorm.DB, *errors.ServiceError) {
+	orgId := auth.GetOrgIdFromClaims(claims)
+
+	query, args := "owner = ?", []interface{}{auth.GetUsernameFromClaims(claims)}
+	if role == rbac.RoleViewer && auth.GetFilterByOrganisationFromContext(ctx) {
+		// filter by organisationId if a user is part of an organisation and is not allowed as a service account
+		query, args = "organisation_id = ?", []interface{}{orgId}
+	} else if role != rbac.RoleViewer && auth.GetIsOrgAdminFromClaims(claims) {
+		query, args = "organisation_id = ?", []interface{}{orgId}
+	}
+
+	grantQuery, grantArgs, err := k.roleBindingService.Filter(ctx, rbac.ResourceTypeKafka, role)
+	if err != nil {
+		return dbConn, err
+	}
+	if grantQuery != "" {
+		query = fmt.Sprintf("%s OR %s", query, grantQuery)
+		args = append(args, grantArgs...)
+	}
+	return dbConn.Where(query, args...), nil
+}
+
 func (k *kafkaService) GetById(id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
 	if id == "" {
 		return nil, errors.Validation("id is undefined")
@@ -406,7 +439,7 @@ func (k *kafkaService) GetById(id string) (*dbapi.KafkaRequest, *errors.ServiceE
 }
 
 // RegisterKafkaDeprovisionJob registers a kafka deprovision job in the kafka table
-func (k *kafkaService) RegisterKafkaDeprovisionJob(ctx context.Context, id string) *errors.ServiceError {
+func (k *kafkaService) RegisterKafkaDeprovisionJob(ctx context.Context, id string, updatedAt time.Time) *errors.ServiceError {
 	if id == "" {
 		return errors.Validation("id is undefined")
 	}
@@ -417,16 +450,14 @@ func (k *kafkaService) RegisterKafkaDeprovisionJob(ctx context.Context, id strin
 		return errors.NewWithCause(errors.ErrorUnauthenticated, err, "user not authenticated")
 	}
 
-	dbConn := k.connectionFactory.New()
+	dbConn := k.connectionFactory.New().Where("id = ?", id)
 
-	if auth.GetIsAdminFromContext(ctx) {
-		dbConn = dbConn.Where("id = ?", id)
-	} else if auth.GetIsOrgAdminFromClaims(claims) {
-		orgId := auth.GetOrgIdFromClaims(claims)
-		dbConn = dbConn.Where("id = ?", id).Where("organisation_id = ?", orgId)
-	} else {
-		user := auth.GetUsernameFromClaims(claims)
-		dbConn = dbConn.Where("id = ?", id).Where("owner = ? ", user)
+	if !auth.GetIsAdminFromContext(ctx) {
+		var serviceErr *errors.ServiceError
+		dbConn, serviceErr = k.filterByUserAccess(ctx, dbConn, claims, rbac.RoleAdmin)
+		if serviceErr != nil {
+			return serviceErr
+		}
 	}
 
 	var kafkaRequest dbapi.KafkaRequest
@@ -437,6 +468,19 @@ func (k *kafkaService) RegisterKafkaDeprovisionJob(ctx context.Context, id strin
 
 	deprovisionStatus := constants2.KafkaRequestStatusDeprovision
 
+	if !updatedAt.IsZero() {
+		if shared.Contains(kafkaDeletionStatuses, kafkaRequest.Status) {
+			return nil
+		}
+		kafkaRequest.UpdatedAt = updatedAt
+		if err := k.UpdatesIfUnmodified(&kafkaRequest, map[string]interface{}{"status": deprovisionStatus.String()}); err != nil {
+			return err
+		}
+		metrics.IncreaseKafkaSuccessOperationsCountMetric(constants2.KafkaOperationDeprovision)
+		metrics.UpdateKafkaRequestsStatusSinceCreatedMetric(deprovisionStatus, kafkaRequest.ID, kafkaRequest.ClusterID, time.Since(kafkaRequest.CreatedAt))
+		return nil
+	}
+
 	if executed, err := k.UpdateStatus(id, deprovisionStatus); executed {
 		if err != nil {
 			return services.HandleGetError("KafkaResource", "id", id, err)
@@ -531,10 +575,23 @@ func (k *kafkaService) Delete(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceE
 		}
 	}
 
+	if err := k.certificateService.Delete(kafkaRequest.ID); err != nil {
+		return err
+	}
+	if err := k.customDomainService.DeleteByKafkaId(kafkaRequest.ID); err != nil {
+		return err
+	}
+	if err := k.alertService.DeleteByKafkaId(kafkaRequest.ID); err != nil {
+		return err
+	}
+
 	// soft delete the kafka request
 	if err := dbConn.Delete(kafkaRequest).Error; err != nil {
 		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to delete kafka request with id %s", kafkaRequest.ID)
 	}
+	if err := k.roleBindingService.DeleteResourceBindings(rbac.ResourceTypeKafka, kafkaRequest.ID); err != nil {
+		return err
+	}
 
 	metrics.IncreaseKafkaTotalOperationsCountMetric(constants2.KafkaOperationDelete)
 	metrics.IncreaseKafkaSuccessOperationsCountMetric(constants2.KafkaOperationDelete)
@@ -562,16 +619,13 @@ func (k *kafkaService) List(ctx context.Context, listArgs *services.ListArgument
 			return nil, nil, errors.Unauthenticated("user not authenticated")
 		}
 
-		orgId := auth.GetOrgIdFromClaims(claims)
-		filterByOrganisationId := auth.GetFilterByOrganisationFromContext(ctx)
-
-		// filter by organisationId if a user is part of an organisation and is not allowed as a service account
-		if filterByOrganisationId {
-			// filter kafka requests by organisation_id since the user is allowed to see all kafka requests of my id
-			dbConn = dbConn.Where("organisation_id = ?", orgId)
-		} else {
-			// filter kafka requests by owner as we are dealing with service accounts which may not have an org id
-			dbConn = dbConn.Where("owner = ?", user)
+		// filter kafka requests by organisation_id if the user is allowed to see all kafka requests of their
+		// organisation, or by owner as we are dealing with service accounts which may not have an org id, and add
+		// the kafka requests shared with the user
+		var serviceErr *errors.ServiceError
+		dbConn, serviceErr = k.filterByUserAccess(ctx, dbConn, claims, rbac.RoleViewer)
+		if serviceErr != nil {
+			return nil, nil, serviceErr
 		}
 	}
 
@@ -628,10 +682,43 @@ func (k *kafkaService) GetManagedKafkaByClusterID(clusterID string) ([]managedka
 		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list kafka requests")
 	}
 
+	kafkaIds := make([]string, 0, len(kafkaRequestList))
+	for _, kafkaRequest := range kafkaRequestList {
+		kafkaIds = append(kafkaIds, kafkaRequest.ID)
+	}
+	certificates := map[string]*dbapi.KafkaCertificate{}
+	customDomains := map[string]*dbapi.KafkaCustomDomain{}
+	if k.kafkaConfig.EnableKafkaExternalCertificate {
+		var err *errors.ServiceError
+		if certificates, err = k.certificateService.FindByKafkaIds(kafkaIds); err != nil {
+			return nil, err
+		}
+		if customDomains, err = k.customDomainService.FindReadyByKafkaIds(kafkaIds); err != nil {
+			return nil, err
+		}
+	}
+
 	var res []managedkafka.ManagedKafka
 	// convert kafka requests to managed kafka
 	for _, kafkaRequest := range kafkaRequestList {
 		mk := BuildManagedKafkaCR(kafkaRequest, k.kafkaConfig, k.keycloakService.GetConfig())
+		// the kafkas without a certificate of their own yet keep the shared one, the CR is rolled by the fleetshard
+		// operator once the certificate is issued
+		if certificate, ok := certificates[kafkaRequest.ID]; ok {
+			mk.Spec.Endpoint.Tls = &managedkafka.TlsSpec{
+				Cert: certificate.Certificate,
+				Key:  certificate.Key,
+			}
+		}
+		if customDomain, ok := customDomains[kafkaRequest.ID]; ok {
+			mk.Spec.Endpoint.CustomDomains = []managedkafka.CustomDomainSpec{{
+				BootstrapServerHost: customDomain.Domain,
+				Tls: &managedkafka.TlsSpec{
+					Cert: customDomain.Certificate,
+					Key:  customDomain.Key,
+				},
+			}}
+		}
 		res = append(res, *mk)
 	}
 
@@ -662,6 +749,23 @@ func (k *kafkaService) Updates(kafkaRequest *dbapi.KafkaRequest, fields map[stri
 	return nil
 }
 
+func (k *kafkaService) UpdatesIfUnmodified(kafkaRequest *dbapi.KafkaRequest, fields map[string]interface{}) *errors.ServiceError {
+	// the update time is checked by the update itself, so that a concurrent update can't be overwritten
+	result := k.connectionFactory.New().
+		Model(kafkaRequest).
+		Where("updated_at = ?", kafkaRequest.UpdatedAt).
+		Where("status not IN (?)", kafkaDeletionStatuses). // ignore updates of kafka under deletion
+		Updates(fields)
+	if result.Error != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, result.Error, "Failed to update kafka")
+	}
+	if result.RowsAffected == 0 && !shared.Contains(kafkaDeletionStatuses, kafkaRequest.Status) {
+		return errors.PreconditionFailed("kafka %s has been modified", kafkaRequest.ID)
+	}
+
+	return nil
+}
+
 func (k *kafkaService) VerifyAndUpdateKafkaAdmin(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
 	if !auth.GetIsAdminFromContext(ctx) {
 		return errors.New(errors.ErrorUnauthenticated, "User not authenticated")
@@ -734,14 +838,7 @@ func (k *kafkaService) VerifyAndUpdateKafkaAdmin(ctx context.Context, kafkaReque
 		"desired_kafka_ibp_version": kafkaRequest.DesiredKafkaIBPVersion,
 	}
 
-	dbConn := k.connectionFactory.New().
-		Model(kafkaRequest)
-
-	if err := dbConn.Updates(updatableFields).Error; err != nil {
-		return errors.NewWithCause(errors.ErrorGeneral, err, "Failed to update kafka")
-	}
-
-	return nil
+	return k.UpdatesIfUnmodified(kafkaRequest, updatableFields)
 }
 
 func (k *kafkaService) UpdateStatus(id string, status constants2.KafkaStatus) (bool, *errors.ServiceError) {
@@ -768,51 +865,27 @@ func (k *kafkaService) UpdateStatus(id string, status constants2.KafkaStatus) (b
 	return true, nil
 }
 
-func (k *kafkaService) ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError) {
+func (k *kafkaService) ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*dns.Change, *errors.ServiceError) {
 	routes, err := kafkaRequest.GetRoutes()
 	if routes == nil || err != nil {
 		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to get routes")
 	}
 
-	domainRecordBatch := buildKafkaClusterCNAMESRecordBatch(routes, string(action))
-
-	// Create AWS client with the region of this Kafka Cluster
-	awsConfig := aws.Config{
-		AccessKeyID:     k.awsConfig.Route53AccessKey,
-		SecretAccessKey: k.awsConfig.Route53SecretAccessKey,
-	}
-	awsClient, err := k.awsClientFactory.NewClient(awsConfig, kafkaRequest.Region)
-	if err != nil {
-		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "Unable to create aws client")
-	}
-
-	changeRecordsOutput, err := awsClient.ChangeResourceRecordSets(k.kafkaConfig.KafkaDomainName, domainRecordBatch)
+	change, err := k.dnsProvider.ChangeRecords(k.kafkaConfig.KafkaDomainName, dns.Action(action), buildKafkaClusterCNAMERecords(routes))
 	if err != nil {
 		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "Unable to create domain record sets")
 	}
 
-	return changeRecordsOutput, nil
+	return change, nil
 }
 
-func (k *kafkaService) GetCNAMERecordStatus(kafkaRequest *dbapi.KafkaRequest) (*CNameRecordStatus, error) {
-	awsConfig := aws.Config{
-		AccessKeyID:     k.awsConfig.Route53AccessKey,
-		SecretAccessKey: k.awsConfig.Route53SecretAccessKey,
-	}
-	awsClient, err := k.awsClientFactory.NewClient(awsConfig, kafkaRequest.Region)
-	if err != nil {
-		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "Unable to create aws client")
-	}
-
-	changeOutput, err := awsClient.GetChange(kafkaRequest.RoutesCreationId)
+func (k *kafkaService) GetCNAMERecordStatus(kafkaRequest *dbapi.KafkaRequest) (*dns.Change, error) {
+	change, err := k.dnsProvider.GetChange(k.kafkaConfig.KafkaDomainName, kafkaRequest.RoutesCreationId)
 	if err != nil {
 		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "Unable to CNAME record status")
 	}
 
-	return &CNameRecordStatus{
-		Id:     changeOutput.ChangeInfo.Id,
-		Status: changeOutput.ChangeInfo.Status,
-	}, nil
+	return change, nil
 }
 
 type KafkaStatusCount struct {
@@ -975,36 +1048,15 @@ func BuildManagedKafkaCR(kafkaRequest *dbapi.KafkaRequest, kafkaConfig *config.K
 	return managedKafkaCR
 }
 
-func buildKafkaClusterCNAMESRecordBatch(routes []dbapi.DataPlaneKafkaRoute, action string) *route53.ChangeBatch {
-	var changes []*route53.Change
+func buildKafkaClusterCNAMERecords(routes []dbapi.DataPlaneKafkaRoute) []dns.Record {
+	var records []dns.Record
 	for _, r := range routes {
-		c := buildResourceRecordChange(r.Domain, r.Router, action)
-		changes = append(changes, c)
-	}
-	recordChangeBatch := &route53.ChangeBatch{
-		Changes: changes,
-	}
-
-	return recordChangeBatch
-}
-
-func buildResourceRecordChange(recordName string, clusterIngress string, action string) *route53.Change {
-	recordType := "CNAME"
-	recordTTL := int64(300)
-
-	resourceRecordChange := &route53.Change{
-		Action: &action,
-		ResourceRecordSet: &route53.ResourceRecordSet{
-			Name: &recordName,
-			Type: &recordType,
-			TTL:  &recordTTL,
-			ResourceRecords: []*route53.ResourceRecord{
-				{
-					Value: &clusterIngress,
-				},
-			},
-		},
+		records = append(records, dns.Record{
+			Type:  dns.RecordTypeCNAME,
+			Name:  r.Domain,
+			Value: r.Router,
+			TTL:   300,
+		})
 	}
-
-	return resourceRecordChange
+	return records
 }
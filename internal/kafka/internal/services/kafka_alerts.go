@@ -0,0 +1,309 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/notification"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	pkgerrors "github.com/pkg/errors"
+)
+
+const maxAlertRuleNameLength = 100
+
+//go:generate moq -out kafka_alerts_moq.go . KafkaAlertService
+type KafkaAlertService interface {
+	// Create validates the alert rule and creates it for the kafka, the rule starts inactive
+	Create(kafka *dbapi.KafkaRequest, rule *dbapi.KafkaAlertRule) (*dbapi.KafkaAlertRule, *errors.ServiceError)
+	Get(kafkaId string, id string) (*dbapi.KafkaAlertRule, *errors.ServiceError)
+	List(kafkaId string) (dbapi.KafkaAlertRuleList, *errors.ServiceError)
+	Delete(kafkaId string, id string) *errors.ServiceError
+	// DeleteByKafkaId deletes the alert rules of a deleted kafka, its alert history is kept until it expires
+	DeleteByKafkaId(kafkaId string) *errors.ServiceError
+	// ListRules returns the alert rules of all the kafkas
+	ListRules() (dbapi.KafkaAlertRuleList, *errors.ServiceError)
+	// UpdateRule saves the outcome of the evaluation of the alert rule
+	UpdateRule(rule *dbapi.KafkaAlertRule) *errors.ServiceError
+	// Notify notifies the target of the alert rule that it fired or resolved and records the alert in the history,
+	// whether or not the notification was delivered
+	Notify(ctx context.Context, kafka *dbapi.KafkaRequest, rule *dbapi.KafkaAlertRule, alert *dbapi.KafkaAlert) *errors.ServiceError
+	// ListAlerts returns the alert history of the kafka, most recent first
+	ListAlerts(kafkaId string, listArgs *services.ListArguments) (dbapi.KafkaAlertList, *api.PagingMeta, *errors.ServiceError)
+	// DeleteAlertsBefore deletes the alerts recorded before the given time
+	DeleteAlertsBefore(before time.Time) *errors.ServiceError
+}
+
+type kafkaAlertService struct {
+	connectionFactory *db.ConnectionFactory
+	alertingConfig    *config.KafkaAlertingConfig
+	notifiers         map[string]notification.Notifier
+}
+
+var _ KafkaAlertService = &kafkaAlertService{}
+
+func NewKafkaAlertService(connectionFactory *db.ConnectionFactory, alertingConfig *config.KafkaAlertingConfig) (KafkaAlertService, error) {
+	service := &kafkaAlertService{
+		connectionFactory: connectionFactory,
+		alertingConfig:    alertingConfig,
+		notifiers:         map[string]notification.Notifier{},
+	}
+	if !alertingConfig.EnableAlerting {
+		return service, nil
+	}
+	service.notifiers[dbapi.AlertNotificationWebhook] = notification.NewWebhookNotifier(notification.WebhookConfig{
+		Timeout:              alertingConfig.WebhookTimeout,
+		AllowHTTP:            alertingConfig.WebhookAllowHTTP,
+		AllowPrivateNetworks: alertingConfig.WebhookAllowPrivateNetworks,
+	})
+	if alertingConfig.IsEmailEnabled() {
+		notifier, err := notification.NewSMTPNotifier(notification.SMTPConfig{
+			Host:     alertingConfig.SMTPHost,
+			Port:     alertingConfig.SMTPPort,
+			Username: alertingConfig.SMTPUsername,
+			Password: alertingConfig.SMTPPassword,
+			From:     alertingConfig.SMTPFrom,
+		})
+		if err != nil {
+			return nil, pkgerrors.Wrap(err, "unable to create the email notifier of the kafka alerts")
+		}
+		service.notifiers[dbapi.AlertNotificationEmail] = notifier
+	}
+	return service, nil
+}
+
+// AlertRuleExpression describes the value the alert rule is evaluated against and its condition, e.g.
+// sum(kafka_broker_quota_totalstorageusedbytes) / sum(kafka_broker_quota_softlimitbytes) > 0.9
+func AlertRuleExpression(rule *dbapi.KafkaAlertRule) string {
+	value := fmt.Sprintf("%s(%s)", rule.Aggregation, rule.Metric)
+	if rule.RelativeTo != "" {
+		value = fmt.Sprintf("%s / %s(%s)", value, rule.Aggregation, rule.RelativeTo)
+	}
+	operators := map[string]string{
+		dbapi.AlertOperatorGreaterThan:        ">",
+		dbapi.AlertOperatorGreaterThanOrEqual: ">=",
+		dbapi.AlertOperatorLessThan:           "<",
+		dbapi.AlertOperatorLessThanOrEqual:    "<=",
+	}
+	return fmt.Sprintf("%s %s %v", value, operators[rule.Operator], rule.Threshold)
+}
+
+func (s *kafkaAlertService) Create(kafka *dbapi.KafkaRequest, rule *dbapi.KafkaAlertRule) (*dbapi.KafkaAlertRule, *errors.ServiceError) {
+	if !s.alertingConfig.EnableAlerting {
+		return nil, errors.BadRequest("kafka alerting is not enabled")
+	}
+	if rule.Aggregation == "" {
+		rule.Aggregation = dbapi.AlertAggregationSum
+	}
+	if err := s.validate(rule); err != nil {
+		return nil, err
+	}
+
+	var count int64
+	if err := s.connectionFactory.New().Model(&dbapi.KafkaAlertRule{}).Where("kafka_id = ?", kafka.ID).Count(&count).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to count the alert rules of kafka %s", kafka.ID)
+	}
+	if int(count) >= s.alertingConfig.MaxRulesPerKafka {
+		return nil, errors.BadRequest("kafka %s already has the maximum of %d alert rules", kafka.ID, s.alertingConfig.MaxRulesPerKafka)
+	}
+
+	rule.ID = ""
+	rule.KafkaId = kafka.ID
+	rule.Owner = kafka.Owner
+	rule.OrganisationId = kafka.OrganisationId
+	rule.State = dbapi.AlertRuleStateInactive
+	rule.ActiveSince = nil
+	rule.LastEvaluatedAt = nil
+	rule.LastValue = nil
+	rule.LastError = ""
+	if err := s.connectionFactory.New().Create(rule).Error; err != nil {
+		return nil, services.HandleCreateError("KafkaAlertRule", err)
+	}
+	return rule, nil
+}
+
+func (s *kafkaAlertService) validate(rule *dbapi.KafkaAlertRule) *errors.ServiceError {
+	rule.Name = strings.TrimSpace(rule.Name)
+	if rule.Name == "" || len(rule.Name) > maxAlertRuleNameLength {
+		return errors.Validation("name must be between 1 and %d characters long", maxAlertRuleNameLength)
+	}
+	metrics := constants2.GetMetricsMetaData()
+	if _, ok := metrics[rule.Metric]; !ok {
+		return errors.Validation("metric %q is not a metric of the kafka", rule.Metric)
+	}
+	if rule.RelativeTo != "" {
+		if _, ok := metrics[rule.RelativeTo]; !ok {
+			return errors.Validation("relative_to %q is not a metric of the kafka", rule.RelativeTo)
+		}
+		if rule.RelativeTo == rule.Metric {
+			return errors.Validation("relative_to must be a different metric than %q", rule.Metric)
+		}
+	}
+	switch rule.Aggregation {
+	case dbapi.AlertAggregationSum, dbapi.AlertAggregationMax, dbapi.AlertAggregationMin, dbapi.AlertAggregationAvg:
+	default:
+		return errors.Validation("aggregation %q must be one of sum, max, min or avg", rule.Aggregation)
+	}
+	switch rule.Operator {
+	case dbapi.AlertOperatorGreaterThan, dbapi.AlertOperatorGreaterThanOrEqual, dbapi.AlertOperatorLessThan, dbapi.AlertOperatorLessThanOrEqual:
+	default:
+		return errors.Validation("operator %q must be one of gt, gte, lt or lte", rule.Operator)
+	}
+	if rule.ForSeconds < 0 {
+		return errors.Validation("for must not be negative")
+	}
+	notifier, ok := s.notifiers[rule.NotificationType]
+	if !ok {
+		return errors.Validation("notification_type %q is not supported", rule.NotificationType)
+	}
+	if err := notifier.ValidateTarget(rule.NotificationTarget); err != nil {
+		return errors.Validation("notification_target is not valid: %v", err)
+	}
+	return nil
+}
+
+func (s *kafkaAlertService) Get(kafkaId string, id string) (*dbapi.KafkaAlertRule, *errors.ServiceError) {
+	var rule dbapi.KafkaAlertRule
+	if err := s.connectionFactory.New().Where("kafka_id = ? AND id = ?", kafkaId, id).First(&rule).Error; err != nil {
+		return nil, services.HandleGetError("KafkaAlertRule", "id", id, err)
+	}
+	return &rule, nil
+}
+
+func (s *kafkaAlertService) List(kafkaId string) (dbapi.KafkaAlertRuleList, *errors.ServiceError) {
+	var rules dbapi.KafkaAlertRuleList
+	if err := s.connectionFactory.New().Where("kafka_id = ?", kafkaId).Order("created_at").Find(&rules).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list alert rules of kafka %s", kafkaId)
+	}
+	return rules, nil
+}
+
+func (s *kafkaAlertService) Delete(kafkaId string, id string) *errors.ServiceError {
+	rule, err := s.Get(kafkaId, id)
+	if err != nil {
+		return err
+	}
+	if err := s.connectionFactory.New().Delete(rule).Error; err != nil {
+		return services.HandleDeleteError("KafkaAlertRule", "id", id, err)
+	}
+	return nil
+}
+
+func (s *kafkaAlertService) DeleteByKafkaId(kafkaId string) *errors.ServiceError {
+	if err := s.connectionFactory.New().Where("kafka_id = ?", kafkaId).Delete(&dbapi.KafkaAlertRule{}).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to delete the alert rules of kafka %s", kafkaId)
+	}
+	return nil
+}
+
+func (s *kafkaAlertService) ListRules() (dbapi.KafkaAlertRuleList, *errors.ServiceError) {
+	var rules dbapi.KafkaAlertRuleList
+	if err := s.connectionFactory.New().Order("kafka_id, created_at").Find(&rules).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list alert rules")
+	}
+	return rules, nil
+}
+
+func (s *kafkaAlertService) UpdateRule(rule *dbapi.KafkaAlertRule) *errors.ServiceError {
+	// Select the evaluation columns so that clearing them, e.g. ActiveSince when the rule resolves, is saved too
+	if err := s.connectionFactory.New().Model(rule).
+		Select("state", "active_since", "last_evaluated_at", "last_value", "last_error").
+		Updates(rule).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to update alert rule %s", rule.ID)
+	}
+	return nil
+}
+
+// alertNotificationPayload is the JSON body of the webhook notifications
+type alertNotificationPayload struct {
+	KafkaId       string    `json:"kafka_id"`
+	KafkaName     string    `json:"kafka_name"`
+	AlertRuleId   string    `json:"alert_rule_id"`
+	AlertRuleName string    `json:"alert_rule_name"`
+	State         string    `json:"state"`
+	Expression    string    `json:"expression"`
+	Value         float64   `json:"value"`
+	Threshold     float64   `json:"threshold"`
+	Timestamp     time.Time `json:"timestamp"`
+}
+
+func (s *kafkaAlertService) Notify(ctx context.Context, kafka *dbapi.KafkaRequest, rule *dbapi.KafkaAlertRule, alert *dbapi.KafkaAlert) *errors.ServiceError {
+	now := time.Now()
+	expression := AlertRuleExpression(rule)
+	message := fmt.Sprintf("The alert rule %q of the kafka instance %s (%s) is %s.\n\nCondition: %s\nValue: %v\n",
+		rule.Name, kafka.Name, kafka.ID, alert.State, expression, alert.Value)
+	if alert.State == dbapi.AlertStateFiring && rule.ForSeconds > 0 {
+		message += fmt.Sprintf("The condition has held for at least %s.\n", time.Duration(rule.ForSeconds)*time.Second)
+	}
+
+	notifier, ok := s.notifiers[rule.NotificationType]
+	var err error
+	if ok {
+		err = notifier.Notify(ctx, rule.NotificationTarget, notification.Notification{
+			Subject: fmt.Sprintf("Kafka %s: alert %q %s", kafka.Name, rule.Name, alert.State),
+			Message: message,
+			Payload: alertNotificationPayload{
+				KafkaId:       kafka.ID,
+				KafkaName:     kafka.Name,
+				AlertRuleId:   rule.ID,
+				AlertRuleName: rule.Name,
+				State:         alert.State,
+				Expression:    expression,
+				Value:         alert.Value,
+				Threshold:     alert.Threshold,
+				Timestamp:     now,
+			},
+		})
+	} else {
+		err = pkgerrors.Errorf("notification type %q is not enabled", rule.NotificationType)
+	}
+	if err != nil {
+		alert.NotificationError = err.Error()
+	} else {
+		alert.NotifiedAt = &now
+	}
+
+	if err := s.connectionFactory.New().Create(alert).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to record the alert of rule %s", rule.ID)
+	}
+	return nil
+}
+
+func (s *kafkaAlertService) ListAlerts(kafkaId string, listArgs *services.ListArguments) (dbapi.KafkaAlertList, *api.PagingMeta, *errors.ServiceError) {
+	var alerts dbapi.KafkaAlertList
+	dbConn := s.connectionFactory.New().Model(&dbapi.KafkaAlert{}).Where("kafka_id = ?", kafkaId)
+	pagingMeta := &api.PagingMeta{
+		Page: listArgs.Page,
+		Size: listArgs.Size,
+	}
+
+	var total int64
+	if err := dbConn.Count(&total).Error; err != nil {
+		return nil, nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to count the alerts of kafka %s", kafkaId)
+	}
+	pagingMeta.Total = int(total)
+
+	if err := dbConn.Order("created_at DESC").
+		Offset((pagingMeta.Page - 1) * pagingMeta.Size).
+		Limit(pagingMeta.Size).
+		Find(&alerts).Error; err != nil {
+		return nil, nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list the alerts of kafka %s", kafkaId)
+	}
+	pagingMeta.Size = len(alerts)
+
+	return alerts, pagingMeta, nil
+}
+
+func (s *kafkaAlertService) DeleteAlertsBefore(before time.Time) *errors.ServiceError {
+	if err := s.connectionFactory.New().Unscoped().Where("created_at < ?", before).Delete(&dbapi.KafkaAlert{}).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to delete the expired alerts")
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/notification"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+	pkgerrors "github.com/pkg/errors"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func newAlertTestRule(modifyFn func(rule *dbapi.KafkaAlertRule)) *dbapi.KafkaAlertRule {
+	rule := &dbapi.KafkaAlertRule{
+		Name:               "storage",
+		Metric:             "kafka_broker_quota_totalstorageusedbytes",
+		RelativeTo:         "kafka_broker_quota_softlimitbytes",
+		Operator:           dbapi.AlertOperatorGreaterThan,
+		Threshold:          0.9,
+		NotificationType:   dbapi.AlertNotificationWebhook,
+		NotificationTarget: "https://hooks.example.com/kafka",
+	}
+	if modifyFn != nil {
+		modifyFn(rule)
+	}
+	return rule
+}
+
+func Test_kafkaAlertService_Create_Validation(t *testing.T) {
+	tests := []struct {
+		name          string
+		enabled       bool
+		rule          *dbapi.KafkaAlertRule
+		wantErrorCode errors.ServiceErrorCode
+	}{
+		{
+			name:          "should fail when alerting is not enabled",
+			rule:          newAlertTestRule(nil),
+			wantErrorCode: errors.ErrorBadRequest,
+		},
+		{
+			name:    "should fail without a name",
+			enabled: true,
+			rule: newAlertTestRule(func(rule *dbapi.KafkaAlertRule) {
+				rule.Name = " "
+			}),
+			wantErrorCode: errors.ErrorValidation,
+		},
+		{
+			name:    "should fail when the metric is not a metric of the kafka",
+			enabled: true,
+			rule: newAlertTestRule(func(rule *dbapi.KafkaAlertRule) {
+				rule.Metric = "up"
+			}),
+			wantErrorCode: errors.ErrorValidation,
+		},
+		{
+			name:    "should fail when the metric is relative to itself",
+			enabled: true,
+			rule: newAlertTestRule(func(rule *dbapi.KafkaAlertRule) {
+				rule.RelativeTo = rule.Metric
+			}),
+			wantErrorCode: errors.ErrorValidation,
+		},
+		{
+			name:    "should fail with an unknown aggregation",
+			enabled: true,
+			rule: newAlertTestRule(func(rule *dbapi.KafkaAlertRule) {
+				rule.Aggregation = "count"
+			}),
+			wantErrorCode: errors.ErrorValidation,
+		},
+		{
+			name:    "should fail with an unknown operator",
+			enabled: true,
+			rule: newAlertTestRule(func(rule *dbapi.KafkaAlertRule) {
+				rule.Operator = "eq"
+			}),
+			wantErrorCode: errors.ErrorValidation,
+		},
+		{
+			name:    "should fail when the email notifications are not enabled",
+			enabled: true,
+			rule: newAlertTestRule(func(rule *dbapi.KafkaAlertRule) {
+				rule.NotificationType = dbapi.AlertNotificationEmail
+				rule.NotificationTarget = "owner@example.com"
+			}),
+			wantErrorCode: errors.ErrorValidation,
+		},
+		{
+			name:    "should fail when the webhook target is not https",
+			enabled: true,
+			rule: newAlertTestRule(func(rule *dbapi.KafkaAlertRule) {
+				rule.NotificationTarget = "http://hooks.example.com/kafka"
+			}),
+			wantErrorCode: errors.ErrorValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			s, err := NewKafkaAlertService(db.NewMockConnectionFactory(nil), &config.KafkaAlertingConfig{EnableAlerting: tt.enabled, MaxRulesPerKafka: 20})
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			kafka := &dbapi.KafkaRequest{}
+			kafka.ID = "test-kafka"
+			_, serviceErr := s.Create(kafka, tt.rule)
+			gomega.Expect(serviceErr).ToNot(gomega.BeNil())
+			gomega.Expect(serviceErr.Code).To(gomega.Equal(tt.wantErrorCode))
+		})
+	}
+}
+
+func TestAlertRuleExpression(t *testing.T) {
+	gomega.RegisterTestingT(t)
+	rule := newAlertTestRule(func(rule *dbapi.KafkaAlertRule) {
+		rule.Aggregation = dbapi.AlertAggregationSum
+	})
+	gomega.Expect(AlertRuleExpression(rule)).To(gomega.Equal("sum(kafka_broker_quota_totalstorageusedbytes) / sum(kafka_broker_quota_softlimitbytes) > 0.9"))
+
+	rule.RelativeTo = ""
+	rule.Aggregation = dbapi.AlertAggregationMax
+	rule.Operator = dbapi.AlertOperatorLessThanOrEqual
+	rule.Threshold = 1000
+	gomega.Expect(AlertRuleExpression(rule)).To(gomega.Equal("max(kafka_broker_quota_totalstorageusedbytes) <= 1000"))
+}
+
+func Test_kafkaAlertService_Notify(t *testing.T) {
+	tests := []struct {
+		name         string
+		notifyErr    error
+		wantNotified bool
+	}{
+		{
+			name:         "should record the alert as notified",
+			wantNotified: true,
+		},
+		{
+			name:      "should record the notification error",
+			notifyErr: pkgerrors.New("webhook responded with 500"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			mocket.Catcher.Reset().NewMock().WithQuery(`INSERT INTO "kafka_alerts"`)
+			notifier := &notification.NotifierMock{
+				NotifyFunc: func(ctx context.Context, target string, n notification.Notification) error {
+					return tt.notifyErr
+				},
+			}
+			s := &kafkaAlertService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+				alertingConfig:    &config.KafkaAlertingConfig{EnableAlerting: true},
+				notifiers:         map[string]notification.Notifier{dbapi.AlertNotificationWebhook: notifier},
+			}
+			kafka := &dbapi.KafkaRequest{Name: "my-kafka"}
+			kafka.ID = "test-kafka"
+			rule := newAlertTestRule(func(rule *dbapi.KafkaAlertRule) {
+				rule.ID = "test-rule"
+				rule.Aggregation = dbapi.AlertAggregationSum
+			})
+			alert := &dbapi.KafkaAlert{RuleId: rule.ID, KafkaId: kafka.ID, State: dbapi.AlertStateFiring, Value: 0.95, Threshold: rule.Threshold}
+
+			gomega.Expect(s.Notify(context.Background(), kafka, rule, alert)).To(gomega.BeNil())
+			gomega.Expect(notifier.NotifyCalls()).To(gomega.HaveLen(1))
+			call := notifier.NotifyCalls()[0]
+			gomega.Expect(call.Target).To(gomega.Equal(rule.NotificationTarget))
+			gomega.Expect(call.Notification.Subject).To(gomega.Equal(`Kafka my-kafka: alert "storage" firing`))
+			payload := call.Notification.Payload.(alertNotificationPayload)
+			gomega.Expect(payload.AlertRuleId).To(gomega.Equal("test-rule"))
+			gomega.Expect(payload.Value).To(gomega.Equal(0.95))
+			gomega.Expect(alert.NotifiedAt != nil).To(gomega.Equal(tt.wantNotified))
+			gomega.Expect(alert.NotificationError != "").To(gomega.Equal(!tt.wantNotified))
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package services
+
+import (
+	"sort"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"gorm.io/gorm/clause"
+)
+
+// KafkaAvailabilityFilter restricts the availability samples summarised by Summarise, the samples are taken in
+// (From, To]
+type KafkaAvailabilityFilter struct {
+	KafkaId   string
+	ClusterId string
+	From      time.Time
+	To        time.Time
+}
+
+// KafkaAvailabilitySummary is the time a kafka was sampled available, unavailable or in an unknown state over a period
+type KafkaAvailabilitySummary struct {
+	KafkaId            string
+	ClusterId          string
+	OrganisationId     string
+	AvailableSeconds   float64
+	UnavailableSeconds float64
+	UnknownSeconds     float64
+}
+
+// MeasuredSeconds is the time the state of the kafka is known for
+func (s KafkaAvailabilitySummary) MeasuredSeconds() float64 {
+	return s.AvailableSeconds + s.UnavailableSeconds
+}
+
+// Availability returns the ratio of the measured time the kafka was available, false when nothing was measured
+func (s KafkaAvailabilitySummary) Availability() (float64, bool) {
+	measured := s.MeasuredSeconds()
+	if measured <= 0 {
+		return 0, false
+	}
+	return s.AvailableSeconds / measured, true
+}
+
+// ErrorBudgetRemaining returns the ratio of the error budget allowed by the SLO target left over the measured time,
+// it is negative once the budget is exhausted
+func (s KafkaAvailabilitySummary) ErrorBudgetRemaining(sloTarget float64) float64 {
+	budget := (1 - sloTarget) * s.MeasuredSeconds()
+	if budget <= 0 {
+		return 1
+	}
+	return 1 - s.UnavailableSeconds/budget
+}
+
+// ErrorBudgetBurnRate returns how many times faster than allowed by the SLO target the error budget was consumed, a
+// burn rate of 1 exhausts the budget exactly at the end of the SLO window
+func (s KafkaAvailabilitySummary) ErrorBudgetBurnRate(sloTarget float64) float64 {
+	measured := s.MeasuredSeconds()
+	if measured <= 0 || sloTarget >= 1 {
+		return 0
+	}
+	return (s.UnavailableSeconds / measured) / (1 - sloTarget)
+}
+
+// SummariseByCluster adds up the summaries of the kafkas of each data plane cluster, sorted by cluster id
+func SummariseByCluster(summaries []KafkaAvailabilitySummary) []KafkaAvailabilitySummary {
+	byCluster := map[string]*KafkaAvailabilitySummary{}
+	for _, s := range summaries {
+		cluster, ok := byCluster[s.ClusterId]
+		if !ok {
+			cluster = &KafkaAvailabilitySummary{ClusterId: s.ClusterId}
+			byCluster[s.ClusterId] = cluster
+		}
+		cluster.AvailableSeconds += s.AvailableSeconds
+		cluster.UnavailableSeconds += s.UnavailableSeconds
+		cluster.UnknownSeconds += s.UnknownSeconds
+	}
+	clusters := make([]KafkaAvailabilitySummary, 0, len(byCluster))
+	for _, cluster := range byCluster {
+		clusters = append(clusters, *cluster)
+	}
+	sort.Slice(clusters, func(i, j int) bool {
+		return clusters[i].ClusterId < clusters[j].ClusterId
+	})
+	return clusters
+}
+
+// KafkaAvailabilityService records the readiness of the kafkas reported by the data plane and the availability samples
+// taken from it, and summarises the samples to measure the kafkas against their service level objective
+//go:generate moq -out kafka_availability_moq.go . KafkaAvailabilityService
+type KafkaAvailabilityService interface {
+	// RecordReadiness stores the readiness of a kafka reported by the data plane in place of its previous report
+	RecordReadiness(report *dbapi.KafkaReadinessReport) *errors.ServiceError
+	// FindReadinessReports returns the latest readiness reports of the kafkas by kafka id
+	FindReadinessReports(kafkaIds []string) (map[string]*dbapi.KafkaReadinessReport, *errors.ServiceError)
+	// RecordSamples persists the availability samples of a sampling
+	RecordSamples(samples dbapi.KafkaAvailabilitySampleList) *errors.ServiceError
+	// LastSampledAt returns the time of the most recent availability samples, the zero time if there are none
+	LastSampledAt() (time.Time, *errors.ServiceError)
+	// FindSampledKafkaIds returns which of the kafkas have been sampled since the given time
+	FindSampledKafkaIds(kafkaIds []string, since time.Time) (map[string]bool, *errors.ServiceError)
+	// DeleteBefore deletes the availability samples taken and the readiness reports received before the given time
+	DeleteBefore(before time.Time) *errors.ServiceError
+	// Summarise returns the availability of the kafkas matching the filter, sorted by cluster and kafka id
+	Summarise(filter KafkaAvailabilityFilter) ([]KafkaAvailabilitySummary, *errors.ServiceError)
+}
+
+type kafkaAvailabilityService struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+var _ KafkaAvailabilityService = &kafkaAvailabilityService{}
+
+func NewKafkaAvailabilityService(connectionFactory *db.ConnectionFactory) KafkaAvailabilityService {
+	return &kafkaAvailabilityService{
+		connectionFactory: connectionFactory,
+	}
+}
+
+func (s *kafkaAvailabilityService) RecordReadiness(report *dbapi.KafkaReadinessReport) *errors.ServiceError {
+	if err := s.connectionFactory.New().Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "kafka_id"}},
+		UpdateAll: true,
+	}).Create(report).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to record the readiness of kafka %s", report.KafkaId)
+	}
+	return nil
+}
+
+func (s *kafkaAvailabilityService) FindReadinessReports(kafkaIds []string) (map[string]*dbapi.KafkaReadinessReport, *errors.ServiceError) {
+	reports := map[string]*dbapi.KafkaReadinessReport{}
+	if len(kafkaIds) == 0 {
+		return reports, nil
+	}
+	var list dbapi.KafkaReadinessReportList
+	if err := s.connectionFactory.New().Where("kafka_id IN (?)", kafkaIds).Find(&list).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to find the readiness reports of kafkas")
+	}
+	for _, report := range list {
+		reports[report.KafkaId] = report
+	}
+	return reports, nil
+}
+
+func (s *kafkaAvailabilityService) RecordSamples(samples dbapi.KafkaAvailabilitySampleList) *errors.ServiceError {
+	if len(samples) == 0 {
+		return nil
+	}
+	if err := s.connectionFactory.New().Create(&samples).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to record kafka availability samples")
+	}
+	return nil
+}
+
+func (s *kafkaAvailabilityService) LastSampledAt() (time.Time, *errors.ServiceError) {
+	var samples dbapi.KafkaAvailabilitySampleList
+	if err := s.connectionFactory.New().
+		Order("sampled_at desc").
+		Limit(1).
+		Find(&samples).Error; err != nil {
+		return time.Time{}, errors.NewWithCause(errors.ErrorGeneral, err, "unable to get the last kafka availability samples")
+	}
+	if len(samples) == 0 {
+		return time.Time{}, nil
+	}
+	return samples[0].SampledAt, nil
+}
+
+func (s *kafkaAvailabilityService) FindSampledKafkaIds(kafkaIds []string, since time.Time) (map[string]bool, *errors.ServiceError) {
+	sampled := map[string]bool{}
+	if len(kafkaIds) == 0 {
+		return sampled, nil
+	}
+	var ids []string
+	if err := s.connectionFactory.New().
+		Model(&dbapi.KafkaAvailabilitySample{}).
+		Distinct("kafka_id").
+		Where("kafka_id IN (?) AND sampled_at > ?", kafkaIds, since).
+		Pluck("kafka_id", &ids).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to find the sampled kafkas")
+	}
+	for _, id := range ids {
+		sampled[id] = true
+	}
+	return sampled, nil
+}
+
+func (s *kafkaAvailabilityService) DeleteBefore(before time.Time) *errors.ServiceError {
+	dbConn := s.connectionFactory.New()
+	// the samples are hard deleted, they would otherwise keep growing the table
+	if err := dbConn.Unscoped().Where("sampled_at < ?", before).Delete(&dbapi.KafkaAvailabilitySample{}).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to delete kafka availability samples")
+	}
+	if err := dbConn.Where("reported_at < ?", before).Delete(&dbapi.KafkaReadinessReport{}).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to delete kafka readiness reports")
+	}
+	return nil
+}
+
+func (s *kafkaAvailabilityService) Summarise(filter KafkaAvailabilityFilter) ([]KafkaAvailabilitySummary, *errors.ServiceError) {
+	columns := "cluster_id, kafka_id, organisation_id"
+	dbConn := s.connectionFactory.New().
+		Model(&dbapi.KafkaAvailabilitySample{}).
+		Select(columns+
+			", sum(case when status = ? then seconds else 0 end) as available_seconds"+
+			", sum(case when status = ? then seconds else 0 end) as unavailable_seconds"+
+			", sum(case when status = ? then seconds else 0 end) as unknown_seconds",
+			dbapi.KafkaAvailabilityStatusAvailable, dbapi.KafkaAvailabilityStatusUnavailable, dbapi.KafkaAvailabilityStatusUnknown).
+		Where("sampled_at > ? AND sampled_at <= ?", filter.From, filter.To)
+	if filter.KafkaId != "" {
+		dbConn = dbConn.Where("kafka_id = ?", filter.KafkaId)
+	}
+	if filter.ClusterId != "" {
+		dbConn = dbConn.Where("cluster_id = ?", filter.ClusterId)
+	}
+
+	summaries := []KafkaAvailabilitySummary{}
+	if err := dbConn.Group(columns).Order(columns).Scan(&summaries).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to summarise kafka availability samples")
+	}
+	return summaries, nil
+}
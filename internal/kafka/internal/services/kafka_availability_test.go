@@ -0,0 +1,72 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+func TestKafkaAvailabilitySummary(t *testing.T) {
+	tests := []struct {
+		name                string
+		summary             KafkaAvailabilitySummary
+		wantAvailability    float64
+		wantMeasured        bool
+		wantBudgetRemaining float64
+		wantBudgetBurnRate  float64
+	}{
+		{
+			name:                "should report nothing measured when the state is unknown",
+			summary:             KafkaAvailabilitySummary{UnknownSeconds: 600},
+			wantMeasured:        false,
+			wantBudgetRemaining: 1,
+		},
+		{
+			name:                "should keep the whole budget when always available",
+			summary:             KafkaAvailabilitySummary{AvailableSeconds: 1000, UnknownSeconds: 600},
+			wantAvailability:    1,
+			wantMeasured:        true,
+			wantBudgetRemaining: 1,
+		},
+		{
+			name:                "should consume the budget when unavailable",
+			summary:             KafkaAvailabilitySummary{AvailableSeconds: 9995, UnavailableSeconds: 5},
+			wantAvailability:    0.9995,
+			wantMeasured:        true,
+			wantBudgetRemaining: 0.9,
+			wantBudgetBurnRate:  0.1,
+		},
+		{
+			name:                "should exhaust the budget when unavailable for longer than allowed",
+			summary:             KafkaAvailabilitySummary{AvailableSeconds: 900, UnavailableSeconds: 100},
+			wantAvailability:    0.9,
+			wantMeasured:        true,
+			wantBudgetRemaining: -19,
+			wantBudgetBurnRate:  20,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			availability, measured := tt.summary.Availability()
+			gomega.Expect(measured).To(gomega.Equal(tt.wantMeasured))
+			gomega.Expect(availability).To(gomega.BeNumerically("~", tt.wantAvailability, 1e-9))
+			gomega.Expect(tt.summary.ErrorBudgetRemaining(0.995)).To(gomega.BeNumerically("~", tt.wantBudgetRemaining, 1e-9))
+			gomega.Expect(tt.summary.ErrorBudgetBurnRate(0.995)).To(gomega.BeNumerically("~", tt.wantBudgetBurnRate, 1e-9))
+		})
+	}
+}
+
+func TestSummariseByCluster(t *testing.T) {
+	gomega.RegisterTestingT(t)
+	clusters := SummariseByCluster([]KafkaAvailabilitySummary{
+		{KafkaId: "kafka-1", ClusterId: "cluster-b", AvailableSeconds: 100},
+		{KafkaId: "kafka-2", ClusterId: "cluster-a", AvailableSeconds: 50, UnavailableSeconds: 50},
+		{KafkaId: "kafka-3", ClusterId: "cluster-b", UnavailableSeconds: 100, UnknownSeconds: 10},
+	})
+	gomega.Expect(clusters).To(gomega.Equal([]KafkaAvailabilitySummary{
+		{ClusterId: "cluster-a", AvailableSeconds: 50, UnavailableSeconds: 50},
+		{ClusterId: "cluster-b", AvailableSeconds: 100, UnavailableSeconds: 100, UnknownSeconds: 10},
+	}))
+}
@@ -0,0 +1,203 @@
+package services
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/acme"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/dns"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	pkgerrors "github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// KafkaCertificateService manages the TLS certificates issued per kafka instance by the ACME certificate authority
+//go:generate moq -out kafka_certificates_moq.go . KafkaCertificateService
+type KafkaCertificateService interface {
+	// Enabled returns whether the kafka instances are given their own certificate
+	Enabled() bool
+	// Issue requests a certificate for the domains of the kafka and stores it in place of its previous certificate
+	Issue(ctx context.Context, kafka *dbapi.KafkaRequest) (*dbapi.KafkaCertificate, *errors.ServiceError)
+	// IssueForDomains requests a certificate for the domains without storing it, see acme.Issuer for the challenge aliases
+	IssueForDomains(ctx context.Context, domains []string, challengeAliases map[string]string) (*acme.Certificate, *errors.ServiceError)
+	// FindByKafkaIds returns the certificates of the kafkas by kafka id, with their private key decrypted
+	FindByKafkaIds(kafkaIds []string) (map[string]*dbapi.KafkaCertificate, *errors.ServiceError)
+	Delete(kafkaId string) *errors.ServiceError
+}
+
+type kafkaCertificateService struct {
+	connectionFactory *db.ConnectionFactory
+	certificateConfig *config.KafkaCertificateConfig
+	issuer            acme.Issuer
+}
+
+var _ KafkaCertificateService = &kafkaCertificateService{}
+
+func NewKafkaCertificateService(connectionFactory *db.ConnectionFactory, certificateConfig *config.KafkaCertificateConfig, kafkaConfig *config.KafkaConfig, dnsProvider dns.Provider) (KafkaCertificateService, error) {
+	service := &kafkaCertificateService{
+		connectionFactory: connectionFactory,
+		certificateConfig: certificateConfig,
+	}
+	if !certificateConfig.EnableACMECertificates {
+		return service, nil
+	}
+	issuer, err := acme.NewIssuer(acme.Config{
+		DirectoryURL:        certificateConfig.ACMEDirectoryURL,
+		AccountKey:          certificateConfig.ACMEAccountKey,
+		AccountEmail:        certificateConfig.ACMEAccountEmail,
+		CABundle:            certificateConfig.ACMECABundle,
+		PropagationTimeout:  certificateConfig.ACMEPropagationTimeout,
+		PropagationInterval: certificateConfig.ACMEPropagationInterval,
+	}, dnsProvider, kafkaConfig.KafkaDomainName)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "unable to create the ACME certificate issuer")
+	}
+	service.issuer = issuer
+	return service, nil
+}
+
+// KafkaCertificateDomains returns the domains the certificate of the kafka is issued for: its bootstrap server host
+// followed by the sorted domains of its routes
+func KafkaCertificateDomains(kafka *dbapi.KafkaRequest) ([]string, error) {
+	routes, err := kafka.GetRoutes()
+	if err != nil {
+		return nil, err
+	}
+	domains := []string{kafka.BootstrapServerHost}
+	var routeDomains []string
+	for _, route := range routes {
+		if route.Domain != kafka.BootstrapServerHost {
+			routeDomains = append(routeDomains, route.Domain)
+		}
+	}
+	sort.Strings(routeDomains)
+	return append(domains, routeDomains...), nil
+}
+
+func (s *kafkaCertificateService) Enabled() bool {
+	return s.certificateConfig.EnableACMECertificates
+}
+
+func (s *kafkaCertificateService) Issue(ctx context.Context, kafka *dbapi.KafkaRequest) (*dbapi.KafkaCertificate, *errors.ServiceError) {
+	if !s.Enabled() {
+		return nil, errors.GeneralError("the issuance of kafka certificates is disabled")
+	}
+	domains, err := KafkaCertificateDomains(kafka)
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to get routes of kafka %s", kafka.ID)
+	}
+	issued, serviceErr := s.IssueForDomains(ctx, domains, nil)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	encryptedKey, err := encryptCertificateKey(s.certificateConfig.EncryptionKey, issued.Key)
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to encrypt the certificate key of kafka %s", kafka.ID)
+	}
+
+	certificate := &dbapi.KafkaCertificate{
+		KafkaId:     kafka.ID,
+		Domains:     strings.Join(domains, ","),
+		Certificate: issued.Certificate,
+		Key:         encryptedKey,
+		NotAfter:    issued.NotAfter,
+	}
+	// the previous certificate is replaced in a transaction so that the kafka always has a certificate
+	if err := s.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("kafka_id = ?", kafka.ID).Delete(&dbapi.KafkaCertificate{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(certificate).Error
+	}); err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to save the certificate of kafka %s", kafka.ID)
+	}
+	certificate.Key = issued.Key
+	return certificate, nil
+}
+
+func (s *kafkaCertificateService) IssueForDomains(ctx context.Context, domains []string, challengeAliases map[string]string) (*acme.Certificate, *errors.ServiceError) {
+	if !s.Enabled() {
+		return nil, errors.GeneralError("the issuance of kafka certificates is disabled")
+	}
+	issued, err := s.issuer.Issue(ctx, domains, challengeAliases)
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to issue a certificate for %s", strings.Join(domains, ", "))
+	}
+	return issued, nil
+}
+
+func (s *kafkaCertificateService) FindByKafkaIds(kafkaIds []string) (map[string]*dbapi.KafkaCertificate, *errors.ServiceError) {
+	certificates := map[string]*dbapi.KafkaCertificate{}
+	if !s.Enabled() || len(kafkaIds) == 0 {
+		return certificates, nil
+	}
+	var list dbapi.KafkaCertificateList
+	if err := s.connectionFactory.New().Where("kafka_id IN (?)", kafkaIds).Find(&list).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list kafka certificates")
+	}
+	for _, certificate := range list {
+		key, err := decryptCertificateKey(s.certificateConfig.EncryptionKey, certificate.Key)
+		if err != nil {
+			return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to decrypt the certificate key of kafka %s", certificate.KafkaId)
+		}
+		certificate.Key = key
+		certificates[certificate.KafkaId] = certificate
+	}
+	return certificates, nil
+}
+
+func (s *kafkaCertificateService) Delete(kafkaId string) *errors.ServiceError {
+	if err := s.connectionFactory.New().Where("kafka_id = ?", kafkaId).Delete(&dbapi.KafkaCertificate{}).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to delete the certificate of kafka %s", kafkaId)
+	}
+	return nil
+}
+
+// encryptCertificateKey encrypts the key with AES-GCM, the result is the base64 encoded nonce followed by the sealed key
+func encryptCertificateKey(encryptionKey []byte, key string) (string, error) {
+	gcm, err := newCertificateKeyCipher(encryptionKey)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, []byte(key), nil)), nil
+}
+
+func decryptCertificateKey(encryptionKey []byte, encrypted string) (string, error) {
+	gcm, err := newCertificateKeyCipher(encryptionKey)
+	if err != nil {
+		return "", err
+	}
+	sealed, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return "", pkgerrors.New("the encrypted key is too short")
+	}
+	key, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", err
+	}
+	return string(key), nil
+}
+
+func newCertificateKeyCipher(encryptionKey []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,385 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/acme"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/dns"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"gorm.io/gorm"
+)
+
+// CustomDomainVerificationRecordPrefix prefixes the custom domain in the name of the TXT record proving its ownership
+const CustomDomainVerificationRecordPrefix = "_kafka-verification."
+
+var customDomainRegexp = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z]{2,63}$`)
+
+//go:generate moq -out kafka_custom_domains_moq.go . KafkaCustomDomainService
+type KafkaCustomDomainService interface {
+	// Create registers a custom domain for the kafka. The certificate and key of the domain are optional, a certificate
+	// is issued by the ACME certificate authority when they are not provided.
+	Create(kafka *dbapi.KafkaRequest, domain string, certificate string, key string) (*dbapi.KafkaCustomDomain, *errors.ServiceError)
+	Get(kafkaId string, id string) (*dbapi.KafkaCustomDomain, *errors.ServiceError)
+	List(kafkaId string) (dbapi.KafkaCustomDomainList, *errors.ServiceError)
+	// Delete deletes the custom domain, the kafka is no longer reachable at it
+	Delete(kafkaId string, id string) *errors.ServiceError
+	// DeleteByKafkaId deletes the custom domains of a deleted kafka
+	DeleteByKafkaId(kafkaId string) *errors.ServiceError
+	ListByStatus(status ...constants2.CustomDomainStatus) (dbapi.KafkaCustomDomainList, *errors.ServiceError)
+	// Verify returns whether the verification TXT record of the custom domain is published
+	Verify(customDomain *dbapi.KafkaCustomDomain) (bool, *errors.ServiceError)
+	// IssueCertificate requests a certificate for the hosts of the kafka in the custom domain and sets it on the domain
+	IssueCertificate(ctx context.Context, kafka *dbapi.KafkaRequest, customDomain *dbapi.KafkaCustomDomain) *errors.ServiceError
+	// Update updates the custom domain, its private key is encrypted if it is set. Verifying a domain already verified
+	// for another kafka is a conflict.
+	Update(customDomain *dbapi.KafkaCustomDomain) *errors.ServiceError
+	// FindReadyByKafkaIds returns the ready custom domains of the kafkas by kafka id, with their private key decrypted
+	FindReadyByKafkaIds(kafkaIds []string) (map[string]*dbapi.KafkaCustomDomain, *errors.ServiceError)
+	// Records returns the DNS records the customer must create in the custom domain
+	Records(kafka *dbapi.KafkaRequest, customDomain *dbapi.KafkaCustomDomain) []dns.Record
+}
+
+type kafkaCustomDomainService struct {
+	connectionFactory  *db.ConnectionFactory
+	kafkaConfig        *config.KafkaConfig
+	certificateConfig  *config.KafkaCertificateConfig
+	certificateService KafkaCertificateService
+	lookupTXT          func(name string) ([]string, error)
+}
+
+var _ KafkaCustomDomainService = &kafkaCustomDomainService{}
+
+func NewKafkaCustomDomainService(connectionFactory *db.ConnectionFactory, kafkaConfig *config.KafkaConfig, certificateConfig *config.KafkaCertificateConfig, certificateService KafkaCertificateService) KafkaCustomDomainService {
+	return &kafkaCustomDomainService{
+		connectionFactory:  connectionFactory,
+		kafkaConfig:        kafkaConfig,
+		certificateConfig:  certificateConfig,
+		certificateService: certificateService,
+		lookupTXT:          net.LookupTXT,
+	}
+}
+
+// CustomDomainHosts returns the hosts of the kafka in the custom domain: the custom domain as bootstrap server host
+// followed by the sorted hosts of its routes, named after the custom domain the same way the routes of the kafka
+// domain are named after the bootstrap server host
+func CustomDomainHosts(kafka *dbapi.KafkaRequest, domain string) ([]string, error) {
+	hosts, err := customDomainHosts(kafka, domain)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]string, 0, len(hosts))
+	for host := range hosts {
+		if host != domain {
+			result = append(result, host)
+		}
+	}
+	sort.Strings(result)
+	return append([]string{domain}, result...), nil
+}
+
+// customDomainHosts maps the hosts of the kafka in the custom domain to its hosts in the kafka domain
+func customDomainHosts(kafka *dbapi.KafkaRequest, domain string) (map[string]string, error) {
+	routes, err := kafka.GetRoutes()
+	if err != nil {
+		return nil, err
+	}
+	hosts := map[string]string{domain: kafka.BootstrapServerHost}
+	for _, route := range routes {
+		if strings.HasSuffix(route.Domain, kafka.BootstrapServerHost) {
+			hosts[strings.TrimSuffix(route.Domain, kafka.BootstrapServerHost)+domain] = route.Domain
+		}
+	}
+	return hosts, nil
+}
+
+func (s *kafkaCustomDomainService) Create(kafka *dbapi.KafkaRequest, domain string, certificate string, key string) (*dbapi.KafkaCustomDomain, *errors.ServiceError) {
+	if !s.certificateConfig.EnableCustomDomains {
+		return nil, errors.BadRequest("custom domains are not enabled")
+	}
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+	if len(domain) > 253 || !customDomainRegexp.MatchString(domain) {
+		return nil, errors.Validation("domain %q is not a valid domain name", domain)
+	}
+	if domain == s.kafkaConfig.KafkaDomainName || strings.HasSuffix(domain, "."+s.kafkaConfig.KafkaDomainName) {
+		return nil, errors.Validation("domain %q must not be in the kafka domain %s", domain, s.kafkaConfig.KafkaDomainName)
+	}
+	if (certificate == "") != (key == "") {
+		return nil, errors.Validation("certificate and private_key must be provided together")
+	}
+	if certificate == "" && !s.certificateService.Enabled() {
+		return nil, errors.Validation("certificate and private_key are required, certificates are not issued for custom domains")
+	}
+
+	customDomain := &dbapi.KafkaCustomDomain{
+		KafkaId: kafka.ID,
+		Domain:  domain,
+		Status:  constants2.CustomDomainStatusPendingVerification.String(),
+	}
+	if certificate != "" {
+		notAfter, err := s.validateCertificate(kafka, domain, certificate, key)
+		if err != nil {
+			return nil, err
+		}
+		customDomain.CertificateProvided = true
+		customDomain.Certificate = certificate
+		customDomain.Key = key
+		customDomain.NotAfter = &notAfter
+	}
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to generate the verification token")
+	}
+	customDomain.VerificationToken = hex.EncodeToString(token)
+
+	// the domains pending their verification don't prevent others from registering the domain, the first kafka whose
+	// domain is verified gets it
+	var existing dbapi.KafkaCustomDomainList
+	if err := s.connectionFactory.New().
+		Where("kafka_id = ? OR (domain = ? AND status <> ?)", kafka.ID, domain, constants2.CustomDomainStatusPendingVerification.String()).
+		Find(&existing).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to check the existing custom domains")
+	}
+	for _, d := range existing {
+		if d.Domain == domain {
+			return nil, errors.Conflict("domain %q is already registered", domain)
+		}
+		return nil, errors.Conflict("kafka %s already has the custom domain %q", kafka.ID, d.Domain)
+	}
+
+	if err := s.encryptKey(customDomain); err != nil {
+		return nil, err
+	}
+	if err := s.connectionFactory.New().Create(customDomain).Error; err != nil {
+		return nil, services.HandleCreateError("KafkaCustomDomain", err)
+	}
+	customDomain.Key = ""
+	return customDomain, nil
+}
+
+// validateCertificate checks the certificate matches the key and is valid for the known hosts of the kafka in the
+// custom domain, and returns its expiry
+func (s *kafkaCustomDomainService) validateCertificate(kafka *dbapi.KafkaRequest, domain string, certificate string, key string) (time.Time, *errors.ServiceError) {
+	pair, err := tls.X509KeyPair([]byte(certificate), []byte(key))
+	if err != nil {
+		return time.Time{}, errors.Validation("the certificate and private key are not a valid PEM encoded pair: %v", err)
+	}
+	leaf, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return time.Time{}, errors.Validation("the certificate is not valid: %v", err)
+	}
+	if time.Now().After(leaf.NotAfter) {
+		return time.Time{}, errors.Validation("the certificate expired on %s", leaf.NotAfter.Format(time.RFC3339))
+	}
+	hosts, err := CustomDomainHosts(kafka, domain)
+	if err != nil {
+		return time.Time{}, errors.NewWithCause(errors.ErrorGeneral, err, "failed to get routes of kafka %s", kafka.ID)
+	}
+	for _, host := range hosts {
+		if err := leaf.VerifyHostname(host); err != nil {
+			return time.Time{}, errors.Validation("the certificate is not valid for %s", host)
+		}
+	}
+	return leaf.NotAfter, nil
+}
+
+func (s *kafkaCustomDomainService) Get(kafkaId string, id string) (*dbapi.KafkaCustomDomain, *errors.ServiceError) {
+	var customDomain dbapi.KafkaCustomDomain
+	if err := s.connectionFactory.New().Where("kafka_id = ? AND id = ?", kafkaId, id).First(&customDomain).Error; err != nil {
+		return nil, services.HandleGetError("KafkaCustomDomain", "id", id, err)
+	}
+	customDomain.Key = ""
+	return &customDomain, nil
+}
+
+func (s *kafkaCustomDomainService) List(kafkaId string) (dbapi.KafkaCustomDomainList, *errors.ServiceError) {
+	var customDomains dbapi.KafkaCustomDomainList
+	if err := s.connectionFactory.New().Where("kafka_id = ?", kafkaId).Order("created_at").Find(&customDomains).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list custom domains of kafka %s", kafkaId)
+	}
+	for _, customDomain := range customDomains {
+		customDomain.Key = ""
+	}
+	return customDomains, nil
+}
+
+func (s *kafkaCustomDomainService) Delete(kafkaId string, id string) *errors.ServiceError {
+	customDomain, serviceErr := s.Get(kafkaId, id)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	if err := s.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(customDomain).Error; err != nil {
+			return err
+		}
+		return tx.Model(&dbapi.KafkaRequest{}).
+			Where("id = ? AND custom_bootstrap_server_host = ?", kafkaId, customDomain.Domain).
+			Update("custom_bootstrap_server_host", "").Error
+	}); err != nil {
+		return services.HandleDeleteError("KafkaCustomDomain", "id", id, err)
+	}
+	return nil
+}
+
+func (s *kafkaCustomDomainService) DeleteByKafkaId(kafkaId string) *errors.ServiceError {
+	if err := s.connectionFactory.New().Where("kafka_id = ?", kafkaId).Delete(&dbapi.KafkaCustomDomain{}).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to delete the custom domains of kafka %s", kafkaId)
+	}
+	return nil
+}
+
+func (s *kafkaCustomDomainService) ListByStatus(status ...constants2.CustomDomainStatus) (dbapi.KafkaCustomDomainList, *errors.ServiceError) {
+	var customDomains dbapi.KafkaCustomDomainList
+	if err := s.connectionFactory.New().Where("status IN (?)", status).Find(&customDomains).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list custom domains")
+	}
+	for _, customDomain := range customDomains {
+		if err := s.decryptKey(customDomain); err != nil {
+			return nil, err
+		}
+	}
+	return customDomains, nil
+}
+
+func (s *kafkaCustomDomainService) Verify(customDomain *dbapi.KafkaCustomDomain) (bool, *errors.ServiceError) {
+	values, err := s.lookupTXT(CustomDomainVerificationRecordPrefix + customDomain.Domain)
+	if err != nil {
+		if dnsErr, ok := err.(*net.DNSError); ok && (dnsErr.IsNotFound || dnsErr.IsTemporary) {
+			return false, nil
+		}
+		return false, errors.NewWithCause(errors.ErrorGeneral, err, "unable to look up the verification record of %s", customDomain.Domain)
+	}
+	for _, value := range values {
+		if value == customDomain.VerificationToken {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *kafkaCustomDomainService) IssueCertificate(ctx context.Context, kafka *dbapi.KafkaRequest, customDomain *dbapi.KafkaCustomDomain) *errors.ServiceError {
+	hosts, err := CustomDomainHosts(kafka, customDomain.Domain)
+	if err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to get routes of kafka %s", kafka.ID)
+	}
+	aliases := map[string]string{}
+	for _, host := range hosts {
+		aliases[host] = s.challengeAlias(host)
+	}
+	issued, serviceErr := s.certificateService.IssueForDomains(ctx, hosts, aliases)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	customDomain.Hosts = strings.Join(hosts, ",")
+	customDomain.Certificate = issued.Certificate
+	customDomain.Key = issued.Key
+	customDomain.NotAfter = &issued.NotAfter
+	return nil
+}
+
+func (s *kafkaCustomDomainService) Update(customDomain *dbapi.KafkaCustomDomain) *errors.ServiceError {
+	key := customDomain.Key
+	if err := s.encryptKey(customDomain); err != nil {
+		return err
+	}
+	err := s.connectionFactory.New().Model(customDomain).Updates(customDomain).Error
+	customDomain.Key = key
+	if err != nil {
+		// the domain was verified for another kafka first
+		if services.HandleUpdateError("KafkaCustomDomain", err).Code == errors.ErrorConflict {
+			return errors.Conflict("domain %q is already registered", customDomain.Domain)
+		}
+		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to update custom domain %s", customDomain.Domain)
+	}
+	return nil
+}
+
+func (s *kafkaCustomDomainService) FindReadyByKafkaIds(kafkaIds []string) (map[string]*dbapi.KafkaCustomDomain, *errors.ServiceError) {
+	customDomains := map[string]*dbapi.KafkaCustomDomain{}
+	if !s.certificateConfig.EnableCustomDomains || len(kafkaIds) == 0 {
+		return customDomains, nil
+	}
+	var list dbapi.KafkaCustomDomainList
+	if err := s.connectionFactory.New().
+		Where("kafka_id IN (?)", kafkaIds).
+		Where("status = ?", constants2.CustomDomainStatusReady.String()).
+		Find(&list).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list custom domains")
+	}
+	for _, customDomain := range list {
+		if err := s.decryptKey(customDomain); err != nil {
+			return nil, err
+		}
+		customDomains[customDomain.KafkaId] = customDomain
+	}
+	return customDomains, nil
+}
+
+func (s *kafkaCustomDomainService) Records(kafka *dbapi.KafkaRequest, customDomain *dbapi.KafkaCustomDomain) []dns.Record {
+	records := []dns.Record{{
+		Type:  dns.RecordTypeTXT,
+		Name:  CustomDomainVerificationRecordPrefix + customDomain.Domain,
+		Value: customDomain.VerificationToken,
+	}}
+	hosts, err := customDomainHosts(kafka, customDomain.Domain)
+	if err != nil {
+		return records
+	}
+	names := make([]string, 0, len(hosts))
+	for host := range hosts {
+		names = append(names, host)
+	}
+	sort.Strings(names)
+	for _, host := range names {
+		records = append(records, dns.Record{Type: dns.RecordTypeCNAME, Name: host, Value: hosts[host]})
+		if !customDomain.CertificateProvided {
+			records = append(records, dns.Record{Type: dns.RecordTypeCNAME, Name: acme.ChallengeRecordName(host), Value: s.challengeAlias(host)})
+		}
+	}
+	return records
+}
+
+// challengeAlias returns the name of the record in the kafka domain the DNS-01 challenge of the host is delegated to
+func (s *kafkaCustomDomainService) challengeAlias(host string) string {
+	sum := sha256.Sum256([]byte(host))
+	return acme.ChallengeRecordName(fmt.Sprintf("%s.%s", hex.EncodeToString(sum[:8]), s.kafkaConfig.KafkaDomainName))
+}
+
+func (s *kafkaCustomDomainService) encryptKey(customDomain *dbapi.KafkaCustomDomain) *errors.ServiceError {
+	if customDomain.Key == "" {
+		return nil
+	}
+	encrypted, err := encryptCertificateKey(s.certificateConfig.EncryptionKey, customDomain.Key)
+	if err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to encrypt the certificate key of custom domain %s", customDomain.Domain)
+	}
+	customDomain.Key = encrypted
+	return nil
+}
+
+func (s *kafkaCustomDomainService) decryptKey(customDomain *dbapi.KafkaCustomDomain) *errors.ServiceError {
+	if customDomain.Key == "" {
+		return nil
+	}
+	key, err := decryptCertificateKey(s.certificateConfig.EncryptionKey, customDomain.Key)
+	if err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to decrypt the certificate key of custom domain %s", customDomain.Domain)
+	}
+	customDomain.Key = key
+	return nil
+}
@@ -0,0 +1,240 @@
+package services
+
+import (
+	"net"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/dns"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func newCustomDomainTestKafka() *dbapi.KafkaRequest {
+	kafka := &dbapi.KafkaRequest{BootstrapServerHost: "test.kafka.example.com"}
+	kafka.ID = "test-kafka"
+	_ = kafka.SetRoutes([]dbapi.DataPlaneKafkaRoute{
+		{Domain: "test.kafka.example.com", Router: "router.example.com"},
+		{Domain: "broker-0-test.kafka.example.com", Router: "router.example.com"},
+		{Domain: "admin-server-test.kafka.example.com", Router: "router.example.com"},
+	})
+	return kafka
+}
+
+func TestCustomDomainHosts(t *testing.T) {
+	gomega.RegisterTestingT(t)
+	hosts, err := CustomDomainHosts(newCustomDomainTestKafka(), "kafka.customer.com")
+	gomega.Expect(err).ToNot(gomega.HaveOccurred())
+	gomega.Expect(hosts).To(gomega.Equal([]string{
+		"kafka.customer.com",
+		"admin-server-kafka.customer.com",
+		"broker-0-kafka.customer.com",
+	}))
+}
+
+func Test_kafkaCustomDomainService_Create_Validation(t *testing.T) {
+	tests := []struct {
+		name          string
+		enabled       bool
+		acmeEnabled   bool
+		domain        string
+		certificate   string
+		key           string
+		wantErrorCode errors.ServiceErrorCode
+	}{
+		{
+			name:          "should fail when custom domains are not enabled",
+			domain:        "kafka.customer.com",
+			acmeEnabled:   true,
+			wantErrorCode: errors.ErrorBadRequest,
+		},
+		{
+			name:          "should fail when the domain is not a valid domain name",
+			enabled:       true,
+			acmeEnabled:   true,
+			domain:        "kafka_customer",
+			wantErrorCode: errors.ErrorValidation,
+		},
+		{
+			name:          "should fail when the domain is in the kafka domain",
+			enabled:       true,
+			acmeEnabled:   true,
+			domain:        "custom.kafka.example.com",
+			wantErrorCode: errors.ErrorValidation,
+		},
+		{
+			name:          "should fail when the certificate is provided without its key",
+			enabled:       true,
+			acmeEnabled:   true,
+			domain:        "kafka.customer.com",
+			certificate:   "certificate",
+			wantErrorCode: errors.ErrorValidation,
+		},
+		{
+			name:          "should fail without a certificate when certificates are not issued",
+			enabled:       true,
+			domain:        "kafka.customer.com",
+			wantErrorCode: errors.ErrorValidation,
+		},
+		{
+			name:          "should fail when the certificate and key are not a valid pair",
+			enabled:       true,
+			domain:        "kafka.customer.com",
+			certificate:   "certificate",
+			key:           "key",
+			wantErrorCode: errors.ErrorValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			s := &kafkaCustomDomainService{
+				kafkaConfig:       &config.KafkaConfig{KafkaDomainName: "kafka.example.com"},
+				certificateConfig: &config.KafkaCertificateConfig{EnableCustomDomains: tt.enabled},
+				certificateService: &KafkaCertificateServiceMock{
+					EnabledFunc: func() bool {
+						return tt.acmeEnabled
+					},
+				},
+			}
+			_, err := s.Create(newCustomDomainTestKafka(), tt.domain, tt.certificate, tt.key)
+			gomega.Expect(err).ToNot(gomega.BeNil())
+			gomega.Expect(err.Code).To(gomega.Equal(tt.wantErrorCode))
+		})
+	}
+}
+
+func Test_kafkaCustomDomainService_Create(t *testing.T) {
+	tests := []struct {
+		name          string
+		existing      []map[string]interface{}
+		wantErrorCode errors.ServiceErrorCode
+	}{
+		{
+			name: "should register domains pending their verification for other kafkas",
+		},
+		{
+			name:          "should fail when the domain is verified for another kafka",
+			existing:      []map[string]interface{}{{"id": "other", "kafka_id": "other-kafka", "domain": "kafka.customer.com", "status": "ready"}},
+			wantErrorCode: errors.ErrorConflict,
+		},
+		{
+			name:          "should fail when the kafka already has a custom domain",
+			existing:      []map[string]interface{}{{"id": "other", "kafka_id": "test-kafka", "domain": "other.customer.com", "status": "pending_verification"}},
+			wantErrorCode: errors.ErrorConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			mocket.Catcher.Reset()
+			// the domains pending their verification for other kafkas are not looked up
+			mocket.Catcher.NewMock().
+				WithQuery(`SELECT * FROM "kafka_custom_domains" WHERE (kafka_id = $1 OR (domain = $2 AND status <> $3))`).
+				WithArgs("test-kafka", "kafka.customer.com", "pending_verification").
+				WithReply(tt.existing)
+			mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_custom_domains"`)
+			s := &kafkaCustomDomainService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+				kafkaConfig:       &config.KafkaConfig{KafkaDomainName: "kafka.example.com"},
+				certificateConfig: &config.KafkaCertificateConfig{EnableCustomDomains: true},
+				certificateService: &KafkaCertificateServiceMock{
+					EnabledFunc: func() bool {
+						return true
+					},
+				},
+			}
+			customDomain, err := s.Create(newCustomDomainTestKafka(), "kafka.customer.com", "", "")
+			if tt.wantErrorCode != 0 {
+				gomega.Expect(err).ToNot(gomega.BeNil())
+				gomega.Expect(err.Code).To(gomega.Equal(tt.wantErrorCode))
+				return
+			}
+			gomega.Expect(err).To(gomega.BeNil())
+			gomega.Expect(customDomain.Status).To(gomega.Equal("pending_verification"))
+		})
+	}
+}
+
+func Test_kafkaCustomDomainService_Verify(t *testing.T) {
+	customDomain := &dbapi.KafkaCustomDomain{Domain: "kafka.customer.com", VerificationToken: "token"}
+	tests := []struct {
+		name      string
+		lookupTXT func(name string) ([]string, error)
+		want      bool
+		wantErr   bool
+	}{
+		{
+			name: "should verify the domain when the verification record holds the token",
+			lookupTXT: func(name string) ([]string, error) {
+				return []string{"other", "token"}, nil
+			},
+			want: true,
+		},
+		{
+			name: "should not verify the domain when the verification record holds another value",
+			lookupTXT: func(name string) ([]string, error) {
+				return []string{"other"}, nil
+			},
+		},
+		{
+			name: "should not verify the domain when the verification record is not published",
+			lookupTXT: func(name string) ([]string, error) {
+				return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+			},
+		},
+		{
+			name: "should fail when the verification record can't be looked up",
+			lookupTXT: func(name string) ([]string, error) {
+				return nil, &net.DNSError{Err: "server misbehaving", Name: name}
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			var lookedUp string
+			s := &kafkaCustomDomainService{
+				lookupTXT: func(name string) ([]string, error) {
+					lookedUp = name
+					return tt.lookupTXT(name)
+				},
+			}
+			verified, err := s.Verify(customDomain)
+			gomega.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			gomega.Expect(verified).To(gomega.Equal(tt.want))
+			gomega.Expect(lookedUp).To(gomega.Equal("_kafka-verification.kafka.customer.com"))
+		})
+	}
+}
+
+func Test_kafkaCustomDomainService_Records(t *testing.T) {
+	gomega.RegisterTestingT(t)
+	s := &kafkaCustomDomainService{
+		kafkaConfig: &config.KafkaConfig{KafkaDomainName: "kafka.example.com"},
+	}
+	customDomain := &dbapi.KafkaCustomDomain{Domain: "kafka.customer.com", VerificationToken: "token", CertificateProvided: true}
+
+	records := s.Records(newCustomDomainTestKafka(), customDomain)
+	gomega.Expect(records).To(gomega.Equal([]dns.Record{
+		{Type: dns.RecordTypeTXT, Name: "_kafka-verification.kafka.customer.com", Value: "token"},
+		{Type: dns.RecordTypeCNAME, Name: "admin-server-kafka.customer.com", Value: "admin-server-test.kafka.example.com"},
+		{Type: dns.RecordTypeCNAME, Name: "broker-0-kafka.customer.com", Value: "broker-0-test.kafka.example.com"},
+		{Type: dns.RecordTypeCNAME, Name: "kafka.customer.com", Value: "test.kafka.example.com"},
+	}))
+
+	// the DNS-01 challenges of the issued certificates are delegated to the kafka domain
+	customDomain.CertificateProvided = false
+	records = s.Records(newCustomDomainTestKafka(), customDomain)
+	gomega.Expect(records).To(gomega.HaveLen(7))
+	gomega.Expect(records[2].Name).To(gomega.Equal("_acme-challenge.admin-server-kafka.customer.com"))
+	gomega.Expect(records[2].Value).To(gomega.HaveSuffix(".kafka.example.com"))
+	gomega.Expect(records[2].Value).To(gomega.HavePrefix("_acme-challenge."))
+}
@@ -10,7 +10,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/aws/aws-sdk-go/service/route53"
 	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
@@ -18,11 +17,12 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
-	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/dns"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/keycloak"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/rbac"
 	"github.com/onsi/gomega"
 	goerrors "github.com/pkg/errors"
 	mocket "github.com/selvatico/go-mocket"
@@ -65,6 +65,18 @@ func buildKafkaRequest(modifyFn func(kafkaRequest *dbapi.KafkaRequest)) *dbapi.K
 	return kafkaRequest
 }
 
+// build a role binding service granting the user access to the kafkas selected by the given condition
+func buildRoleBindingService(grantQuery string, grantArgs ...interface{}) *rbac.RoleBindingServiceMock {
+	return &rbac.RoleBindingServiceMock{
+		FilterFunc: func(ctx context.Context, resourceType rbac.ResourceType, role rbac.Role) (string, []interface{}, *errors.ServiceError) {
+			return grantQuery, grantArgs, nil
+		},
+		DeleteResourceBindingsFunc: func(resourceType rbac.ResourceType, resourceId string) *errors.ServiceError {
+			return nil
+		},
+	}
+}
+
 func buildDataplaneClusterConfig(clusters []config.ManualCluster) *config.DataplaneClusterConfig {
 	dataplane := config.NewDataplaneClusterConfig()
 	dataplane.ClusterConfig = config.NewClusterConfig(clusters)
@@ -128,7 +140,8 @@ func buildProviderConfiguration(regionName string, standardLimit, evalLimit int,
 func Test_kafkaService_Get(t *testing.T) {
 	// fields are the variables on the struct that we're testing, in this case kafkaService
 	type fields struct {
-		connectionFactory *db.ConnectionFactory
+		connectionFactory  *db.ConnectionFactory
+		roleBindingService rbac.RoleBindingService
 	}
 	// args are the variables that will be provided to the function we're testing, in this case it's just the id we
 	// pass to kafkaService.PrepareKafkaRequest
@@ -175,7 +188,8 @@ func Test_kafkaService_Get(t *testing.T) {
 		{
 			name: "error when id is undefined",
 			fields: fields{
-				connectionFactory: db.NewMockConnectionFactory(nil),
+				connectionFactory:  db.NewMockConnectionFactory(nil),
+				roleBindingService: buildRoleBindingService(""),
 			},
 			args: args{
 				ctx: context.TODO(),
@@ -186,7 +200,8 @@ func Test_kafkaService_Get(t *testing.T) {
 		{
 			name: "error when sql where query fails",
 			fields: fields{
-				connectionFactory: db.NewMockConnectionFactory(nil),
+				connectionFactory:  db.NewMockConnectionFactory(nil),
+				roleBindingService: buildRoleBindingService(""),
 			},
 			args: args{
 				ctx: authenticatedCtx,
@@ -200,7 +215,8 @@ func Test_kafkaService_Get(t *testing.T) {
 		{
 			name: "successful output",
 			fields: fields{
-				connectionFactory: db.NewMockConnectionFactory(nil),
+				connectionFactory:  db.NewMockConnectionFactory(nil),
+				roleBindingService: buildRoleBindingService(""),
 			},
 			args: args{
 				ctx: authenticatedCtx,
@@ -215,6 +231,26 @@ func Test_kafkaService_Get(t *testing.T) {
 					WithReply(converters.ConvertKafkaRequest(buildKafkaRequest(nil)))
 			},
 		},
+		{
+			name: "successful output of a kafka shared with the user",
+			fields: fields{
+				connectionFactory:  db.NewMockConnectionFactory(nil),
+				roleBindingService: buildRoleBindingService("id IN ?", []string{testID}),
+			},
+			args: args{
+				ctx: authenticatedCtx,
+				id:  testID,
+			},
+			want: buildKafkaRequest(nil),
+			setupFn: func() {
+				mocket.Catcher.Reset().
+					NewMock().
+					WithQuery(`SELECT * FROM "kafka_requests" WHERE id = $1 AND (owner = $2 OR id IN ($3))`).
+					WithArgs(testID, testUser, testID).
+					WithReply(converters.ConvertKafkaRequest(buildKafkaRequest(nil)))
+				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+			},
+		},
 	}
 	// we loop through each test case defined in the list above and start a new test invocation, using the testing
 	// t.Run function
@@ -228,7 +264,8 @@ func Test_kafkaService_Get(t *testing.T) {
 			}
 			// we're testing the kafkaService struct, so use the 'fields' to create one
 			k := &kafkaService{
-				connectionFactory: tt.fields.connectionFactory,
+				connectionFactory:  tt.fields.connectionFactory,
+				roleBindingService: tt.fields.roleBindingService,
 			}
 			// we're testing the kafkaService.Get function so use the 'args' to provide arguments to the function
 			got, err := k.Get(tt.args.ctx, tt.args.id)
@@ -539,7 +576,6 @@ func Test_kafkaService_PrepareKafkaRequest(t *testing.T) {
 				clusterService:    tt.fields.clusterService,
 				keycloakService:   tt.fields.keycloakService,
 				kafkaConfig:       tt.fields.kafkaConfig,
-				awsConfig:         config.NewAWSConfig(),
 			}
 
 			if err := k.PrepareKafkaRequest(tt.args.kafkaRequest); (err != nil) != tt.wantErr {
@@ -618,11 +654,11 @@ func Test_kafkaService_RegisterKafkaDeprovisionJob(t *testing.T) {
 				tt.setupFn()
 			}
 			k := &kafkaService{
-				connectionFactory: tt.fields.connectionFactory,
-				kafkaConfig:       config.NewKafkaConfig(),
-				awsConfig:         config.NewAWSConfig(),
+				connectionFactory:  tt.fields.connectionFactory,
+				kafkaConfig:        config.NewKafkaConfig(),
+				roleBindingService: buildRoleBindingService(""),
 			}
-			err := k.RegisterKafkaDeprovisionJob(context.TODO(), tt.args.kafkaRequest.ID)
+			err := k.RegisterKafkaDeprovisionJob(context.TODO(), tt.args.kafkaRequest.ID, time.Time{})
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Delete() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -798,11 +834,26 @@ func Test_kafkaService_Delete(t *testing.T) {
 				tt.setupFn()
 			}
 			k := &kafkaService{
-				connectionFactory: tt.fields.connectionFactory,
-				clusterService:    tt.fields.clusterService,
-				keycloakService:   tt.fields.keycloakService,
-				kafkaConfig:       tt.fields.kafkaConfig,
-				awsConfig:         config.NewAWSConfig(),
+				connectionFactory:  tt.fields.connectionFactory,
+				clusterService:     tt.fields.clusterService,
+				keycloakService:    tt.fields.keycloakService,
+				kafkaConfig:        tt.fields.kafkaConfig,
+				roleBindingService: buildRoleBindingService(""),
+				certificateService: &KafkaCertificateServiceMock{
+					DeleteFunc: func(kafkaId string) *errors.ServiceError {
+						return nil
+					},
+				},
+				customDomainService: &KafkaCustomDomainServiceMock{
+					DeleteByKafkaIdFunc: func(kafkaId string) *errors.ServiceError {
+						return nil
+					},
+				},
+				alertService: &KafkaAlertServiceMock{
+					DeleteByKafkaIdFunc: func(kafkaId string) *errors.ServiceError {
+						return nil
+					},
+				},
 			}
 			err := k.Delete(tt.args.kafkaRequest)
 			if (err != nil) != tt.wantErr {
@@ -1186,7 +1237,6 @@ func Test_kafkaService_RegisterKafkaJob(t *testing.T) {
 				connectionFactory:        tt.fields.connectionFactory,
 				clusterService:           tt.fields.clusterService,
 				kafkaConfig:              &tt.fields.kafkaConfig,
-				awsConfig:                config.NewAWSConfig(),
 				providerConfig:           tt.fields.providerConfig,
 				clusterPlacementStrategy: tt.fields.clusterPlmtStrategy,
 				dataplaneClusterConfig:   tt.fields.dataplaneClusterConfig,
@@ -1197,7 +1247,7 @@ func Test_kafkaService_RegisterKafkaJob(t *testing.T) {
 				},
 			}
 
-			err := k.RegisterKafkaJob(tt.args.kafkaRequest)
+			err := k.RegisterKafkaJob(context.Background(), tt.args.kafkaRequest)
 
 			if (err != nil) != tt.error.wantErr {
 				t.Errorf("RegisterKafkaJob() error = %v, wantErr = %v", err, tt.error.wantErr)
@@ -1532,9 +1582,9 @@ func Test_kafkaService_List(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupFn(tt.want.kafkaList)
 			k := &kafkaService{
-				connectionFactory: tt.fields.connectionFactory,
-				kafkaConfig:       config.NewKafkaConfig(),
-				awsConfig:         config.NewAWSConfig(),
+				connectionFactory:  tt.fields.connectionFactory,
+				kafkaConfig:        config.NewKafkaConfig(),
+				roleBindingService: buildRoleBindingService(""),
 			}
 
 			result, pagingMeta, err := k.List(tt.args.ctx, tt.args.listArgs)
@@ -1612,7 +1662,6 @@ func Test_kafkaService_ListByStatus(t *testing.T) {
 				connectionFactory: tt.fields.connectionFactory,
 				clusterService:    tt.fields.clusterService,
 				kafkaConfig:       config.NewKafkaConfig(),
-				awsConfig:         config.NewAWSConfig(),
 			}
 			got, err := k.ListByStatus(tt.args.status)
 			// check errors
@@ -1726,7 +1775,6 @@ func Test_kafkaService_UpdateStatus(t *testing.T) {
 				connectionFactory: tt.fields.connectionFactory,
 				clusterService:    tt.fields.clusterService,
 				kafkaConfig:       config.NewKafkaConfig(),
-				awsConfig:         config.NewAWSConfig(),
 			}
 			executed, err := k.UpdateStatus(tt.args.id, tt.args.status)
 			if executed != tt.wantExecuted {
@@ -1790,7 +1838,6 @@ func Test_kafkaService_Update(t *testing.T) {
 				connectionFactory: tt.fields.connectionFactory,
 				clusterService:    tt.fields.clusterService,
 				kafkaConfig:       config.NewKafkaConfig(),
-				awsConfig:         config.NewAWSConfig(),
 			}
 			err := k.Update(tt.args.kafkaRequest)
 			if (err != nil) != tt.wantErr {
@@ -1850,7 +1897,6 @@ func Test_kafkaService_Updates(t *testing.T) {
 				connectionFactory: tt.fields.connectionFactory,
 				clusterService:    tt.fields.clusterService,
 				kafkaConfig:       config.NewKafkaConfig(),
-				awsConfig:         config.NewAWSConfig(),
 			}
 			err := k.Updates(tt.args.kafkaRequest, map[string]interface{}{
 				"id":    "idsds",
@@ -1864,6 +1910,61 @@ func Test_kafkaService_Updates(t *testing.T) {
 	}
 }
 
+func Test_kafkaService_UpdatesIfUnmodified(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  constants2.KafkaStatus
+		wantErr []bool
+	}{
+		{
+			name:    "should only apply the first of two concurrent updates",
+			status:  constants2.KafkaRequestStatusReady,
+			wantErr: []bool{false, true},
+		},
+		{
+			name:    "should ignore updates of kafka under deletion",
+			status:  constants2.KafkaRequestStatusDeprovision,
+			wantErr: []bool{false, false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			updatedAt := time.Now().Add(-time.Hour)
+			// the first update changes the update time the second update is conditional on
+			mocket.Catcher.Reset()
+			update := mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "owner"=$1,"updated_at"=$2 WHERE updated_at = $3`).OneTime()
+			if tt.status == constants2.KafkaRequestStatusReady {
+				update.WithRowsNum(1)
+			}
+			k := kafkaService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+				kafkaConfig:       config.NewKafkaConfig(),
+			}
+
+			// both writers read the kafka before either of them updates it
+			writers := []*dbapi.KafkaRequest{
+				buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+					kafkaRequest.UpdatedAt = updatedAt
+					kafkaRequest.Status = tt.status.String()
+				}),
+				buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+					kafkaRequest.UpdatedAt = updatedAt
+					kafkaRequest.Status = tt.status.String()
+				}),
+			}
+			for i, kafkaRequest := range writers {
+				err := k.UpdatesIfUnmodified(kafkaRequest, map[string]interface{}{"owner": "new-owner"})
+				gomega.Expect(err != nil).To(gomega.Equal(tt.wantErr[i]))
+				if err != nil {
+					gomega.Expect(err.Code).To(gomega.Equal(errors.ErrorPreconditionFailed))
+				}
+			}
+			gomega.Expect(update.Triggered).To(gomega.BeTrue())
+		})
+	}
+}
+
 func Test_kafkaService_DeprovisionKafkaForUsers(t *testing.T) {
 	type fields struct {
 		connectionFactory *db.ConnectionFactory
@@ -2125,7 +2226,7 @@ func TestKafkaService_CountByRegionAndInstanceType(t *testing.T) {
 
 func TestKafkaService_ChangeKafkaCNAMErecords(t *testing.T) {
 	type fields struct {
-		awsClient aws.Client
+		dnsProvider dns.Provider
 	}
 
 	type args struct {
@@ -2142,18 +2243,15 @@ func TestKafkaService_ChangeKafkaCNAMErecords(t *testing.T) {
 		{
 			name: "should create CNAMEs for kafka",
 			fields: fields{
-				awsClient: &aws.ClientMock{
-					ChangeResourceRecordSetsFunc: func(dnsName string, recordChangeBatch *route53.ChangeBatch) (*route53.ChangeResourceRecordSetsOutput, error) {
-						if len(recordChangeBatch.Changes) != 1 {
+				dnsProvider: &dns.ProviderMock{
+					ChangeRecordsFunc: func(domain string, action dns.Action, records []dns.Record) (*dns.Change, error) {
+						if len(records) != 1 {
 							return nil, goerrors.Errorf("number of record changes should be 1")
 						}
-						if *recordChangeBatch.Changes[0].Action != "CREATE" {
+						if action != dns.ActionCreate {
 							return nil, goerrors.Errorf("the action of the record change is not CREATE")
 						}
-						return nil, nil
-					},
-					ListHostedZonesByNameInputFunc: func(dnsName string) (*route53.ListHostedZonesByNameOutput, error) {
-						return nil, nil
+						return &dns.Change{InSync: true}, nil
 					},
 				},
 			},
@@ -2172,18 +2270,15 @@ func TestKafkaService_ChangeKafkaCNAMErecords(t *testing.T) {
 		{
 			name: "should delete CNAMEs for kafka",
 			fields: fields{
-				awsClient: &aws.ClientMock{
-					ChangeResourceRecordSetsFunc: func(dnsName string, recordChangeBatch *route53.ChangeBatch) (*route53.ChangeResourceRecordSetsOutput, error) {
-						if len(recordChangeBatch.Changes) != 1 {
+				dnsProvider: &dns.ProviderMock{
+					ChangeRecordsFunc: func(domain string, action dns.Action, records []dns.Record) (*dns.Change, error) {
+						if len(records) != 1 {
 							return nil, goerrors.Errorf("number of record changes should be 1")
 						}
-						if *recordChangeBatch.Changes[0].Action != "DELETE" {
+						if action != dns.ActionDelete {
 							return nil, goerrors.Errorf("the action of the record change is not DELETE")
 						}
-						return nil, nil
-					},
-					ListHostedZonesByNameInputFunc: func(dnsName string) (*route53.ListHostedZonesByNameOutput, error) {
-						return nil, nil
+						return &dns.Change{InSync: true}, nil
 					},
 				},
 			},
@@ -2199,23 +2294,41 @@ func TestKafkaService_ChangeKafkaCNAMErecords(t *testing.T) {
 				action: KafkaRoutesActionDelete,
 			},
 		},
+		{
+			name: "should return an error when the DNS provider fails",
+			fields: fields{
+				dnsProvider: &dns.ProviderMock{
+					ChangeRecordsFunc: func(domain string, action dns.Action, records []dns.Record) (*dns.Change, error) {
+						return nil, goerrors.Errorf("DNS provider is unavailable")
+					},
+				},
+			},
+			args: args{
+				kafkaRequest: &dbapi.KafkaRequest{
+					Meta: api.Meta{
+						ID: "test-kafka-id",
+					},
+					Name:   "test-kafka-cname",
+					Routes: []byte("[{\"domain\": \"test-kafka-id.example.com\", \"router\": \"test-kafka-id.rhcloud.com\"}]"),
+					Region: testKafkaRequestRegion,
+				},
+				action: KafkaRoutesActionCreate,
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			kafkaService := &kafkaService{
-				awsClientFactory: aws.NewMockClientFactory(tt.fields.awsClient),
-				awsConfig: &config.AWSConfig{
-					Route53AccessKey:       "test-route-53-key",
-					Route53SecretAccessKey: "test-route-53-secret-key",
-				},
+				dnsProvider: tt.fields.dnsProvider,
 				kafkaConfig: &config.KafkaConfig{
 					KafkaDomainName: "rhcloud.com",
 				},
 			}
 
 			_, err := kafkaService.ChangeKafkaCNAMErecords(tt.args.kafkaRequest, tt.args.action)
-			if err != nil && !tt.wantErr {
+			if (err != nil) != tt.wantErr {
 				t.Errorf("unexpected error for ChangeKafkaCNAMErecords %v", err)
 			}
 		})
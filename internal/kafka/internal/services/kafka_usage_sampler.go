@@ -0,0 +1,47 @@
+package services
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/metering"
+)
+
+// kafkaUsageSampler meters the ready kafkas
+type kafkaUsageSampler struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+var _ metering.UsageSampler = &kafkaUsageSampler{}
+
+func NewKafkaUsageSampler(connectionFactory *db.ConnectionFactory) *kafkaUsageSampler {
+	return &kafkaUsageSampler{
+		connectionFactory: connectionFactory,
+	}
+}
+
+func (s *kafkaUsageSampler) Sample() (api.UsageRecordList, *errors.ServiceError) {
+	var kafkas dbapi.KafkaList
+	if err := s.connectionFactory.New().
+		Where("status = ?", constants.KafkaRequestStatusReady.String()).
+		Find(&kafkas).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list ready kafkas")
+	}
+
+	records := api.UsageRecordList{}
+	for _, kafka := range kafkas {
+		records = append(records, &api.UsageRecord{
+			OrganisationId: kafka.OrganisationId,
+			ResourceType:   api.UsageResourceTypeKafka,
+			ResourceId:     kafka.ID,
+			InstanceType:   kafka.InstanceType,
+			CloudProvider:  kafka.CloudProvider,
+			Region:         kafka.Region,
+			MultiAZ:        kafka.MultiAZ,
+			StorageSize:    kafka.KafkaStorageSize,
+		})
+	}
+	return records, nil
+}
@@ -5,15 +5,16 @@ package services
 
 import (
 	"context"
-	"github.com/aws/aws-sdk-go/service/route53"
 	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/dns"
 	managedkafka "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api/managedkafkas.managedkafka.bf2.org/v1"
 	serviceError "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
 	"sync"
+	"time"
 )
 
 // Ensure, that KafkaServiceMock does implement KafkaService.
@@ -26,7 +27,7 @@ var _ KafkaService = &KafkaServiceMock{}
 //
 // 		// make and configure a mocked KafkaService
 // 		mockedKafkaService := &KafkaServiceMock{
-// 			ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *serviceError.ServiceError) {
+// 			ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*dns.Change, *serviceError.ServiceError) {
 // 				panic("mock out the ChangeKafkaCNAMErecords method")
 // 			},
 // 			CountByRegionAndInstanceTypeFunc: func() ([]KafkaRegionCount, error) {
@@ -53,7 +54,7 @@ var _ KafkaService = &KafkaServiceMock{}
 // 			GetByIdFunc: func(id string) (*dbapi.KafkaRequest, *serviceError.ServiceError) {
 // 				panic("mock out the GetById method")
 // 			},
-// 			GetCNAMERecordStatusFunc: func(kafkaRequest *dbapi.KafkaRequest) (*CNameRecordStatus, error) {
+// 			GetCNAMERecordStatusFunc: func(kafkaRequest *dbapi.KafkaRequest) (*dns.Change, error) {
 // 				panic("mock out the GetCNAMERecordStatus method")
 // 			},
 // 			GetManagedKafkaByClusterIDFunc: func(clusterID string) ([]managedkafka.ManagedKafka, *serviceError.ServiceError) {
@@ -77,10 +78,10 @@ var _ KafkaService = &KafkaServiceMock{}
 // 			PrepareKafkaRequestFunc: func(kafkaRequest *dbapi.KafkaRequest) *serviceError.ServiceError {
 // 				panic("mock out the PrepareKafkaRequest method")
 // 			},
-// 			RegisterKafkaDeprovisionJobFunc: func(ctx context.Context, id string) *serviceError.ServiceError {
+// 			RegisterKafkaDeprovisionJobFunc: func(ctx context.Context, id string, updatedAt time.Time) *serviceError.ServiceError {
 // 				panic("mock out the RegisterKafkaDeprovisionJob method")
 // 			},
-// 			RegisterKafkaJobFunc: func(kafkaRequest *dbapi.KafkaRequest) *serviceError.ServiceError {
+// 			RegisterKafkaJobFunc: func(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *serviceError.ServiceError {
 // 				panic("mock out the RegisterKafkaJob method")
 // 			},
 // 			UpdateFunc: func(kafkaRequest *dbapi.KafkaRequest) *serviceError.ServiceError {
@@ -92,6 +93,9 @@ var _ KafkaService = &KafkaServiceMock{}
 // 			UpdatesFunc: func(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *serviceError.ServiceError {
 // 				panic("mock out the Updates method")
 // 			},
+// 			UpdatesIfUnmodifiedFunc: func(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *serviceError.ServiceError {
+// 				panic("mock out the UpdatesIfUnmodified method")
+// 			},
 // 			VerifyAndUpdateKafkaAdminFunc: func(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *serviceError.ServiceError {
 // 				panic("mock out the VerifyAndUpdateKafkaAdmin method")
 // 			},
@@ -103,7 +107,7 @@ var _ KafkaService = &KafkaServiceMock{}
 // 	}
 type KafkaServiceMock struct {
 	// ChangeKafkaCNAMErecordsFunc mocks the ChangeKafkaCNAMErecords method.
-	ChangeKafkaCNAMErecordsFunc func(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *serviceError.ServiceError)
+	ChangeKafkaCNAMErecordsFunc func(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*dns.Change, *serviceError.ServiceError)
 
 	// CountByRegionAndInstanceTypeFunc mocks the CountByRegionAndInstanceType method.
 	CountByRegionAndInstanceTypeFunc func() ([]KafkaRegionCount, error)
@@ -130,7 +134,7 @@ type KafkaServiceMock struct {
 	GetByIdFunc func(id string) (*dbapi.KafkaRequest, *serviceError.ServiceError)
 
 	// GetCNAMERecordStatusFunc mocks the GetCNAMERecordStatus method.
-	GetCNAMERecordStatusFunc func(kafkaRequest *dbapi.KafkaRequest) (*CNameRecordStatus, error)
+	GetCNAMERecordStatusFunc func(kafkaRequest *dbapi.KafkaRequest) (*dns.Change, error)
 
 	// GetManagedKafkaByClusterIDFunc mocks the GetManagedKafkaByClusterID method.
 	GetManagedKafkaByClusterIDFunc func(clusterID string) ([]managedkafka.ManagedKafka, *serviceError.ServiceError)
@@ -154,10 +158,10 @@ type KafkaServiceMock struct {
 	PrepareKafkaRequestFunc func(kafkaRequest *dbapi.KafkaRequest) *serviceError.ServiceError
 
 	// RegisterKafkaDeprovisionJobFunc mocks the RegisterKafkaDeprovisionJob method.
-	RegisterKafkaDeprovisionJobFunc func(ctx context.Context, id string) *serviceError.ServiceError
+	RegisterKafkaDeprovisionJobFunc func(ctx context.Context, id string, updatedAt time.Time) *serviceError.ServiceError
 
 	// RegisterKafkaJobFunc mocks the RegisterKafkaJob method.
-	RegisterKafkaJobFunc func(kafkaRequest *dbapi.KafkaRequest) *serviceError.ServiceError
+	RegisterKafkaJobFunc func(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *serviceError.ServiceError
 
 	// UpdateFunc mocks the Update method.
 	UpdateFunc func(kafkaRequest *dbapi.KafkaRequest) *serviceError.ServiceError
@@ -168,6 +172,9 @@ type KafkaServiceMock struct {
 	// UpdatesFunc mocks the Updates method.
 	UpdatesFunc func(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *serviceError.ServiceError
 
+	// UpdatesIfUnmodifiedFunc mocks the UpdatesIfUnmodified method.
+	UpdatesIfUnmodifiedFunc func(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *serviceError.ServiceError
+
 	// VerifyAndUpdateKafkaAdminFunc mocks the VerifyAndUpdateKafkaAdmin method.
 	VerifyAndUpdateKafkaAdminFunc func(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *serviceError.ServiceError
 
@@ -264,9 +271,13 @@ type KafkaServiceMock struct {
 			Ctx context.Context
 			// ID is the id argument value.
 			ID string
+			// UpdatedAt is the updatedAt argument value.
+			UpdatedAt time.Time
 		}
 		// RegisterKafkaJob holds details about calls to the RegisterKafkaJob method.
 		RegisterKafkaJob []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// KafkaRequest is the kafkaRequest argument value.
 			KafkaRequest *dbapi.KafkaRequest
 		}
@@ -289,6 +300,13 @@ type KafkaServiceMock struct {
 			// Values is the values argument value.
 			Values map[string]interface{}
 		}
+		// UpdatesIfUnmodified holds details about calls to the UpdatesIfUnmodified method.
+		UpdatesIfUnmodified []struct {
+			// KafkaRequest is the kafkaRequest argument value.
+			KafkaRequest *dbapi.KafkaRequest
+			// Values is the values argument value.
+			Values map[string]interface{}
+		}
 		// VerifyAndUpdateKafkaAdmin holds details about calls to the VerifyAndUpdateKafkaAdmin method.
 		VerifyAndUpdateKafkaAdmin []struct {
 			// Ctx is the ctx argument value.
@@ -319,11 +337,12 @@ type KafkaServiceMock struct {
 	lockUpdate                         sync.RWMutex
 	lockUpdateStatus                   sync.RWMutex
 	lockUpdates                        sync.RWMutex
+	lockUpdatesIfUnmodified            sync.RWMutex
 	lockVerifyAndUpdateKafkaAdmin      sync.RWMutex
 }
 
 // ChangeKafkaCNAMErecords calls ChangeKafkaCNAMErecordsFunc.
-func (mock *KafkaServiceMock) ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *serviceError.ServiceError) {
+func (mock *KafkaServiceMock) ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*dns.Change, *serviceError.ServiceError) {
 	if mock.ChangeKafkaCNAMErecordsFunc == nil {
 		panic("KafkaServiceMock.ChangeKafkaCNAMErecordsFunc: method is nil but KafkaService.ChangeKafkaCNAMErecords was just called")
 	}
@@ -605,7 +624,7 @@ func (mock *KafkaServiceMock) GetByIdCalls() []struct {
 }
 
 // GetCNAMERecordStatus calls GetCNAMERecordStatusFunc.
-func (mock *KafkaServiceMock) GetCNAMERecordStatus(kafkaRequest *dbapi.KafkaRequest) (*CNameRecordStatus, error) {
+func (mock *KafkaServiceMock) GetCNAMERecordStatus(kafkaRequest *dbapi.KafkaRequest) (*dns.Change, error) {
 	if mock.GetCNAMERecordStatusFunc == nil {
 		panic("KafkaServiceMock.GetCNAMERecordStatusFunc: method is nil but KafkaService.GetCNAMERecordStatus was just called")
 	}
@@ -847,33 +866,37 @@ func (mock *KafkaServiceMock) PrepareKafkaRequestCalls() []struct {
 }
 
 // RegisterKafkaDeprovisionJob calls RegisterKafkaDeprovisionJobFunc.
-func (mock *KafkaServiceMock) RegisterKafkaDeprovisionJob(ctx context.Context, id string) *serviceError.ServiceError {
+func (mock *KafkaServiceMock) RegisterKafkaDeprovisionJob(ctx context.Context, id string, updatedAt time.Time) *serviceError.ServiceError {
 	if mock.RegisterKafkaDeprovisionJobFunc == nil {
 		panic("KafkaServiceMock.RegisterKafkaDeprovisionJobFunc: method is nil but KafkaService.RegisterKafkaDeprovisionJob was just called")
 	}
 	callInfo := struct {
-		Ctx context.Context
-		ID  string
+		Ctx       context.Context
+		ID        string
+		UpdatedAt time.Time
 	}{
-		Ctx: ctx,
-		ID:  id,
+		Ctx:       ctx,
+		ID:        id,
+		UpdatedAt: updatedAt,
 	}
 	mock.lockRegisterKafkaDeprovisionJob.Lock()
 	mock.calls.RegisterKafkaDeprovisionJob = append(mock.calls.RegisterKafkaDeprovisionJob, callInfo)
 	mock.lockRegisterKafkaDeprovisionJob.Unlock()
-	return mock.RegisterKafkaDeprovisionJobFunc(ctx, id)
+	return mock.RegisterKafkaDeprovisionJobFunc(ctx, id, updatedAt)
 }
 
 // RegisterKafkaDeprovisionJobCalls gets all the calls that were made to RegisterKafkaDeprovisionJob.
 // Check the length with:
 //     len(mockedKafkaService.RegisterKafkaDeprovisionJobCalls())
 func (mock *KafkaServiceMock) RegisterKafkaDeprovisionJobCalls() []struct {
-	Ctx context.Context
-	ID  string
+	Ctx       context.Context
+	ID        string
+	UpdatedAt time.Time
 } {
 	var calls []struct {
-		Ctx context.Context
-		ID  string
+		Ctx       context.Context
+		ID        string
+		UpdatedAt time.Time
 	}
 	mock.lockRegisterKafkaDeprovisionJob.RLock()
 	calls = mock.calls.RegisterKafkaDeprovisionJob
@@ -882,28 +905,32 @@ func (mock *KafkaServiceMock) RegisterKafkaDeprovisionJobCalls() []struct {
 }
 
 // RegisterKafkaJob calls RegisterKafkaJobFunc.
-func (mock *KafkaServiceMock) RegisterKafkaJob(kafkaRequest *dbapi.KafkaRequest) *serviceError.ServiceError {
+func (mock *KafkaServiceMock) RegisterKafkaJob(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *serviceError.ServiceError {
 	if mock.RegisterKafkaJobFunc == nil {
 		panic("KafkaServiceMock.RegisterKafkaJobFunc: method is nil but KafkaService.RegisterKafkaJob was just called")
 	}
 	callInfo := struct {
+		Ctx          context.Context
 		KafkaRequest *dbapi.KafkaRequest
 	}{
+		Ctx:          ctx,
 		KafkaRequest: kafkaRequest,
 	}
 	mock.lockRegisterKafkaJob.Lock()
 	mock.calls.RegisterKafkaJob = append(mock.calls.RegisterKafkaJob, callInfo)
 	mock.lockRegisterKafkaJob.Unlock()
-	return mock.RegisterKafkaJobFunc(kafkaRequest)
+	return mock.RegisterKafkaJobFunc(ctx, kafkaRequest)
 }
 
 // RegisterKafkaJobCalls gets all the calls that were made to RegisterKafkaJob.
 // Check the length with:
 //     len(mockedKafkaService.RegisterKafkaJobCalls())
 func (mock *KafkaServiceMock) RegisterKafkaJobCalls() []struct {
+	Ctx          context.Context
 	KafkaRequest *dbapi.KafkaRequest
 } {
 	var calls []struct {
+		Ctx          context.Context
 		KafkaRequest *dbapi.KafkaRequest
 	}
 	mock.lockRegisterKafkaJob.RLock()
@@ -1013,6 +1040,41 @@ func (mock *KafkaServiceMock) UpdatesCalls() []struct {
 	return calls
 }
 
+// UpdatesIfUnmodified calls UpdatesIfUnmodifiedFunc.
+func (mock *KafkaServiceMock) UpdatesIfUnmodified(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *serviceError.ServiceError {
+	if mock.UpdatesIfUnmodifiedFunc == nil {
+		panic("KafkaServiceMock.UpdatesIfUnmodifiedFunc: method is nil but KafkaService.UpdatesIfUnmodified was just called")
+	}
+	callInfo := struct {
+		KafkaRequest *dbapi.KafkaRequest
+		Values       map[string]interface{}
+	}{
+		KafkaRequest: kafkaRequest,
+		Values:       values,
+	}
+	mock.lockUpdatesIfUnmodified.Lock()
+	mock.calls.UpdatesIfUnmodified = append(mock.calls.UpdatesIfUnmodified, callInfo)
+	mock.lockUpdatesIfUnmodified.Unlock()
+	return mock.UpdatesIfUnmodifiedFunc(kafkaRequest, values)
+}
+
+// UpdatesIfUnmodifiedCalls gets all the calls that were made to UpdatesIfUnmodified.
+// Check the length with:
+//     len(mockedKafkaService.UpdatesIfUnmodifiedCalls())
+func (mock *KafkaServiceMock) UpdatesIfUnmodifiedCalls() []struct {
+	KafkaRequest *dbapi.KafkaRequest
+	Values       map[string]interface{}
+} {
+	var calls []struct {
+		KafkaRequest *dbapi.KafkaRequest
+		Values       map[string]interface{}
+	}
+	mock.lockUpdatesIfUnmodified.RLock()
+	calls = mock.calls.UpdatesIfUnmodified
+	mock.lockUpdatesIfUnmodified.RUnlock()
+	return calls
+}
+
 // VerifyAndUpdateKafkaAdmin calls VerifyAndUpdateKafkaAdminFunc.
 func (mock *KafkaServiceMock) VerifyAndUpdateKafkaAdmin(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *serviceError.ServiceError {
 	if mock.VerifyAndUpdateKafkaAdminFunc == nil {
@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/observatorium"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 )
@@ -24,6 +25,8 @@ func NewObservatoriumService(observatorium *observatorium.Client, kafkaService K
 type ObservatoriumService interface {
 	GetKafkaState(name string, namespaceName string) (observatorium.KafkaState, error)
 	GetMetricsByKafkaId(ctx context.Context, csMetrics *observatorium.KafkaMetrics, id string, query observatorium.MetricsReqParams) (string, *errors.ServiceError)
+	// GetMetricsByKafka fetches the metrics of a kafka already retrieved, without checking the caller may access it
+	GetMetricsByKafka(kafka *dbapi.KafkaRequest, csMetrics *observatorium.KafkaMetrics, query observatorium.MetricsReqParams) *errors.ServiceError
 }
 
 func (obs observatoriumService) GetKafkaState(name string, namespaceName string) (observatorium.KafkaState, error) {
@@ -43,3 +46,10 @@ func (obs observatoriumService) GetMetricsByKafkaId(ctx context.Context, kafkasM
 
 	return kafkaRequest.ID, nil
 }
+
+func (obs observatoriumService) GetMetricsByKafka(kafka *dbapi.KafkaRequest, kafkasMetrics *observatorium.KafkaMetrics, query observatorium.MetricsReqParams) *errors.ServiceError {
+	if err := obs.observatorium.Service.GetMetrics(kafkasMetrics, kafka.Namespace, &query); err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to retrieve metrics of kafka %s", kafka.ID)
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/ocm"
 
@@ -169,7 +170,7 @@ func Test_AMSCheckQuota(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			gomega.RegisterTestingT(t)
-			factory := NewDefaultQuotaServiceFactory(tt.fields.ocmClient, nil, nil)
+			factory := NewDefaultQuotaServiceFactory(tt.fields.ocmClient, nil, nil, unlimitedOrganisationQuotaService(), config.NewKafkaConfig())
 			quotaService, _ := factory.GetQuotaService(api.AMSQuotaType)
 			kafka := &dbapi.KafkaRequest{
 				Meta: api.Meta{
@@ -472,7 +473,7 @@ func Test_AMSReserveQuota(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			gomega.RegisterTestingT(t)
-			factory := NewDefaultQuotaServiceFactory(tt.fields.ocmClient, nil, nil)
+			factory := NewDefaultQuotaServiceFactory(tt.fields.ocmClient, nil, nil, unlimitedOrganisationQuotaService(), config.NewKafkaConfig())
 			quotaService, _ := factory.GetQuotaService(api.AMSQuotaType)
 			kafka := &dbapi.KafkaRequest{
 				Meta: api.Meta{
@@ -547,7 +548,7 @@ func Test_Delete_Quota(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			factory := NewDefaultQuotaServiceFactory(tt.fields.ocmClient, nil, nil)
+			factory := NewDefaultQuotaServiceFactory(tt.fields.ocmClient, nil, nil, unlimitedOrganisationQuotaService(), config.NewKafkaConfig())
 			quotaService, _ := factory.GetQuotaService(api.AMSQuotaType)
 			err := quotaService.DeleteQuota(tt.args.subscriptionId)
 			if (err != nil) != tt.wantErr {
@@ -725,7 +726,7 @@ func Test_amsQuotaService_CheckIfQuotaIsDefinedForInstanceType(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			gomega.RegisterTestingT(t)
-			quotaServiceFactory := NewDefaultQuotaServiceFactory(tt.ocmClient, nil, nil)
+			quotaServiceFactory := NewDefaultQuotaServiceFactory(tt.ocmClient, nil, nil, unlimitedOrganisationQuotaService(), config.NewKafkaConfig())
 			quotaService, _ := quotaServiceFactory.GetQuotaService(api.AMSQuotaType)
 			res, err := quotaService.CheckIfQuotaIsDefinedForInstanceType(tt.args.kafkaRequest, tt.args.kafkaInstanceType)
 			gomega.Expect(err != nil).To(gomega.Equal(tt.wantErr))
@@ -1,12 +1,14 @@
 package quota
 
 import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/ocm"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/quota_management"
+	coreQuota "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/quota"
 )
 
 // DefaultQuotaServiceFactory the default implementation for ProviderFactory
@@ -18,10 +20,21 @@ func NewDefaultQuotaServiceFactory(
 	amsClient ocm.AMSClient,
 	connectionFactory *db.ConnectionFactory,
 	quotaManagementListConfig *quota_management.QuotaManagementListConfig,
+	organisationQuotaService coreQuota.OrganisationQuotaService,
+	kafkaConfig *config.KafkaConfig,
 ) services.QuotaServiceFactory {
 	quoataServiceContainer := map[api.QuotaType]services.QuotaService{
 		api.AMSQuotaType:                 &amsQuotaService{amsClient: amsClient},
 		api.QuotaManagementListQuotaType: &QuotaManagementListService{connectionFactory: connectionFactory, quotaManagementList: quotaManagementListConfig},
+		api.LocalQuotaType:               &LocalQuotaService{organisationQuotaService: organisationQuotaService},
+	}
+	// the organisation quotas apply whatever the quota type
+	for quotaType, quotaService := range quoataServiceContainer {
+		quoataServiceContainer[quotaType] = &organisationQuotaLimiter{
+			QuotaService:             quotaService,
+			organisationQuotaService: organisationQuotaService,
+			kafkaConfig:              kafkaConfig,
+		}
 	}
 	return &DefaultQuotaServiceFactory{quoataServiceContainer: quoataServiceContainer}
 }
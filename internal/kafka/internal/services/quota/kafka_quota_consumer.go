@@ -0,0 +1,41 @@
+package quota
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	coreQuota "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/quota"
+)
+
+// kafkaQuotaConsumer reports the capacity units consumed by the kafkas of an organisation per instance type
+type kafkaQuotaConsumer struct {
+	connectionFactory *db.ConnectionFactory
+	kafkaConfig       *config.KafkaConfig
+}
+
+var _ coreQuota.QuotaConsumer = &kafkaQuotaConsumer{}
+
+func NewKafkaQuotaConsumer(connectionFactory *db.ConnectionFactory, kafkaConfig *config.KafkaConfig) *kafkaQuotaConsumer {
+	return &kafkaQuotaConsumer{
+		connectionFactory: connectionFactory,
+		kafkaConfig:       kafkaConfig,
+	}
+}
+
+func (c *kafkaQuotaConsumer) Resources() []string {
+	return []string{types.STANDARD.String(), types.EVAL.String()}
+}
+
+func (c *kafkaQuotaConsumer) Consumed(orgId string, resource string) (int, *errors.ServiceError) {
+	var count int64
+	if err := c.connectionFactory.New().
+		Model(&dbapi.KafkaRequest{}).
+		Where("organisation_id = ?", orgId).
+		Where("instance_type = ?", resource).
+		Count(&count).Error; err != nil {
+		return 0, errors.NewWithCause(errors.ErrorGeneral, err, "unable to count %s kafkas of organisation %s", resource, orgId)
+	}
+	return int(count) * c.kafkaConfig.Quota.GetInstanceTypeUnits(resource), nil
+}
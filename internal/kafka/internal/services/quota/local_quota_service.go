@@ -0,0 +1,40 @@
+package quota
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	coreQuota "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/quota"
+)
+
+// LocalQuotaService only relies on the organisation quotas managed with the admin API, for installations without
+// access to AMS. Organisations with a quota for the standard instance type create standard instances, the others
+// create eval instances. The units of the organisation quotas are reserved for every quota type by the factory, an
+// organisation without a quota for the instance type has none with the local quota type.
+type LocalQuotaService struct {
+	organisationQuotaService coreQuota.OrganisationQuotaService
+}
+
+func (q LocalQuotaService) CheckIfQuotaIsDefinedForInstanceType(kafka *dbapi.KafkaRequest, instanceType types.KafkaInstanceType) (bool, *errors.ServiceError) {
+	quota, err := q.organisationQuotaService.Get(kafka.OrganisationId, instanceType.String())
+	if err != nil {
+		return false, err
+	}
+	return quota != nil && quota.Allowed > 0, nil
+}
+
+func (q LocalQuotaService) ReserveQuota(kafka *dbapi.KafkaRequest, instanceType types.KafkaInstanceType) (string, *errors.ServiceError) {
+	// the units are reserved by the organisation quota limiter, only the organisations with a quota can create kafkas
+	quota, err := q.organisationQuotaService.Get(kafka.OrganisationId, instanceType.String())
+	if err != nil {
+		return "", err
+	}
+	if quota == nil || quota.Allowed <= 0 {
+		return "", errors.InsufficientQuotaError("Insufficient Quota")
+	}
+	return "", nil
+}
+
+func (q LocalQuotaService) DeleteQuota(subscriptionId string) *errors.ServiceError {
+	return nil // NOOP, the quota is released when the kafka is deleted
+}
@@ -0,0 +1,169 @@
+package quota
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	coreQuota "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/quota"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+// unlimitedOrganisationQuotaService doesn't limit the kafkas of any organisation
+func unlimitedOrganisationQuotaService() coreQuota.OrganisationQuotaService {
+	return &coreQuota.OrganisationQuotaServiceMock{
+		ReserveFunc: func(orgId string, resource string, units int) *errors.ServiceError {
+			return nil
+		},
+	}
+}
+
+func Test_LocalQuotaService(t *testing.T) {
+	tests := []struct {
+		name        string
+		quota       *api.OrganisationQuota
+		wantDefined bool
+	}{
+		{
+			name:        "should not define the quota of organisations without quota",
+			wantDefined: false,
+		},
+		{
+			name:        "should not define the quota of organisations with a zero quota",
+			quota:       &api.OrganisationQuota{OrganisationId: "test-org", Resource: "standard", Allowed: 0},
+			wantDefined: false,
+		},
+		{
+			name:        "should define the quota of organisations with a quota",
+			quota:       &api.OrganisationQuota{OrganisationId: "test-org", Resource: "standard", Allowed: 6},
+			wantDefined: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			organisationQuotaService := &coreQuota.OrganisationQuotaServiceMock{
+				GetFunc: func(orgId string, resource string) (*api.OrganisationQuota, *errors.ServiceError) {
+					return tt.quota, nil
+				},
+			}
+			quotaService := LocalQuotaService{organisationQuotaService: organisationQuotaService}
+			kafka := &dbapi.KafkaRequest{Owner: "test-user", OrganisationId: "test-org"}
+
+			defined, err := quotaService.CheckIfQuotaIsDefinedForInstanceType(kafka, types.STANDARD)
+			gomega.Expect(err).To(gomega.BeNil())
+			gomega.Expect(defined).To(gomega.Equal(tt.wantDefined))
+		})
+	}
+}
+
+func Test_LocalQuotaService_ReserveQuota(t *testing.T) {
+	tests := []struct {
+		name        string
+		quota       *api.OrganisationQuota
+		wantErrCode errors.ServiceErrorCode
+	}{
+		{
+			name:        "should not reserve the quota of organisations without quota",
+			wantErrCode: errors.ErrorInsufficientQuota,
+		},
+		{
+			name:        "should not reserve the quota of organisations with a zero quota",
+			quota:       &api.OrganisationQuota{OrganisationId: "test-org", Resource: "standard", Allowed: 0},
+			wantErrCode: errors.ErrorInsufficientQuota,
+		},
+		{
+			name:  "should reserve the quota of organisations with a quota",
+			quota: &api.OrganisationQuota{OrganisationId: "test-org", Resource: "standard", Allowed: 6},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			organisationQuotaService := &coreQuota.OrganisationQuotaServiceMock{
+				GetFunc: func(orgId string, resource string) (*api.OrganisationQuota, *errors.ServiceError) {
+					return tt.quota, nil
+				},
+			}
+			quotaService := LocalQuotaService{organisationQuotaService: organisationQuotaService}
+			kafka := &dbapi.KafkaRequest{Owner: "test-user", OrganisationId: "test-org"}
+
+			_, err := quotaService.ReserveQuota(kafka, types.STANDARD)
+			if tt.wantErrCode == 0 {
+				gomega.Expect(err).To(gomega.BeNil())
+				return
+			}
+			gomega.Expect(err).ToNot(gomega.BeNil())
+			gomega.Expect(err.Code).To(gomega.Equal(tt.wantErrCode))
+		})
+	}
+}
+
+func Test_organisationQuotaLimiter_ReserveQuota(t *testing.T) {
+	tests := []struct {
+		name         string
+		reserveErr   *errors.ServiceError
+		wantReserved bool
+		wantErr      bool
+	}{
+		{
+			name:         "should reserve the quota of the quota service within the organisation quota",
+			wantReserved: true,
+		},
+		{
+			name:       "should not reserve the quota of the quota service when the organisation quota is exceeded",
+			reserveErr: errors.MaximumAllowedInstanceReached("Organization 'test-org' has 0 of 6 allowed standard units left, 3 are required."),
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			kafkaConfig := config.NewKafkaConfig()
+			kafkaConfig.Quota.InstanceTypeUnits["standard"] = 3
+			organisationQuotaService := &coreQuota.OrganisationQuotaServiceMock{
+				ReserveFunc: func(orgId string, resource string, units int) *errors.ServiceError {
+					return tt.reserveErr
+				},
+			}
+			quotaService := &services.QuotaServiceMock{
+				ReserveQuotaFunc: func(kafka *dbapi.KafkaRequest, instanceType types.KafkaInstanceType) (string, *errors.ServiceError) {
+					return "subscription-id", nil
+				},
+			}
+			limiter := organisationQuotaLimiter{QuotaService: quotaService, organisationQuotaService: organisationQuotaService, kafkaConfig: kafkaConfig}
+
+			subscriptionId, err := limiter.ReserveQuota(&dbapi.KafkaRequest{Owner: "test-user", OrganisationId: "test-org"}, types.STANDARD)
+			gomega.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			gomega.Expect(organisationQuotaService.ReserveCalls()).To(gomega.HaveLen(1))
+			gomega.Expect(organisationQuotaService.ReserveCalls()[0].Resource).To(gomega.Equal("standard"))
+			gomega.Expect(organisationQuotaService.ReserveCalls()[0].Units).To(gomega.Equal(3))
+			gomega.Expect(len(quotaService.ReserveQuotaCalls()) > 0).To(gomega.Equal(tt.wantReserved))
+			if tt.wantReserved {
+				gomega.Expect(subscriptionId).To(gomega.Equal("subscription-id"))
+			}
+		})
+	}
+}
+
+func Test_KafkaQuotaConsumer_Consumed(t *testing.T) {
+	gomega.RegisterTestingT(t)
+	mocket.Catcher.Reset()
+	mocket.Catcher.NewMock().WithQuery(`SELECT count(1) FROM "kafka_requests"`).WithReply([]map[string]interface{}{{"count": 2}})
+	kafkaConfig := config.NewKafkaConfig()
+	kafkaConfig.Quota.InstanceTypeUnits["standard"] = 3
+	consumer := NewKafkaQuotaConsumer(db.NewMockConnectionFactory(nil), kafkaConfig)
+
+	consumed, err := consumer.Consumed("test-org", types.STANDARD.String())
+	gomega.Expect(err).To(gomega.BeNil())
+	gomega.Expect(consumed).To(gomega.Equal(6))
+}
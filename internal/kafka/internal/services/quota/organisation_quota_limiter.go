@@ -0,0 +1,30 @@
+package quota
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	coreQuota "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/quota"
+)
+
+// organisationQuotaLimiter enforces the organisation quotas managed with the admin API on top of the quota service of
+// the configured quota type. Each kafka consumes the capacity units configured for its instance type, organisations
+// without a quota for the instance type are only limited by the quota service.
+type organisationQuotaLimiter struct {
+	services.QuotaService
+	organisationQuotaService coreQuota.OrganisationQuotaService
+	kafkaConfig              *config.KafkaConfig
+}
+
+var _ services.QuotaService = &organisationQuotaLimiter{}
+
+func (q organisationQuotaLimiter) ReserveQuota(kafka *dbapi.KafkaRequest, instanceType types.KafkaInstanceType) (string, *errors.ServiceError) {
+	// the organisation quota is checked first, so that no quota is reserved by the quota service when it's exceeded
+	units := q.kafkaConfig.Quota.GetInstanceTypeUnits(instanceType.String())
+	if err := q.organisationQuotaService.Reserve(kafka.OrganisationId, instanceType.String(), units); err != nil {
+		return "", err
+	}
+	return q.QuotaService.ReserveQuota(kafka, instanceType)
+}
@@ -165,6 +165,10 @@ func (c *ClusterManager) SetIsRunning(val bool) {
 	c.isRunning = val
 }
 
+func (c *ClusterManager) GetReconcileStatus() workers.ReconcileStatus {
+	return c.Reconciler.Status()
+}
+
 func (c *ClusterManager) Reconcile() []error {
 	glog.Infoln("reconciling clusters")
 	var encounteredErrors []error
@@ -1,6 +1,7 @@
 package kafka_mgrs
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/tracing"
 	"github.com/google/uuid"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
@@ -58,6 +60,11 @@ func (k *AcceptedKafkaManager) Stop() {
 }
 
 func (k *AcceptedKafkaManager) Reconcile() []error {
+	return k.ReconcileWithContext(context.Background())
+}
+
+// ReconcileWithContext reconciles the accepted kafkas, their spans are children of the span of the reconcile carried by ctx
+func (k *AcceptedKafkaManager) ReconcileWithContext(ctx context.Context) []error {
 	glog.Infoln("reconciling accepted kafkas")
 	var encounteredErrors []error
 
@@ -72,7 +79,10 @@ func (k *AcceptedKafkaManager) Reconcile() []error {
 	for _, kafka := range acceptedKafkas {
 		glog.V(10).Infof("accepted kafka id = %s", kafka.ID)
 		metrics.UpdateKafkaRequestsStatusSinceCreatedMetric(constants2.KafkaRequestStatusAccepted, kafka.ID, kafka.ClusterID, time.Since(kafka.CreatedAt))
-		if err := k.reconcileAcceptedKafka(kafka); err != nil {
+		_, span := tracing.Start(ctx, "reconcile accepted kafka", tracing.KafkaIDKey.String(kafka.ID), tracing.ClusterIDKey.String(kafka.ClusterID))
+		err := k.reconcileAcceptedKafka(kafka)
+		tracing.End(span, err)
+		if err != nil {
 			encounteredErrors = append(encounteredErrors, errors.Wrapf(err, "failed to reconcile accepted kafka %s", kafka.ID))
 			continue
 		}
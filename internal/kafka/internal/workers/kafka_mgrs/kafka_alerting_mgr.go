@@ -0,0 +1,232 @@
+package kafka_mgrs
+
+import (
+	"context"
+	"time"
+
+	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/observatorium"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// KafkaAlertingManager evaluates the alert rules of the ready kafkas against their latest metrics. A rule whose
+// condition holds is pending until it has held for its duration, it then fires and its target is notified, and it is
+// notified again once the condition no longer holds. The state of the rules is kept in the database so that a new
+// leader does not notify again the alerts already fired.
+type KafkaAlertingManager struct {
+	workers.BaseWorker
+	kafkaService         services.KafkaService
+	alertService         services.KafkaAlertService
+	observatoriumService services.ObservatoriumService
+	alertingConfig       *config.KafkaAlertingConfig
+}
+
+var _ workers.Worker = &KafkaAlertingManager{}
+
+func NewKafkaAlertingManager(kafkaService services.KafkaService, alertService services.KafkaAlertService, observatoriumService services.ObservatoriumService, alertingConfig *config.KafkaAlertingConfig, bus signalbus.SignalBus) *KafkaAlertingManager {
+	return &KafkaAlertingManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: "kafka_alerting",
+			Reconciler: workers.Reconciler{SignalBus: bus},
+		},
+		kafkaService:         kafkaService,
+		alertService:         alertService,
+		observatoriumService: observatoriumService,
+		alertingConfig:       alertingConfig,
+	}
+}
+
+func (k *KafkaAlertingManager) Start() {
+	k.StartWorker(k)
+}
+
+func (k *KafkaAlertingManager) Stop() {
+	k.StopWorker(k)
+}
+
+func (k *KafkaAlertingManager) Reconcile() []error {
+	if !k.alertingConfig.EnableAlerting {
+		return nil
+	}
+	glog.V(5).Infoln("evaluating kafka alert rules")
+	now := time.Now()
+
+	var errs []error
+	rules, err := k.alertService.ListRules()
+	if err != nil {
+		return []error{errors.Wrap(err, "failed to list kafka alert rules")}
+	}
+	kafkas, err := k.kafkaService.ListByStatus(constants2.KafkaRequestStatusReady)
+	if err != nil {
+		return []error{errors.Wrap(err, "failed to list ready kafkas")}
+	}
+	readyKafkas := map[string]*dbapi.KafkaRequest{}
+	for _, kafka := range kafkas {
+		readyKafkas[kafka.ID] = kafka
+	}
+
+	// the metrics of a kafka are fetched once for all its rules evaluated in this reconcile
+	values := map[string]map[string]metricValue{}
+	for _, rule := range rules {
+		kafka, ok := readyKafkas[rule.KafkaId]
+		if !ok || !k.isDue(rule, now) {
+			continue
+		}
+		if _, ok := values[kafka.ID]; !ok {
+			values[kafka.ID] = map[string]metricValue{}
+		}
+		if err := k.evaluate(kafka, rule, values[kafka.ID], now); err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to evaluate alert rule %s of kafka %s", rule.ID, kafka.ID))
+		}
+	}
+
+	if err := k.alertService.DeleteAlertsBefore(now.Add(-k.alertingConfig.HistoryRetention)); err != nil {
+		errs = append(errs, errors.Wrap(err, "failed to delete expired kafka alerts"))
+	}
+	return errs
+}
+
+// isDue returns whether the rule was not evaluated over the last evaluation interval
+func (k *KafkaAlertingManager) isDue(rule *dbapi.KafkaAlertRule, now time.Time) bool {
+	return rule.LastEvaluatedAt == nil || now.Sub(*rule.LastEvaluatedAt) >= k.alertingConfig.EvaluationInterval
+}
+
+type metricValue struct {
+	series []float64
+	err    error
+}
+
+// evaluate updates the state of the rule from the current value of its metric and notifies the alerts it fires or
+// resolves. A value that can not be computed is saved as the error of the rule without changing its state.
+func (k *KafkaAlertingManager) evaluate(kafka *dbapi.KafkaRequest, rule *dbapi.KafkaAlertRule, values map[string]metricValue, now time.Time) error {
+	rule.LastEvaluatedAt = &now
+	value, err := k.value(kafka, rule, values)
+	if err != nil {
+		rule.LastError = err.Error()
+		if err := k.alertService.UpdateRule(rule); err != nil {
+			return err
+		}
+		return nil
+	}
+	rule.LastValue = &value
+	rule.LastError = ""
+
+	var alertState string
+	if rule.Holds(value) {
+		if rule.State == dbapi.AlertRuleStateInactive {
+			rule.State = dbapi.AlertRuleStatePending
+			rule.ActiveSince = &now
+		}
+		if rule.State == dbapi.AlertRuleStatePending && now.Sub(*rule.ActiveSince) >= time.Duration(rule.ForSeconds)*time.Second {
+			rule.State = dbapi.AlertRuleStateFiring
+			alertState = dbapi.AlertStateFiring
+		}
+	} else {
+		if rule.State == dbapi.AlertRuleStateFiring {
+			alertState = dbapi.AlertStateResolved
+		}
+		rule.State = dbapi.AlertRuleStateInactive
+		rule.ActiveSince = nil
+	}
+
+	// the rule is saved before notifying so that a failure to save it does not notify the same alert repeatedly
+	if err := k.alertService.UpdateRule(rule); err != nil {
+		return err
+	}
+	if alertState == "" {
+		return nil
+	}
+	glog.Infof("alert rule %s of kafka %s is %s with value %v", rule.ID, kafka.ID, alertState, value)
+	alert := &dbapi.KafkaAlert{
+		RuleId:    rule.ID,
+		RuleName:  rule.Name,
+		KafkaId:   kafka.ID,
+		State:     alertState,
+		Value:     value,
+		Threshold: rule.Threshold,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	if err := k.alertService.Notify(ctx, kafka, rule, alert); err != nil {
+		return err
+	}
+	return nil
+}
+
+// value returns the aggregation of the series of the metric of the rule, divided by the aggregation of the series of
+// the metric it is relative to
+func (k *KafkaAlertingManager) value(kafka *dbapi.KafkaRequest, rule *dbapi.KafkaAlertRule, values map[string]metricValue) (float64, error) {
+	value, err := k.aggregate(kafka, rule.Metric, rule.Aggregation, values)
+	if err != nil {
+		return 0, err
+	}
+	if rule.RelativeTo == "" {
+		return value, nil
+	}
+	relativeTo, err := k.aggregate(kafka, rule.RelativeTo, rule.Aggregation, values)
+	if err != nil {
+		return 0, err
+	}
+	if relativeTo == 0 {
+		return 0, errors.Errorf("%s(%s) is zero", rule.Aggregation, rule.RelativeTo)
+	}
+	return value / relativeTo, nil
+}
+
+func (k *KafkaAlertingManager) aggregate(kafka *dbapi.KafkaRequest, metric string, aggregation string, values map[string]metricValue) (float64, error) {
+	v, ok := values[metric]
+	if !ok {
+		v.series, v.err = k.fetch(kafka, metric)
+		values[metric] = v
+	}
+	if v.err != nil {
+		return 0, v.err
+	}
+	if len(v.series) == 0 {
+		return 0, errors.Errorf("metric %s has no series", metric)
+	}
+	result := v.series[0]
+	sum := 0.0
+	for _, s := range v.series {
+		sum += s
+		switch {
+		case aggregation == dbapi.AlertAggregationMax && s > result:
+			result = s
+		case aggregation == dbapi.AlertAggregationMin && s < result:
+			result = s
+		}
+	}
+	switch aggregation {
+	case dbapi.AlertAggregationSum:
+		return sum, nil
+	case dbapi.AlertAggregationAvg:
+		return sum / float64(len(v.series)), nil
+	}
+	return result, nil
+}
+
+// fetch returns the current value of each series of the metric of the kafka
+func (k *KafkaAlertingManager) fetch(kafka *dbapi.KafkaRequest, metric string) ([]float64, error) {
+	var kafkaMetrics observatorium.KafkaMetrics
+	if err := k.observatoriumService.GetMetricsByKafka(kafka, &kafkaMetrics, observatorium.MetricsReqParams{
+		ResultType: observatorium.Query,
+		Filters:    []string{metric},
+	}); err != nil {
+		return nil, err
+	}
+	var series []float64
+	for _, m := range kafkaMetrics {
+		for _, sample := range m.Vector {
+			series = append(series, float64(sample.Value))
+		}
+	}
+	return series, nil
+}
@@ -0,0 +1,167 @@
+package kafka_mgrs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/observatorium"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+	pModel "github.com/prometheus/common/model"
+)
+
+func TestKafkaAlertingManager_Reconcile(t *testing.T) {
+	now := time.Now()
+	ago := func(d time.Duration) *time.Time {
+		t := now.Add(-d)
+		return &t
+	}
+	newRule := func(state string, activeSince *time.Time, lastEvaluatedAt *time.Time) *dbapi.KafkaAlertRule {
+		return &dbapi.KafkaAlertRule{
+			Meta:            api.Meta{ID: "test-rule"},
+			KafkaId:         "test-kafka",
+			Name:            "storage",
+			Metric:          "kafka_broker_quota_totalstorageusedbytes",
+			RelativeTo:      "kafka_broker_quota_softlimitbytes",
+			Aggregation:     dbapi.AlertAggregationSum,
+			Operator:        dbapi.AlertOperatorGreaterThan,
+			Threshold:       0.9,
+			ForSeconds:      300,
+			State:           state,
+			ActiveSince:     activeSince,
+			LastEvaluatedAt: lastEvaluatedAt,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		rule        *dbapi.KafkaAlertRule
+		usedBytes   []float64
+		wantState   string
+		wantAlert   string
+		wantError   bool
+		wantUpdates int
+	}{
+		{
+			name:      "should not evaluate a rule evaluated within the interval",
+			rule:      newRule(dbapi.AlertRuleStateInactive, nil, ago(10*time.Second)),
+			usedBytes: []float64{50, 50},
+			wantState: dbapi.AlertRuleStateInactive,
+		},
+		{
+			name:        "should be pending when the condition starts to hold",
+			rule:        newRule(dbapi.AlertRuleStateInactive, nil, ago(time.Minute)),
+			usedBytes:   []float64{50, 50},
+			wantState:   dbapi.AlertRuleStatePending,
+			wantUpdates: 1,
+		},
+		{
+			name:        "should fire when the condition held for the duration of the rule",
+			rule:        newRule(dbapi.AlertRuleStatePending, ago(5*time.Minute), ago(time.Minute)),
+			usedBytes:   []float64{50, 50},
+			wantState:   dbapi.AlertRuleStateFiring,
+			wantAlert:   dbapi.AlertStateFiring,
+			wantUpdates: 1,
+		},
+		{
+			name:        "should keep firing without notifying again",
+			rule:        newRule(dbapi.AlertRuleStateFiring, ago(time.Hour), ago(time.Minute)),
+			usedBytes:   []float64{50, 50},
+			wantState:   dbapi.AlertRuleStateFiring,
+			wantUpdates: 1,
+		},
+		{
+			name:        "should resolve when the condition no longer holds",
+			rule:        newRule(dbapi.AlertRuleStateFiring, ago(time.Hour), ago(time.Minute)),
+			usedBytes:   []float64{20, 20},
+			wantState:   dbapi.AlertRuleStateInactive,
+			wantAlert:   dbapi.AlertStateResolved,
+			wantUpdates: 1,
+		},
+		{
+			name:        "should become inactive without notifying when a pending condition no longer holds",
+			rule:        newRule(dbapi.AlertRuleStatePending, ago(time.Minute), ago(time.Minute)),
+			usedBytes:   []float64{20, 20},
+			wantState:   dbapi.AlertRuleStateInactive,
+			wantUpdates: 1,
+		},
+		{
+			name:        "should keep the state and save the error when the metric has no series",
+			rule:        newRule(dbapi.AlertRuleStateFiring, ago(time.Hour), ago(time.Minute)),
+			wantState:   dbapi.AlertRuleStateFiring,
+			wantError:   true,
+			wantUpdates: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			kafka := &dbapi.KafkaRequest{Meta: api.Meta{ID: "test-kafka"}, Name: "test-kafka", Namespace: "kafka-test-kafka"}
+			kafkaService := &services.KafkaServiceMock{
+				ListByStatusFunc: func(status ...constants2.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+					return []*dbapi.KafkaRequest{kafka}, nil
+				},
+			}
+			alertService := &services.KafkaAlertServiceMock{
+				ListRulesFunc: func() (dbapi.KafkaAlertRuleList, *errors.ServiceError) {
+					return dbapi.KafkaAlertRuleList{tt.rule}, nil
+				},
+				UpdateRuleFunc: func(rule *dbapi.KafkaAlertRule) *errors.ServiceError {
+					return nil
+				},
+				NotifyFunc: func(ctx context.Context, kafka *dbapi.KafkaRequest, rule *dbapi.KafkaAlertRule, alert *dbapi.KafkaAlert) *errors.ServiceError {
+					return nil
+				},
+				DeleteAlertsBeforeFunc: func(before time.Time) *errors.ServiceError {
+					return nil
+				},
+			}
+			observatoriumService := &services.ObservatoriumServiceMock{
+				GetMetricsByKafkaFunc: func(kafka *dbapi.KafkaRequest, csMetrics *observatorium.KafkaMetrics, query observatorium.MetricsReqParams) *errors.ServiceError {
+					var vector pModel.Vector
+					for _, value := range tt.usedBytes {
+						if query.Filters[0] == "kafka_broker_quota_softlimitbytes" {
+							value = 50
+						}
+						vector = append(vector, &pModel.Sample{Value: pModel.SampleValue(value)})
+					}
+					*csMetrics = append(*csMetrics, observatorium.Metric{Vector: vector})
+					return nil
+				},
+			}
+			k := NewKafkaAlertingManager(kafkaService, alertService, observatoriumService, &config.KafkaAlertingConfig{
+				EnableAlerting:     true,
+				EvaluationInterval: time.Minute,
+				HistoryRetention:   time.Hour,
+			}, nil)
+
+			gomega.Expect(k.Reconcile()).To(gomega.BeEmpty())
+			gomega.Expect(tt.rule.State).To(gomega.Equal(tt.wantState))
+			gomega.Expect(alertService.UpdateRuleCalls()).To(gomega.HaveLen(tt.wantUpdates))
+			gomega.Expect(tt.rule.LastError != "").To(gomega.Equal(tt.wantError))
+			gomega.Expect(alertService.DeleteAlertsBeforeCalls()).To(gomega.HaveLen(1))
+			if tt.wantAlert == "" {
+				gomega.Expect(alertService.NotifyCalls()).To(gomega.BeEmpty())
+				return
+			}
+			gomega.Expect(alertService.NotifyCalls()).To(gomega.HaveLen(1))
+			alert := alertService.NotifyCalls()[0].Alert
+			gomega.Expect(alert.State).To(gomega.Equal(tt.wantAlert))
+			gomega.Expect(alert.RuleId).To(gomega.Equal("test-rule"))
+			gomega.Expect(alert.Value).To(gomega.Equal(*tt.rule.LastValue))
+		})
+	}
+}
+
+func TestKafkaAlertingManager_Reconcile_Disabled(t *testing.T) {
+	gomega.RegisterTestingT(t)
+	k := NewKafkaAlertingManager(nil, &services.KafkaAlertServiceMock{}, nil, &config.KafkaAlertingConfig{}, nil)
+	gomega.Expect(k.Reconcile()).To(gomega.BeEmpty())
+}
@@ -0,0 +1,208 @@
+package kafka_mgrs
+
+import (
+	"time"
+
+	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/observatorium"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// KafkaAvailabilityManager samples the availability of the kafkas once per sample interval and exports their
+// availability and error budget over the SLO window. The state of a kafka is taken from the readiness last reported by
+// the data plane when recent enough, from observatorium otherwise. The time of the last sampling is read from the
+// database and the samples account for the time elapsed since then, up to the max sample gap: the time the sampling
+// stopped for beyond it, e.g. during a leader election, is left unsampled and so neither available nor unavailable.
+type KafkaAvailabilityManager struct {
+	workers.BaseWorker
+	kafkaService         services.KafkaService
+	availabilityService  services.KafkaAvailabilityService
+	observatoriumService services.ObservatoriumService
+	availabilityConfig   *config.KafkaAvailabilityConfig
+}
+
+var _ workers.Worker = &KafkaAvailabilityManager{}
+
+func NewKafkaAvailabilityManager(kafkaService services.KafkaService, availabilityService services.KafkaAvailabilityService, observatoriumService services.ObservatoriumService, availabilityConfig *config.KafkaAvailabilityConfig, bus signalbus.SignalBus) *KafkaAvailabilityManager {
+	return &KafkaAvailabilityManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: "kafka_availability",
+			Reconciler: workers.Reconciler{SignalBus: bus},
+		},
+		kafkaService:         kafkaService,
+		availabilityService:  availabilityService,
+		observatoriumService: observatoriumService,
+		availabilityConfig:   availabilityConfig,
+	}
+}
+
+func (k *KafkaAvailabilityManager) Start() {
+	k.StartWorker(k)
+}
+
+func (k *KafkaAvailabilityManager) Stop() {
+	k.StopWorker(k)
+	metrics.ResetMetricsForKafkaAvailability()
+}
+
+func (k *KafkaAvailabilityManager) Reconcile() []error {
+	if !k.availabilityConfig.IsSamplingEnabled() {
+		return nil
+	}
+	interval := k.availabilityConfig.SampleInterval
+	lastSampledAt, err := k.availabilityService.LastSampledAt()
+	if err != nil {
+		return []error{errors.Wrap(err, "failed to get the time of the last kafka availability samples")}
+	}
+	now := time.Now()
+	if now.Sub(lastSampledAt) < interval {
+		return nil
+	}
+	glog.V(5).Infoln("sampling availability of kafkas")
+
+	// the first samples account for a single interval
+	elapsed := interval
+	if !lastSampledAt.IsZero() {
+		elapsed = now.Sub(lastSampledAt)
+		if maxGap := k.availabilityConfig.MaxSampleGap; elapsed > maxGap && maxGap >= interval {
+			glog.Warningf("the availability of kafkas was last sampled %s ago, only the last %s are sampled", elapsed, maxGap)
+			elapsed = maxGap
+		}
+	}
+
+	var errs []error
+	samples, sampleErr := k.sample(now, elapsed)
+	if sampleErr != nil {
+		return []error{errors.Wrap(sampleErr, "failed to sample the availability of kafkas")}
+	}
+	if err := k.availabilityService.RecordSamples(samples); err != nil {
+		errs = append(errs, errors.Wrap(err, "failed to record kafka availability samples"))
+	}
+	if err := k.availabilityService.DeleteBefore(now.Add(-k.availabilityConfig.Retention)); err != nil {
+		errs = append(errs, errors.Wrap(err, "failed to delete expired kafka availability samples"))
+	}
+	if err := k.updateMetrics(now); err != nil {
+		errs = append(errs, errors.Wrap(err, "failed to update kafka availability metrics"))
+	}
+	return errs
+}
+
+// sample returns an availability sample accounting for the elapsed time for the ready kafkas and for the failed kafkas
+// sampled over the SLO window, a kafka failing once ready consumes its error budget
+func (k *KafkaAvailabilityManager) sample(now time.Time, elapsed time.Duration) (dbapi.KafkaAvailabilitySampleList, error) {
+	kafkas, err := k.kafkaService.ListByStatus(constants2.KafkaRequestStatusReady, constants2.KafkaRequestStatusFailed)
+	if err != nil {
+		return nil, err
+	}
+	var kafkaIds, failedKafkaIds []string
+	for _, kafka := range kafkas {
+		kafkaIds = append(kafkaIds, kafka.ID)
+		if kafka.Status == constants2.KafkaRequestStatusFailed.String() {
+			failedKafkaIds = append(failedKafkaIds, kafka.ID)
+		}
+	}
+	sampledFailedKafkas, err := k.availabilityService.FindSampledKafkaIds(failedKafkaIds, now.Add(-k.availabilityConfig.SLOWindow))
+	if err != nil {
+		return nil, err
+	}
+	reports, err := k.availabilityService.FindReadinessReports(kafkaIds)
+	if err != nil {
+		return nil, err
+	}
+
+	var samples dbapi.KafkaAvailabilitySampleList
+	for _, kafka := range kafkas {
+		sample := &dbapi.KafkaAvailabilitySample{
+			KafkaId:        kafka.ID,
+			ClusterId:      kafka.ClusterID,
+			OrganisationId: kafka.OrganisationId,
+			SampledAt:      now,
+			Seconds:        elapsed.Seconds(),
+		}
+		if kafka.Status == constants2.KafkaRequestStatusFailed.String() {
+			if !sampledFailedKafkas[kafka.ID] {
+				continue
+			}
+			sample.Status = dbapi.KafkaAvailabilityStatusUnavailable
+			sample.Source = dbapi.KafkaAvailabilitySourceDataPlane
+			sample.Reason = kafka.FailedReason
+		} else if report, ok := reports[kafka.ID]; ok && now.Sub(report.ReportedAt) <= k.availabilityConfig.ReadinessReportMaxAge {
+			sample.Source = dbapi.KafkaAvailabilitySourceDataPlane
+			sample.Reason = report.Reason
+			sample.Status = dbapi.KafkaAvailabilityStatusUnavailable
+			if report.Ready {
+				sample.Status = dbapi.KafkaAvailabilityStatusAvailable
+			}
+		} else {
+			sample.Source = dbapi.KafkaAvailabilitySourceObservatorium
+			sample.Status = k.observatoriumStatus(kafka)
+		}
+		samples = append(samples, sample)
+	}
+	return samples, nil
+}
+
+// observatoriumStatus returns the availability of the kafka from the state of its Kafka resource, unknown when
+// observatorium cannot tell
+func (k *KafkaAvailabilityManager) observatoriumStatus(kafka *dbapi.KafkaRequest) string {
+	state, err := k.observatoriumService.GetKafkaState(kafka.Name, kafka.Namespace)
+	if err != nil {
+		glog.Warningf("failed to get the state of kafka %s from observatorium: %v", kafka.ID, err)
+		return dbapi.KafkaAvailabilityStatusUnknown
+	}
+	switch state.State {
+	case observatorium.ClusterStateReady:
+		return dbapi.KafkaAvailabilityStatusAvailable
+	case observatorium.ClusterStateNotReady:
+		return dbapi.KafkaAvailabilityStatusUnavailable
+	default:
+		return dbapi.KafkaAvailabilityStatusUnknown
+	}
+}
+
+// updateMetrics exports the availability and the error budget of the kafkas sampled over the SLO window, the metrics
+// are reset first to drop the kafkas no longer sampled
+func (k *KafkaAvailabilityManager) updateMetrics(now time.Time) error {
+	sloTarget := k.availabilityConfig.SLOTarget
+	summaries, err := k.availabilityService.Summarise(services.KafkaAvailabilityFilter{
+		From: now.Add(-k.availabilityConfig.SLOWindow),
+		To:   now,
+	})
+	if err != nil {
+		return err
+	}
+	burnRateSummaries, err := k.availabilityService.Summarise(services.KafkaAvailabilityFilter{
+		From: now.Add(-k.availabilityConfig.BurnRateWindow),
+		To:   now,
+	})
+	if err != nil {
+		return err
+	}
+
+	metrics.ResetMetricsForKafkaAvailability()
+	for _, summary := range summaries {
+		if availability, measured := summary.Availability(); measured {
+			metrics.UpdateKafkaAvailabilityMetrics(summary.KafkaId, summary.ClusterId, availability, summary.ErrorBudgetRemaining(sloTarget))
+		}
+	}
+	for _, summary := range burnRateSummaries {
+		if _, measured := summary.Availability(); measured {
+			metrics.UpdateKafkaErrorBudgetBurnRateMetric(summary.KafkaId, summary.ClusterId, summary.ErrorBudgetBurnRate(sloTarget))
+		}
+	}
+	for _, cluster := range services.SummariseByCluster(summaries) {
+		if availability, measured := cluster.Availability(); measured {
+			metrics.UpdateClusterKafkaAvailabilityMetric(cluster.ClusterId, availability)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package kafka_mgrs
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/observatorium"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+)
+
+func TestKafkaAvailabilityManager_Reconcile(t *testing.T) {
+	newKafka := func(id string, status constants2.KafkaStatus) *dbapi.KafkaRequest {
+		return &dbapi.KafkaRequest{
+			Meta:      api.Meta{ID: id},
+			Name:      id,
+			Namespace: "kafka-" + id,
+			ClusterID: "test-cluster",
+			Status:    status.String(),
+		}
+	}
+
+	tests := []struct {
+		name          string
+		interval      time.Duration
+		lastSampledAt time.Time
+		wantSeconds   float64
+		wantSamples   map[string]string
+	}{
+		{
+			name:          "should not sample when the sampling is disabled",
+			interval:      0,
+			lastSampledAt: time.Time{},
+		},
+		{
+			name:          "should not sample before the end of the interval",
+			interval:      5 * time.Minute,
+			lastSampledAt: time.Now().Add(-time.Minute),
+		},
+		{
+			name:          "should sample at the end of the interval",
+			interval:      5 * time.Minute,
+			lastSampledAt: time.Now().Add(-5 * time.Minute),
+			wantSeconds:   300,
+			wantSamples: map[string]string{
+				"ready-reported":   dbapi.KafkaAvailabilityStatusAvailable,
+				"ready-stale":      dbapi.KafkaAvailabilityStatusUnavailable,
+				"ready-unreported": dbapi.KafkaAvailabilityStatusUnknown,
+				"failed-sampled":   dbapi.KafkaAvailabilityStatusUnavailable,
+			},
+		},
+		{
+			name:          "should sample the time elapsed since the last samples",
+			interval:      5 * time.Minute,
+			lastSampledAt: time.Now().Add(-8 * time.Minute),
+			wantSeconds:   480,
+			wantSamples: map[string]string{
+				"ready-reported":   dbapi.KafkaAvailabilityStatusAvailable,
+				"ready-stale":      dbapi.KafkaAvailabilityStatusUnavailable,
+				"ready-unreported": dbapi.KafkaAvailabilityStatusUnknown,
+				"failed-sampled":   dbapi.KafkaAvailabilityStatusUnavailable,
+			},
+		},
+		{
+			name:          "should cap the time sampled by the max sample gap",
+			interval:      5 * time.Minute,
+			lastSampledAt: time.Now().Add(-2 * time.Hour),
+			wantSeconds:   900,
+			wantSamples: map[string]string{
+				"ready-reported":   dbapi.KafkaAvailabilityStatusAvailable,
+				"ready-stale":      dbapi.KafkaAvailabilityStatusUnavailable,
+				"ready-unreported": dbapi.KafkaAvailabilityStatusUnknown,
+				"failed-sampled":   dbapi.KafkaAvailabilityStatusUnavailable,
+			},
+		},
+		{
+			name:          "should sample a single interval the first time",
+			interval:      5 * time.Minute,
+			lastSampledAt: time.Time{},
+			wantSeconds:   300,
+			wantSamples: map[string]string{
+				"ready-reported":   dbapi.KafkaAvailabilityStatusAvailable,
+				"ready-stale":      dbapi.KafkaAvailabilityStatusUnavailable,
+				"ready-unreported": dbapi.KafkaAvailabilityStatusUnknown,
+				"failed-sampled":   dbapi.KafkaAvailabilityStatusUnavailable,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			kafkaService := &services.KafkaServiceMock{
+				ListByStatusFunc: func(status ...constants2.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+					return []*dbapi.KafkaRequest{
+						newKafka("ready-reported", constants2.KafkaRequestStatusReady),
+						newKafka("ready-stale", constants2.KafkaRequestStatusReady),
+						newKafka("ready-unreported", constants2.KafkaRequestStatusReady),
+						newKafka("failed-sampled", constants2.KafkaRequestStatusFailed),
+						newKafka("failed-unsampled", constants2.KafkaRequestStatusFailed),
+					}, nil
+				},
+			}
+			availabilityService := &services.KafkaAvailabilityServiceMock{
+				LastSampledAtFunc: func() (time.Time, *errors.ServiceError) {
+					return tt.lastSampledAt, nil
+				},
+				FindSampledKafkaIdsFunc: func(kafkaIds []string, since time.Time) (map[string]bool, *errors.ServiceError) {
+					return map[string]bool{"failed-sampled": true}, nil
+				},
+				FindReadinessReportsFunc: func(kafkaIds []string) (map[string]*dbapi.KafkaReadinessReport, *errors.ServiceError) {
+					return map[string]*dbapi.KafkaReadinessReport{
+						"ready-reported": {KafkaId: "ready-reported", Ready: true, ReportedAt: time.Now().Add(-time.Minute)},
+						"ready-stale":    {KafkaId: "ready-stale", Ready: true, ReportedAt: time.Now().Add(-time.Hour)},
+					}, nil
+				},
+				RecordSamplesFunc: func(samples dbapi.KafkaAvailabilitySampleList) *errors.ServiceError {
+					return nil
+				},
+				DeleteBeforeFunc: func(before time.Time) *errors.ServiceError {
+					return nil
+				},
+				SummariseFunc: func(filter services.KafkaAvailabilityFilter) ([]services.KafkaAvailabilitySummary, *errors.ServiceError) {
+					return []services.KafkaAvailabilitySummary{{KafkaId: "ready-reported", ClusterId: "test-cluster", AvailableSeconds: 300}}, nil
+				},
+			}
+			observatoriumService := &services.ObservatoriumServiceMock{
+				GetKafkaStateFunc: func(name string, namespaceName string) (observatorium.KafkaState, error) {
+					if name == "ready-stale" {
+						return observatorium.KafkaState{State: observatorium.ClusterStateNotReady}, nil
+					}
+					return observatorium.KafkaState{}, fmt.Errorf("observatorium is unavailable")
+				},
+			}
+			manager := NewKafkaAvailabilityManager(kafkaService, availabilityService, observatoriumService, &config.KafkaAvailabilityConfig{
+				SampleInterval:        tt.interval,
+				MaxSampleGap:          15 * time.Minute,
+				SLOTarget:             0.995,
+				SLOWindow:             30 * 24 * time.Hour,
+				BurnRateWindow:        time.Hour,
+				Retention:             90 * 24 * time.Hour,
+				ReadinessReportMaxAge: 5 * time.Minute,
+			}, nil)
+
+			gomega.Expect(manager.Reconcile()).To(gomega.BeEmpty())
+			if tt.wantSamples == nil {
+				gomega.Expect(availabilityService.RecordSamplesCalls()).To(gomega.BeEmpty())
+				return
+			}
+			gomega.Expect(availabilityService.RecordSamplesCalls()).To(gomega.HaveLen(1))
+			samples := map[string]string{}
+			for _, sample := range availabilityService.RecordSamplesCalls()[0].Samples {
+				gomega.Expect(sample.Seconds).To(gomega.BeNumerically("~", tt.wantSeconds, 1))
+				samples[sample.KafkaId] = sample.Status
+			}
+			gomega.Expect(samples).To(gomega.Equal(tt.wantSamples))
+			gomega.Expect(availabilityService.DeleteBeforeCalls()).To(gomega.HaveLen(1))
+			gomega.Expect(availabilityService.SummariseCalls()).To(gomega.HaveLen(2))
+		})
+	}
+}
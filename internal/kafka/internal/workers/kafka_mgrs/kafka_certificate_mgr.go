@@ -0,0 +1,118 @@
+package kafka_mgrs
+
+import (
+	"context"
+	"time"
+
+	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// KafkaCertificateManager issues a TLS certificate to the ready kafkas whose routes are created, and issues a new one
+// when the domains of the kafka change or the certificate is about to expire. Issuing a certificate waits for the
+// challenge records to be published, so a reconciliation only issues a few of them.
+type KafkaCertificateManager struct {
+	workers.BaseWorker
+	kafkaService       services.KafkaService
+	certificateService services.KafkaCertificateService
+	kafkaConfig        *config.KafkaConfig
+	certificateConfig  *config.KafkaCertificateConfig
+}
+
+var _ workers.Worker = &KafkaCertificateManager{}
+
+func NewKafkaCertificateManager(kafkaService services.KafkaService, certificateService services.KafkaCertificateService, kafkaConfig *config.KafkaConfig, certificateConfig *config.KafkaCertificateConfig, bus signalbus.SignalBus) *KafkaCertificateManager {
+	return &KafkaCertificateManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: "kafka_certificate",
+			Reconciler: workers.Reconciler{SignalBus: bus},
+		},
+		kafkaService:       kafkaService,
+		certificateService: certificateService,
+		kafkaConfig:        kafkaConfig,
+		certificateConfig:  certificateConfig,
+	}
+}
+
+func (k *KafkaCertificateManager) Start() {
+	k.StartWorker(k)
+}
+
+func (k *KafkaCertificateManager) Stop() {
+	k.StopWorker(k)
+}
+
+func (k *KafkaCertificateManager) Reconcile() []error {
+	if !k.kafkaConfig.EnableKafkaExternalCertificate || !k.certificateService.Enabled() {
+		return nil
+	}
+	glog.Infoln("reconciling certificates of kafkas")
+
+	kafkas, listErr := k.kafkaService.ListByStatus(constants2.KafkaRequestStatusReady)
+	if listErr != nil {
+		return []error{errors.Wrap(listErr, "failed to list ready kafkas")}
+	}
+	kafkaIds := make([]string, 0, len(kafkas))
+	for _, kafka := range kafkas {
+		kafkaIds = append(kafkaIds, kafka.ID)
+	}
+	certificates, findErr := k.certificateService.FindByKafkaIds(kafkaIds)
+	if findErr != nil {
+		return []error{errors.Wrap(findErr, "failed to find certificates of kafkas")}
+	}
+
+	var errs []error
+	issued := 0
+	for _, kafka := range kafkas {
+		if !kafka.RoutesCreated {
+			continue
+		}
+		domains, err := services.KafkaCertificateDomains(kafka)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to get routes of kafka %s", kafka.ID))
+			continue
+		}
+		if !k.needsCertificate(certificates[kafka.ID], domains) {
+			continue
+		}
+		if issued >= k.certificateConfig.MaxIssuancesPerReconcile {
+			glog.Infof("certificate of kafka %s left to the next reconciliation", kafka.ID)
+			continue
+		}
+		issued++
+
+		glog.Infof("issuing certificate for kafka %s", kafka.ID)
+		// every domain is authorized in turn, each waiting for its challenge record to be published
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(len(domains)+1)*k.certificateConfig.ACMEPropagationTimeout)
+		if _, err := k.certificateService.Issue(ctx, kafka); err != nil {
+			errs = append(errs, err)
+		}
+		cancel()
+	}
+	return errs
+}
+
+// needsCertificate returns whether the certificate is missing, not issued for the domains or due for renewal
+func (k *KafkaCertificateManager) needsCertificate(certificate *dbapi.KafkaCertificate, domains []string) bool {
+	if certificate == nil {
+		return true
+	}
+	issued := certificate.GetDomains()
+	if len(issued) != len(domains) {
+		return true
+	}
+	for i := range domains {
+		if issued[i] != domains[i] {
+			return true
+		}
+	}
+	return time.Until(certificate.NotAfter) < k.certificateConfig.RenewBefore
+}
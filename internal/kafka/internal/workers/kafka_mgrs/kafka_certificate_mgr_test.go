@@ -0,0 +1,158 @@
+package kafka_mgrs
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+)
+
+func TestKafkaCertificateManager_Reconcile(t *testing.T) {
+	domains := []string{"test.kafka.example.com", "admin-server-test.kafka.example.com", "broker-0-test.kafka.example.com"}
+	newKafka := func(routesCreated bool) *dbapi.KafkaRequest {
+		kafka := &dbapi.KafkaRequest{
+			BootstrapServerHost: domains[0],
+			RoutesCreated:       routesCreated,
+		}
+		kafka.ID = "test-kafka"
+		_ = kafka.SetRoutes([]dbapi.DataPlaneKafkaRoute{
+			{Domain: domains[2], Router: "router.example.com"},
+			{Domain: domains[1], Router: "router.example.com"},
+		})
+		return kafka
+	}
+
+	tests := []struct {
+		name        string
+		kafka       *dbapi.KafkaRequest
+		certificate *dbapi.KafkaCertificate
+		wantIssued  bool
+	}{
+		{
+			name:       "should issue a certificate to kafkas without one",
+			kafka:      newKafka(true),
+			wantIssued: true,
+		},
+		{
+			name:  "should not issue a certificate to kafkas whose routes are not created",
+			kafka: newKafka(false),
+		},
+		{
+			name:  "should not issue a certificate to kafkas with a valid certificate",
+			kafka: newKafka(true),
+			certificate: &dbapi.KafkaCertificate{
+				Domains:  strings.Join(domains, ","),
+				NotAfter: time.Now().Add(90 * 24 * time.Hour),
+			},
+		},
+		{
+			name:  "should issue a certificate when the domains of the kafka changed",
+			kafka: newKafka(true),
+			certificate: &dbapi.KafkaCertificate{
+				Domains:  domains[0],
+				NotAfter: time.Now().Add(90 * 24 * time.Hour),
+			},
+			wantIssued: true,
+		},
+		{
+			name:  "should issue a certificate when the certificate is due for renewal",
+			kafka: newKafka(true),
+			certificate: &dbapi.KafkaCertificate{
+				Domains:  strings.Join(domains, ","),
+				NotAfter: time.Now().Add(10 * 24 * time.Hour),
+			},
+			wantIssued: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			certificateService := &services.KafkaCertificateServiceMock{
+				EnabledFunc: func() bool {
+					return true
+				},
+				FindByKafkaIdsFunc: func(kafkaIds []string) (map[string]*dbapi.KafkaCertificate, *errors.ServiceError) {
+					if tt.certificate == nil {
+						return map[string]*dbapi.KafkaCertificate{}, nil
+					}
+					return map[string]*dbapi.KafkaCertificate{tt.kafka.ID: tt.certificate}, nil
+				},
+				IssueFunc: func(ctx context.Context, kafka *dbapi.KafkaRequest) (*dbapi.KafkaCertificate, *errors.ServiceError) {
+					return &dbapi.KafkaCertificate{}, nil
+				},
+			}
+			k := &KafkaCertificateManager{
+				kafkaService: &services.KafkaServiceMock{
+					ListByStatusFunc: func(status ...constants2.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+						return []*dbapi.KafkaRequest{tt.kafka}, nil
+					},
+				},
+				certificateService: certificateService,
+				kafkaConfig:        &config.KafkaConfig{EnableKafkaExternalCertificate: true},
+				certificateConfig:  config.NewKafkaCertificateConfig(),
+			}
+
+			gomega.Expect(k.Reconcile()).To(gomega.BeEmpty())
+			gomega.Expect(len(certificateService.IssueCalls()) == 1).To(gomega.Equal(tt.wantIssued))
+		})
+	}
+}
+
+func TestKafkaCertificateManager_Reconcile_Disabled(t *testing.T) {
+	gomega.RegisterTestingT(t)
+	k := &KafkaCertificateManager{
+		kafkaService: &services.KafkaServiceMock{},
+		certificateService: &services.KafkaCertificateServiceMock{
+			EnabledFunc: func() bool {
+				return false
+			},
+		},
+		kafkaConfig:       &config.KafkaConfig{EnableKafkaExternalCertificate: true},
+		certificateConfig: config.NewKafkaCertificateConfig(),
+	}
+	gomega.Expect(k.Reconcile()).To(gomega.BeEmpty())
+}
+
+func TestKafkaCertificateManager_Reconcile_MaxIssuances(t *testing.T) {
+	gomega.RegisterTestingT(t)
+	var kafkas []*dbapi.KafkaRequest
+	for _, id := range []string{"kafka-1", "kafka-2", "kafka-3"} {
+		kafka := &dbapi.KafkaRequest{BootstrapServerHost: id + ".kafka.example.com", RoutesCreated: true}
+		kafka.ID = id
+		kafkas = append(kafkas, kafka)
+	}
+	certificateService := &services.KafkaCertificateServiceMock{
+		EnabledFunc: func() bool {
+			return true
+		},
+		FindByKafkaIdsFunc: func(kafkaIds []string) (map[string]*dbapi.KafkaCertificate, *errors.ServiceError) {
+			return map[string]*dbapi.KafkaCertificate{}, nil
+		},
+		IssueFunc: func(ctx context.Context, kafka *dbapi.KafkaRequest) (*dbapi.KafkaCertificate, *errors.ServiceError) {
+			return &dbapi.KafkaCertificate{}, nil
+		},
+	}
+	certificateConfig := config.NewKafkaCertificateConfig()
+	certificateConfig.MaxIssuancesPerReconcile = 2
+	k := &KafkaCertificateManager{
+		kafkaService: &services.KafkaServiceMock{
+			ListByStatusFunc: func(status ...constants2.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+				return kafkas, nil
+			},
+		},
+		certificateService: certificateService,
+		kafkaConfig:        &config.KafkaConfig{EnableKafkaExternalCertificate: true},
+		certificateConfig:  certificateConfig,
+	}
+
+	gomega.Expect(k.Reconcile()).To(gomega.BeEmpty())
+	gomega.Expect(certificateService.IssueCalls()).To(gomega.HaveLen(2))
+}
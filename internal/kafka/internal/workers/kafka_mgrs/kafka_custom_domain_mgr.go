@@ -0,0 +1,211 @@
+package kafka_mgrs
+
+import (
+	"context"
+	"time"
+
+	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	pkgerrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// KafkaCustomDomainManager verifies the ownership of the custom domains of kafkas, issues their certificate when the
+// customer did not provide one and sets the custom bootstrap server host of the kafkas once their domain is ready. The
+// ownership of the ready domains is verified again periodically, the domains that are no longer owned are revoked.
+type KafkaCustomDomainManager struct {
+	workers.BaseWorker
+	kafkaService        services.KafkaService
+	customDomainService services.KafkaCustomDomainService
+	certificateConfig   *config.KafkaCertificateConfig
+}
+
+var _ workers.Worker = &KafkaCustomDomainManager{}
+
+func NewKafkaCustomDomainManager(kafkaService services.KafkaService, customDomainService services.KafkaCustomDomainService, certificateConfig *config.KafkaCertificateConfig, bus signalbus.SignalBus) *KafkaCustomDomainManager {
+	return &KafkaCustomDomainManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: "kafka_custom_domain",
+			Reconciler: workers.Reconciler{SignalBus: bus},
+		},
+		kafkaService:        kafkaService,
+		customDomainService: customDomainService,
+		certificateConfig:   certificateConfig,
+	}
+}
+
+func (k *KafkaCustomDomainManager) Start() {
+	k.StartWorker(k)
+}
+
+func (k *KafkaCustomDomainManager) Stop() {
+	k.StopWorker(k)
+}
+
+func (k *KafkaCustomDomainManager) Reconcile() []error {
+	if !k.certificateConfig.EnableCustomDomains {
+		return nil
+	}
+	glog.Infoln("reconciling custom domains of kafkas")
+
+	customDomains, listErr := k.customDomainService.ListByStatus(constants2.CustomDomainStatusPendingVerification, constants2.CustomDomainStatusPendingCertificate, constants2.CustomDomainStatusReady)
+	if listErr != nil {
+		return []error{errors.Wrap(listErr, "failed to list custom domains")}
+	}
+
+	var errs []error
+	for _, customDomain := range customDomains {
+		if err := k.reconcileCustomDomain(customDomain); err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to reconcile custom domain %s", customDomain.Domain))
+		}
+	}
+	return errs
+}
+
+func (k *KafkaCustomDomainManager) reconcileCustomDomain(customDomain *dbapi.KafkaCustomDomain) error {
+	kafka, err := k.kafkaService.GetById(customDomain.KafkaId)
+	if err != nil {
+		return err
+	}
+
+	switch customDomain.Status {
+	case constants2.CustomDomainStatusPendingVerification.String():
+		verified, err := k.customDomainService.Verify(customDomain)
+		if err != nil {
+			return err
+		}
+		if !verified {
+			return nil
+		}
+		glog.Infof("custom domain %s of kafka %s is verified", customDomain.Domain, kafka.ID)
+		now := time.Now()
+		customDomain.VerifiedAt = &now
+		customDomain.Status = constants2.CustomDomainStatusPendingCertificate.String()
+		if customDomain.CertificateProvided {
+			customDomain.Status = constants2.CustomDomainStatusReady.String()
+		}
+		if err := k.customDomainService.Update(customDomain); err != nil {
+			if err.Code == pkgerrors.ErrorConflict {
+				glog.Warningf("custom domain %s of kafka %s is verified but already registered by another kafka", customDomain.Domain, kafka.ID)
+				customDomain.Status = constants2.CustomDomainStatusPendingVerification.String()
+				customDomain.VerifiedAt = nil
+				return nil
+			}
+			return err
+		}
+	case constants2.CustomDomainStatusPendingCertificate.String():
+		// the hosts of the certificate are derived from the routes of the kafka
+		if !kafka.RoutesCreated {
+			return nil
+		}
+		if err := k.issueCertificate(kafka, customDomain); err != nil {
+			return err
+		}
+		customDomain.Status = constants2.CustomDomainStatusReady.String()
+		if err := k.customDomainService.Update(customDomain); err != nil {
+			return err
+		}
+	case constants2.CustomDomainStatusReady.String():
+		revoked, err := k.reverify(kafka, customDomain)
+		if err != nil || revoked {
+			return err
+		}
+		if !customDomain.CertificateProvided && kafka.RoutesCreated && k.needsCertificate(kafka, customDomain) {
+			if err := k.issueCertificate(kafka, customDomain); err != nil {
+				return err
+			}
+			if err := k.customDomainService.Update(customDomain); err != nil {
+				return err
+			}
+		}
+	}
+
+	if customDomain.Status != constants2.CustomDomainStatusReady.String() || kafka.CustomBootstrapServerHost == customDomain.Domain {
+		return nil
+	}
+	if err := k.kafkaService.Updates(kafka, map[string]interface{}{
+		"custom_bootstrap_server_host": customDomain.Domain,
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// reverify verifies the ownership of a ready custom domain again once the reverify interval elapsed since its last
+// verification. The domain is revoked when its ownership wasn't verified for longer than the revoke delay: the kafka is
+// no longer reachable at it and other kafkas can register it.
+func (k *KafkaCustomDomainManager) reverify(kafka *dbapi.KafkaRequest, customDomain *dbapi.KafkaCustomDomain) (bool, error) {
+	if customDomain.VerifiedAt != nil && time.Since(*customDomain.VerifiedAt) < k.certificateConfig.CustomDomainReverifyInterval {
+		return false, nil
+	}
+	verified, err := k.customDomainService.Verify(customDomain)
+	if err != nil {
+		return false, err
+	}
+	if verified {
+		now := time.Now()
+		customDomain.VerifiedAt = &now
+		if err := k.customDomainService.Update(customDomain); err != nil {
+			return false, err
+		}
+		return false, nil
+	}
+	if customDomain.VerifiedAt != nil && time.Since(*customDomain.VerifiedAt) < k.certificateConfig.CustomDomainRevokeAfter {
+		glog.Warningf("the ownership of custom domain %s of kafka %s can't be verified", customDomain.Domain, kafka.ID)
+		return false, nil
+	}
+
+	glog.Warningf("revoking custom domain %s of kafka %s, its ownership can't be verified", customDomain.Domain, kafka.ID)
+	customDomain.Status = constants2.CustomDomainStatusPendingVerification.String()
+	if err := k.customDomainService.Update(customDomain); err != nil {
+		return false, err
+	}
+	if kafka.CustomBootstrapServerHost == customDomain.Domain {
+		if err := k.kafkaService.Updates(kafka, map[string]interface{}{
+			"custom_bootstrap_server_host": "",
+		}); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func (k *KafkaCustomDomainManager) issueCertificate(kafka *dbapi.KafkaRequest, customDomain *dbapi.KafkaCustomDomain) error {
+	glog.Infof("issuing certificate for custom domain %s of kafka %s", customDomain.Domain, kafka.ID)
+	hosts, err := services.CustomDomainHosts(kafka, customDomain.Domain)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get routes of kafka %s", kafka.ID)
+	}
+	// every host is authorized in turn, each waiting for its challenge record to be published
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(len(hosts)+1)*k.certificateConfig.ACMEPropagationTimeout)
+	defer cancel()
+	if err := k.customDomainService.IssueCertificate(ctx, kafka, customDomain); err != nil {
+		return err
+	}
+	return nil
+}
+
+// needsCertificate returns whether the issued certificate does not cover the hosts of the kafka or is due for renewal
+func (k *KafkaCustomDomainManager) needsCertificate(kafka *dbapi.KafkaRequest, customDomain *dbapi.KafkaCustomDomain) bool {
+	hosts, err := services.CustomDomainHosts(kafka, customDomain.Domain)
+	if err != nil {
+		return false
+	}
+	issued := customDomain.GetHosts()
+	if len(issued) != len(hosts) {
+		return true
+	}
+	for i := range hosts {
+		if issued[i] != hosts[i] {
+			return true
+		}
+	}
+	return customDomain.NotAfter == nil || time.Until(*customDomain.NotAfter) < k.certificateConfig.RenewBefore
+}
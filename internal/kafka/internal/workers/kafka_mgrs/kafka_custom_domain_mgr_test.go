@@ -0,0 +1,207 @@
+package kafka_mgrs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+)
+
+func TestKafkaCustomDomainManager_Reconcile(t *testing.T) {
+	notAfter := time.Now().Add(90 * 24 * time.Hour)
+	expiring := time.Now().Add(10 * 24 * time.Hour)
+	verifiedAt := time.Now().Add(-time.Hour)
+	reverifyDue := time.Now().Add(-25 * time.Hour)
+	revokeDue := time.Now().Add(-73 * time.Hour)
+	newKafka := func(routesCreated bool) *dbapi.KafkaRequest {
+		kafka := &dbapi.KafkaRequest{
+			BootstrapServerHost: "test.kafka.example.com",
+			RoutesCreated:       routesCreated,
+		}
+		kafka.ID = "test-kafka"
+		_ = kafka.SetRoutes([]dbapi.DataPlaneKafkaRoute{
+			{Domain: "admin-server-test.kafka.example.com", Router: "router.example.com"},
+		})
+		return kafka
+	}
+
+	tests := []struct {
+		name                 string
+		kafka                *dbapi.KafkaRequest
+		customDomain         *dbapi.KafkaCustomDomain
+		verified             bool
+		updateErr            *errors.ServiceError
+		wantStatus           constants2.CustomDomainStatus
+		wantIssued           bool
+		wantBootstrapUpdated bool
+	}{
+		{
+			name:         "should keep unverified domains pending",
+			kafka:        newKafka(true),
+			customDomain: &dbapi.KafkaCustomDomain{Domain: "kafka.customer.com", Status: constants2.CustomDomainStatusPendingVerification.String()},
+			wantStatus:   constants2.CustomDomainStatusPendingVerification,
+		},
+		{
+			name:         "should wait for the certificate of verified domains",
+			kafka:        newKafka(true),
+			customDomain: &dbapi.KafkaCustomDomain{Domain: "kafka.customer.com", Status: constants2.CustomDomainStatusPendingVerification.String()},
+			verified:     true,
+			wantStatus:   constants2.CustomDomainStatusPendingCertificate,
+		},
+		{
+			name:                 "should make verified domains with a provided certificate ready",
+			kafka:                newKafka(true),
+			customDomain:         &dbapi.KafkaCustomDomain{Domain: "kafka.customer.com", Status: constants2.CustomDomainStatusPendingVerification.String(), CertificateProvided: true},
+			verified:             true,
+			wantStatus:           constants2.CustomDomainStatusReady,
+			wantBootstrapUpdated: true,
+		},
+		{
+			name:         "should not issue a certificate before the routes of the kafka are created",
+			kafka:        newKafka(false),
+			customDomain: &dbapi.KafkaCustomDomain{Domain: "kafka.customer.com", Status: constants2.CustomDomainStatusPendingCertificate.String()},
+			wantStatus:   constants2.CustomDomainStatusPendingCertificate,
+		},
+		{
+			name:                 "should issue a certificate to domains pending one",
+			kafka:                newKafka(true),
+			customDomain:         &dbapi.KafkaCustomDomain{Domain: "kafka.customer.com", Status: constants2.CustomDomainStatusPendingCertificate.String()},
+			wantStatus:           constants2.CustomDomainStatusReady,
+			wantIssued:           true,
+			wantBootstrapUpdated: true,
+		},
+		{
+			name:  "should not issue a certificate to ready domains with a valid certificate",
+			kafka: newKafka(true),
+			customDomain: &dbapi.KafkaCustomDomain{
+				Domain:     "kafka.customer.com",
+				Status:     constants2.CustomDomainStatusReady.String(),
+				VerifiedAt: &verifiedAt,
+				Hosts:      "kafka.customer.com,admin-server-kafka.customer.com",
+				NotAfter:   &notAfter,
+			},
+			wantStatus:           constants2.CustomDomainStatusReady,
+			wantBootstrapUpdated: true,
+		},
+		{
+			name:  "should renew the certificate of ready domains before it expires",
+			kafka: newKafka(true),
+			customDomain: &dbapi.KafkaCustomDomain{
+				Domain:     "kafka.customer.com",
+				Status:     constants2.CustomDomainStatusReady.String(),
+				VerifiedAt: &verifiedAt,
+				Hosts:      "kafka.customer.com,admin-server-kafka.customer.com",
+				NotAfter:   &expiring,
+			},
+			wantStatus:           constants2.CustomDomainStatusReady,
+			wantIssued:           true,
+			wantBootstrapUpdated: true,
+		},
+		{
+			name:         "should keep verified domains already registered by another kafka pending",
+			kafka:        newKafka(true),
+			customDomain: &dbapi.KafkaCustomDomain{Domain: "kafka.customer.com", Status: constants2.CustomDomainStatusPendingVerification.String(), CertificateProvided: true},
+			verified:     true,
+			updateErr:    errors.Conflict("domain %q is already registered", "kafka.customer.com"),
+			wantStatus:   constants2.CustomDomainStatusPendingVerification,
+		},
+		{
+			name:  "should keep ready domains whose ownership is verified again",
+			kafka: newKafka(true),
+			customDomain: &dbapi.KafkaCustomDomain{
+				Domain:              "kafka.customer.com",
+				Status:              constants2.CustomDomainStatusReady.String(),
+				VerifiedAt:          &reverifyDue,
+				CertificateProvided: true,
+			},
+			verified:             true,
+			wantStatus:           constants2.CustomDomainStatusReady,
+			wantBootstrapUpdated: true,
+		},
+		{
+			name:  "should keep ready domains whose ownership can't be verified before the revoke delay",
+			kafka: newKafka(true),
+			customDomain: &dbapi.KafkaCustomDomain{
+				Domain:              "kafka.customer.com",
+				Status:              constants2.CustomDomainStatusReady.String(),
+				VerifiedAt:          &reverifyDue,
+				CertificateProvided: true,
+			},
+			wantStatus:           constants2.CustomDomainStatusReady,
+			wantBootstrapUpdated: true,
+		},
+		{
+			name: "should revoke ready domains whose ownership can't be verified after the revoke delay",
+			kafka: func() *dbapi.KafkaRequest {
+				kafka := newKafka(true)
+				kafka.CustomBootstrapServerHost = "kafka.customer.com"
+				return kafka
+			}(),
+			customDomain: &dbapi.KafkaCustomDomain{
+				Domain:              "kafka.customer.com",
+				Status:              constants2.CustomDomainStatusReady.String(),
+				VerifiedAt:          &revokeDue,
+				CertificateProvided: true,
+			},
+			wantStatus:           constants2.CustomDomainStatusPendingVerification,
+			wantBootstrapUpdated: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			tt.customDomain.KafkaId = tt.kafka.ID
+			customDomainService := &services.KafkaCustomDomainServiceMock{
+				ListByStatusFunc: func(status ...constants2.CustomDomainStatus) (dbapi.KafkaCustomDomainList, *errors.ServiceError) {
+					return dbapi.KafkaCustomDomainList{tt.customDomain}, nil
+				},
+				VerifyFunc: func(customDomain *dbapi.KafkaCustomDomain) (bool, *errors.ServiceError) {
+					return tt.verified, nil
+				},
+				IssueCertificateFunc: func(ctx context.Context, kafka *dbapi.KafkaRequest, customDomain *dbapi.KafkaCustomDomain) *errors.ServiceError {
+					return nil
+				},
+				UpdateFunc: func(customDomain *dbapi.KafkaCustomDomain) *errors.ServiceError {
+					return tt.updateErr
+				},
+			}
+			kafkaService := &services.KafkaServiceMock{
+				GetByIdFunc: func(id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+					return tt.kafka, nil
+				},
+				UpdatesFunc: func(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *errors.ServiceError {
+					return nil
+				},
+			}
+			certificateConfig := config.NewKafkaCertificateConfig()
+			certificateConfig.EnableCustomDomains = true
+			k := &KafkaCustomDomainManager{
+				kafkaService:        kafkaService,
+				customDomainService: customDomainService,
+				certificateConfig:   certificateConfig,
+			}
+
+			gomega.Expect(k.Reconcile()).To(gomega.BeEmpty())
+			gomega.Expect(tt.customDomain.Status).To(gomega.Equal(tt.wantStatus.String()))
+			gomega.Expect(len(customDomainService.IssueCertificateCalls()) == 1).To(gomega.Equal(tt.wantIssued))
+			gomega.Expect(len(kafkaService.UpdatesCalls()) == 1).To(gomega.Equal(tt.wantBootstrapUpdated))
+		})
+	}
+}
+
+func TestKafkaCustomDomainManager_Reconcile_Disabled(t *testing.T) {
+	gomega.RegisterTestingT(t)
+	k := &KafkaCustomDomainManager{
+		kafkaService:        &services.KafkaServiceMock{},
+		customDomainService: &services.KafkaCustomDomainServiceMock{},
+		certificateConfig:   config.NewKafkaCertificateConfig(),
+	}
+	gomega.Expect(k.Reconcile()).To(gomega.BeEmpty())
+}
@@ -1,18 +1,15 @@
 package kafka_mgrs
 
 import (
-	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/dns"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"testing"
 )
 
 func TestKafkaRoutesCNAMEManager(t *testing.T) {
-	testChangeID := "1234"
-	testChangeINSYNC := route53.ChangeStatusInsync
-
 	type fields struct {
 		kafkaService services.KafkaService
 	}
@@ -33,12 +30,10 @@ func TestKafkaRoutesCNAMEManager(t *testing.T) {
 						kafka,
 					}, nil
 				},
-				ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError) {
-					return &route53.ChangeResourceRecordSetsOutput{
-						ChangeInfo: &route53.ChangeInfo{
-							Id:     &testChangeID,
-							Status: &testChangeINSYNC,
-						},
+				ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*dns.Change, *errors.ServiceError) {
+					return &dns.Change{
+						Id:     "1234",
+						InSync: true,
 					}, nil
 				},
 				UpdateFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
@@ -71,7 +66,7 @@ func TestKafkaRoutesCNAMEManager(t *testing.T) {
 						kafka,
 					}, nil
 				},
-				ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError) {
+				ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*dns.Change, *errors.ServiceError) {
 					return nil, errors.GeneralError("failed to create CNAME")
 				},
 			}},
@@ -1,12 +1,14 @@
 package kafka_mgrs
 
 import (
+	"context"
 	"time"
 
 	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/tracing"
 	"github.com/google/uuid"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
@@ -49,6 +51,11 @@ func (k *PreparingKafkaManager) Stop() {
 }
 
 func (k *PreparingKafkaManager) Reconcile() []error {
+	return k.ReconcileWithContext(context.Background())
+}
+
+// ReconcileWithContext reconciles the preparing kafkas, their spans are children of the span of the reconcile carried by ctx
+func (k *PreparingKafkaManager) ReconcileWithContext(ctx context.Context) []error {
 	glog.Infoln("reconciling preparing kafkas")
 	var encounteredErrors []error
 
@@ -63,7 +70,10 @@ func (k *PreparingKafkaManager) Reconcile() []error {
 	for _, kafka := range preparingKafkas {
 		glog.V(10).Infof("preparing kafka id = %s", kafka.ID)
 		metrics.UpdateKafkaRequestsStatusSinceCreatedMetric(constants2.KafkaRequestStatusPreparing, kafka.ID, kafka.ClusterID, time.Since(kafka.CreatedAt))
-		if err := k.reconcilePreparingKafka(kafka); err != nil {
+		_, span := tracing.Start(ctx, "reconcile preparing kafka", tracing.KafkaIDKey.String(kafka.ID), tracing.ClusterIDKey.String(kafka.ClusterID))
+		err := k.reconcilePreparingKafka(kafka)
+		tracing.End(span, err)
+		if err != nil {
 			encounteredErrors = append(encounteredErrors, errors.Wrapf(err, "failed to reconcile preparing kafka %s", kafka.ID))
 			continue
 		}
@@ -21,6 +21,8 @@ import (
 	observatoriumClient "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/observatorium"
 	environments2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/providers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/metering"
+	coreQuota "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/quota"
 	"github.com/goava/di"
 )
 
@@ -42,6 +44,11 @@ func ConfigProviders() di.Option {
 
 		// Configuration for the Kafka service...
 		di.Provide(config.NewAWSConfig, di.As(new(environments2.ConfigModule))),
+		di.Provide(config.NewDNSConfig, di.As(new(environments2.ConfigModule))),
+		di.Provide(config.NewKafkaCertificateConfig, di.As(new(environments2.ConfigModule))),
+		di.Provide(config.NewKafkaAvailabilityConfig, di.As(new(environments2.ConfigModule))),
+		di.Provide(config.NewKafkaAlertingConfig, di.As(new(environments2.ConfigModule))),
+		di.Provide(config.NewDataplaneClusterStatusHistoryConfig, di.As(new(environments2.ConfigModule))),
 		di.Provide(config.NewSupportedProvidersConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.ServiceValidator))),
 		di.Provide(observatoriumClient.NewObservabilityConfigurationConfig, di.As(new(environments2.ConfigModule))),
 		di.Provide(config.NewKafkaConfig, di.As(new(environments2.ConfigModule))),
@@ -65,6 +72,12 @@ func ConfigProviders() di.Option {
 func ServiceProviders() di.Option {
 	return di.Options(
 		di.Provide(services.NewClusterService),
+		di.Provide(services.NewDNSProvider),
+		di.Provide(services.NewKafkaCertificateService),
+		di.Provide(services.NewKafkaCustomDomainService),
+		di.Provide(services.NewKafkaAvailabilityService),
+		di.Provide(services.NewKafkaAlertService),
+		di.Provide(services.NewDataPlaneClusterStatusHistoryService),
 		di.Provide(services.NewKafkaService, di.As(new(services.KafkaService))),
 		di.Provide(services.NewCloudProvidersService),
 		di.Provide(services.NewObservatoriumService),
@@ -76,6 +89,8 @@ func ServiceProviders() di.Option {
 		di.Provide(clusters.NewDefaultProviderFactory, di.As(new(clusters.ProviderFactory))),
 		di.Provide(routes.NewRouteLoader),
 		di.Provide(quota.NewDefaultQuotaServiceFactory),
+		di.Provide(quota.NewKafkaQuotaConsumer, di.As(new(coreQuota.QuotaConsumer))),
+		di.Provide(services.NewKafkaUsageSampler, di.As(new(metering.UsageSampler))),
 		di.Provide(workers.NewClusterManager, di.As(new(workers.Worker))),
 		di.Provide(kafka_mgrs.NewKafkaManager, di.As(new(workers.Worker))),
 		di.Provide(kafka_mgrs.NewAcceptedKafkaManager, di.As(new(workers.Worker))),
@@ -84,5 +99,9 @@ func ServiceProviders() di.Option {
 		di.Provide(kafka_mgrs.NewProvisioningKafkaManager, di.As(new(workers.Worker))),
 		di.Provide(kafka_mgrs.NewReadyKafkaManager, di.As(new(workers.Worker))),
 		di.Provide(kafka_mgrs.NewKafkaCNAMEManager, di.As(new(workers.Worker))),
+		di.Provide(kafka_mgrs.NewKafkaCertificateManager, di.As(new(workers.Worker))),
+		di.Provide(kafka_mgrs.NewKafkaCustomDomainManager, di.As(new(workers.Worker))),
+		di.Provide(kafka_mgrs.NewKafkaAvailabilityManager, di.As(new(workers.Worker))),
+		di.Provide(kafka_mgrs.NewKafkaAlertingManager, di.As(new(workers.Worker))),
 	)
 }
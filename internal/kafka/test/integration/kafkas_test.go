@@ -382,7 +382,7 @@ func buildKafkaRequest(modifyFn func(kafkaRequest *dbapi.KafkaRequest)) *dbapi.K
 
 func testValidations(standardClusters, evalClusters string, t *testing.T, kafkaValidations []kafkaValidation) {
 	for _, val := range kafkaValidations {
-		errK := test.TestServices.KafkaService.RegisterKafkaJob(val.kafka)
+		errK := test.TestServices.KafkaService.RegisterKafkaJob(context.Background(), val.kafka)
 		if (errK != nil) != val.eCheck.wantErr {
 			t.Errorf("Unexpected error %v, wantErr = %v for kafka %s", errK, val.eCheck.wantErr, val.kafka.Name)
 		}
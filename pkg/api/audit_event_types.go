@@ -0,0 +1,37 @@
+package api
+
+import (
+	"gorm.io/gorm"
+)
+
+const (
+	AuditOutcomeSuccess = "success"
+	AuditOutcomeFailure = "failure"
+)
+
+// AuditEvent records a mutation made through the public or admin APIs
+type AuditEvent struct {
+	Meta
+	Actor          string
+	OrganisationId string
+	Action         string
+	ResourceType   string
+	ResourceId     string
+	Method         string
+	Path           string
+	RemoteAddr     string
+	StatusCode     int
+	Outcome        string
+	// Diff holds the changed top level fields of the resource as {"field": {"before": ..., "after": ...}}, with
+	// secrets redacted
+	Diff JSON `gorm:"type:jsonb"`
+}
+
+type AuditEventList []*AuditEvent
+
+func (auditEvent *AuditEvent) BeforeCreate(tx *gorm.DB) error {
+	if auditEvent.ID == "" {
+		auditEvent.ID = NewID()
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+package api
+
+import (
+	"gorm.io/gorm"
+)
+
+// LocalUser is a user of an organisation managed through the admin API, used instead of OCM to validate users and
+// their acceptance of the terms when the fleet manager runs without access to OCM
+type LocalUser struct {
+	Meta
+	Username       string
+	OrganisationId string
+	Banned         bool
+	TermsAccepted  bool
+}
+
+type LocalUserList []*LocalUser
+
+func (user *LocalUser) BeforeCreate(tx *gorm.DB) error {
+	if user.ID == "" {
+		user.ID = NewID()
+	}
+	return nil
+}
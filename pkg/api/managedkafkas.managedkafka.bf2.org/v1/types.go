@@ -61,6 +61,14 @@ type TlsSpec struct {
 }
 
 type EndpointSpec struct {
+	BootstrapServerHost string             `json:"bootstrapServerHost"`
+	Tls                 *TlsSpec           `json:"tls,omitempty"`
+	CustomDomains       []CustomDomainSpec `json:"customDomains,omitempty"`
+}
+
+// CustomDomainSpec is a domain the kafka is also reachable at, the hosts of its routes are derived from its bootstrap
+// server host the same way they are derived from the bootstrap server host of the endpoint
+type CustomDomainSpec struct {
 	BootstrapServerHost string   `json:"bootstrapServerHost"`
 	Tls                 *TlsSpec `json:"tls,omitempty"`
 }
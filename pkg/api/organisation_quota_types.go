@@ -0,0 +1,23 @@
+package api
+
+import (
+	"gorm.io/gorm"
+)
+
+// OrganisationQuota is the number of capacity units of a resource an organisation is allowed to consume, e.g. the
+// units of standard kafka instances or the number of connectors
+type OrganisationQuota struct {
+	Meta
+	OrganisationId string
+	Resource       string
+	Allowed        int
+}
+
+type OrganisationQuotaList []*OrganisationQuota
+
+func (quota *OrganisationQuota) BeforeCreate(tx *gorm.DB) error {
+	if quota.ID == "" {
+		quota.ID = NewID()
+	}
+	return nil
+}
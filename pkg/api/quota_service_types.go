@@ -3,6 +3,7 @@ package api
 const (
 	AMSQuotaType                 QuotaType = "ams"
 	QuotaManagementListQuotaType QuotaType = "quota-management-list"
+	LocalQuotaType               QuotaType = "local"
 	UndefinedQuotaType           QuotaType = ""
 )
 
@@ -0,0 +1,25 @@
+package api
+
+import (
+	"gorm.io/gorm"
+)
+
+// RoleBinding grants a user a role on a single resource, or on all resources of an organisation
+type RoleBinding struct {
+	Meta
+	ResourceType   string
+	ResourceId     string
+	OrganisationId string
+	Subject        string
+	Role           string
+	CreatedBy      string
+}
+
+type RoleBindingList []*RoleBinding
+
+func (roleBinding *RoleBinding) BeforeCreate(tx *gorm.DB) error {
+	if roleBinding.ID == "" {
+		roleBinding.ID = NewID()
+	}
+	return nil
+}
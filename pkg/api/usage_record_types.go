@@ -0,0 +1,37 @@
+package api
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	UsageResourceTypeKafka     = "kafka"
+	UsageResourceTypeConnector = "connector"
+)
+
+// UsageRecord is a sample of a running resource, accounting for the hours of usage since the previous sample
+type UsageRecord struct {
+	Meta
+	SampledAt      time.Time
+	OrganisationId string
+	ResourceType   string
+	ResourceId     string
+	// InstanceType is the kafka instance type or the connector type
+	InstanceType  string
+	CloudProvider string
+	Region        string
+	MultiAZ       bool
+	StorageSize   string
+	Hours         float64
+}
+
+type UsageRecordList []*UsageRecord
+
+func (record *UsageRecord) BeforeCreate(tx *gorm.DB) error {
+	if record.ID == "" {
+		record.ID = NewID()
+	}
+	return nil
+}
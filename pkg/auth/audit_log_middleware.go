@@ -5,7 +5,6 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/server/logging"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
-	"io"
 	"net/http"
 )
 
@@ -14,13 +13,12 @@ type AuditLogMiddleware interface {
 }
 
 type auditInfo struct {
-	Type               string        `json:"type"`
-	Username           string        `json:"username"`
-	Method             string        `json:"request_method,omitempty"`
-	RequestURI         string        `json:"request_url,omitempty"`
-	Body               io.ReadCloser `json:"request_body,omitempty"`
-	RemoteAddr         string        `json:"request_remote_ip,omitempty"`
-	ResponseStatusCode int           `json:"response_status_code,omitempty"`
+	Type               string `json:"type"`
+	Username           string `json:"username"`
+	Method             string `json:"request_method,omitempty"`
+	RequestURI         string `json:"request_url,omitempty"`
+	RemoteAddr         string `json:"request_remote_ip,omitempty"`
+	ResponseStatusCode int    `json:"response_status_code,omitempty"`
 }
 
 type auditLogMiddleware struct {
@@ -48,7 +46,6 @@ func (a *auditLogMiddleware) AuditLog(code errors.ServiceErrorCode) func(handler
 				Username:   username,
 				Method:     request.Method,
 				RequestURI: request.RequestURI,
-				Body:       request.Body,
 				RemoteAddr: request.RemoteAddr,
 			}
 			logWriter := logging.NewLoggingWriter(writer, request, logging.NewJSONLogFormatter())
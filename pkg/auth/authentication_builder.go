@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/openshift-online/ocm-sdk-go/authentication"
+	"github.com/pkg/errors"
+)
+
+// AuthenticationBuilder builds the authentication handler of the API. The tokens of the OpenID Connect providers are
+// only verified with the keys of the provider whose issuer they claim, so that a provider can not sign tokens
+// impersonating another issuer, e.g. the OCM or the SSO issuers the admin endpoints trust.
+type AuthenticationBuilder struct {
+	// Default verifies the tokens claiming an issuer without a builder of its own
+	Default *authentication.HandlerBuilder
+	// Issuers verify the tokens claiming their issuer with the keys of that issuer only
+	Issuers map[string]*authentication.HandlerBuilder
+}
+
+// Build returns the authentication handler passing the authenticated requests to next. The handler verifying a token
+// is selected by its unverified iss claim, a token signed by the keys of another issuer than the one it claims fails
+// to be verified.
+func (b *AuthenticationBuilder) Build(next http.Handler) (http.Handler, error) {
+	defaultHandler, err := b.Default.Next(next).Build()
+	if err != nil {
+		return nil, err
+	}
+	issuerHandlers := map[string]http.Handler{}
+	for issuer, builder := range b.Issuers {
+		handler, err := builder.Next(next).Build()
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to create the authentication handler of issuer %s", issuer)
+		}
+		issuerHandlers[issuer] = handler
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if handler, ok := issuerHandlers[unverifiedIssuer(r)]; ok {
+			handler.ServeHTTP(w, r)
+			return
+		}
+		defaultHandler.ServeHTTP(w, r)
+	}), nil
+}
+
+// unverifiedIssuer returns the iss claim of the bearer token of the request without verifying the token, it must only
+// be used to select the keys the token is verified with
+func unverifiedIssuer(r *http.Request) string {
+	fields := strings.Fields(r.Header.Get("Authorization"))
+	if len(fields) != 2 || !strings.EqualFold(fields[0], "Bearer") {
+		return ""
+	}
+	claims := jwt.MapClaims{}
+	if _, _, err := new(jwt.Parser).ParseUnverified(fields[1], claims); err != nil {
+		return ""
+	}
+	issuer, _ := claims["iss"].(string)
+	return issuer
+}
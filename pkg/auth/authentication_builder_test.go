@@ -0,0 +1,97 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/onsi/gomega"
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	"github.com/openshift-online/ocm-sdk-go/authentication"
+)
+
+func TestAuthenticationBuilder_Build(t *testing.T) {
+	gomega.RegisterTestingT(t)
+
+	const issuerA, issuerB = "https://provider-a.example.com", "https://sso.example.com"
+	keyA, err := rsa.GenerateKey(rand.Reader, 2048)
+	gomega.Expect(err).ToNot(gomega.HaveOccurred())
+	keyB, err := rsa.GenerateKey(rand.Reader, 2048)
+	gomega.Expect(err).ToNot(gomega.HaveOccurred())
+
+	dir := t.TempDir()
+	writeKeys := func(name string, kid string, key *rsa.PrivateKey) string {
+		keys := map[string]interface{}{
+			"keys": []map[string]string{{
+				"kid": kid,
+				"kty": "RSA",
+				"alg": "RS256",
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		}
+		data, err := json.Marshal(keys)
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		file := filepath.Join(dir, name)
+		gomega.Expect(ioutil.WriteFile(file, data, 0600)).To(gomega.Succeed())
+		return file
+	}
+	keysA := writeKeys("a.json", "key-a", keyA)
+	keysB := writeKeys("b.json", "key-b", keyB)
+
+	logger, err := sdk.NewGlogLoggerBuilder().Build()
+	gomega.Expect(err).ToNot(gomega.HaveOccurred())
+	builder := &AuthenticationBuilder{
+		Default: authentication.NewHandler().Logger(logger).KeysFile(keysB),
+		Issuers: map[string]*authentication.HandlerBuilder{
+			issuerA: authentication.NewHandler().Logger(logger).KeysFile(keysA),
+		},
+	}
+	handler, err := builder.Build(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	gomega.Expect(err).ToNot(gomega.HaveOccurred())
+
+	tests := []struct {
+		name     string
+		kid      string
+		key      *rsa.PrivateKey
+		issuer   string
+		wantCode int
+	}{
+		{name: "should accept a token of provider A claiming issuer A", kid: "key-a", key: keyA, issuer: issuerA, wantCode: http.StatusOK},
+		{name: "should accept a token of issuer B claiming issuer B", kid: "key-b", key: keyB, issuer: issuerB, wantCode: http.StatusOK},
+		{name: "should reject a token of provider A claiming issuer B", kid: "key-a", key: keyA, issuer: issuerB, wantCode: http.StatusUnauthorized},
+		{name: "should reject a token of issuer B claiming issuer A", kid: "key-b", key: keyB, issuer: issuerA, wantCode: http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+				"iss": tt.issuer,
+				"typ": "Bearer",
+				"iat": time.Now().Unix(),
+				"exp": time.Now().Add(time.Minute).Unix(),
+			})
+			token.Header["kid"] = tt.kid
+			signed, err := token.SignedString(tt.key)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+
+			req := httptest.NewRequest(http.MethodGet, "/api/kafkas_mgmt/v1/kafkas", nil)
+			req.Header.Set("Authorization", "Bearer "+signed)
+			rw := httptest.NewRecorder()
+			handler.ServeHTTP(rw, req)
+			gomega.Expect(rw.Code).To(gomega.Equal(tt.wantCode))
+		})
+	}
+}
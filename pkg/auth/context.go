@@ -3,6 +3,7 @@ package auth
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/openshift-online/ocm-sdk-go/authentication"
@@ -27,21 +28,17 @@ const (
 	ssoRHUsernameKey  string = "preferred_username" // same key used in mas-sso tokens
 	ssoRhAccountIdKey string = "account_id"
 
+	// OpenID Connect standard claim keys
+	emailKey         string = "email"
+	emailVerifiedKey string = "email_verified"
+
 	// mas-sso token claim keys
 	// NOTE: This should be removed once we migrate to sso.redhat.com as it will no longer be needed (TODO: to be removed as part of MGDSTRM-6159)
 	masSsoOrgIdKey = "rh-org-id"
 )
 
 func GetUsernameFromClaims(claims jwt.MapClaims) string {
-	if claims[ocmUsernameKey] != nil {
-		return claims[ocmUsernameKey].(string)
-	}
-
-	if claims[ssoRHUsernameKey] != nil {
-		return claims[ssoRHUsernameKey].(string)
-	}
-
-	return ""
+	return getStringClaim(claims, claimsMapping.UsernameClaims)
 }
 
 func GetAccountIdFromClaims(claims jwt.MapClaims) string {
@@ -51,26 +48,33 @@ func GetAccountIdFromClaims(claims jwt.MapClaims) string {
 	return ""
 }
 
-func GetOrgIdFromClaims(claims jwt.MapClaims) string {
-	if claims[ocmOrgIdKey] != nil {
-		if orgId, ok := claims[ocmOrgIdKey].(string); ok {
-			return orgId
+// GetVerifiedEmailFromClaims returns the email address of the user when the identity provider verified it, an empty
+// string otherwise
+func GetVerifiedEmailFromClaims(claims jwt.MapClaims) string {
+	email, _ := claims[emailKey].(string)
+	switch verified := claims[emailVerifiedKey].(type) {
+	case bool:
+		if verified {
+			return email
 		}
-	}
-
-	// NOTE: This should be removed once we migrate to sso.redhat.com as it will no longer be needed (TODO: to be removed as part of MGDSTRM-6159)
-	if claims[masSsoOrgIdKey] != nil {
-		if orgId, ok := claims[masSsoOrgIdKey].(string); ok {
-			return orgId
+	case string:
+		if strings.EqualFold(verified, "true") {
+			return email
 		}
 	}
-
 	return ""
 }
 
+func GetOrgIdFromClaims(claims jwt.MapClaims) string {
+	return getStringClaim(claims, claimsMapping.OrgIdClaims)
+}
+
 func GetIsOrgAdminFromClaims(claims jwt.MapClaims) bool {
-	if claims[isOrgAdmin] != nil {
-		return claims[isOrgAdmin].(bool)
+	switch isOrgAdmin := getClaim(claims, claimsMapping.OrgAdminClaim).(type) {
+	case bool:
+		return isOrgAdmin
+	case string:
+		return strings.EqualFold(isOrgAdmin, "true")
 	}
 	return false
 }
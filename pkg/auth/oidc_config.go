@@ -0,0 +1,175 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+)
+
+const (
+	oidcDiscoveryPath = "/.well-known/openid-configuration"
+
+	// UserValidationOCM checks with OCM that users belong to the organisation before granting them a role
+	UserValidationOCM = "ocm"
+	// UserValidationNone accepts any user, for identity providers which can't be queried for the users of an organisation
+	UserValidationNone = "none"
+	// UserValidationLocal checks users and their terms acceptance against the users registered with the admin API, for
+	// installations without access to OCM
+	UserValidationLocal = "local"
+)
+
+// ClaimsMapping defines the JWT claims the user details are read from. Claims are either top level claims or paths
+// to nested claims separated by dots, e.g. realm_access.roles.
+type ClaimsMapping struct {
+	// UsernameClaims are checked in order, the first one set in the token is used
+	UsernameClaims []string
+	// OrgIdClaims are checked in order, the first one set in the token is used
+	OrgIdClaims   []string
+	OrgAdminClaim string
+	RolesClaim    string
+}
+
+// DefaultClaimsMapping reads the user details from the claims of sso.redhat.com, OCM and mas-sso tokens
+func DefaultClaimsMapping() ClaimsMapping {
+	return ClaimsMapping{
+		UsernameClaims: []string{ocmUsernameKey, ssoRHUsernameKey},
+		// NOTE: rh-org-id should be removed once we migrate to sso.redhat.com as it will no longer be needed (TODO: to be removed as part of MGDSTRM-6159)
+		OrgIdClaims:   []string{ocmOrgIdKey, masSsoOrgIdKey},
+		OrgAdminClaim: isOrgAdmin,
+		RolesClaim:    "realm_access.roles",
+	}
+}
+
+var claimsMapping = DefaultClaimsMapping()
+
+// SetClaimsMapping changes the claims the user details are read from by the Get*FromClaims functions
+func SetClaimsMapping(mapping ClaimsMapping) {
+	claimsMapping = mapping
+}
+
+// OIDCProvider is the configuration of an OpenID Connect provider read from its discovery document
+type OIDCProvider struct {
+	Issuer  string `json:"issuer"`
+	JwksURI string `json:"jwks_uri"`
+}
+
+// OIDCConfig configures the OpenID Connect providers whose tokens are accepted by the public endpoints, in addition
+// to the token issuer of the server config, and how the user details are read from the tokens.
+type OIDCConfig struct {
+	IssuerURLs       []string
+	DiscoveryTimeout time.Duration
+	ClaimsMapping    ClaimsMapping
+	UserValidation   string
+	// Providers are discovered from the issuer URLs when the config is read
+	Providers []OIDCProvider
+}
+
+func NewOIDCConfig() *OIDCConfig {
+	return &OIDCConfig{
+		DiscoveryTimeout: 10 * time.Second,
+		ClaimsMapping:    DefaultClaimsMapping(),
+		UserValidation:   UserValidationOCM,
+	}
+}
+
+func (c *OIDCConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.StringArrayVar(&c.IssuerURLs, "oidc-issuer-url", c.IssuerURLs, "Issuer URL of an OpenID Connect provider whose tokens are accepted by the public endpoints, its signing keys are found with OpenID Connect discovery. Can be repeated")
+	fs.DurationVar(&c.DiscoveryTimeout, "oidc-discovery-timeout", c.DiscoveryTimeout, "Timeout of the requests reading the OpenID Connect discovery documents of the issuers")
+	fs.StringSliceVar(&c.ClaimsMapping.UsernameClaims, "oidc-username-claims", c.ClaimsMapping.UsernameClaims, "Comma separated list of the JWT claims the username is read from, the first one set in the token is used")
+	fs.StringSliceVar(&c.ClaimsMapping.OrgIdClaims, "oidc-org-id-claims", c.ClaimsMapping.OrgIdClaims, "Comma separated list of the JWT claims the organisation id is read from, the first one set in the token is used")
+	fs.StringVar(&c.ClaimsMapping.OrgAdminClaim, "oidc-org-admin-claim", c.ClaimsMapping.OrgAdminClaim, "JWT claim set to true for the organisation admins")
+	fs.StringVar(&c.ClaimsMapping.RolesClaim, "oidc-roles-claim", c.ClaimsMapping.RolesClaim, "JWT claim listing the roles of the user, e.g. the admin API roles. Nested claims are separated by dots")
+	fs.StringVar(&c.UserValidation, "user-validation", c.UserValidation, fmt.Sprintf("How users are checked to belong to an organisation before being granted a role, and to have accepted the terms when terms acceptance is enabled. One of %s, %s or %s", UserValidationOCM, UserValidationNone, UserValidationLocal))
+}
+
+func (c *OIDCConfig) ReadFiles() error {
+	if len(c.ClaimsMapping.UsernameClaims) == 0 {
+		return errors.New("oidc-username-claims must not be empty")
+	}
+	switch c.UserValidation {
+	case UserValidationOCM, UserValidationNone, UserValidationLocal:
+	default:
+		return errors.Errorf("user-validation must be one of %s, %s or %s", UserValidationOCM, UserValidationNone, UserValidationLocal)
+	}
+	SetClaimsMapping(c.ClaimsMapping)
+
+	client := &http.Client{Timeout: c.DiscoveryTimeout}
+	c.Providers = nil
+	for _, issuerURL := range c.IssuerURLs {
+		provider, err := discoverOIDCProvider(client, issuerURL)
+		if err != nil {
+			return err
+		}
+		c.Providers = append(c.Providers, provider)
+	}
+	return nil
+}
+
+// Issuers returns the issuers of the discovered providers
+func (c *OIDCConfig) Issuers() []string {
+	var issuers []string
+	for _, provider := range c.Providers {
+		issuers = append(issuers, provider.Issuer)
+	}
+	return issuers
+}
+
+func discoverOIDCProvider(client *http.Client, issuerURL string) (OIDCProvider, error) {
+	var provider OIDCProvider
+	discoveryURL := strings.TrimSuffix(issuerURL, "/") + oidcDiscoveryPath
+	resp, err := client.Get(discoveryURL)
+	if err != nil {
+		return provider, errors.Wrapf(err, "unable to read the OpenID Connect discovery document of %s", issuerURL)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return provider, errors.Errorf("unable to read the OpenID Connect discovery document of %s: %s", issuerURL, resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&provider); err != nil {
+		return provider, errors.Wrapf(err, "invalid OpenID Connect discovery document at %s", discoveryURL)
+	}
+
+	// as required by the OpenID Connect discovery specification, the issuer must be the URL the document was read from
+	if provider.Issuer != issuerURL {
+		return provider, errors.Errorf("issuer %q of the OpenID Connect discovery document at %s doesn't match %s", provider.Issuer, discoveryURL, issuerURL)
+	}
+	if provider.JwksURI == "" {
+		return provider, errors.Errorf("OpenID Connect discovery document at %s has no jwks_uri", discoveryURL)
+	}
+	return provider, nil
+}
+
+// getClaim returns the value of a top level claim, or of a nested claim if the name contains dots
+func getClaim(claims jwt.MapClaims, name string) interface{} {
+	if name == "" {
+		return nil
+	}
+	if value, ok := claims[name]; ok {
+		return value
+	}
+	var value interface{} = map[string]interface{}(claims)
+	for _, key := range strings.Split(name, ".") {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = object[key]
+	}
+	return value
+}
+
+// getStringClaim returns the value of the first of the claims set to a non empty string
+func getStringClaim(claims jwt.MapClaims, names []string) string {
+	for _, name := range names {
+		if value, ok := getClaim(claims, name).(string); ok && value != "" {
+			return value
+		}
+	}
+	return ""
+}
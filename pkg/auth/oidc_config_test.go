@@ -0,0 +1,140 @@
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/onsi/gomega"
+)
+
+func TestOIDCConfig_ReadFiles(t *testing.T) {
+	tests := []struct {
+		name          string
+		issuer        func(serverURL string) string
+		jwksURI       string
+		status        int
+		wantProviders bool
+		wantErr       bool
+	}{
+		{
+			name:          "should discover the providers of the issuers",
+			issuer:        func(serverURL string) string { return serverURL },
+			jwksURI:       "/certs",
+			status:        http.StatusOK,
+			wantProviders: true,
+		},
+		{
+			name:    "should fail when the discovery document can't be read",
+			issuer:  func(serverURL string) string { return serverURL },
+			status:  http.StatusNotFound,
+			wantErr: true,
+		},
+		{
+			name:    "should fail when the issuer doesn't match the discovery document url",
+			issuer:  func(serverURL string) string { return "https://other-issuer" },
+			jwksURI: "/certs",
+			status:  http.StatusOK,
+			wantErr: true,
+		},
+		{
+			name:    "should fail when the discovery document has no jwks uri",
+			issuer:  func(serverURL string) string { return serverURL },
+			status:  http.StatusOK,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			var server *httptest.Server
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gomega.Expect(r.URL.Path).To(gomega.Equal(oidcDiscoveryPath))
+				w.WriteHeader(tt.status)
+				_ = json.NewEncoder(w).Encode(map[string]string{"issuer": tt.issuer(server.URL), "jwks_uri": tt.jwksURI})
+			}))
+			defer server.Close()
+			defer SetClaimsMapping(DefaultClaimsMapping())
+
+			config := NewOIDCConfig()
+			config.IssuerURLs = []string{server.URL}
+			err := config.ReadFiles()
+
+			gomega.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantProviders {
+				gomega.Expect(config.Providers).To(gomega.Equal([]OIDCProvider{{Issuer: server.URL, JwksURI: tt.jwksURI}}))
+				gomega.Expect(config.Issuers()).To(gomega.Equal([]string{server.URL}))
+			}
+		})
+	}
+}
+
+func Test_ClaimsMapping(t *testing.T) {
+	tests := []struct {
+		name         string
+		mapping      ClaimsMapping
+		claims       jwt.MapClaims
+		wantUsername string
+		wantOrgId    string
+		wantOrgAdmin bool
+		wantRoles    []string
+	}{
+		{
+			name:    "should read the claims of sso.redhat.com tokens by default",
+			mapping: DefaultClaimsMapping(),
+			claims: jwt.MapClaims{
+				"preferred_username": "test-user",
+				"org_id":             "test-org",
+				"is_org_admin":       true,
+				"realm_access":       map[string]interface{}{"roles": []interface{}{"kas-fleet-manager-admin-read"}},
+			},
+			wantUsername: "test-user",
+			wantOrgId:    "test-org",
+			wantOrgAdmin: true,
+			wantRoles:    []string{"kas-fleet-manager-admin-read"},
+		},
+		{
+			name: "should read the configured claims",
+			mapping: ClaimsMapping{
+				UsernameClaims: []string{"email", "sub"},
+				OrgIdClaims:    []string{"tenant.id"},
+				OrgAdminClaim:  "tenant.admin",
+				RolesClaim:     "groups",
+			},
+			claims: jwt.MapClaims{
+				"sub":    "1234",
+				"tenant": map[string]interface{}{"id": "test-org", "admin": "true"},
+				"groups": []interface{}{"kas-fleet-manager-admin-full"},
+				// ignored as not mapped
+				"org_id":       "other-org",
+				"realm_access": map[string]interface{}{"roles": []interface{}{"kas-fleet-manager-admin-read"}},
+			},
+			wantUsername: "1234",
+			wantOrgId:    "test-org",
+			wantOrgAdmin: true,
+			wantRoles:    []string{"kas-fleet-manager-admin-full"},
+		},
+		{
+			name:      "should return empty values for missing claims",
+			mapping:   DefaultClaimsMapping(),
+			claims:    jwt.MapClaims{},
+			wantRoles: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			SetClaimsMapping(tt.mapping)
+			defer SetClaimsMapping(DefaultClaimsMapping())
+
+			gomega.Expect(GetUsernameFromClaims(tt.claims)).To(gomega.Equal(tt.wantUsername))
+			gomega.Expect(GetOrgIdFromClaims(tt.claims)).To(gomega.Equal(tt.wantOrgId))
+			gomega.Expect(GetIsOrgAdminFromClaims(tt.claims)).To(gomega.Equal(tt.wantOrgAdmin))
+			gomega.Expect(getRealmRolesClaim(tt.claims)).To(gomega.Equal(tt.wantRoles))
+		})
+	}
+}
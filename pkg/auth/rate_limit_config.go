@@ -0,0 +1,134 @@
+package auth
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	RateLimitScopeUser         = "user"
+	RateLimitScopeOrganisation = "organisation"
+
+	RateLimitStoreMemory   = "memory"
+	RateLimitStorePostgres = "postgres"
+
+	defaultRateLimitBudgetName = "default"
+)
+
+// RateLimitBudget is the number of requests a user, or all the users of an organisation, can send to the routes
+// matching Path and Method within Window
+type RateLimitBudget struct {
+	Name string `yaml:"name"`
+	// Path is the route path template (i.e. /api/kafkas_mgmt/v1/kafkas/{id}). A trailing '*' matches any route starting
+	// with the given prefix.
+	Path string `yaml:"path"`
+	// Method is the HTTP method of the requests the budget applies to. All methods match if empty.
+	Method   string        `yaml:"method"`
+	Scope    string        `yaml:"scope"`
+	Requests int           `yaml:"requests"`
+	Window   time.Duration `yaml:"window"`
+}
+
+func (b *RateLimitBudget) matches(method string, pathTemplate string) bool {
+	if b.Method != "" && !strings.EqualFold(b.Method, method) {
+		return false
+	}
+	if strings.HasSuffix(b.Path, "*") {
+		return strings.HasPrefix(pathTemplate, strings.TrimSuffix(b.Path, "*"))
+	}
+	return b.Path == pathTemplate
+}
+
+type RateLimitConfig struct {
+	EnableRateLimit     bool
+	RateLimitConfigFile string
+	// Store is where request counters are kept, either in memory (per replica) or in postgres (shared across replicas)
+	Store string
+	// DefaultBudget applies to the requests that don't match any of the configured Budgets
+	DefaultBudget RateLimitBudget
+	Budgets       []RateLimitBudget
+}
+
+func NewRateLimitConfig() *RateLimitConfig {
+	return &RateLimitConfig{
+		EnableRateLimit:     false,
+		RateLimitConfigFile: "config/rate-limit-configuration.yaml",
+		Store:               RateLimitStoreMemory,
+		DefaultBudget: RateLimitBudget{
+			Name:     defaultRateLimitBudgetName,
+			Scope:    RateLimitScopeUser,
+			Requests: 600,
+			Window:   time.Minute,
+		},
+	}
+}
+
+func (c *RateLimitConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&c.EnableRateLimit, "enable-rate-limit", c.EnableRateLimit, "Enable rate limiting of the API requests per user and organisation")
+	fs.StringVar(&c.RateLimitConfigFile, "rate-limit-config-file", c.RateLimitConfigFile, "Rate limit budgets per route configuration file")
+	fs.StringVar(&c.Store, "rate-limit-store", c.Store, "Where to keep rate limit counters, either 'memory' (per replica) or 'postgres' (shared across replicas)")
+	fs.IntVar(&c.DefaultBudget.Requests, "rate-limit-default-requests", c.DefaultBudget.Requests, "Number of requests a user can send within the default rate limit window to routes without a configured budget")
+	fs.DurationVar(&c.DefaultBudget.Window, "rate-limit-default-window", c.DefaultBudget.Window, "Default rate limit window")
+}
+
+func (c *RateLimitConfig) ReadFiles() error {
+	if !c.EnableRateLimit {
+		return nil
+	}
+
+	if err := readRateLimitConfigFile(c.RateLimitConfigFile, &c.Budgets); err != nil {
+		return err
+	}
+	return c.validate()
+}
+
+func (c *RateLimitConfig) validate() error {
+	if c.Store != RateLimitStoreMemory && c.Store != RateLimitStorePostgres {
+		return fmt.Errorf("invalid rate limit store %q, expected %q or %q", c.Store, RateLimitStoreMemory, RateLimitStorePostgres)
+	}
+
+	names := map[string]bool{}
+	budgets := append([]RateLimitBudget{c.DefaultBudget}, c.Budgets...)
+	for i := range budgets {
+		b := &budgets[i]
+		if b.Name == "" {
+			return fmt.Errorf("rate limit budget for path %q has no name", b.Path)
+		}
+		if names[b.Name] {
+			return fmt.Errorf("rate limit budget %q is defined more than once", b.Name)
+		}
+		names[b.Name] = true
+		if b.Scope != "" && b.Scope != RateLimitScopeUser && b.Scope != RateLimitScopeOrganisation {
+			return fmt.Errorf("invalid scope %q of rate limit budget %q, expected %q or %q", b.Scope, b.Name, RateLimitScopeUser, RateLimitScopeOrganisation)
+		}
+		if b.Requests <= 0 || b.Window <= 0 {
+			return fmt.Errorf("rate limit budget %q must allow a positive number of requests in a positive window", b.Name)
+		}
+	}
+	return nil
+}
+
+// findBudget returns the first configured budget matching the request, or the default budget
+func (c *RateLimitConfig) findBudget(method string, pathTemplate string) *RateLimitBudget {
+	for i := range c.Budgets {
+		if c.Budgets[i].matches(method, pathTemplate) {
+			return &c.Budgets[i]
+		}
+	}
+	return &c.DefaultBudget
+}
+
+// Read the contents of file into the rate limit budgets
+func readRateLimitConfigFile(file string, val *[]RateLimitBudget) error {
+	fileContents, err := shared.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	return yaml.UnmarshalStrict([]byte(fileContents), val)
+}
@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+)
+
+func TestRateLimitConfig_ReadFiles(t *testing.T) {
+	tests := []struct {
+		name     string
+		modifyFn func(config *RateLimitConfig)
+		wantErr  bool
+	}{
+		{
+			name: "should read the default rate limit configuration file",
+		},
+		{
+			name: "should fail when the store is not supported",
+			modifyFn: func(config *RateLimitConfig) {
+				config.Store = "redis"
+			},
+			wantErr: true,
+		},
+		{
+			name: "should fail when the default budget has no requests",
+			modifyFn: func(config *RateLimitConfig) {
+				config.DefaultBudget.Requests = 0
+			},
+			wantErr: true,
+		},
+		{
+			name: "should fail when the configuration file does not exist",
+			modifyFn: func(config *RateLimitConfig) {
+				config.RateLimitConfigFile = "config/does-not-exist.yaml"
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			config := NewRateLimitConfig()
+			config.EnableRateLimit = true
+			if tt.modifyFn != nil {
+				tt.modifyFn(config)
+			}
+			err := config.ReadFiles()
+			gomega.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if !tt.wantErr {
+				budget := config.findBudget("POST", "/api/kafkas_mgmt/v1/kafkas")
+				gomega.Expect(budget.Name).To(gomega.Equal("create-kafka"))
+				gomega.Expect(budget.Window).To(gomega.Equal(time.Minute))
+				gomega.Expect(config.findBudget("GET", "/api/kafkas_mgmt/v1/kafkas").Name).To(gomega.Equal(defaultRateLimitBudgetName))
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/gorilla/mux"
+)
+
+const (
+	rateLimitResultAllowed  = "allowed"
+	rateLimitResultRejected = "rejected"
+	rateLimitResultError    = "error"
+)
+
+type RateLimitMiddleware struct {
+	rateLimitConfig *RateLimitConfig
+	store           RateLimitStore
+}
+
+func NewRateLimitMiddleware(rateLimitConfig *RateLimitConfig, connectionFactory *db.ConnectionFactory) *RateLimitMiddleware {
+	var store RateLimitStore
+	if rateLimitConfig.Store == RateLimitStorePostgres {
+		store = NewPostgresRateLimitStore(connectionFactory)
+	} else {
+		store = NewMemoryRateLimitStore()
+	}
+	return &RateLimitMiddleware{
+		rateLimitConfig: rateLimitConfig,
+		store:           store,
+	}
+}
+
+// Middleware handler to reject requests once the user, or the organisation of the user, has used up the rate limit
+// budget of the route. Rejected requests get a 429 response with a Retry-After header set to the number of seconds
+// until the budget is renewed.
+func (m *RateLimitMiddleware) RateLimit(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !m.rateLimitConfig.EnableRateLimit {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := r.Context()
+		claims, err := GetClaimsFromContext(ctx)
+		if err != nil {
+			shared.HandleError(r, w, errors.NewWithCause(errors.ErrorUnauthenticated, err, ""))
+			return
+		}
+
+		pathTemplate := r.URL.Path
+		if route := mux.CurrentRoute(r); route != nil {
+			if tpl, err := route.GetPathTemplate(); err == nil {
+				pathTemplate = tpl
+			}
+		}
+		budget := m.rateLimitConfig.findBudget(r.Method, pathTemplate)
+
+		scope := budget.Scope
+		subject := GetUsernameFromClaims(claims)
+		if scope == RateLimitScopeOrganisation {
+			if orgId := GetOrgIdFromClaims(claims); orgId != "" {
+				subject = orgId
+			} else {
+				// users without an organisation are limited on their own
+				scope = RateLimitScopeUser
+			}
+		} else {
+			scope = RateLimitScopeUser
+		}
+
+		now := time.Now()
+		windowStart := now.Truncate(budget.Window)
+		key := fmt.Sprintf("%s:%s:%s", budget.Name, scope, subject)
+		count, err := m.store.Increment(key, windowStart, budget.Window)
+		if err != nil {
+			// don't reject requests because the counters are unavailable
+			logger.NewUHCLogger(ctx).Errorf("failed to check rate limit budget %q: %v", budget.Name, err)
+			metrics.IncreaseRateLimitRequestCount(budget.Name, scope, rateLimitResultError)
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if count > budget.Requests {
+			metrics.IncreaseRateLimitRequestCount(budget.Name, scope, rateLimitResultRejected)
+			retryAfter := int(math.Ceil(windowStart.Add(budget.Window).Sub(now).Seconds()))
+			if retryAfter < 1 {
+				retryAfter = 1
+			}
+			w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+			shared.HandleError(r, w, errors.RateLimitExceeded("%s '%s' exceeded the rate limit of %d requests per %s, retry in %d seconds",
+				scope, subject, budget.Requests, budget.Window, retryAfter))
+			return
+		}
+
+		metrics.IncreaseRateLimitRequestCount(budget.Name, scope, rateLimitResultAllowed)
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,110 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+)
+
+func TestRateLimitMiddleware(t *testing.T) {
+	type request struct {
+		method   string
+		claims   jwt.MapClaims
+		wantCode int
+	}
+
+	userA := jwt.MapClaims{ocmUsernameKey: "user-a", ocmOrgIdKey: "org-1"}
+	userB := jwt.MapClaims{ocmUsernameKey: "user-b", ocmOrgIdKey: "org-1"}
+	userC := jwt.MapClaims{ocmUsernameKey: "user-c", ocmOrgIdKey: "org-2"}
+
+	tests := []struct {
+		name     string
+		enabled  bool
+		budgets  []RateLimitBudget
+		requests []request
+	}{
+		{
+			name:    "should not limit requests when rate limiting is disabled",
+			enabled: false,
+			requests: []request{
+				{method: http.MethodGet, claims: userA, wantCode: http.StatusOK},
+				{method: http.MethodGet, claims: userA, wantCode: http.StatusOK},
+				{method: http.MethodGet, claims: userA, wantCode: http.StatusOK},
+			},
+		},
+		{
+			name:    "should limit each user with the default budget",
+			enabled: true,
+			requests: []request{
+				{method: http.MethodGet, claims: userA, wantCode: http.StatusOK},
+				{method: http.MethodGet, claims: userA, wantCode: http.StatusOK},
+				{method: http.MethodGet, claims: userA, wantCode: http.StatusTooManyRequests},
+				{method: http.MethodGet, claims: userB, wantCode: http.StatusOK},
+			},
+		},
+		{
+			name:    "should share organisation budgets between the users of the organisation",
+			enabled: true,
+			budgets: []RateLimitBudget{
+				{Name: "create-kafka", Path: "/kafkas", Method: http.MethodPost, Scope: RateLimitScopeOrganisation, Requests: 1, Window: time.Hour},
+			},
+			requests: []request{
+				{method: http.MethodPost, claims: userA, wantCode: http.StatusOK},
+				{method: http.MethodPost, claims: userB, wantCode: http.StatusTooManyRequests},
+				{method: http.MethodPost, claims: userC, wantCode: http.StatusOK},
+				{method: http.MethodGet, claims: userB, wantCode: http.StatusOK},
+			},
+		},
+		{
+			name:    "should match budgets on path prefixes",
+			enabled: true,
+			budgets: []RateLimitBudget{
+				{Name: "kafkas", Path: "/kaf*", Scope: RateLimitScopeUser, Requests: 1, Window: time.Hour},
+			},
+			requests: []request{
+				{method: http.MethodGet, claims: userA, wantCode: http.StatusOK},
+				{method: http.MethodPost, claims: userA, wantCode: http.StatusTooManyRequests},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			config := NewRateLimitConfig()
+			config.EnableRateLimit = tt.enabled
+			config.DefaultBudget.Requests = 2
+			config.DefaultBudget.Window = time.Hour
+			config.Budgets = tt.budgets
+			gomega.Expect(config.validate()).To(gomega.Succeed())
+
+			var claims jwt.MapClaims
+			router := mux.NewRouter()
+			router.Use(func(next http.Handler) http.Handler {
+				return setContextToken(next, &jwt.Token{Claims: claims})
+			})
+			router.Use(NewRateLimitMiddleware(config, nil).RateLimit)
+			router.HandleFunc("/kafkas", func(writer http.ResponseWriter, request *http.Request) {
+				shared.WriteJSONResponse(writer, http.StatusOK, "")
+			})
+
+			for _, req := range tt.requests {
+				claims = req.claims
+				recorder := httptest.NewRecorder()
+				router.ServeHTTP(recorder, httptest.NewRequest(req.method, "http://example.com/kafkas", nil))
+				gomega.Expect(recorder.Result().StatusCode).To(gomega.Equal(req.wantCode))
+				if req.wantCode == http.StatusTooManyRequests {
+					gomega.Expect(recorder.Result().Header.Get("Retry-After")).ToNot(gomega.BeEmpty())
+					gomega.Expect(recorder.Body.String()).To(gomega.ContainSubstring(errors.CodeStr(errors.ErrorRateLimitExceeded)))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package auth
+
+import (
+	"sync"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+)
+
+// rateLimitCleanupInterval is how often expired counters are removed from the store
+const rateLimitCleanupInterval = time.Minute
+
+// RateLimitStore keeps the number of requests counted against a key in fixed time windows
+type RateLimitStore interface {
+	// Increment counts a request against the key in the window starting at windowStart and returns the number of
+	// requests counted in that window so far
+	Increment(key string, windowStart time.Time, window time.Duration) (int, error)
+}
+
+type rateLimitCounter struct {
+	windowStart time.Time
+	expires     time.Time
+	count       int
+}
+
+type memoryRateLimitStore struct {
+	mutex       sync.Mutex
+	counters    map[string]*rateLimitCounter
+	lastCleanup time.Time
+}
+
+var _ RateLimitStore = &memoryRateLimitStore{}
+
+// NewMemoryRateLimitStore returns a store that keeps the counters in memory, so limits are enforced per replica
+func NewMemoryRateLimitStore() RateLimitStore {
+	return &memoryRateLimitStore{
+		counters:    map[string]*rateLimitCounter{},
+		lastCleanup: time.Now(),
+	}
+}
+
+func (s *memoryRateLimitStore) Increment(key string, windowStart time.Time, window time.Duration) (int, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := time.Now()
+	if now.Sub(s.lastCleanup) > rateLimitCleanupInterval {
+		for k, c := range s.counters {
+			if c.expires.Before(now) {
+				delete(s.counters, k)
+			}
+		}
+		s.lastCleanup = now
+	}
+
+	counter, ok := s.counters[key]
+	if !ok || !counter.windowStart.Equal(windowStart) {
+		counter = &rateLimitCounter{
+			windowStart: windowStart,
+			expires:     windowStart.Add(window),
+		}
+		s.counters[key] = counter
+	}
+	counter.count++
+	return counter.count, nil
+}
+
+type postgresRateLimitStore struct {
+	connectionFactory *db.ConnectionFactory
+	mutex             sync.Mutex
+	lastCleanup       time.Time
+}
+
+var _ RateLimitStore = &postgresRateLimitStore{}
+
+// NewPostgresRateLimitStore returns a store that keeps the counters in the rate_limit_counters table, so limits are
+// shared across all the replicas of the service
+func NewPostgresRateLimitStore(connectionFactory *db.ConnectionFactory) RateLimitStore {
+	return &postgresRateLimitStore{
+		connectionFactory: connectionFactory,
+		lastCleanup:       time.Now(),
+	}
+}
+
+func (s *postgresRateLimitStore) Increment(key string, windowStart time.Time, window time.Duration) (int, error) {
+	dbConn := s.connectionFactory.New()
+
+	var count int
+	if err := dbConn.Raw(`INSERT INTO rate_limit_counters (key, window_start, expires_at, count) VALUES (?, ?, ?, 1)
+		ON CONFLICT (key, window_start) DO UPDATE SET count = rate_limit_counters.count + 1
+		RETURNING count`, key, windowStart, windowStart.Add(window)).Scan(&count).Error; err != nil {
+		return 0, err
+	}
+
+	if s.shouldCleanup() {
+		if err := dbConn.Exec("DELETE FROM rate_limit_counters WHERE expires_at < ?", time.Now()).Error; err != nil {
+			return 0, err
+		}
+	}
+	return count, nil
+}
+
+func (s *postgresRateLimitStore) shouldCleanup() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := time.Now()
+	if now.Sub(s.lastCleanup) > rateLimitCleanupInterval {
+		s.lastCleanup = now
+		return true
+	}
+	return false
+}
@@ -4,18 +4,22 @@ import (
 	"net/http"
 	"time"
 
-	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/ocm"
-
 	"github.com/patrickmn/go-cache"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 )
 
+// TermsAcceptanceClient checks whether users have accepted the required terms. It is implemented by the OCM AMS client,
+// and by the local authorization for installations without access to OCM.
+type TermsAcceptanceClient interface {
+	GetRequiresTermsAcceptance(username string) (termsRequired bool, redirectUrl string, err error)
+}
+
 type RequireTermsAcceptanceMiddleware interface {
 	// RequireTermsAcceptance will check that the user has accepted the required terms.
-	// The current implementation is backed by OCM and can be disabled with the "enabled" flag set to false.
-	RequireTermsAcceptance(enabled bool, amsClient ocm.AMSClient, code errors.ServiceErrorCode) func(handler http.Handler) http.Handler
+	// The check is done by the given client and can be disabled with the "enabled" flag set to false.
+	RequireTermsAcceptance(enabled bool, termsClient TermsAcceptanceClient, code errors.ServiceErrorCode) func(handler http.Handler) http.Handler
 }
 
 type requireTermsAcceptanceMiddleware struct {
@@ -31,7 +35,7 @@ func NewRequireTermsAcceptanceMiddleware() RequireTermsAcceptanceMiddleware {
 	}
 }
 
-func (m *requireTermsAcceptanceMiddleware) RequireTermsAcceptance(enabled bool, amsClient ocm.AMSClient, code errors.ServiceErrorCode) func(handler http.Handler) http.Handler {
+func (m *requireTermsAcceptanceMiddleware) RequireTermsAcceptance(enabled bool, termsClient TermsAcceptanceClient, code errors.ServiceErrorCode) func(handler http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
 			if enabled {
@@ -44,7 +48,7 @@ func (m *requireTermsAcceptanceMiddleware) RequireTermsAcceptance(enabled bool,
 				username := GetUsernameFromClaims(claims)
 				termsRequired, cached := m.cache.Get(username)
 				if !cached {
-					termsRequired, _, err = amsClient.GetRequiresTermsAcceptance(username)
+					termsRequired, _, err = termsClient.GetRequiresTermsAcceptance(username)
 					if err != nil {
 						shared.HandleError(request, writer, errors.NewWithCause(code, err, ""))
 						return
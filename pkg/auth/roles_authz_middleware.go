@@ -93,18 +93,15 @@ func (m *rolesAuthMiddleware) RequireRolesForMethods(roles map[string][]string,
 }
 
 func getRealmRolesClaim(claims jwt.MapClaims) []string {
-	if realmRoles, ok := claims["realm_access"]; ok {
-		if roles, ok := realmRoles.(map[string]interface{}); ok {
-			if arr, ok := roles["roles"].([]interface{}); ok {
-				var r []string
-				for _, i := range arr {
-					r = append(r, i.(string))
-				}
-				return r
+	r := []string{}
+	if arr, ok := getClaim(claims, claimsMapping.RolesClaim).([]interface{}); ok {
+		for _, i := range arr {
+			if role, ok := i.(string); ok {
+				r = append(r, role)
 			}
 		}
 	}
-	return []string{}
+	return r
 }
 
 func hasRole(roles []string, roleName string) bool {
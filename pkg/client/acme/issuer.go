@@ -0,0 +1,296 @@
+package acme
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/dns"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/acme"
+)
+
+const (
+	challengeRecordPrefix = "_acme-challenge."
+	challengeRecordTTL    = 60
+	certificateKeySize    = 2048
+)
+
+// Config contains the settings of the ACME certificate authority
+type Config struct {
+	// DirectoryURL is the URL of the directory of the certificate authority
+	DirectoryURL string
+	// AccountKey is the PEM encoded private key of the ACME account, a new account is registered at every start if empty
+	AccountKey   string
+	AccountEmail string
+	// CABundle contains PEM encoded certificates trusted to reach the directory in addition to the system ones, e.g.
+	// the certificate of a Pebble test server
+	CABundle string
+	// PropagationTimeout is how long the DNS provider is waited for to publish the challenge records
+	PropagationTimeout time.Duration
+	// PropagationInterval is the interval the changes of the challenge records are polled at
+	PropagationInterval time.Duration
+}
+
+// Certificate is a certificate issued by the certificate authority
+type Certificate struct {
+	// Certificate is the PEM encoded certificate chain, starting with the certificate of the domains
+	Certificate string
+	// Key is the PEM encoded private key of the certificate
+	Key      string
+	NotAfter time.Time
+}
+
+//go:generate moq -out issuer_moq.go . Issuer
+type Issuer interface {
+	// Issue requests a certificate for the domains. The control of the domains is proven with DNS-01 challenges whose
+	// records are created with the DNS provider in the zone of the DNS domain. The domains outside of the DNS domain
+	// must delegate their challenge with a CNAME record from ChallengeRecordName(domain) to the name challengeAliases
+	// maps them to in the DNS domain.
+	Issue(ctx context.Context, domains []string, challengeAliases map[string]string) (*Certificate, error)
+}
+
+type issuer struct {
+	config      Config
+	client      *acme.Client
+	dnsProvider dns.Provider
+	dnsDomain   string
+	mu          sync.Mutex
+	registered  bool
+}
+
+var _ Issuer = &issuer{}
+
+func NewIssuer(config Config, dnsProvider dns.Provider, dnsDomain string) (Issuer, error) {
+	accountKey, err := parseAccountKey(config.AccountKey)
+	if err != nil {
+		return nil, err
+	}
+	httpClient, err := newHTTPClient(config.CABundle)
+	if err != nil {
+		return nil, err
+	}
+	return &issuer{
+		config: config,
+		client: &acme.Client{
+			Key:          accountKey,
+			DirectoryURL: config.DirectoryURL,
+			HTTPClient:   httpClient,
+			UserAgent:    "kas-fleet-manager",
+		},
+		dnsProvider: dnsProvider,
+		dnsDomain:   dnsDomain,
+	}, nil
+}
+
+// ChallengeRecordName returns the name of the DNS-01 challenge record of the domain
+func ChallengeRecordName(domain string) string {
+	return challengeRecordPrefix + domain
+}
+
+func (i *issuer) Issue(ctx context.Context, domains []string, challengeAliases map[string]string) (*Certificate, error) {
+	if len(domains) == 0 {
+		return nil, errors.New("no domain to issue a certificate for")
+	}
+	if err := i.register(ctx); err != nil {
+		return nil, err
+	}
+
+	order, err := i.client.AuthorizeOrder(ctx, acme.DomainIDs(domains...))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create the certificate order")
+	}
+	for _, authzURL := range order.AuthzURLs {
+		if err := i.authorize(ctx, authzURL, challengeAliases); err != nil {
+			return nil, err
+		}
+	}
+	order, err = i.client.WaitOrder(ctx, order.URI)
+	if err != nil {
+		return nil, errors.Wrap(err, "the certificate order was not authorized")
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, certificateKeySize)
+	if err != nil {
+		return nil, err
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: domains[0]},
+		DNSNames: domains,
+	}, key)
+	if err != nil {
+		return nil, err
+	}
+	chain, _, err := i.client.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to finalize the certificate order")
+	}
+	return encodeCertificate(chain, key)
+}
+
+// register registers the account on first use, registering an account that already exists returns it
+func (i *issuer) register(ctx context.Context) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if i.registered {
+		return nil
+	}
+	account := &acme.Account{}
+	if i.config.AccountEmail != "" {
+		account.Contact = []string{"mailto:" + i.config.AccountEmail}
+	}
+	if _, err := i.client.Register(ctx, account, acme.AcceptTOS); err != nil && err != acme.ErrAccountAlreadyExists {
+		return errors.Wrap(err, "unable to register the ACME account")
+	}
+	i.registered = true
+	return nil
+}
+
+// authorize proves the control of the domain of the authorization with a DNS-01 challenge
+func (i *issuer) authorize(ctx context.Context, authzURL string, challengeAliases map[string]string) error {
+	authz, err := i.client.GetAuthorization(ctx, authzURL)
+	if err != nil {
+		return errors.Wrap(err, "unable to get the authorization")
+	}
+	if authz.Status == acme.StatusValid {
+		return nil
+	}
+	var challenge *acme.Challenge
+	for _, c := range authz.Challenges {
+		if c.Type == "dns-01" {
+			challenge = c
+		}
+	}
+	if challenge == nil {
+		return errors.Errorf("no dns-01 challenge is offered for %s", authz.Identifier.Value)
+	}
+
+	value, err := i.client.DNS01ChallengeRecord(challenge.Token)
+	if err != nil {
+		return err
+	}
+	name := ChallengeRecordName(authz.Identifier.Value)
+	if alias, ok := challengeAliases[authz.Identifier.Value]; ok {
+		name = alias
+	}
+	record := dns.Record{
+		Type:  dns.RecordTypeTXT,
+		Name:  name,
+		Value: value,
+		TTL:   challengeRecordTTL,
+	}
+	if err := i.publish(ctx, record); err != nil {
+		return err
+	}
+	defer func() {
+		if _, err := i.dnsProvider.ChangeRecords(i.dnsDomain, dns.ActionDelete, []dns.Record{record}); err != nil {
+			glog.Warningf("unable to delete the challenge record %s: %v", record.Name, err)
+		}
+	}()
+
+	if _, err := i.client.Accept(ctx, challenge); err != nil {
+		return errors.Wrapf(err, "unable to accept the challenge of %s", authz.Identifier.Value)
+	}
+	if _, err := i.client.WaitAuthorization(ctx, authz.URI); err != nil {
+		return errors.Wrapf(err, "the control of %s was not proven", authz.Identifier.Value)
+	}
+	return nil
+}
+
+// publish upserts the record and waits for the DNS provider to publish it, the record of a previous attempt whose
+// deletion failed is replaced
+func (i *issuer) publish(ctx context.Context, record dns.Record) error {
+	change, err := i.dnsProvider.ChangeRecords(i.dnsDomain, dns.ActionUpsert, []dns.Record{record})
+	if err != nil {
+		return errors.Wrapf(err, "unable to create the challenge record %s", record.Name)
+	}
+	deadline := time.Now().Add(i.config.PropagationTimeout)
+	for !change.InSync {
+		if time.Now().After(deadline) {
+			return errors.Errorf("the challenge record %s was not published in %s", record.Name, i.config.PropagationTimeout)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(i.config.PropagationInterval):
+		}
+		if change, err = i.dnsProvider.GetChange(i.dnsDomain, change.Id); err != nil {
+			return errors.Wrapf(err, "unable to get the status of the challenge record %s", record.Name)
+		}
+	}
+	return nil
+}
+
+func encodeCertificate(chain [][]byte, key *rsa.PrivateKey) (*Certificate, error) {
+	leaf, err := x509.ParseCertificate(chain[0])
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to parse the issued certificate")
+	}
+	var certificate strings.Builder
+	for _, der := range chain {
+		certificate.Write(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return &Certificate{
+		Certificate: certificate.String(),
+		Key:         string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})),
+		NotAfter:    leaf.NotAfter,
+	}, nil
+}
+
+func parseAccountKey(accountKey string) (crypto.Signer, error) {
+	if accountKey == "" {
+		glog.Warningln("no ACME account key is configured, a new account is registered")
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	}
+	block, _ := pem.Decode([]byte(accountKey))
+	if block == nil {
+		return nil, errors.New("the ACME account key is not PEM encoded")
+	}
+	switch block.Type {
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to parse the ACME account key")
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("the ACME account key can't sign")
+	}
+	return signer, nil
+}
+
+func newHTTPClient(caBundle string) (*http.Client, error) {
+	if caBundle == "" {
+		return http.DefaultClient, nil
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM([]byte(caBundle)) {
+		return nil, errors.New("the ACME CA bundle doesn't contain any PEM encoded certificate")
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+	return &http.Client{Transport: transport}, nil
+}
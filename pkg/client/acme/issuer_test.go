@@ -0,0 +1,79 @@
+package acme
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/dns"
+	. "github.com/onsi/gomega"
+)
+
+// TestIssuer_Issue runs against a Pebble test server started with PEBBLE_VA_ALWAYS_VALID=1 (see `make acme/setup`), so
+// that the challenge records don't need to be resolvable
+func TestIssuer_Issue(t *testing.T) {
+	directoryURL := os.Getenv("ACME_DIRECTORY_URL")
+	if directoryURL == "" {
+		t.Skip("ACME_DIRECTORY_URL is not set")
+	}
+	RegisterTestingT(t)
+
+	caBundle, err := ioutil.ReadFile(os.Getenv("ACME_CA_BUNDLE_FILE"))
+	Expect(err).ToNot(HaveOccurred())
+	var records []dns.Record
+	provider := &dns.ProviderMock{
+		ChangeRecordsFunc: func(domain string, action dns.Action, changed []dns.Record) (*dns.Change, error) {
+			if action == dns.ActionUpsert {
+				records = append(records, changed...)
+			}
+			return &dns.Change{InSync: true}, nil
+		},
+	}
+	issuer, err := NewIssuer(Config{DirectoryURL: directoryURL, CABundle: string(caBundle)}, provider, "kafka.example.com")
+	Expect(err).ToNot(HaveOccurred())
+
+	certificate, err := issuer.Issue(context.Background(), []string{"my-kafka.kafka.example.com", "kafka.customer.com"}, map[string]string{
+		"kafka.customer.com": "_acme-challenge.customer.kafka.example.com",
+	})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(records).To(HaveLen(2))
+	Expect(records[0].Type).To(Equal(dns.RecordTypeTXT))
+	names := []string{records[0].Name, records[1].Name}
+	Expect(names).To(ConsistOf("_acme-challenge.my-kafka.kafka.example.com", "_acme-challenge.customer.kafka.example.com"))
+
+	block, _ := pem.Decode([]byte(certificate.Certificate))
+	leaf, err := x509.ParseCertificate(block.Bytes)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(leaf.DNSNames).To(ConsistOf("my-kafka.kafka.example.com", "kafka.customer.com"))
+	Expect(certificate.NotAfter).To(Equal(leaf.NotAfter))
+}
+
+func TestIssuer_publish(t *testing.T) {
+	RegisterTestingT(t)
+
+	polls := 0
+	provider := &dns.ProviderMock{
+		ChangeRecordsFunc: func(domain string, action dns.Action, records []dns.Record) (*dns.Change, error) {
+			return &dns.Change{Id: "change", InSync: false}, nil
+		},
+		GetChangeFunc: func(domain string, changeId string) (*dns.Change, error) {
+			polls++
+			return &dns.Change{Id: changeId, InSync: polls == 2}, nil
+		},
+	}
+	i := &issuer{
+		config:      Config{PropagationTimeout: time.Second, PropagationInterval: time.Millisecond},
+		dnsProvider: provider,
+	}
+
+	Expect(i.publish(context.Background(), dns.Record{Type: dns.RecordTypeTXT, Name: "_acme-challenge.example.com", Value: "token"})).To(Succeed())
+	Expect(polls).To(Equal(2))
+
+	polls = -10
+	i.config.PropagationTimeout = 5 * time.Millisecond
+	Expect(i.publish(context.Background(), dns.Record{Type: dns.RecordTypeTXT, Name: "_acme-challenge.example.com", Value: "token"})).ToNot(Succeed())
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/route53/route53iface"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/tracing"
 )
 
 //go:generate moq -out client_moq.go . Client
@@ -76,6 +77,8 @@ func newClient(credentials Config, region string) (Client, error) {
 	if err != nil {
 		return nil, err
 	}
+	// wrapped once the session is created, the session only loads custom CA bundles into an *http.Transport
+	sess.Config.HTTPClient = tracing.HTTPClient(sess.Config.HTTPClient)
 	return &awsClient{
 		route53Client: route53.New(sess),
 	}, nil
@@ -0,0 +1,224 @@
+package dns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2/jwt"
+)
+
+const (
+	cloudDNSEndpoint = "https://dns.googleapis.com/dns/v1"
+	cloudDNSScope    = "https://www.googleapis.com/auth/ndev.clouddns.readwrite"
+	googleTokenURL   = "https://oauth2.googleapis.com/token"
+)
+
+// CloudDNSConfig contains the settings of the Google Cloud DNS provider
+type CloudDNSConfig struct {
+	// Project is the id of the GCP project of the managed zone
+	Project string
+	// ManagedZone is the name of the managed zone the records are changed in
+	ManagedZone string
+	// Credentials is the JSON key of the service account used to change the records
+	Credentials string
+}
+
+type cloudDNSProvider struct {
+	config   CloudDNSConfig
+	client   *http.Client
+	endpoint string
+}
+
+var _ Provider = &cloudDNSProvider{}
+
+type cloudDNSRecordSet struct {
+	Name    string   `json:"name"`
+	Type    string   `json:"type"`
+	TTL     int64    `json:"ttl"`
+	Rrdatas []string `json:"rrdatas"`
+}
+
+type cloudDNSChange struct {
+	Id        string              `json:"id,omitempty"`
+	Status    string              `json:"status,omitempty"`
+	Additions []cloudDNSRecordSet `json:"additions,omitempty"`
+	Deletions []cloudDNSRecordSet `json:"deletions,omitempty"`
+}
+
+type cloudDNSRecordSetList struct {
+	Rrsets []cloudDNSRecordSet `json:"rrsets"`
+}
+
+func NewCloudDNSProvider(config CloudDNSConfig) (Provider, error) {
+	var key struct {
+		ClientEmail  string `json:"client_email"`
+		PrivateKey   string `json:"private_key"`
+		PrivateKeyID string `json:"private_key_id"`
+		TokenURI     string `json:"token_uri"`
+	}
+	if err := json.Unmarshal([]byte(config.Credentials), &key); err != nil {
+		return nil, errors.Wrap(err, "unable to parse the Cloud DNS service account key")
+	}
+	jwtConfig := &jwt.Config{
+		Email:        key.ClientEmail,
+		PrivateKey:   []byte(key.PrivateKey),
+		PrivateKeyID: key.PrivateKeyID,
+		Scopes:       []string{cloudDNSScope},
+		TokenURL:     key.TokenURI,
+	}
+	if jwtConfig.TokenURL == "" {
+		jwtConfig.TokenURL = googleTokenURL
+	}
+	return newCloudDNSProvider(config, jwtConfig.Client(context.Background()), cloudDNSEndpoint), nil
+}
+
+func newCloudDNSProvider(config CloudDNSConfig, client *http.Client, endpoint string) *cloudDNSProvider {
+	return &cloudDNSProvider{
+		config:   config,
+		client:   client,
+		endpoint: endpoint,
+	}
+}
+
+func (p *cloudDNSProvider) ChangeRecords(domain string, action Action, records []Record) (*Change, error) {
+	change := cloudDNSChange{}
+	for _, record := range records {
+		value := fqdn(record.Value)
+		if record.Type == RecordTypeTXT {
+			value = quoteTXT(record.Value)
+		}
+		recordSet := cloudDNSRecordSet{
+			Name:    fqdn(record.Name),
+			Type:    string(record.Type),
+			TTL:     record.TTL,
+			Rrdatas: []string{value},
+		}
+		if action == ActionDelete {
+			change.Deletions = append(change.Deletions, recordSet)
+		} else {
+			change.Additions = append(change.Additions, recordSet)
+		}
+	}
+	// Cloud DNS has no upsert, the current records are replaced by a change deleting them
+	if action == ActionUpsert {
+		return p.reconcileChange(action, change)
+	}
+	result, status, err := p.submit(change)
+	if err != nil {
+		// some of the records already exist when creating them, or are already gone when deleting them, Cloud DNS
+		// rejects the whole change so it is reconciled against the current records of the zone
+		if status == http.StatusConflict || (action == ActionDelete && status == http.StatusNotFound) {
+			return p.reconcileChange(action, change)
+		}
+		return nil, err
+	}
+	return result, nil
+}
+
+// reconcileChange submits the part of the change that isn't applied yet. The records being created that already exist
+// with other values are only replaced by upserts, the records being deleted are deleted whatever their current values.
+func (p *cloudDNSProvider) reconcileChange(action Action, change cloudDNSChange) (*Change, error) {
+	reconciled := cloudDNSChange{}
+	for _, recordSet := range append(change.Additions, change.Deletions...) {
+		current, err := p.getRecordSet(recordSet.Name, recordSet.Type)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case action == ActionDelete:
+			if current != nil {
+				reconciled.Deletions = append(reconciled.Deletions, *current)
+			}
+		case current == nil:
+			reconciled.Additions = append(reconciled.Additions, recordSet)
+		case reflect.DeepEqual(current.Rrdatas, recordSet.Rrdatas) && current.TTL == recordSet.TTL:
+			// the record is already up to date
+		case action == ActionUpsert:
+			reconciled.Deletions = append(reconciled.Deletions, *current)
+			reconciled.Additions = append(reconciled.Additions, recordSet)
+		case !reflect.DeepEqual(current.Rrdatas, recordSet.Rrdatas):
+			return nil, errors.Errorf("%s record %s already exists with the values %v", recordSet.Type, recordSet.Name, current.Rrdatas)
+		}
+	}
+	if len(reconciled.Additions) == 0 && len(reconciled.Deletions) == 0 {
+		return &Change{InSync: true}, nil
+	}
+	result, _, err := p.submit(reconciled)
+	return result, err
+}
+
+// submit creates the change in the managed zone, the status code of the response is returned along with the error of
+// unsuccessful requests
+func (p *cloudDNSProvider) submit(change cloudDNSChange) (*Change, int, error) {
+	body, err := json.Marshal(change)
+	if err != nil {
+		return nil, 0, err
+	}
+	var result cloudDNSChange
+	status, err := p.do(http.MethodPost, p.changesURL(), body, &result)
+	if err != nil {
+		return nil, status, err
+	}
+	return &Change{Id: result.Id, InSync: result.Status == "done"}, status, nil
+}
+
+// getRecordSet returns the record set of the managed zone with the name and type, nil if there is none
+func (p *cloudDNSProvider) getRecordSet(name string, recordType string) (*cloudDNSRecordSet, error) {
+	query := url.Values{}
+	query.Set("name", name)
+	query.Set("type", recordType)
+	var result cloudDNSRecordSetList
+	if _, err := p.do(http.MethodGet, p.zoneURL()+"/rrsets?"+query.Encode(), nil, &result); err != nil {
+		return nil, err
+	}
+	if len(result.Rrsets) == 0 {
+		return nil, nil
+	}
+	return &result.Rrsets[0], nil
+}
+
+func (p *cloudDNSProvider) GetChange(domain string, changeId string) (*Change, error) {
+	var result cloudDNSChange
+	if _, err := p.do(http.MethodGet, p.changesURL()+"/"+url.PathEscape(changeId), nil, &result); err != nil {
+		return nil, err
+	}
+	return &Change{Id: result.Id, InSync: result.Status == "done"}, nil
+}
+
+func (p *cloudDNSProvider) zoneURL() string {
+	return fmt.Sprintf("%s/projects/%s/managedZones/%s", p.endpoint, url.PathEscape(p.config.Project), url.PathEscape(p.config.ManagedZone))
+}
+
+func (p *cloudDNSProvider) changesURL() string {
+	return p.zoneURL() + "/changes"
+}
+
+// do sends the request to the Cloud DNS API and decodes the response into result, the status code of the response is
+// returned along with the error of unsuccessful requests
+func (p *cloudDNSProvider) do(method string, url string, body []byte, result interface{}) (int, error) {
+	request, err := http.NewRequest(method, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	response, err := p.client.Do(request)
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to reach Cloud DNS")
+	}
+	defer response.Body.Close()
+	content, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return response.StatusCode, err
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return response.StatusCode, errors.Errorf("Cloud DNS returned %d: %s", response.StatusCode, string(content))
+	}
+	return response.StatusCode, json.Unmarshal(content, result)
+}
@@ -0,0 +1,158 @@
+package dns
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestCloudDNSProvider_ChangeRecords(t *testing.T) {
+	kafkaRecordSet := cloudDNSRecordSet{Name: "my-kafka.kafka.example.com.", Type: "CNAME", TTL: 300, Rrdatas: []string{"elb.example.com."}}
+	adminRecordSet := cloudDNSRecordSet{Name: "admin-server-my-kafka.kafka.example.com.", Type: "CNAME", TTL: 300, Rrdatas: []string{"elb.example.com."}}
+	tests := []struct {
+		name     string
+		action   Action
+		status   int
+		response string
+		// current are the record sets of the zone the change is reconciled against
+		current        []cloudDNSRecordSet
+		wantChange     *Change
+		wantReconciled *cloudDNSChange
+		wantErr        bool
+	}{
+		{
+			name:       "should submit the additions of the records",
+			action:     ActionCreate,
+			status:     http.StatusOK,
+			response:   `{"id": "7", "status": "pending"}`,
+			wantChange: &Change{Id: "7", InSync: false},
+		},
+		{
+			name:       "should be in sync when the records already exist",
+			action:     ActionCreate,
+			status:     http.StatusConflict,
+			current:    []cloudDNSRecordSet{kafkaRecordSet, adminRecordSet},
+			wantChange: &Change{InSync: true},
+		},
+		{
+			name:           "should submit the additions of the missing records when some already exist",
+			action:         ActionCreate,
+			status:         http.StatusConflict,
+			current:        []cloudDNSRecordSet{kafkaRecordSet},
+			wantChange:     &Change{Id: "8", InSync: false},
+			wantReconciled: &cloudDNSChange{Additions: []cloudDNSRecordSet{adminRecordSet}},
+		},
+		{
+			name:    "should return an error when the records exist with other values",
+			action:  ActionCreate,
+			status:  http.StatusConflict,
+			current: []cloudDNSRecordSet{{Name: kafkaRecordSet.Name, Type: "CNAME", TTL: 300, Rrdatas: []string{"other.example.com."}}, adminRecordSet},
+			wantErr: true,
+		},
+		{
+			name:       "should be in sync when the deleted records don't exist",
+			action:     ActionDelete,
+			status:     http.StatusNotFound,
+			wantChange: &Change{InSync: true},
+		},
+		{
+			name:           "should submit the deletions of the remaining records when some are already gone",
+			action:         ActionDelete,
+			status:         http.StatusNotFound,
+			current:        []cloudDNSRecordSet{adminRecordSet},
+			wantChange:     &Change{Id: "8", InSync: false},
+			wantReconciled: &cloudDNSChange{Deletions: []cloudDNSRecordSet{adminRecordSet}},
+		},
+		{
+			name:    "should return an error when the change is rejected",
+			action:  ActionCreate,
+			status:  http.StatusForbidden,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			var submitted []cloudDNSChange
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodGet {
+					Expect(r.URL.Path).To(Equal("/projects/my-project/managedZones/kafka-zone/rrsets"))
+					list := cloudDNSRecordSetList{Rrsets: []cloudDNSRecordSet{}}
+					for _, recordSet := range tt.current {
+						if recordSet.Name == r.URL.Query().Get("name") && recordSet.Type == r.URL.Query().Get("type") {
+							list.Rrsets = append(list.Rrsets, recordSet)
+						}
+					}
+					Expect(json.NewEncoder(w).Encode(list)).To(Succeed())
+					return
+				}
+				Expect(r.Method).To(Equal(http.MethodPost))
+				Expect(r.URL.Path).To(Equal("/projects/my-project/managedZones/kafka-zone/changes"))
+				var change cloudDNSChange
+				Expect(json.NewDecoder(r.Body).Decode(&change)).To(Succeed())
+				submitted = append(submitted, change)
+				if len(submitted) > 1 {
+					_, _ = w.Write([]byte(`{"id": "8", "status": "pending"}`))
+					return
+				}
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			provider := newCloudDNSProvider(CloudDNSConfig{Project: "my-project", ManagedZone: "kafka-zone"}, server.Client(), server.URL)
+			change, err := provider.ChangeRecords("kafka.example.com", tt.action, []Record{
+				{Type: RecordTypeCNAME, Name: "my-kafka.kafka.example.com", Value: "elb.example.com", TTL: 300},
+				{Type: RecordTypeCNAME, Name: "admin-server-my-kafka.kafka.example.com", Value: "elb.example.com", TTL: 300},
+			})
+			Expect(err != nil).To(Equal(tt.wantErr))
+			Expect(change).To(Equal(tt.wantChange))
+
+			Expect(submitted).ToNot(BeEmpty())
+			recordSets := submitted[0].Additions
+			if tt.action == ActionDelete {
+				recordSets = submitted[0].Deletions
+			}
+			Expect(recordSets).To(Equal([]cloudDNSRecordSet{kafkaRecordSet, adminRecordSet}))
+			if tt.wantReconciled == nil {
+				Expect(submitted).To(HaveLen(1))
+				return
+			}
+			Expect(submitted).To(HaveLen(2))
+			Expect(submitted[1]).To(Equal(*tt.wantReconciled))
+		})
+	}
+}
+
+func TestCloudDNSProvider_ChangeRecords_Upsert(t *testing.T) {
+	RegisterTestingT(t)
+	current := cloudDNSRecordSet{Name: "_acme-challenge.my-kafka.kafka.example.com.", Type: "TXT", TTL: 60, Rrdatas: []string{`"old-token"`}}
+	var submitted []cloudDNSChange
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			Expect(json.NewEncoder(w).Encode(cloudDNSRecordSetList{Rrsets: []cloudDNSRecordSet{current}})).To(Succeed())
+			return
+		}
+		var change cloudDNSChange
+		Expect(json.NewDecoder(r.Body).Decode(&change)).To(Succeed())
+		submitted = append(submitted, change)
+		_, _ = w.Write([]byte(`{"id": "7", "status": "pending"}`))
+	}))
+	defer server.Close()
+
+	provider := newCloudDNSProvider(CloudDNSConfig{Project: "my-project", ManagedZone: "kafka-zone"}, server.Client(), server.URL)
+	change, err := provider.ChangeRecords("kafka.example.com", ActionUpsert, []Record{
+		{Type: RecordTypeTXT, Name: "_acme-challenge.my-kafka.kafka.example.com", Value: "token", TTL: 60},
+	})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(change).To(Equal(&Change{Id: "7", InSync: false}))
+	// the current record is replaced in a single change
+	Expect(submitted).To(Equal([]cloudDNSChange{{
+		Additions: []cloudDNSRecordSet{{Name: current.Name, Type: "TXT", TTL: 60, Rrdatas: []string{`"token"`}}},
+		Deletions: []cloudDNSRecordSet{current},
+	}}))
+}
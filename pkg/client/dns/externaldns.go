@@ -0,0 +1,134 @@
+package dns
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+var dnsEndpointResource = schema.GroupVersionResource{
+	Group:    "externaldns.k8s.io",
+	Version:  "v1alpha1",
+	Resource: "dnsendpoints",
+}
+
+// ExternalDNSConfig contains the settings of the provider creating DNSEndpoint resources for external-dns
+type ExternalDNSConfig struct {
+	// Kubeconfig is the path of the kubeconfig of the cluster external-dns watches, the in-cluster configuration is
+	// used if empty
+	Kubeconfig string
+	// Namespace is the namespace the DNSEndpoint resources are created in
+	Namespace string
+}
+
+// externalDNSProvider creates a DNSEndpoint resource per record and leaves publishing them to external-dns. External-dns
+// doesn't report on the state of the endpoints, so the changes are in sync once the resources are changed.
+type externalDNSProvider struct {
+	client    dynamic.Interface
+	namespace string
+}
+
+var _ Provider = &externalDNSProvider{}
+
+func NewExternalDNSProvider(config ExternalDNSConfig) (Provider, error) {
+	restConfig, err := clientcmd.BuildConfigFromFlags("", config.Kubeconfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to load the external-dns cluster configuration")
+	}
+	client, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create the external-dns cluster client")
+	}
+	return newExternalDNSProvider(client, config.Namespace), nil
+}
+
+func newExternalDNSProvider(client dynamic.Interface, namespace string) *externalDNSProvider {
+	return &externalDNSProvider{
+		client:    client,
+		namespace: namespace,
+	}
+}
+
+func (p *externalDNSProvider) ChangeRecords(domain string, action Action, records []Record) (*Change, error) {
+	endpoints := p.client.Resource(dnsEndpointResource).Namespace(p.namespace)
+	for _, record := range records {
+		name := dnsEndpointName(record)
+		if action == ActionDelete {
+			err := endpoints.Delete(context.Background(), name, metav1.DeleteOptions{})
+			if err != nil && !apierrors.IsNotFound(err) {
+				return nil, errors.Wrapf(err, "unable to delete DNSEndpoint %s", name)
+			}
+			continue
+		}
+		endpoint := buildDNSEndpoint(name, record)
+		_, err := endpoints.Create(context.Background(), endpoint, metav1.CreateOptions{})
+		if err == nil {
+			continue
+		}
+		if !apierrors.IsAlreadyExists(err) {
+			return nil, errors.Wrapf(err, "unable to create DNSEndpoint %s", name)
+		}
+		existing, err := endpoints.Get(context.Background(), name, metav1.GetOptions{})
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to get DNSEndpoint %s", name)
+		}
+		if equality.Semantic.DeepEqual(existing.Object["spec"], endpoint.Object["spec"]) {
+			continue
+		}
+		if action != ActionUpsert {
+			return nil, errors.Errorf("DNSEndpoint %s already exists with other endpoints", name)
+		}
+		endpoint.SetResourceVersion(existing.GetResourceVersion())
+		if _, err := endpoints.Update(context.Background(), endpoint, metav1.UpdateOptions{}); err != nil {
+			return nil, errors.Wrapf(err, "unable to update DNSEndpoint %s", name)
+		}
+	}
+	return &Change{InSync: true}, nil
+}
+
+func (p *externalDNSProvider) GetChange(domain string, changeId string) (*Change, error) {
+	return &Change{Id: changeId, InSync: true}, nil
+}
+
+// dnsEndpointName returns the name of the DNSEndpoint of a record. Host names are valid resource names, the names of
+// TXT records are prefixed as they are usually set next to other records and may contain underscores.
+func dnsEndpointName(record Record) string {
+	name := strings.TrimSuffix(strings.ToLower(record.Name), ".")
+	if record.Type == RecordTypeTXT {
+		name = "txt." + strings.ReplaceAll(name, "_", "-")
+	}
+	return name
+}
+
+func buildDNSEndpoint(name string, record Record) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "externaldns.k8s.io/v1alpha1",
+			"kind":       "DNSEndpoint",
+			"metadata": map[string]interface{}{
+				"name": name,
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/managed-by": "kas-fleet-manager",
+				},
+			},
+			"spec": map[string]interface{}{
+				"endpoints": []interface{}{
+					map[string]interface{}{
+						"dnsName":    strings.TrimSuffix(record.Name, "."),
+						"recordType": string(record.Type),
+						"recordTTL":  record.TTL,
+						"targets":    []interface{}{strings.TrimSuffix(record.Value, ".")},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,54 @@
+package dns
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+)
+
+func TestExternalDNSProvider_ChangeRecords(t *testing.T) {
+	RegisterTestingT(t)
+
+	client := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		dnsEndpointResource: "DNSEndpointList",
+	})
+	provider := newExternalDNSProvider(client, "kas-fleet-manager")
+	records := []Record{{Type: RecordTypeCNAME, Name: "My-Kafka.kafka.example.com", Value: "elb.example.com", TTL: 300}}
+
+	change, err := provider.ChangeRecords("kafka.example.com", ActionCreate, records)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(change.InSync).To(BeTrue())
+	// creating the records again is not an error
+	_, err = provider.ChangeRecords("kafka.example.com", ActionCreate, records)
+	Expect(err).ToNot(HaveOccurred())
+	// but creating them with other targets is
+	_, err = provider.ChangeRecords("kafka.example.com", ActionCreate, []Record{{Type: RecordTypeCNAME, Name: "My-Kafka.kafka.example.com", Value: "other.example.com", TTL: 300}})
+	Expect(err).To(HaveOccurred())
+
+	endpoint, err := client.Resource(dnsEndpointResource).Namespace("kas-fleet-manager").Get(context.Background(), "my-kafka.kafka.example.com", metav1.GetOptions{})
+	Expect(err).ToNot(HaveOccurred())
+	endpoints, _, _ := unstructured.NestedSlice(endpoint.Object, "spec", "endpoints")
+	Expect(endpoints).To(HaveLen(1))
+	Expect(endpoints[0]).To(HaveKeyWithValue("dnsName", "My-Kafka.kafka.example.com"))
+	Expect(endpoints[0]).To(HaveKeyWithValue("targets", []interface{}{"elb.example.com"}))
+
+	// upserting the records replaces their targets
+	_, err = provider.ChangeRecords("kafka.example.com", ActionUpsert, []Record{{Type: RecordTypeCNAME, Name: "My-Kafka.kafka.example.com", Value: "other.example.com", TTL: 300}})
+	Expect(err).ToNot(HaveOccurred())
+	endpoint, err = client.Resource(dnsEndpointResource).Namespace("kas-fleet-manager").Get(context.Background(), "my-kafka.kafka.example.com", metav1.GetOptions{})
+	Expect(err).ToNot(HaveOccurred())
+	endpoints, _, _ = unstructured.NestedSlice(endpoint.Object, "spec", "endpoints")
+	Expect(endpoints[0]).To(HaveKeyWithValue("targets", []interface{}{"other.example.com"}))
+
+	_, err = provider.ChangeRecords("kafka.example.com", ActionDelete, records)
+	Expect(err).ToNot(HaveOccurred())
+	// deleting the records again is not an error
+	_, err = provider.ChangeRecords("kafka.example.com", ActionDelete, records)
+	Expect(err).ToNot(HaveOccurred())
+}
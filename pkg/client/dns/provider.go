@@ -0,0 +1,66 @@
+package dns
+
+import "strings"
+
+// Action is the change applied to the records by a provider
+type Action string
+
+const (
+	ActionCreate Action = "CREATE"
+	// ActionUpsert creates the records or replaces the values of the records that already exist
+	ActionUpsert Action = "UPSERT"
+	ActionDelete Action = "DELETE"
+)
+
+const (
+	ProviderRoute53     = "route53"
+	ProviderCloudDNS    = "clouddns"
+	ProviderRFC2136     = "rfc2136"
+	ProviderExternalDNS = "external-dns"
+)
+
+// RecordType is the type of the records managed by the providers
+type RecordType string
+
+const (
+	RecordTypeCNAME RecordType = "CNAME"
+	RecordTypeTXT   RecordType = "TXT"
+)
+
+// Record is a DNS record, the value of a CNAME record is the name it targets
+type Record struct {
+	Type  RecordType
+	Name  string
+	Value string
+	TTL   int64
+}
+
+// Change is a change of records submitted to a provider. The changes of the providers applying them synchronously are
+// in sync straight away.
+type Change struct {
+	Id     string
+	InSync bool
+}
+
+//go:generate moq -out provider_moq.go . Provider
+type Provider interface {
+	// ChangeRecords creates, upserts or deletes the records in the zone of the domain. Creating records that already exist with
+	// the same values and deleting records that don't exist are not errors, creating records that exist with other
+	// values is.
+	ChangeRecords(domain string, action Action, records []Record) (*Change, error)
+	// GetChange returns the status of a change returned by ChangeRecords
+	GetChange(domain string, changeId string) (*Change, error)
+}
+
+// fqdn returns the name with the trailing dot most DNS APIs expect
+func fqdn(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
+
+// quoteTXT returns the value of a TXT record in the quoted form of the zone files most DNS APIs expect
+func quoteTXT(value string) string {
+	return `"` + strings.ReplaceAll(value, `"`, `\"`) + `"`
+}
@@ -0,0 +1,263 @@
+package dns
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/binary"
+	"hash"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+const (
+	TSIGAlgorithmHMACSHA256 = "hmac-sha256"
+	TSIGAlgorithmHMACSHA512 = "hmac-sha512"
+
+	// opCodeUpdate is the operation code of the dynamic updates defined by RFC 2136
+	opCodeUpdate = dnsmessage.OpCode(5)
+	typeTSIG     = 250
+	// tsigFudge is the number of seconds the clocks of the server and of the service may differ by
+	tsigFudge          = 300
+	rfc2136DialTimeout = 10 * time.Second
+)
+
+// RFC2136Config contains the settings of the provider sending dynamic updates (RFC 2136) to a DNS server, e.g. BIND
+type RFC2136Config struct {
+	// Server is the address of the DNS server accepting the updates, e.g. ns1.example.com:53
+	Server string
+	// Zone is the zone the records are updated in, the domain of the changes if empty
+	Zone string
+	// TSIGKeyName is the name of the key the updates are signed with (RFC 8945), they are not signed if empty
+	TSIGKeyName string
+	// TSIGAlgorithm is hmac-sha256 or hmac-sha512
+	TSIGAlgorithm string
+	// TSIGSecret is the base64 encoded secret of the key
+	TSIGSecret string
+}
+
+type rfc2136Provider struct {
+	config RFC2136Config
+	secret []byte
+	hash   func() hash.Hash
+	now    func() time.Time
+}
+
+var _ Provider = &rfc2136Provider{}
+
+func NewRFC2136Provider(config RFC2136Config) (Provider, error) {
+	provider := &rfc2136Provider{
+		config: config,
+		now:    time.Now,
+	}
+	if config.TSIGKeyName == "" {
+		return provider, nil
+	}
+
+	switch config.TSIGAlgorithm {
+	case TSIGAlgorithmHMACSHA256:
+		provider.hash = sha256.New
+	case TSIGAlgorithmHMACSHA512:
+		provider.hash = sha512.New
+	default:
+		return nil, errors.Errorf("unsupported TSIG algorithm %q, must be one of %s or %s", config.TSIGAlgorithm, TSIGAlgorithmHMACSHA256, TSIGAlgorithmHMACSHA512)
+	}
+	secret, err := base64.StdEncoding.DecodeString(strings.TrimSpace(config.TSIGSecret))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to decode the TSIG secret")
+	}
+	provider.secret = secret
+	return provider, nil
+}
+
+// ChangeRecords sends an update of the records to the server. The update is applied by the server when it answers, so
+// the change is in sync straight away.
+func (p *rfc2136Provider) ChangeRecords(domain string, action Action, records []Record) (*Change, error) {
+	zone := p.config.Zone
+	if zone == "" {
+		zone = domain
+	}
+	message, err := p.buildUpdate(zone, action, records)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.send(message); err != nil {
+		return nil, err
+	}
+	return &Change{InSync: true}, nil
+}
+
+func (p *rfc2136Provider) GetChange(domain string, changeId string) (*Change, error) {
+	return &Change{Id: changeId, InSync: true}, nil
+}
+
+func (p *rfc2136Provider) buildUpdate(zone string, action Action, records []Record) ([]byte, error) {
+	var id [2]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return nil, err
+	}
+	zoneName, err := dnsmessage.NewName(fqdn(zone))
+	if err != nil {
+		return nil, err
+	}
+
+	builder := dnsmessage.NewBuilder(nil, dnsmessage.Header{ID: binary.BigEndian.Uint16(id[:]), OpCode: opCodeUpdate})
+	// the zone section of an update has the layout of the question section
+	if err := builder.StartQuestions(); err != nil {
+		return nil, err
+	}
+	if err := builder.Question(dnsmessage.Question{Name: zoneName, Type: dnsmessage.TypeSOA, Class: dnsmessage.ClassINET}); err != nil {
+		return nil, err
+	}
+	// the update section of an update has the layout of the authority section
+	if err := builder.StartAuthorities(); err != nil {
+		return nil, err
+	}
+	for _, record := range records {
+		name, err := dnsmessage.NewName(fqdn(record.Name))
+		if err != nil {
+			return nil, err
+		}
+		recordType := dnsmessage.TypeCNAME
+		if record.Type == RecordTypeTXT {
+			recordType = dnsmessage.TypeTXT
+		}
+		if action == ActionDelete || action == ActionUpsert {
+			// deleting the record set of a name is sent as a record of class ANY without data, an upsert replaces the
+			// record set in the same update
+			err = builder.UnknownResource(dnsmessage.ResourceHeader{Name: name, Type: recordType, Class: dnsmessage.ClassANY}, dnsmessage.UnknownResource{Type: recordType})
+			if err != nil {
+				return nil, err
+			}
+		}
+		switch {
+		case action == ActionDelete:
+			continue
+		case record.Type == RecordTypeTXT:
+			err = builder.TXTResource(dnsmessage.ResourceHeader{Name: name, Class: dnsmessage.ClassINET, TTL: uint32(record.TTL)}, dnsmessage.TXTResource{TXT: []string{record.Value}})
+		default:
+			target, targetErr := dnsmessage.NewName(fqdn(record.Value))
+			if targetErr != nil {
+				return nil, targetErr
+			}
+			err = builder.CNAMEResource(dnsmessage.ResourceHeader{Name: name, Class: dnsmessage.ClassINET, TTL: uint32(record.TTL)}, dnsmessage.CNAMEResource{CNAME: target})
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	message, err := builder.Finish()
+	if err != nil {
+		return nil, err
+	}
+	if p.config.TSIGKeyName == "" {
+		return message, nil
+	}
+	return p.sign(message), nil
+}
+
+// sign appends the TSIG record (RFC 8945) of the message to its additional section
+func (p *rfc2136Provider) sign(message []byte) []byte {
+	keyName := packName(p.config.TSIGKeyName)
+	algorithm := packName(p.config.TSIGAlgorithm)
+	signedAt := uint64(p.now().Unix())
+
+	// the time signed is a 48 bits integer followed by the fudge
+	var timers [8]byte
+	binary.BigEndian.PutUint16(timers[0:], uint16(signedAt>>32))
+	binary.BigEndian.PutUint32(timers[2:], uint32(signedAt))
+	binary.BigEndian.PutUint16(timers[6:], tsigFudge)
+
+	mac := hmac.New(p.hash, p.secret)
+	mac.Write(message)
+	mac.Write(keyName)
+	// class and TTL of the TSIG record
+	mac.Write(uint16Bytes(uint16(dnsmessage.ClassANY)))
+	mac.Write([]byte{0, 0, 0, 0})
+	mac.Write(algorithm)
+	mac.Write(timers[:])
+	// error and other data length
+	mac.Write([]byte{0, 0, 0, 0})
+	sum := mac.Sum(nil)
+
+	var rdata []byte
+	rdata = append(rdata, algorithm...)
+	rdata = append(rdata, timers[:]...)
+	rdata = append(rdata, uint16Bytes(uint16(len(sum)))...)
+	rdata = append(rdata, sum...)
+	// original id, error and other data length
+	rdata = append(rdata, message[0:2]...)
+	rdata = append(rdata, 0, 0, 0, 0)
+
+	signed := append([]byte{}, message...)
+	signed = append(signed, keyName...)
+	signed = append(signed, uint16Bytes(typeTSIG)...)
+	signed = append(signed, uint16Bytes(uint16(dnsmessage.ClassANY))...)
+	signed = append(signed, 0, 0, 0, 0)
+	signed = append(signed, uint16Bytes(uint16(len(rdata)))...)
+	signed = append(signed, rdata...)
+	// the record count of the additional section is the last field of the header
+	binary.BigEndian.PutUint16(signed[10:], binary.BigEndian.Uint16(signed[10:])+1)
+	return signed
+}
+
+// send sends the message to the server over TCP and checks the server applied it
+func (p *rfc2136Provider) send(message []byte) error {
+	conn, err := net.DialTimeout("tcp", p.config.Server, rfc2136DialTimeout)
+	if err != nil {
+		return errors.Wrapf(err, "unable to reach DNS server %s", p.config.Server)
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(rfc2136DialTimeout)); err != nil {
+		return err
+	}
+
+	// messages sent over TCP are prefixed by their length
+	if _, err := conn.Write(append(uint16Bytes(uint16(len(message))), message...)); err != nil {
+		return errors.Wrap(err, "unable to send the DNS update")
+	}
+	var length [2]byte
+	if _, err := io.ReadFull(conn, length[:]); err != nil {
+		return errors.Wrap(err, "unable to read the DNS update response")
+	}
+	response := make([]byte, binary.BigEndian.Uint16(length[:]))
+	if _, err := io.ReadFull(conn, response); err != nil {
+		return errors.Wrap(err, "unable to read the DNS update response")
+	}
+
+	var parser dnsmessage.Parser
+	header, err := parser.Start(response)
+	if err != nil {
+		return errors.Wrap(err, "unable to parse the DNS update response")
+	}
+	if header.RCode != dnsmessage.RCodeSuccess {
+		return errors.Errorf("DNS server %s refused the update: %s", p.config.Server, header.RCode)
+	}
+	return nil
+}
+
+// packName returns the uncompressed wire format of the name in lower case, as used for computing the TSIG MAC
+func packName(name string) []byte {
+	var packed []byte
+	for _, label := range strings.Split(strings.TrimSuffix(strings.ToLower(name), "."), ".") {
+		if label == "" {
+			continue
+		}
+		packed = append(packed, byte(len(label)))
+		packed = append(packed, label...)
+	}
+	return append(packed, 0)
+}
+
+func uint16Bytes(value uint16) []byte {
+	var b [2]byte
+	binary.BigEndian.PutUint16(b[:], value)
+	return b[:]
+}
@@ -0,0 +1,171 @@
+package dns
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// serveUpdate answers a single update sent to the returned address with rcode, the update is sent to the channel
+func serveUpdate(rcode dnsmessage.RCode) (string, chan []byte) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).ToNot(HaveOccurred())
+	updates := make(chan []byte, 1)
+	go func() {
+		defer listener.Close()
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		var length [2]byte
+		if _, err := io.ReadFull(conn, length[:]); err != nil {
+			return
+		}
+		request := make([]byte, binary.BigEndian.Uint16(length[:]))
+		if _, err := io.ReadFull(conn, request); err != nil {
+			return
+		}
+		updates <- request
+
+		builder := dnsmessage.NewBuilder(nil, dnsmessage.Header{ID: binary.BigEndian.Uint16(request), Response: true, OpCode: opCodeUpdate, RCode: rcode})
+		response, _ := builder.Finish()
+		_, _ = conn.Write(append(uint16Bytes(uint16(len(response))), response...))
+	}()
+	return listener.Addr().String(), updates
+}
+
+func TestRFC2136Provider_ChangeRecords(t *testing.T) {
+	RegisterTestingT(t)
+
+	server, updates := serveUpdate(dnsmessage.RCodeSuccess)
+	provider, err := NewRFC2136Provider(RFC2136Config{
+		Server:        server,
+		TSIGKeyName:   "kas-fleet-manager",
+		TSIGAlgorithm: TSIGAlgorithmHMACSHA256,
+		TSIGSecret:    "c2VjcmV0",
+	})
+	Expect(err).ToNot(HaveOccurred())
+
+	change, err := provider.ChangeRecords("kafka.example.com", ActionCreate, []Record{
+		{Type: RecordTypeCNAME, Name: "my-kafka.kafka.example.com", Value: "elb.example.com", TTL: 300},
+	})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(change.InSync).To(BeTrue())
+
+	update := &dnsmessage.Message{}
+	Expect(update.Unpack(<-updates)).To(Succeed())
+	Expect(update.Header.OpCode).To(Equal(opCodeUpdate))
+	Expect(update.Questions).To(HaveLen(1))
+	Expect(update.Questions[0].Name.String()).To(Equal("kafka.example.com."))
+	Expect(update.Authorities).To(HaveLen(1))
+	Expect(update.Authorities[0].Header.Name.String()).To(Equal("my-kafka.kafka.example.com."))
+	Expect(update.Authorities[0].Body).To(Equal(&dnsmessage.CNAMEResource{CNAME: dnsmessage.MustNewName("elb.example.com.")}))
+	Expect(update.Additionals).To(HaveLen(1))
+	Expect(update.Additionals[0].Header.Type).To(Equal(dnsmessage.Type(typeTSIG)))
+	Expect(update.Additionals[0].Header.Name.String()).To(Equal("kas-fleet-manager."))
+}
+
+func TestRFC2136Provider_ChangeRecords_Delete(t *testing.T) {
+	RegisterTestingT(t)
+
+	server, updates := serveUpdate(dnsmessage.RCodeSuccess)
+	provider, err := NewRFC2136Provider(RFC2136Config{Server: server, Zone: "example.com"})
+	Expect(err).ToNot(HaveOccurred())
+
+	_, err = provider.ChangeRecords("kafka.example.com", ActionDelete, []Record{
+		{Type: RecordTypeCNAME, Name: "my-kafka.kafka.example.com", Value: "elb.example.com", TTL: 300},
+	})
+	Expect(err).ToNot(HaveOccurred())
+
+	// the parser doesn't accept CNAME records without data, so only the headers of the records are parsed
+	var parser dnsmessage.Parser
+	header, err := parser.Start(<-updates)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(header.OpCode).To(Equal(opCodeUpdate))
+	questions, err := parser.AllQuestions()
+	Expect(err).ToNot(HaveOccurred())
+	Expect(questions[0].Name.String()).To(Equal("example.com."))
+	Expect(parser.SkipAllAnswers()).To(Succeed())
+	authority, err := parser.AuthorityHeader()
+	Expect(err).ToNot(HaveOccurred())
+	Expect(authority.Name.String()).To(Equal("my-kafka.kafka.example.com."))
+	Expect(authority.Class).To(Equal(dnsmessage.ClassANY))
+	Expect(authority.Type).To(Equal(dnsmessage.TypeCNAME))
+	Expect(authority.Length).To(BeZero())
+}
+
+func TestRFC2136Provider_ChangeRecords_TXT(t *testing.T) {
+	RegisterTestingT(t)
+
+	server, updates := serveUpdate(dnsmessage.RCodeSuccess)
+	provider, err := NewRFC2136Provider(RFC2136Config{Server: server})
+	Expect(err).ToNot(HaveOccurred())
+
+	_, err = provider.ChangeRecords("kafka.example.com", ActionCreate, []Record{
+		{Type: RecordTypeTXT, Name: "_acme-challenge.my-kafka.kafka.example.com", Value: "token", TTL: 60},
+	})
+	Expect(err).ToNot(HaveOccurred())
+
+	update := &dnsmessage.Message{}
+	Expect(update.Unpack(<-updates)).To(Succeed())
+	Expect(update.Authorities).To(HaveLen(1))
+	Expect(update.Authorities[0].Body).To(Equal(&dnsmessage.TXTResource{TXT: []string{"token"}}))
+}
+
+func TestRFC2136Provider_ChangeRecords_Upsert(t *testing.T) {
+	RegisterTestingT(t)
+
+	server, updates := serveUpdate(dnsmessage.RCodeSuccess)
+	provider, err := NewRFC2136Provider(RFC2136Config{Server: server})
+	Expect(err).ToNot(HaveOccurred())
+
+	_, err = provider.ChangeRecords("kafka.example.com", ActionUpsert, []Record{
+		{Type: RecordTypeTXT, Name: "_acme-challenge.my-kafka.kafka.example.com", Value: "token", TTL: 60},
+	})
+	Expect(err).ToNot(HaveOccurred())
+
+	// the record set is deleted before the record is added
+	var parser dnsmessage.Parser
+	_, err = parser.Start(<-updates)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(parser.SkipAllQuestions()).To(Succeed())
+	Expect(parser.SkipAllAnswers()).To(Succeed())
+	deletion, err := parser.AuthorityHeader()
+	Expect(err).ToNot(HaveOccurred())
+	Expect(deletion.Class).To(Equal(dnsmessage.ClassANY))
+	Expect(deletion.Type).To(Equal(dnsmessage.TypeTXT))
+	Expect(deletion.Length).To(BeZero())
+	Expect(parser.SkipAuthority()).To(Succeed())
+	addition, err := parser.AuthorityHeader()
+	Expect(err).ToNot(HaveOccurred())
+	Expect(addition.Class).To(Equal(dnsmessage.ClassINET))
+	Expect(addition.Type).To(Equal(dnsmessage.TypeTXT))
+	txt, err := parser.TXTResource()
+	Expect(err).ToNot(HaveOccurred())
+	Expect(txt.TXT).To(Equal([]string{"token"}))
+}
+
+func TestRFC2136Provider_ChangeRecords_Refused(t *testing.T) {
+	RegisterTestingT(t)
+
+	server, _ := serveUpdate(dnsmessage.RCodeRefused)
+	provider, err := NewRFC2136Provider(RFC2136Config{Server: server})
+	Expect(err).ToNot(HaveOccurred())
+
+	_, err = provider.ChangeRecords("kafka.example.com", ActionCreate, []Record{
+		{Type: RecordTypeCNAME, Name: "my-kafka.kafka.example.com", Value: "elb.example.com", TTL: 300},
+	})
+	Expect(err).To(HaveOccurred())
+}
+
+func TestNewRFC2136Provider_UnsupportedAlgorithm(t *testing.T) {
+	RegisterTestingT(t)
+
+	_, err := NewRFC2136Provider(RFC2136Config{TSIGKeyName: "key", TSIGAlgorithm: "hmac-md5", TSIGSecret: "c2VjcmV0"})
+	Expect(err).To(HaveOccurred())
+}
@@ -0,0 +1,89 @@
+package dns
+
+import (
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
+)
+
+// Route53 is a global service, its API is served from us-east-1 whatever the region of the records
+const route53Region = "us-east-1"
+
+type route53Provider struct {
+	clientFactory aws.ClientFactory
+	credentials   aws.Config
+}
+
+var _ Provider = &route53Provider{}
+
+func NewRoute53Provider(clientFactory aws.ClientFactory, credentials aws.Config) Provider {
+	return &route53Provider{
+		clientFactory: clientFactory,
+		credentials:   credentials,
+	}
+}
+
+func (p *route53Provider) ChangeRecords(domain string, action Action, records []Record) (*Change, error) {
+	client, err := p.clientFactory.NewClient(p.credentials, route53Region)
+	if err != nil {
+		return nil, err
+	}
+	output, err := client.ChangeResourceRecordSets(domain, buildChangeBatch(action, records))
+	if err != nil {
+		return nil, err
+	}
+	// the records already were in the requested state
+	if output == nil || output.ChangeInfo == nil {
+		return &Change{InSync: true}, nil
+	}
+	return route53Change(output.ChangeInfo), nil
+}
+
+func (p *route53Provider) GetChange(domain string, changeId string) (*Change, error) {
+	client, err := p.clientFactory.NewClient(p.credentials, route53Region)
+	if err != nil {
+		return nil, err
+	}
+	output, err := client.GetChange(changeId)
+	if err != nil {
+		return nil, err
+	}
+	return route53Change(output.ChangeInfo), nil
+}
+
+func route53Change(info *route53.ChangeInfo) *Change {
+	change := &Change{}
+	if info.Id != nil {
+		change.Id = *info.Id
+	}
+	change.InSync = info.Status != nil && *info.Status == route53.ChangeStatusInsync
+	return change
+}
+
+func buildChangeBatch(action Action, records []Record) *route53.ChangeBatch {
+	var changes []*route53.Change
+	for i := range records {
+		record := records[i]
+		recordAction := string(action)
+		recordType := string(record.Type)
+		value := record.Value
+		if record.Type == RecordTypeTXT {
+			value = quoteTXT(value)
+		}
+		changes = append(changes, &route53.Change{
+			Action: &recordAction,
+			ResourceRecordSet: &route53.ResourceRecordSet{
+				Name: &record.Name,
+				Type: &recordType,
+				TTL:  &record.TTL,
+				ResourceRecords: []*route53.ResourceRecord{
+					{
+						Value: &value,
+					},
+				},
+			},
+		})
+	}
+	return &route53.ChangeBatch{
+		Changes: changes,
+	}
+}
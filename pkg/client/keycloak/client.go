@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/Nerzal/gocloak/v8"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/tracing"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 	"github.com/patrickmn/go-cache"
 	"github.com/pkg/errors"
@@ -74,6 +75,8 @@ func NewClient(config *KeycloakConfig, realmConfig *KeycloakRealmConfig) *kcClie
 	client := gocloak.NewClient(config.BaseURL)
 	client.RestyClient().SetDebug(config.Debug)
 	client.RestyClient().SetTLSClientConfig(&tls.Config{InsecureSkipVerify: config.InsecureSkipVerify})
+	// wrapped once the TLS config is set, resty only sets it on an *http.Transport
+	client.RestyClient().SetTransport(tracing.Transport(client.RestyClient().GetClient().Transport))
 	return &kcClient{
 		kcClient:    client,
 		ctx:         context.Background(),
@@ -0,0 +1,24 @@
+package keycloak
+
+import (
+	"crypto/tls"
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/health"
+)
+
+// HealthChecks checks the mas-sso can be reached, the service accounts and the kafka clients can't be managed without it
+func (kc *KeycloakConfig) HealthChecks() []health.Check {
+	if kc.BaseURL == "" || kc.KafkaRealm == nil {
+		return nil
+	}
+	client := &http.Client{
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: kc.InsecureSkipVerify},
+		},
+	}
+	return []health.Check{
+		health.HTTPCheck("sso", kc.KafkaRealm.JwksEndpointURI, client),
+	}
+}
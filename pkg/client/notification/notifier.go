@@ -0,0 +1,26 @@
+package notification
+
+import (
+	"context"
+)
+
+const (
+	TypeEmail   = "email"
+	TypeWebhook = "webhook"
+)
+
+// Notification is a message sent to a target of a notifier
+type Notification struct {
+	Subject string
+	// Message is the plain text body of the notification
+	Message string
+	// Payload is encoded to JSON as the body of webhook notifications
+	Payload interface{}
+}
+
+//go:generate moq -out notifier_moq.go . Notifier
+type Notifier interface {
+	// ValidateTarget returns an error when the notifier can not deliver notifications to the target
+	ValidateTarget(target string) error
+	Notify(ctx context.Context, target string, notification Notification) error
+}
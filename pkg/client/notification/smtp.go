@@ -0,0 +1,98 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// SMTPConfig contains the settings of the SMTP server the email notifications are sent with
+type SMTPConfig struct {
+	Host string
+	Port int
+	// Username and Password authenticate to the server with PLAIN authentication, which requires TLS, no
+	// authentication is done when Username is empty
+	Username string
+	Password string
+	From     string
+}
+
+type smtpNotifier struct {
+	config   SMTPConfig
+	sendMail func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+var _ Notifier = &smtpNotifier{}
+
+// NewSMTPNotifier returns a notifier sending the notifications by email to the addresses they target
+func NewSMTPNotifier(config SMTPConfig) (Notifier, error) {
+	if _, err := mail.ParseAddress(config.From); err != nil {
+		return nil, errors.Wrapf(err, "invalid sender address %q", config.From)
+	}
+	return &smtpNotifier{
+		config:   config,
+		sendMail: smtp.SendMail,
+	}, nil
+}
+
+func (n *smtpNotifier) ValidateTarget(target string) error {
+	address, err := mail.ParseAddress(target)
+	if err != nil {
+		return errors.Wrapf(err, "invalid email address %q", target)
+	}
+	if address.Name != "" {
+		return errors.Errorf("email address %q must not have a display name", target)
+	}
+	return nil
+}
+
+func (n *smtpNotifier) Notify(ctx context.Context, target string, notification Notification) error {
+	if err := n.ValidateTarget(target); err != nil {
+		return err
+	}
+	var auth smtp.Auth
+	if n.config.Username != "" {
+		auth = smtp.PlainAuth("", n.config.Username, n.config.Password, n.config.Host)
+	}
+	addr := net.JoinHostPort(n.config.Host, strconv.Itoa(n.config.Port))
+	msg := emailMessage(n.config.From, target, notification, time.Now())
+
+	// net/smtp does not take a context, the send is abandoned rather than interrupted when the context is done
+	result := make(chan error, 1)
+	go func() {
+		result <- n.sendMail(addr, auth, n.config.From, []string{target}, msg)
+	}()
+	select {
+	case err := <-result:
+		return errors.Wrapf(err, "unable to send email to %s", target)
+	case <-ctx.Done():
+		return errors.Wrapf(ctx.Err(), "unable to send email to %s", target)
+	}
+}
+
+// emailMessage formats the notification as an RFC 5322 plain text message
+func emailMessage(from string, to string, notification Notification, date time.Time) []byte {
+	var msg bytes.Buffer
+	for _, header := range [][2]string{
+		{"From", from},
+		{"To", to},
+		{"Subject", mime.QEncoding.Encode("utf-8", notification.Subject)},
+		{"Date", date.Format(time.RFC1123Z)},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", "text/plain; charset=utf-8"},
+	} {
+		fmt.Fprintf(&msg, "%s: %s\r\n", header[0], header[1])
+	}
+	msg.WriteString("\r\n")
+	msg.WriteString(strings.ReplaceAll(strings.ReplaceAll(notification.Message, "\r\n", "\n"), "\n", "\r\n"))
+	return msg.Bytes()
+}
@@ -0,0 +1,66 @@
+package notification
+
+import (
+	"context"
+	"net/smtp"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestSMTPNotifier_ValidateTarget(t *testing.T) {
+	RegisterTestingT(t)
+	notifier, err := NewSMTPNotifier(SMTPConfig{From: "kafka@example.com"})
+	Expect(err).ToNot(HaveOccurred())
+
+	Expect(notifier.ValidateTarget("owner@example.com")).To(Succeed())
+	Expect(notifier.ValidateTarget("Owner <owner@example.com>")).ToNot(Succeed())
+	Expect(notifier.ValidateTarget("example.com")).ToNot(Succeed())
+
+	_, err = NewSMTPNotifier(SMTPConfig{From: "kafka"})
+	Expect(err).To(HaveOccurred())
+}
+
+func TestSMTPNotifier_Notify(t *testing.T) {
+	RegisterTestingT(t)
+	var sent struct {
+		addr string
+		auth smtp.Auth
+		from string
+		to   []string
+		msg  string
+	}
+	notifier := &smtpNotifier{
+		config: SMTPConfig{Host: "smtp.example.com", Port: 587, Username: "kafka", Password: "secret", From: "kafka@example.com"},
+		sendMail: func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+			sent.addr, sent.auth, sent.from, sent.to, sent.msg = addr, a, from, to, string(msg)
+			return nil
+		},
+	}
+
+	err := notifier.Notify(context.Background(), "owner@example.com", Notification{Subject: "Alert firing", Message: "line 1\nline 2"})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(sent.addr).To(Equal("smtp.example.com:587"))
+	Expect(sent.auth).ToNot(BeNil())
+	Expect(sent.from).To(Equal("kafka@example.com"))
+	Expect(sent.to).To(Equal([]string{"owner@example.com"}))
+	Expect(sent.msg).To(ContainSubstring("To: owner@example.com\r\n"))
+	Expect(sent.msg).To(ContainSubstring("Subject: Alert firing\r\n"))
+	Expect(strings.HasSuffix(sent.msg, "\r\n\r\nline 1\r\nline 2")).To(BeTrue())
+}
+
+func TestEmailMessage(t *testing.T) {
+	RegisterTestingT(t)
+	date := time.Date(2022, 3, 5, 12, 0, 0, 0, time.UTC)
+	msg := string(emailMessage("kafka@example.com", "owner@example.com", Notification{Subject: "Über", Message: "body"}, date))
+	Expect(msg).To(Equal("From: kafka@example.com\r\n" +
+		"To: owner@example.com\r\n" +
+		"Subject: =?utf-8?q?=C3=9Cber?=\r\n" +
+		"Date: Sat, 05 Mar 2022 12:00:00 +0000\r\n" +
+		"MIME-Version: 1.0\r\n" +
+		"Content-Type: text/plain; charset=utf-8\r\n" +
+		"\r\n" +
+		"body"))
+}
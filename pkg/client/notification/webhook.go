@@ -0,0 +1,157 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// WebhookConfig contains the settings of the webhook notifications
+type WebhookConfig struct {
+	Timeout time.Duration
+	// AllowHTTP allows plain http targets, the targets must be https URLs otherwise
+	AllowHTTP bool
+	// AllowPrivateNetworks allows the targets to resolve to loopback, private and link-local addresses, they are
+	// refused when dialing otherwise so that the webhooks can not reach the internal services
+	AllowPrivateNetworks bool
+}
+
+// blockedNetworks are the networks the webhooks are not delivered to unless private networks are allowed
+var blockedNetworks = parseCIDRs(
+	"0.0.0.0/8",
+	"10.0.0.0/8",
+	"100.64.0.0/10",
+	"127.0.0.0/8",
+	"169.254.0.0/16",
+	"172.16.0.0/12",
+	"192.168.0.0/16",
+	"::/128",
+	"::1/128",
+	"fc00::/7",
+	"fe80::/10",
+)
+
+func parseCIDRs(cidrs ...string) []*net.IPNet {
+	var networks []*net.IPNet
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+func isBlockedIP(ip net.IP) bool {
+	if ip.IsMulticast() {
+		return true
+	}
+	for _, network := range blockedNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// dialControl refuses the connections to the blocked networks, it is called with the resolved address so that a
+// target host resolving to a private address, or rebinding to one after it was validated, is refused
+func dialControl(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || isBlockedIP(ip) {
+		return errors.Errorf("webhook address %s is not allowed", host)
+	}
+	return nil
+}
+
+type webhookNotifier struct {
+	config WebhookConfig
+	client *http.Client
+}
+
+var _ Notifier = &webhookNotifier{}
+
+// NewWebhookNotifier returns a notifier posting the JSON payload of the notifications to the URLs they target
+func NewWebhookNotifier(config WebhookConfig) Notifier {
+	dialer := &net.Dialer{Timeout: config.Timeout}
+	if !config.AllowPrivateNetworks {
+		dialer.Control = dialControl
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// the targets are called directly, a proxy would dial them on behalf of the notifier
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &webhookNotifier{
+		config: config,
+		client: &http.Client{
+			Timeout:   config.Timeout,
+			Transport: transport,
+			// a redirect could send the notification to a target that was not validated
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return errors.Errorf("webhook redirected to %s", req.URL.Redacted())
+			},
+		},
+	}
+}
+
+func (n *webhookNotifier) ValidateTarget(target string) error {
+	u, err := url.Parse(target)
+	if err != nil {
+		return errors.Wrapf(err, "invalid webhook URL %q", target)
+	}
+	if u.Host == "" {
+		return errors.Errorf("webhook URL %q has no host", target)
+	}
+	if u.User != nil {
+		return errors.Errorf("webhook URL %q must not contain credentials", target)
+	}
+	switch {
+	case u.Scheme == "https":
+	case u.Scheme == "http" && n.config.AllowHTTP:
+	default:
+		return errors.Errorf("webhook URL %q must be an https URL", target)
+	}
+	if ip := net.ParseIP(u.Hostname()); ip != nil && isBlockedIP(ip) && !n.config.AllowPrivateNetworks {
+		return errors.Errorf("webhook URL %q must not target a private address", target)
+	}
+	return nil
+}
+
+func (n *webhookNotifier) Notify(ctx context.Context, target string, notification Notification) error {
+	if err := n.ValidateTarget(target); err != nil {
+		return err
+	}
+	body, err := json.Marshal(notification.Payload)
+	if err != nil {
+		return errors.Wrap(err, "unable to encode the webhook payload")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrapf(err, "unable to create the request to %s", target)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "unable to call webhook %s", target)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("webhook %s responded with %s", target, resp.Status)
+	}
+	return nil
+}
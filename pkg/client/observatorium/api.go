@@ -2,6 +2,7 @@ package observatorium
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/golang/glog"
@@ -26,7 +27,8 @@ type ServiceObservatorium struct {
 }
 
 func (obs *ServiceObservatorium) GetKafkaState(name string, resourceNamespace string) (KafkaState, error) {
-	KafkaState := KafkaState{}
+	// the state is unknown when the kafka has no strimzi_resource_state series
+	KafkaState := KafkaState{State: ClusterStateUnknown}
 	c := obs.client
 	metric := `strimzi_resource_state{%s}`
 	labels := fmt.Sprintf(`kind=~'Kafka', name=~'%s',resource_namespace=~'%s'`, name, resourceNamespace)
@@ -40,7 +42,7 @@ func (obs *ServiceObservatorium) GetKafkaState(name string, resourceNamespace st
 		if s.Value == 1 {
 			KafkaState.State = ClusterStateReady
 		} else {
-			KafkaState.State = ClusterStateUnknown
+			KafkaState.State = ClusterStateNotReady
 		}
 	}
 	return KafkaState, nil
@@ -48,7 +50,53 @@ func (obs *ServiceObservatorium) GetKafkaState(name string, resourceNamespace st
 
 func (obs *ServiceObservatorium) GetMetrics(metrics *KafkaMetrics, namespace string, rq *MetricsReqParams) error {
 	failedMetrics := []string{}
-	fetchers := map[string]fetcher{
+	fetchers := kafkaMetricFetchers(metrics, namespace)
+
+	for msg, f := range fetchers {
+		fetchAll := len(rq.Filters) == 0
+		if fetchAll {
+			result := obs.fetchMetricsResult(rq, &f)
+			if result.Err != nil {
+				glog.Error("error from metric ", result.Err)
+				failedMetrics = append(failedMetrics, fmt.Sprintf("%s: %s", msg, result.Err))
+			}
+			f.callback(result)
+		}
+		if !fetchAll {
+			for _, filter := range rq.Filters {
+				if filter == msg {
+					result := obs.fetchMetricsResult(rq, &f)
+					if result.Err != nil {
+						glog.Error("error from metric ", result.Err)
+						failedMetrics = append(failedMetrics, fmt.Sprintf("%s: %s", msg, result.Err))
+					}
+					f.callback(result)
+				}
+			}
+
+		}
+
+	}
+	if len(failedMetrics) > 0 {
+		return errors.New(fmt.Sprintf("Failed to fetch metrics data [%s]", strings.Join(failedMetrics, ",")))
+	}
+	return nil
+}
+
+// KafkaMetricNames returns the names of the metrics that can be fetched for a kafka, sorted
+func KafkaMetricNames() []string {
+	var names []string
+	for name := range kafkaMetricFetchers(nil, "") {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// kafkaMetricFetchers returns the fetchers of the metrics of the kafka in the namespace by metric name, appending the
+// fetched metrics to metrics
+func kafkaMetricFetchers(metrics *KafkaMetrics, namespace string) map[string]fetcher {
+	return map[string]fetcher{
 		//Check metrics for available disk space per broker
 		"kubelet_volume_stats_available_bytes": {
 			`kubelet_volume_stats_available_bytes{%s}`,
@@ -200,36 +248,6 @@ func (obs *ServiceObservatorium) GetMetrics(metrics *KafkaMetrics, namespace str
 			},
 		},
 	}
-
-	for msg, f := range fetchers {
-		fetchAll := len(rq.Filters) == 0
-		if fetchAll {
-			result := obs.fetchMetricsResult(rq, &f)
-			if result.Err != nil {
-				glog.Error("error from metric ", result.Err)
-				failedMetrics = append(failedMetrics, fmt.Sprintf("%s: %s", msg, result.Err))
-			}
-			f.callback(result)
-		}
-		if !fetchAll {
-			for _, filter := range rq.Filters {
-				if filter == msg {
-					result := obs.fetchMetricsResult(rq, &f)
-					if result.Err != nil {
-						glog.Error("error from metric ", result.Err)
-						failedMetrics = append(failedMetrics, fmt.Sprintf("%s: %s", msg, result.Err))
-					}
-					f.callback(result)
-				}
-			}
-
-		}
-
-	}
-	if len(failedMetrics) > 0 {
-		return errors.New(fmt.Sprintf("Failed to fetch metrics data [%s]", strings.Join(failedMetrics, ",")))
-	}
-	return nil
 }
 
 func (obs *ServiceObservatorium) fetchMetricsResult(rq *MetricsReqParams, f *fetcher) Metric {
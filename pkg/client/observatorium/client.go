@@ -12,6 +12,7 @@ import (
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/tracing"
 	"github.com/pkg/errors"
 	pAPI "github.com/prometheus/client_golang/api"
 	pV1 "github.com/prometheus/client_golang/api/prometheus/v1"
@@ -56,12 +57,17 @@ func NewObservatoriumClient(c *ObservabilityConfiguration) (client *Client, err
 	if c.EnableMock {
 		glog.Infof("Using Mock Observatorium Client")
 		client, err = NewClientMock(observatoriumConfig)
+	} else if c.MetricsBackend == MetricsBackendPrometheus {
+		glog.Infof("Using Prometheus metrics backend %s", c.Prometheus.URL)
+		client, err = NewPrometheusClient(&c.Prometheus, c.Timeout)
 	} else {
 		client, err = NewClient(observatoriumConfig)
 	}
 	if err != nil {
 		glog.Errorf("Unable to create Observatorium client: %s", err)
+		return
 	}
+	client.Service = NewCachedService(client.Service, c.MetricsCache)
 	return
 }
 
@@ -90,7 +96,7 @@ func NewClient(config *Configuration) (*Client, error) {
 		Address: client.Config.BaseURL,
 		RoundTripper: observatoriumRoundTripper{
 			config:  *client.Config,
-			wrapped: pAPI.DefaultRoundTripper,
+			wrapped: tracing.Transport(pAPI.DefaultRoundTripper),
 		},
 	})
 	if err != nil {
@@ -139,6 +145,9 @@ func (p observatoriumRoundTripper) RoundTrip(request *http.Request) (*http.Respo
 			metrics.IncreaseObservatoriumRequestCount(statusCode, path, request.Method)
 			return nil, errors.Errorf("can't request metrics without auth")
 		}
+	} else if p.config.AuthToken != "" {
+		// e.g. the Prometheus servers authenticating with an optional bearer token
+		request.Header.Set("Authorization", fmt.Sprintf("Bearer %s", p.config.AuthToken))
 	}
 
 	start := time.Now()
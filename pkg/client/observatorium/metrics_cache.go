@@ -0,0 +1,192 @@
+package observatorium
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"github.com/pkg/errors"
+)
+
+// DefaultMetricSet is the metric set the metrics API fetches for the requests that don't filter the metrics. All the
+// metrics are fetched in its place when it isn't configured.
+const DefaultMetricSet = "default"
+
+// MetricsCacheConfig configures the cache in front of the Observatorium metrics queries
+type MetricsCacheConfig struct {
+	// InstantTTL is how long the result of an instant query of a metric is cached, 0 disables the cache
+	InstantTTL time.Duration `json:"instant_ttl"`
+	// RangeTTL is how long the result of a range query of a metric is cached, 0 disables the cache
+	RangeTTL time.Duration `json:"range_ttl"`
+	// MaxDataPoints is the maximum number of samples per series returned by a range query. The step of the range
+	// queries spanning more samples is widened to fit, 0 disables the downsampling.
+	MaxDataPoints int `json:"max_data_points"`
+	// MetricSets are named lists of metrics that can be requested in place of the metrics they list
+	MetricSets     map[string][]string `json:"-"`
+	MetricSetsFile string              `json:"metric_sets_file"`
+}
+
+// validate checks the metric sets only list metrics that can be fetched
+func (c *MetricsCacheConfig) validate() error {
+	known := map[string]bool{}
+	for _, name := range KafkaMetricNames() {
+		known[name] = true
+	}
+	for set, metrics := range c.MetricSets {
+		if known[set] {
+			return errors.Errorf("metric set %q has the name of a metric", set)
+		}
+		if len(metrics) == 0 {
+			return errors.Errorf("metric set %q is empty", set)
+		}
+		for _, metric := range metrics {
+			if !known[metric] {
+				return errors.Errorf("metric set %q lists unknown metric %q", set, metric)
+			}
+		}
+	}
+	return nil
+}
+
+var _ APIObservatoriumService = &cachedService{}
+
+// cachedService fetches each metric of a query separately so that the result of a metric of a kafka is cached, and
+// shared by the concurrent identical queries, independently of the other metrics requested with it. The range queries
+// are aligned on their step so that the queries sent within the same step share their results.
+type cachedService struct {
+	service  APIObservatoriumService
+	config   MetricsCacheConfig
+	cache    *cache.Cache
+	mutex    sync.Mutex
+	inflight map[string]*inflightQuery
+}
+
+type inflightQuery struct {
+	done    chan struct{}
+	metrics KafkaMetrics
+	err     error
+}
+
+// NewCachedService returns an APIObservatoriumService caching the metrics fetched by service
+func NewCachedService(service APIObservatoriumService, config MetricsCacheConfig) APIObservatoriumService {
+	return &cachedService{
+		service:  service,
+		config:   config,
+		cache:    cache.New(cache.NoExpiration, 10*time.Minute),
+		inflight: map[string]*inflightQuery{},
+	}
+}
+
+// GetKafkaState is not cached as the workers rely on the current state of the kafkas
+func (s *cachedService) GetKafkaState(name string, namespaceName string) (KafkaState, error) {
+	return s.service.GetKafkaState(name, namespaceName)
+}
+
+func (s *cachedService) GetMetrics(metrics *KafkaMetrics, namespace string, rq *MetricsReqParams) error {
+	ttl := s.config.InstantTTL
+	if rq.ResultType == RangeQuery {
+		s.downsample(rq)
+		ttl = s.config.RangeTTL
+	}
+
+	failedMetrics := []string{}
+	for _, metric := range s.metricNames(rq.Filters) {
+		query := *rq
+		query.Filters = []string{metric}
+		result, err := s.fetch(namespace, &query, ttl)
+		if err != nil {
+			// the error of the metric itself rather than the error of the query wrapping it
+			for _, m := range result {
+				if m.Err != nil {
+					err = m.Err
+				}
+			}
+			failedMetrics = append(failedMetrics, fmt.Sprintf("%s: %s", metric, err))
+		}
+		*metrics = append(*metrics, result...)
+	}
+	if len(failedMetrics) > 0 {
+		return errors.New(fmt.Sprintf("Failed to fetch metrics data [%s]", strings.Join(failedMetrics, ",")))
+	}
+	return nil
+}
+
+// metricNames expands the metric sets in the filters into the metrics they list. All the metrics are fetched when no
+// filters are given, e.g. by the federate endpoint, or when the default metric set is requested but not configured.
+func (s *cachedService) metricNames(filters []string) []string {
+	if len(filters) == 0 {
+		return KafkaMetricNames()
+	}
+	var names []string
+	seen := map[string]bool{}
+	for _, filter := range filters {
+		metrics, ok := s.config.MetricSets[filter]
+		switch {
+		case !ok && filter == DefaultMetricSet:
+			metrics = KafkaMetricNames()
+		case !ok:
+			metrics = []string{filter}
+		}
+		for _, metric := range metrics {
+			if !seen[metric] {
+				seen[metric] = true
+				names = append(names, metric)
+			}
+		}
+	}
+	return names
+}
+
+// downsample widens the step of the range query to a multiple of its step so that it returns at most MaxDataPoints
+// samples per series, and aligns the range on the step. The start is rounded down and the end up, so that the aligned
+// range still covers the newest sample.
+func (s *cachedService) downsample(rq *MetricsReqParams) {
+	if rq.Step <= 0 {
+		return
+	}
+	duration := rq.End.Sub(rq.Start)
+	if max := int64(s.config.MaxDataPoints); max > 0 && int64(duration/rq.Step) > max {
+		factor := (int64(duration/rq.Step) + max - 1) / max
+		rq.Step = time.Duration(factor) * rq.Step
+	}
+	rq.Start = rq.Start.Truncate(rq.Step)
+	if end := rq.End.Truncate(rq.Step); end.Before(rq.End) {
+		rq.End = end.Add(rq.Step)
+	}
+}
+
+// fetch returns the cached result of the query of a single metric, or fetches it, waiting for the identical query in
+// flight if any
+func (s *cachedService) fetch(namespace string, rq *MetricsReqParams, ttl time.Duration) (KafkaMetrics, error) {
+	key := fmt.Sprintf("%s/%s/%s", namespace, rq.Filters[0], rq.ResultType)
+	if rq.ResultType == RangeQuery {
+		key = fmt.Sprintf("%s/%s/%d/%d", key, rq.Step, rq.Start.Unix(), rq.End.Unix())
+	}
+
+	s.mutex.Lock()
+	if cached, ok := s.cache.Get(key); ok {
+		s.mutex.Unlock()
+		return cached.(KafkaMetrics), nil
+	}
+	if q, ok := s.inflight[key]; ok {
+		s.mutex.Unlock()
+		<-q.done
+		return q.metrics, q.err
+	}
+	q := &inflightQuery{done: make(chan struct{})}
+	s.inflight[key] = q
+	s.mutex.Unlock()
+
+	q.err = s.service.GetMetrics(&q.metrics, namespace, rq)
+
+	s.mutex.Lock()
+	delete(s.inflight, key)
+	if q.err == nil && ttl > 0 {
+		s.cache.Set(key, q.metrics, ttl)
+	}
+	s.mutex.Unlock()
+	close(q.done)
+	return q.metrics, q.err
+}
@@ -0,0 +1,201 @@
+package observatorium
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	pV1 "github.com/prometheus/client_golang/api/prometheus/v1"
+)
+
+// countingService returns one metric per filter, failing for the metrics in fail, and counts the queries per metric
+type countingService struct {
+	mutex   sync.Mutex
+	queries map[string]int
+	steps   []time.Duration
+	fail    map[string]bool
+	release chan struct{}
+}
+
+func (s *countingService) GetKafkaState(name string, namespaceName string) (KafkaState, error) {
+	return KafkaState{State: ClusterStateReady}, nil
+}
+
+func (s *countingService) GetMetrics(metrics *KafkaMetrics, namespace string, rq *MetricsReqParams) error {
+	if s.release != nil {
+		<-s.release
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.steps = append(s.steps, rq.Step)
+	for _, filter := range rq.Filters {
+		s.queries[filter]++
+		if s.fail[filter] {
+			*metrics = append(*metrics, Metric{Err: errors.New("timeout")})
+			return errors.Errorf("Failed to fetch metrics data [%s: timeout]", filter)
+		}
+		*metrics = append(*metrics, Metric{})
+	}
+	return nil
+}
+
+func newCountingService() *countingService {
+	return &countingService{queries: map[string]int{}, fail: map[string]bool{}}
+}
+
+func TestCachedService_GetMetrics(t *testing.T) {
+	RegisterTestingT(t)
+	service := newCountingService()
+	service.fail["kubelet_volume_stats_used_bytes"] = true
+	cached := NewCachedService(service, MetricsCacheConfig{
+		InstantTTL: time.Minute,
+		MetricSets: map[string][]string{
+			"storage": {"kafka_broker_quota_softlimitbytes", "kubelet_volume_stats_used_bytes"},
+		},
+	})
+
+	var metrics KafkaMetrics
+	err := cached.GetMetrics(&metrics, "kafka-ns", &MetricsReqParams{ResultType: Query, Filters: []string{"storage", "kafka_broker_quota_softlimitbytes"}})
+	Expect(err).To(MatchError("Failed to fetch metrics data [kubelet_volume_stats_used_bytes: timeout]"))
+	Expect(metrics).To(HaveLen(2))
+
+	metrics = nil
+	err = cached.GetMetrics(&metrics, "kafka-ns", &MetricsReqParams{ResultType: Query, Filters: []string{"kafka_broker_quota_softlimitbytes"}})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(metrics).To(HaveLen(1))
+	// the successful metric is cached, the failed one is not
+	Expect(service.queries).To(Equal(map[string]int{"kafka_broker_quota_softlimitbytes": 1, "kubelet_volume_stats_used_bytes": 1}))
+
+	// the cache is per kafka
+	err = cached.GetMetrics(&metrics, "other-kafka-ns", &MetricsReqParams{ResultType: Query, Filters: []string{"kafka_broker_quota_softlimitbytes"}})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(service.queries["kafka_broker_quota_softlimitbytes"]).To(Equal(2))
+}
+
+func TestCachedService_GetMetrics_DefaultMetricSet(t *testing.T) {
+	RegisterTestingT(t)
+	service := newCountingService()
+	cached := NewCachedService(service, MetricsCacheConfig{})
+	var metrics KafkaMetrics
+	Expect(cached.GetMetrics(&metrics, "kafka-ns", &MetricsReqParams{ResultType: Query, Filters: []string{DefaultMetricSet}})).To(Succeed())
+	Expect(metrics).To(HaveLen(len(KafkaMetricNames())))
+
+	service = newCountingService()
+	cached = NewCachedService(service, MetricsCacheConfig{
+		MetricSets: map[string][]string{DefaultMetricSet: {"kafka_broker_quota_softlimitbytes"}},
+	})
+	metrics = nil
+	Expect(cached.GetMetrics(&metrics, "kafka-ns", &MetricsReqParams{ResultType: Query, Filters: []string{DefaultMetricSet}})).To(Succeed())
+	Expect(service.queries).To(Equal(map[string]int{"kafka_broker_quota_softlimitbytes": 1}))
+
+	// the federate endpoint doesn't filter the metrics, it gets all of them whatever the default metric set
+	metrics = nil
+	Expect(cached.GetMetrics(&metrics, "kafka-ns", &MetricsReqParams{ResultType: Query})).To(Succeed())
+	Expect(metrics).To(HaveLen(len(KafkaMetricNames())))
+}
+
+func TestCachedService_GetMetrics_Coalescing(t *testing.T) {
+	RegisterTestingT(t)
+	service := newCountingService()
+	service.release = make(chan struct{})
+	// no TTL so that only the queries in flight are shared
+	cached := NewCachedService(service, MetricsCacheConfig{})
+
+	var wg sync.WaitGroup
+	errs := make([]error, 5)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			var metrics KafkaMetrics
+			errs[i] = cached.GetMetrics(&metrics, "kafka-ns", &MetricsReqParams{ResultType: Query, Filters: []string{"kafka_broker_quota_softlimitbytes"}})
+		}(i)
+	}
+	Eventually(func() int {
+		s := cached.(*cachedService)
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		return len(s.inflight)
+	}).Should(Equal(1))
+	// lets the other queries join the query in flight
+	time.Sleep(100 * time.Millisecond)
+	close(service.release)
+	wg.Wait()
+	Expect(errs).To(Equal(make([]error, 5)))
+	Expect(service.queries["kafka_broker_quota_softlimitbytes"]).To(Equal(1))
+}
+
+func TestCachedService_Downsample(t *testing.T) {
+	end := time.Date(2022, 3, 6, 12, 0, 45, 0, time.UTC)
+	tests := []struct {
+		name          string
+		maxDataPoints int
+		duration      time.Duration
+		wantStep      time.Duration
+		wantStart     time.Time
+		wantEnd       time.Time
+	}{
+		{
+			name:          "should keep the step of a short range",
+			maxDataPoints: 300,
+			duration:      time.Hour,
+			wantStep:      30 * time.Second,
+			wantStart:     time.Date(2022, 3, 6, 11, 0, 30, 0, time.UTC),
+			wantEnd:       time.Date(2022, 3, 6, 12, 1, 0, 0, time.UTC),
+		},
+		{
+			name:          "should widen the step of a long range to a multiple of the step",
+			maxDataPoints: 300,
+			duration:      24 * time.Hour,
+			wantStep:      5 * time.Minute,
+			wantStart:     time.Date(2022, 3, 5, 12, 0, 0, 0, time.UTC),
+			wantEnd:       time.Date(2022, 3, 6, 12, 5, 0, 0, time.UTC),
+		},
+		{
+			name:     "should not downsample when disabled",
+			duration: 24 * time.Hour,
+			wantStep:  30 * time.Second,
+			wantStart: time.Date(2022, 3, 5, 12, 0, 30, 0, time.UTC),
+			wantEnd:   time.Date(2022, 3, 6, 12, 1, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			service := newCountingService()
+			cached := NewCachedService(service, MetricsCacheConfig{RangeTTL: time.Minute, MaxDataPoints: tt.maxDataPoints})
+			rq := &MetricsReqParams{
+				ResultType: RangeQuery,
+				Filters:    []string{"kafka_broker_quota_softlimitbytes"},
+				Range:      pV1.Range{Start: end.Add(-tt.duration), End: end, Step: 30 * time.Second},
+			}
+			var metrics KafkaMetrics
+			Expect(cached.GetMetrics(&metrics, "kafka-ns", rq)).To(Succeed())
+			Expect(rq.Step).To(Equal(tt.wantStep))
+			Expect(rq.Start).To(Equal(tt.wantStart))
+			Expect(rq.End).To(Equal(tt.wantEnd))
+			// the aligned range still covers the newest sample
+			Expect(rq.End).To(BeTemporally(">=", end))
+			Expect(service.steps).To(Equal([]time.Duration{tt.wantStep}))
+
+			// a refresh within the same step hits the cache
+			rq.Range = pV1.Range{Start: end.Add(-tt.duration + 5*time.Second), End: end.Add(5 * time.Second), Step: 30 * time.Second}
+			Expect(cached.GetMetrics(&metrics, "kafka-ns", rq)).To(Succeed())
+			Expect(service.queries["kafka_broker_quota_softlimitbytes"]).To(Equal(1))
+		})
+	}
+}
+
+func TestMetricsCacheConfig_MetricSetsFile(t *testing.T) {
+	RegisterTestingT(t)
+	config := MetricsCacheConfig{}
+	Expect(readMetricSetsFile("config/metric-sets.yaml", &config.MetricSets)).To(Succeed())
+	Expect(config.MetricSets).ToNot(BeEmpty())
+	Expect(config.validate()).To(Succeed())
+
+	config.MetricSets["storage"] = append(config.MetricSets["storage"], "up")
+	Expect(config.validate()).To(MatchError(`metric set "storage" lists unknown metric "up"`))
+}
@@ -1,10 +1,12 @@
 package observatorium
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v2"
 )
 
 const (
@@ -48,6 +50,13 @@ type ObservabilityConfiguration struct {
 	Debug                bool          `json:"debug"`
 	EnableMock           bool          `json:"enable_mock"`
 
+	// MetricsBackend is either Observatorium or a Prometheus server the metrics of the kafkas are queried from
+	MetricsBackend string           `json:"metrics_backend"`
+	Prometheus     PrometheusConfig `json:"prometheus"`
+
+	// MetricsCache configures the cache of the metrics of the kafkas fetched from Observatorium
+	MetricsCache MetricsCacheConfig `json:"metrics_cache"`
+
 	// Configuration repo for the Observability operator
 	ObservabilityConfigTag             string `json:"observability_config_tag"`
 	ObservabilityConfigRepo            string `json:"observability_config_repo"`
@@ -85,6 +94,12 @@ func NewObservabilityConfigurationConfig() *ObservabilityConfiguration {
 		RedHatSsoRealm:                     "",
 		RedHatSsoTokenRefresherUrl:         "",
 		RedHatSsoGatewayUrl:                "",
+		MetricsBackend:                     MetricsBackendObservatorium,
+		MetricsCache: MetricsCacheConfig{
+			InstantTTL:    30 * time.Second,
+			RangeTTL:      time.Minute,
+			MaxDataPoints: 300,
+		},
 	}
 }
 
@@ -112,6 +127,17 @@ func (c *ObservabilityConfiguration) AddFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&c.Insecure, "observatorium-ignore-ssl", c.Insecure, "ignore SSL Observatorium certificate")
 	fs.BoolVar(&c.EnableMock, "enable-observatorium-mock", c.EnableMock, "Enable mock Observatorium client")
 	fs.BoolVar(&c.Debug, "observatorium-debug", c.Debug, "Debug flag for Observatorium client")
+	fs.StringVar(&c.MetricsBackend, "metrics-backend", c.MetricsBackend, "Where the metrics of the kafkas are queried from, either 'observatorium' or 'prometheus'")
+	fs.StringVar(&c.Prometheus.URL, "prometheus-url", c.Prometheus.URL, "URL of the Prometheus HTTP API queried with the prometheus metrics backend (i.e. https://thanos-querier:9091)")
+	fs.StringVar(&c.Prometheus.BearerTokenFile, "prometheus-bearer-token-file", c.Prometheus.BearerTokenFile, "File containing the bearer token authenticating to Prometheus")
+	fs.StringVar(&c.Prometheus.ClientCertFile, "prometheus-client-cert-file", c.Prometheus.ClientCertFile, "File containing the client certificate authenticating to Prometheus with mTLS")
+	fs.StringVar(&c.Prometheus.ClientKeyFile, "prometheus-client-key-file", c.Prometheus.ClientKeyFile, "File containing the key of the client certificate authenticating to Prometheus")
+	fs.StringVar(&c.Prometheus.CAFile, "prometheus-ca-file", c.Prometheus.CAFile, "File containing the CA certificates verifying the certificate of Prometheus, the system CAs if empty")
+	fs.BoolVar(&c.Prometheus.Insecure, "prometheus-ignore-ssl", c.Prometheus.Insecure, "Ignore the certificate of Prometheus")
+	fs.DurationVar(&c.MetricsCache.InstantTTL, "observatorium-metrics-cache-ttl", c.MetricsCache.InstantTTL, "How long the result of an instant query of a kafka metric is cached, 0 disables the cache")
+	fs.DurationVar(&c.MetricsCache.RangeTTL, "observatorium-metrics-range-cache-ttl", c.MetricsCache.RangeTTL, "How long the result of a range query of a kafka metric is cached, 0 disables the cache")
+	fs.IntVar(&c.MetricsCache.MaxDataPoints, "observatorium-metrics-max-data-points", c.MetricsCache.MaxDataPoints, "Maximum number of samples per series returned by a range query of the kafka metrics, the step of longer ranges is widened to fit. 0 disables the downsampling")
+	fs.StringVar(&c.MetricsCache.MetricSetsFile, "observatorium-metric-sets-file", c.MetricsCache.MetricSetsFile, "File containing the named sets of kafka metrics that can be requested by name, no metric sets if empty")
 
 	fs.StringVar(&c.ObservabilityConfigRepo, "observability-config-repo", c.ObservabilityConfigRepo, "Repo for the observability operator configuration repo")
 	fs.StringVar(&c.ObservabilityConfigChannel, "observability-config-channel", c.ObservabilityConfigChannel, "Channel for the observability operator configuration repo")
@@ -143,6 +169,25 @@ func (c *ObservabilityConfiguration) ReadFiles() error {
 		return configFileError
 	}
 
+	switch c.MetricsBackend {
+	case MetricsBackendObservatorium:
+	case MetricsBackendPrometheus:
+		if err := c.Prometheus.ReadFiles(); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("invalid metrics backend %q, expected %q or %q", c.MetricsBackend, MetricsBackendObservatorium, MetricsBackendPrometheus)
+	}
+
+	if c.MetricsCache.MetricSetsFile != "" {
+		if err := readMetricSetsFile(c.MetricsCache.MetricSetsFile, &c.MetricsCache.MetricSets); err != nil {
+			return err
+		}
+		if err := c.MetricsCache.validate(); err != nil {
+			return err
+		}
+	}
+
 	if c.ObservabilityConfigAccessToken == "" && c.ObservabilityConfigAccessTokenFile != "" {
 		return shared.ReadFileValueString(c.ObservabilityConfigAccessTokenFile, &c.ObservabilityConfigAccessToken)
 	}
@@ -172,3 +217,12 @@ func (c *ObservabilityConfiguration) ReadObservatoriumConfigFiles() error {
 
 	return nil
 }
+
+func readMetricSetsFile(file string, val *map[string][]string) error {
+	fileContents, err := shared.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	return yaml.UnmarshalStrict([]byte(fileContents), val)
+}
@@ -0,0 +1,105 @@
+package observatorium
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/tracing"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/pkg/errors"
+	pAPI "github.com/prometheus/client_golang/api"
+	pV1 "github.com/prometheus/client_golang/api/prometheus/v1"
+)
+
+const (
+	MetricsBackendObservatorium = "observatorium"
+	MetricsBackendPrometheus    = "prometheus"
+)
+
+// PrometheusConfig configures a server implementing the Prometheus HTTP API (i.e. Prometheus or a Thanos querier)
+// queried for the metrics of the kafkas in place of Observatorium
+type PrometheusConfig struct {
+	URL             string `json:"url"`
+	BearerToken     string `json:"-"`
+	BearerTokenFile string `json:"bearer_token_file"`
+	// ClientCertFile and ClientKeyFile authenticate to the server with mTLS
+	ClientCertFile string `json:"client_cert_file"`
+	ClientKeyFile  string `json:"client_key_file"`
+	// CAFile verifies the certificate of the server instead of the system CAs
+	CAFile   string `json:"ca_file"`
+	Insecure bool   `json:"insecure"`
+}
+
+func (c *PrometheusConfig) ReadFiles() error {
+	if c.URL == "" {
+		return errors.New("the URL of the Prometheus server must be set with --prometheus-url")
+	}
+	if (c.ClientCertFile == "") != (c.ClientKeyFile == "") {
+		return errors.New("both --prometheus-client-cert-file and --prometheus-client-key-file must be set to authenticate to Prometheus with mTLS")
+	}
+	if c.BearerToken == "" && c.BearerTokenFile != "" {
+		return shared.ReadFileValueString(c.BearerTokenFile, &c.BearerToken)
+	}
+	return nil
+}
+
+// NewPrometheusClient returns a client querying the metrics of the kafkas from a Prometheus server. The kafka metrics
+// queries are the same as the ones sent to Observatorium, some of them read series recorded by the recording rules of
+// the observability stack (e.g. kafka_topic:kafka_log_log_size:sum) which the Prometheus server must evaluate too.
+func NewPrometheusClient(config *PrometheusConfig, timeout time.Duration) (*Client, error) {
+	client := &Client{
+		Config: &ClientConfiguration{
+			BaseURL:   strings.TrimSuffix(config.URL, "/") + "/",
+			Timeout:   timeout,
+			AuthToken: config.BearerToken,
+			Insecure:  config.Insecure,
+		},
+	}
+
+	tlsConfig, err := prometheusTLSConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	transport := pAPI.DefaultRoundTripper.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	apiClient, err := pAPI.NewClient(pAPI.Config{
+		Address: client.Config.BaseURL,
+		RoundTripper: observatoriumRoundTripper{
+			config:  *client.Config,
+			wrapped: tracing.Transport(transport),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	client.connection = pV1.NewAPI(apiClient)
+	client.Service = &ServiceObservatorium{client: client}
+	return client, nil
+}
+
+func prometheusTLSConfig(config *PrometheusConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: config.Insecure}
+	if config.CAFile != "" {
+		caCert, err := ioutil.ReadFile(shared.BuildFullFilePath(config.CAFile))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read the CA of the Prometheus server")
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caCert) {
+			return nil, errors.Errorf("no certificate found in %s", config.CAFile)
+		}
+	}
+	if config.ClientCertFile != "" {
+		cert, err := tls.LoadX509KeyPair(shared.BuildFullFilePath(config.ClientCertFile), shared.BuildFullFilePath(config.ClientKeyFile))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load the client certificate authenticating to Prometheus")
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
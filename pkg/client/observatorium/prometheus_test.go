@@ -0,0 +1,63 @@
+package observatorium
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestNewPrometheusClient(t *testing.T) {
+	RegisterTestingT(t)
+	var authorization, query string
+	var peerCertificates int
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		Expect(r.ParseForm()).To(Succeed())
+		authorization, query = r.Header.Get("Authorization"), r.Form.Get("query")
+		peerCertificates = len(r.TLS.PeerCertificates)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[{"metric":{"name":"my-kafka"},"value":[1646568000,"1"]}]}}`))
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	// the certificate of the test server is its own CA, it is also used as the client certificate
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	serverCert := server.TLS.Certificates[0]
+	key, err := x509.MarshalPKCS8PrivateKey(serverCert.PrivateKey)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: serverCert.Certificate[0]}), 0600)).To(Succeed())
+	Expect(ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: key}), 0600)).To(Succeed())
+
+	client, err := NewPrometheusClient(&PrometheusConfig{
+		URL:            server.URL + "/",
+		BearerToken:    "token",
+		ClientCertFile: certFile,
+		ClientKeyFile:  keyFile,
+		CAFile:         certFile,
+	}, 10*time.Second)
+	Expect(err).ToNot(HaveOccurred())
+
+	state, err := client.Service.GetKafkaState("my-kafka", "kafka-my-kafka")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(state.State).To(Equal(ClusterStateReady))
+	Expect(authorization).To(Equal("Bearer token"))
+	Expect(query).To(ContainSubstring("strimzi_resource_state{"))
+	Expect(peerCertificates).To(Equal(1))
+}
+
+func TestPrometheusConfig_ReadFiles(t *testing.T) {
+	RegisterTestingT(t)
+	Expect((&PrometheusConfig{}).ReadFiles()).To(MatchError("the URL of the Prometheus server must be set with --prometheus-url"))
+	Expect((&PrometheusConfig{URL: "https://prometheus:9091", ClientCertFile: "tls.crt"}).ReadFiles()).ToNot(Succeed())
+	Expect((&PrometheusConfig{URL: "https://prometheus:9091"}).ReadFiles()).To(Succeed())
+}
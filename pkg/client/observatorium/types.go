@@ -11,10 +11,11 @@ type State string
 type ResultType string
 
 const (
-	ClusterStateUnknown State      = "unknown"
-	ClusterStateReady   State      = "ready"
-	RangeQuery          ResultType = "query_range"
-	Query               ResultType = "query"
+	ClusterStateUnknown  State      = "unknown"
+	ClusterStateReady    State      = "ready"
+	ClusterStateNotReady State      = "not_ready"
+	RangeQuery           ResultType = "query_range"
+	Query                ResultType = "query"
 )
 
 type KafkaState struct {
@@ -7,6 +7,7 @@ import (
 	pkgerrors "github.com/pkg/errors"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/tracing"
 	sdkClient "github.com/openshift-online/ocm-sdk-go"
 	amsv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
 	v1 "github.com/openshift-online/ocm-sdk-go/authorizations/v1"
@@ -66,7 +67,8 @@ func NewOCMConnection(ocmConfig *OCMConfig, BaseUrl string) (*sdkClient.Connecti
 
 	builder := sdkClient.NewConnectionBuilder().
 		URL(BaseUrl).
-		MetricsSubsystem("api_outbound")
+		MetricsSubsystem("api_outbound").
+		TransportWrapper(tracing.Transport)
 
 	if !ocmConfig.EnableMock {
 		// Create a logger that has the debug level enabled:
@@ -0,0 +1,16 @@
+package ocm
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/health"
+)
+
+// HealthChecks checks the OCM and AMS APIs can be reached, the clusters and the quotas can't be managed without them
+func (c *OCMConfig) HealthChecks() []health.Check {
+	if c.EnableMock {
+		return nil
+	}
+	return []health.Check{
+		health.HTTPCheck("ocm", c.BaseURL+"/api/clusters_mgmt/v1", nil),
+		health.HTTPCheck("ams", c.AmsUrl+"/api/accounts_mgmt/v1", nil),
+	}
+}
@@ -0,0 +1,49 @@
+package migrate
+
+import (
+	"fmt"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+)
+
+func NewCheck(env *environments.Env) *cobra.Command {
+	return &cobra.Command{
+		Use:   "check",
+		Short: "check the database schema against the models",
+		Long:  "compare the live database schema with the models, failing on missing tables or columns",
+		Run: func(cmd *cobra.Command, args []string) {
+			env.MustInvoke(func(migrations []*db.Migration) {
+				// tables shared by the migration sets are checked by each of them
+				reported := map[db.SchemaDrift]bool{}
+				missing := 0
+				for _, migration := range migrations {
+					drifts, err := migration.CheckSchema()
+					if err != nil {
+						glog.Fatalf("Could not check the schema of the %s: %v", migration.GormOptions.TableName, err)
+					}
+					for _, drift := range drifts {
+						if reported[drift] {
+							continue
+						}
+						reported[drift] = true
+						if drift.Problem != db.SchemaDriftUnmappedColumn {
+							missing++
+						}
+						if drift.Column == "" {
+							fmt.Fprintf(cmd.OutOrStdout(), "%s: %s\n", drift.Problem, drift.Table)
+						} else {
+							fmt.Fprintf(cmd.OutOrStdout(), "%s: %s.%s\n", drift.Problem, drift.Table, drift.Column)
+						}
+					}
+				}
+				if missing > 0 {
+					glog.Fatalf("Database schema is missing %d tables or columns of the models", missing)
+				}
+				glog.Infoln("Database schema matches the models")
+			})
+		},
+	}
+}
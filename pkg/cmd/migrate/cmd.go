@@ -27,6 +27,9 @@ func NewMigrateCommand(env *environments.Env) *cobra.Command {
 	cmd.AddCommand(
 		NewRollbackAll(env),
 		NewRollbackLast(env),
+		NewStatus(env),
+		NewPlan(env),
+		NewCheck(env),
 	)
 	return cmd
 }
@@ -0,0 +1,26 @@
+package migrate
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+)
+
+func NewPlan(env *environments.Env) *cobra.Command {
+	return &cobra.Command{
+		Use:   "plan",
+		Short: "print the SQL of the pending migrations without applying them",
+		Long:  "apply the pending migrations in a transaction that is rolled back and print the SQL statements they execute",
+		Run: func(cmd *cobra.Command, args []string) {
+			env.MustInvoke(func(migrations []*db.Migration) {
+				for _, migration := range migrations {
+					glog.Infof("Planning the pending %s", migration.GormOptions.TableName)
+					if err := migration.Plan(cmd.OutOrStdout()); err != nil {
+						glog.Fatalf("Could not plan: %v", err)
+					}
+				}
+			})
+		},
+	}
+}
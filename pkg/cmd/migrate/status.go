@@ -0,0 +1,41 @@
+package migrate
+
+import (
+	"fmt"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+)
+
+func NewStatus(env *environments.Env) *cobra.Command {
+	return &cobra.Command{
+		Use:   "status",
+		Short: "list the applied and pending migrations",
+		Long:  "list the applied and pending migrations of every migration set, and the applied migrations unknown to this version",
+		Run: func(cmd *cobra.Command, args []string) {
+			env.MustInvoke(func(migrations []*db.Migration) {
+				for _, migration := range migrations {
+					status, err := migration.Status()
+					if err != nil {
+						glog.Fatalf("Could not get the status of the %s: %v", migration.GormOptions.TableName, err)
+					}
+					pending := 0
+					fmt.Fprintf(cmd.OutOrStdout(), "%s:\n", migration.GormOptions.TableName)
+					for _, s := range status {
+						state := "applied"
+						if !s.Applied {
+							state = "pending"
+							pending++
+						} else if !s.Known {
+							state = "unknown"
+						}
+						fmt.Fprintf(cmd.OutOrStdout(), "  %-8s %s\n", state, s.ID)
+					}
+					glog.Infof("Database has %d %s pending", pending, migration.GormOptions.TableName)
+				}
+			})
+		},
+	}
+}
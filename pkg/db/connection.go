@@ -114,12 +114,13 @@ const defaultRollbackPolicy = false
 
 // TxFactory represents an sql transaction
 type txFactory struct {
-	resolved          bool
-	rollbackFlag      bool
-	tx                *sql.Tx
-	txid              int64
-	postCommitActions []func()
-	db                *sql.DB
+	resolved            bool
+	rollbackFlag        bool
+	tx                  *sql.Tx
+	txid                int64
+	postCommitActions   []func()
+	postRollbackActions []func()
+	db                  *sql.DB
 }
 
 // newTransaction constructs a new Transaction object.
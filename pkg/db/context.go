@@ -48,14 +48,23 @@ func Resolve(ctx context.Context) error {
 	tx.resolved = true
 	postCommitActions := tx.postCommitActions
 	tx.postCommitActions = nil
+	postRollbackActions := tx.postRollbackActions
+	tx.postRollbackActions = nil
 	if tx.markedForRollback() {
-		if err := tx.tx.Rollback(); err != nil {
+		err := tx.tx.Rollback()
+		for _, f := range postRollbackActions {
+			f()
+		}
+		if err != nil {
 			return fmt.Errorf("Could not rollback transaction: %v", err)
 		}
 		ulog := logger.NewUHCLogger(ctx)
 		ulog.Infof("Rolled back transaction")
 	} else {
 		if err := tx.tx.Commit(); err != nil {
+			for _, f := range postRollbackActions {
+				f()
+			}
 			// TODO:  what does the user see when this occurs? seems like they will get a false positive
 			return fmt.Errorf("Could not commit transaction: %v", err)
 		}
@@ -89,6 +98,17 @@ func AddPostCommitAction(ctx context.Context, f func()) error {
 	return nil
 }
 
+// AddPostRollbackAction adds an action that is executed when the transaction is rolled back, or fails to commit
+func AddPostRollbackAction(ctx context.Context, f func()) error {
+	tx, ok := ctx.Value(transactionKey).(*txFactory)
+	if !ok {
+		return fmt.Errorf("Could not retrieve transaction from context")
+	}
+
+	tx.postRollbackActions = append(tx.postRollbackActions, f)
+	return nil
+}
+
 // FromContext Retrieves the transaction from the context.
 func FromContext(ctx context.Context) (*sql.Tx, error) {
 	transaction, ok := ctx.Value(transactionKey).(*txFactory)
@@ -0,0 +1,41 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/health"
+)
+
+var _ health.Checker = &ConnectionFactory{}
+
+// HealthChecks checks the database is reachable, which is critical, and that its connection pool is not exhausted
+func (f *ConnectionFactory) HealthChecks() []health.Check {
+	return []health.Check{
+		{
+			Name:     "database",
+			Critical: true,
+			Func: func(ctx context.Context) error {
+				sqlDB, err := f.DB.DB()
+				if err != nil {
+					return err
+				}
+				return sqlDB.PingContext(ctx)
+			},
+		},
+		{
+			Name: "database_connection_pool",
+			Func: func(ctx context.Context) error {
+				sqlDB, err := f.DB.DB()
+				if err != nil {
+					return err
+				}
+				stats := sqlDB.Stats()
+				if stats.MaxOpenConnections > 0 && stats.InUse >= stats.MaxOpenConnections {
+					return fmt.Errorf("all the %d connections of the pool are in use, %d queries waited for a connection", stats.MaxOpenConnections, stats.WaitCount)
+				}
+				return nil
+			},
+		},
+	}
+}
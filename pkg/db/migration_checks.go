@@ -0,0 +1,188 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// MigrationStatus is the state of a migration ID in the database
+type MigrationStatus struct {
+	ID      string
+	Applied bool
+	// Known is false for migrations applied to the database that are not defined by this version of the service
+	Known bool
+}
+
+// SchemaDrift is a difference between the GORM models of the service and the live database schema
+type SchemaDrift struct {
+	Table   string
+	Column  string
+	Problem string
+}
+
+const (
+	SchemaDriftMissingTable  = "missing table"
+	SchemaDriftMissingColumn = "missing column"
+	// SchemaDriftUnmappedColumn is a column of the database that no model field maps to, usually a column kept
+	// around after the field was removed. It is reported but doesn't prevent the service from working.
+	SchemaDriftUnmappedColumn = "unmapped column"
+)
+
+// Status returns the status of the migrations in the order they are applied, followed by the migrations applied to
+// the database that are unknown to this version of the service
+func (m *Migration) Status() ([]MigrationStatus, error) {
+	applied, err := m.appliedMigrationIDs()
+	if err != nil {
+		return nil, err
+	}
+	return migrationStatus(m.Migrations, applied), nil
+}
+
+// Plan applies the pending migrations in a transaction that is rolled back, printing the SQL statements they execute
+// to out. Queries are left out of the output. Migrations depending on data that only exists after a commit or on
+// statements postgres doesn't allow in a transaction can't be planned.
+func (m *Migration) Plan(out io.Writer) error {
+	planned := make([]*gormigrate.Migration, len(m.Migrations))
+	for i := range m.Migrations {
+		migration := m.Migrations[i]
+		planned[i] = &gormigrate.Migration{
+			ID: migration.ID,
+			Migrate: func(tx *gorm.DB) error {
+				fmt.Fprintf(out, "-- %s\n", migration.ID)
+				return migration.Migrate(tx)
+			},
+			Rollback: migration.Rollback,
+		}
+	}
+
+	tx := m.DbFactory.New().Session(&gorm.Session{Logger: &sqlPrinter{out: out}}).Begin()
+	if tx.Error != nil {
+		return errors.Wrap(tx.Error, "unable to start the plan transaction")
+	}
+	defer tx.Rollback()
+
+	if err := gormigrate.New(tx, m.GormOptions, planned).Migrate(); err != nil {
+		return errors.Wrap(err, "unable to plan the migrations")
+	}
+	return nil
+}
+
+// CheckSchema compares the Models of the migration with the live database schema. Only the presence of the tables and
+// columns is checked, their types are not as GORM and postgres don't agree on the type names.
+func (m *Migration) CheckSchema() ([]SchemaDrift, error) {
+	db := m.DbFactory.New()
+	drifts := []SchemaDrift{}
+	for _, model := range m.Models {
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(model); err != nil {
+			return nil, errors.Wrapf(err, "unable to parse model %T", model)
+		}
+		table := stmt.Schema.Table
+		if !db.Migrator().HasTable(table) {
+			drifts = append(drifts, SchemaDrift{Table: table, Problem: SchemaDriftMissingTable})
+			continue
+		}
+		columnTypes, err := db.Migrator().ColumnTypes(model)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to get the columns of table %s", table)
+		}
+		columns := make([]string, 0, len(columnTypes))
+		for _, columnType := range columnTypes {
+			columns = append(columns, columnType.Name())
+		}
+		drifts = append(drifts, compareColumns(table, stmt.Schema.DBNames, columns)...)
+	}
+	return drifts, nil
+}
+
+func (m *Migration) appliedMigrationIDs() ([]string, error) {
+	db := m.DbFactory.New()
+	if !db.Migrator().HasTable(m.GormOptions.TableName) {
+		return nil, nil
+	}
+	var ids []string
+	if err := db.Table(m.GormOptions.TableName).Pluck(m.GormOptions.IDColumnName, &ids).Error; err != nil {
+		return nil, errors.Wrapf(err, "unable to read the applied %s", m.GormOptions.TableName)
+	}
+	return ids, nil
+}
+
+func migrationStatus(migrations []*gormigrate.Migration, applied []string) []MigrationStatus {
+	appliedIDs := map[string]bool{}
+	for _, id := range applied {
+		appliedIDs[id] = true
+	}
+
+	status := make([]MigrationStatus, 0, len(migrations))
+	for _, migration := range migrations {
+		status = append(status, MigrationStatus{ID: migration.ID, Applied: appliedIDs[migration.ID], Known: true})
+		delete(appliedIDs, migration.ID)
+	}
+
+	unknown := make([]string, 0, len(appliedIDs))
+	for id := range appliedIDs {
+		unknown = append(unknown, id)
+	}
+	sort.Strings(unknown)
+	for _, id := range unknown {
+		status = append(status, MigrationStatus{ID: id, Applied: true})
+	}
+	return status
+}
+
+func compareColumns(table string, modelColumns []string, tableColumns []string) []SchemaDrift {
+	existing := map[string]bool{}
+	for _, column := range tableColumns {
+		existing[column] = true
+	}
+	mapped := map[string]bool{}
+	drifts := []SchemaDrift{}
+	for _, column := range modelColumns {
+		mapped[column] = true
+		if !existing[column] {
+			drifts = append(drifts, SchemaDrift{Table: table, Column: column, Problem: SchemaDriftMissingColumn})
+		}
+	}
+	for _, column := range tableColumns {
+		if !mapped[column] {
+			drifts = append(drifts, SchemaDrift{Table: table, Column: column, Problem: SchemaDriftUnmappedColumn})
+		}
+	}
+	return drifts
+}
+
+// sqlPrinter is a gorm logger writing the statements modifying the database to out
+type sqlPrinter struct {
+	out io.Writer
+}
+
+func (p *sqlPrinter) LogMode(level logger.LogLevel) logger.Interface {
+	return p
+}
+
+func (p *sqlPrinter) Info(ctx context.Context, msg string, data ...interface{}) {
+}
+
+func (p *sqlPrinter) Warn(ctx context.Context, msg string, data ...interface{}) {
+}
+
+func (p *sqlPrinter) Error(ctx context.Context, msg string, data ...interface{}) {
+}
+
+func (p *sqlPrinter) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	sql, _ := fc()
+	sql = strings.TrimSpace(sql)
+	if strings.HasPrefix(strings.ToUpper(sql), "SELECT") {
+		return
+	}
+	fmt.Fprintf(p.out, "%s;\n", sql)
+}
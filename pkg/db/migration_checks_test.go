@@ -0,0 +1,32 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestMigrationStatus(t *testing.T) {
+	RegisterTestingT(t)
+
+	migrations := []*gormigrate.Migration{{ID: "202201010000"}, {ID: "202202010000"}, {ID: "202203010000"}}
+	status := migrationStatus(migrations, []string{"202202010000", "202201010000", "202112010000"})
+	Expect(status).To(Equal([]MigrationStatus{
+		{ID: "202201010000", Applied: true, Known: true},
+		{ID: "202202010000", Applied: true, Known: true},
+		{ID: "202203010000", Applied: false, Known: true},
+		{ID: "202112010000", Applied: true, Known: false},
+	}))
+}
+
+func TestCompareColumns(t *testing.T) {
+	RegisterTestingT(t)
+
+	drifts := compareColumns("kafka_requests", []string{"id", "name", "region"}, []string{"id", "name", "legacy"})
+	Expect(drifts).To(Equal([]SchemaDrift{
+		{Table: "kafka_requests", Column: "region", Problem: SchemaDriftMissingColumn},
+		{Table: "kafka_requests", Column: "legacy", Problem: SchemaDriftUnmappedColumn},
+	}))
+	Expect(compareColumns("kafka_requests", []string{"id"}, []string{"id"})).To(BeEmpty())
+}
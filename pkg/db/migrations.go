@@ -15,6 +15,10 @@ type Migration struct {
 	DbFactory   *ConnectionFactory
 	Gormigrate  *gormigrate.Gormigrate
 	GormOptions *gormigrate.Options
+	Migrations  []*gormigrate.Migration
+	// Models are the GORM models of the tables managed by the migrations, the live schema is checked against them by
+	// CheckSchema
+	Models []interface{}
 }
 
 func NewMigration(dbConfig *DatabaseConfig, gormOptions *gormigrate.Options, migrations []*gormigrate.Migration) (*Migration, func(), error) {
@@ -28,6 +32,7 @@ func NewMigration(dbConfig *DatabaseConfig, gormOptions *gormigrate.Options, mig
 		DbFactory:   dbFactory,
 		GormOptions: gormOptions,
 		Gormigrate:  gormigrate.New(dbFactory.New(), gormOptions, migrations),
+		Migrations:  migrations,
 	}, cleanup, nil
 }
 
@@ -10,6 +10,7 @@ import (
 
 	serviceError "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/tracing"
 )
 
 // TransactionMiddleware creates a new HTTP middleware that begins a database transaction
@@ -22,9 +23,17 @@ func TransactionMiddleware(db *ConnectionFactory) func(next http.Handler) http.H
 
 func transactionMiddleware(db *ConnectionFactory, next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The span of the transaction covers the handling of the request, up to the commit or the rollback
+		ctx, span := tracing.Start(r.Context(), "db transaction")
+		var spanErr error
+		defer func() {
+			tracing.End(span, spanErr)
+		}()
+
 		// Create a new Context with the transaction stored in it.
-		ctx, err := db.NewContext(r.Context())
+		ctx, err := db.NewContext(ctx)
 		if err != nil {
+			spanErr = err
 			ulog := logger.NewUHCLogger(ctx)
 			ulog.Error(errors.Wrap(err, "Could not create transaction"))
 			// use default error to avoid exposing internals to users
@@ -48,6 +57,7 @@ func transactionMiddleware(db *ConnectionFactory, next http.Handler) http.Handle
 		defer func() {
 			err := Resolve(r.Context())
 			if err != nil {
+				spanErr = err
 				ulog := logger.NewUHCLogger(ctx)
 				ulog.Error(err)
 			}
@@ -181,6 +181,14 @@ const (
 	ErrorInstanceTypeNotSupported       ServiceErrorCode = 41
 	ErrorInstanceTypeNotSupportedReason string           = "Instance Type not supported"
 
+	// Request rate limit exceeded
+	ErrorRateLimitExceeded       ServiceErrorCode = 42
+	ErrorRateLimitExceededReason string           = "Request rate limit exceeded"
+
+	// Resource changed since the version the client sent the request for
+	ErrorPreconditionFailed       ServiceErrorCode = 43
+	ErrorPreconditionFailedReason string           = "Precondition failed"
+
 	// Too Many requests error. Used by rate limiting
 	ErrorTooManyRequests       ServiceErrorCode = 429
 	ErrorTooManyRequestsReason string           = "Too Many requests"
@@ -253,6 +261,8 @@ func Errors() ServiceErrors {
 		ServiceError{ErrorMalformedServiceAccountName, ErrorMalformedServiceAccountNameReason, http.StatusBadRequest, nil},
 		ServiceError{ErrorMalformedServiceAccountDesc, ErrorMalformedServiceAccountDescReason, http.StatusBadRequest, nil},
 		ServiceError{ErrorMalformedServiceAccountId, ErrorMalformedServiceAccountIdReason, http.StatusBadRequest, nil},
+		ServiceError{ErrorRateLimitExceeded, ErrorRateLimitExceededReason, http.StatusTooManyRequests, nil},
+		ServiceError{ErrorPreconditionFailed, ErrorPreconditionFailedReason, http.StatusPreconditionFailed, nil},
 	}
 }
 
@@ -566,6 +576,14 @@ func InstanceTypeNotSupported(reason string, values ...interface{}) *ServiceErro
 	return New(ErrorInstanceTypeNotSupported, reason, values...)
 }
 
+func RateLimitExceeded(reason string, values ...interface{}) *ServiceError {
+	return New(ErrorRateLimitExceeded, reason, values...)
+}
+
+func PreconditionFailed(reason string, values ...interface{}) *ServiceError {
+	return New(ErrorPreconditionFailed, reason, values...)
+}
+
 func ProviderNotSupported(reason string, values ...interface{}) *ServiceError {
 	return New(ErrorProviderNotSupported, reason, values...)
 }
@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+)
+
+// ETagFromTime returns the entity tag of a resource versioned by its last update time
+func ETagFromTime(t time.Time) string {
+	// timestamps are stored with microsecond precision
+	return fmt.Sprintf(`"%x"`, t.Round(time.Microsecond).UnixNano()/int64(time.Microsecond))
+}
+
+// ETagFromVersion returns the entity tag of a resource versioned by a sequence number
+func ETagFromVersion(version int64) string {
+	return fmt.Sprintf(`"%d"`, version)
+}
+
+// checkIfMatch fails with a precondition failed error if the request has an If-Match header that doesn't match the
+// current entity tag of the resource
+func checkIfMatch(r *http.Request, cfg *HandlerConfig) *errors.ServiceError {
+	ifMatch := r.Header.Get("If-Match")
+	if ifMatch == "" || cfg.ETag == nil {
+		return nil
+	}
+
+	etag, err := cfg.ETag()
+	if err != nil {
+		return err
+	}
+	for _, tag := range strings.Split(ifMatch, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == "*" || tag == etag {
+			return nil
+		}
+	}
+	return errors.PreconditionFailed("resource has been modified, expected entity tag %s but the current one is %s", ifMatch, etag)
+}
+
+// setETag sets the ETag response header to the current entity tag of the resource
+func setETag(w http.ResponseWriter, cfg *HandlerConfig) {
+	if cfg.ETag == nil {
+		return
+	}
+	if etag, err := cfg.ETag(); err == nil && etag != "" {
+		w.Header().Set("ETag", etag)
+	}
+}
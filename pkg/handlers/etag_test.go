@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+)
+
+func TestETagFromTime(t *testing.T) {
+	gomega.RegisterTestingT(t)
+	updatedAt := time.Date(2022, 2, 23, 10, 0, 0, 123456789, time.UTC)
+
+	// the tag must not change once the timestamp is stored with microsecond precision
+	gomega.Expect(ETagFromTime(updatedAt)).To(gomega.Equal(ETagFromTime(updatedAt.Round(time.Microsecond))))
+	gomega.Expect(ETagFromTime(updatedAt)).To(gomega.Equal(ETagFromTime(updatedAt.In(time.FixedZone("CET", 3600)))))
+	gomega.Expect(ETagFromTime(updatedAt)).ToNot(gomega.Equal(ETagFromTime(updatedAt.Add(time.Millisecond))))
+}
+
+func TestHandle_IfMatch(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		ifMatch    string
+		etagErr    *errors.ServiceError
+		wantCode   int
+		wantAction bool
+		wantETag   string
+	}{
+		{
+			name:       "should update without If-Match header",
+			method:     http.MethodPatch,
+			wantCode:   http.StatusOK,
+			wantAction: true,
+			wantETag:   `"2"`,
+		},
+		{
+			name:       "should update when If-Match matches the current entity tag",
+			method:     http.MethodPatch,
+			ifMatch:    `"1"`,
+			wantCode:   http.StatusOK,
+			wantAction: true,
+			wantETag:   `"2"`,
+		},
+		{
+			name:       "should update when If-Match is a wildcard",
+			method:     http.MethodPatch,
+			ifMatch:    `*`,
+			wantCode:   http.StatusOK,
+			wantAction: true,
+			wantETag:   `"2"`,
+		},
+		{
+			name:       "should update when one of the If-Match entity tags matches",
+			method:     http.MethodPatch,
+			ifMatch:    `"0", W/"1"`,
+			wantCode:   http.StatusOK,
+			wantAction: true,
+			wantETag:   `"2"`,
+		},
+		{
+			name:     "should fail with precondition failed when If-Match doesn't match",
+			method:   http.MethodPatch,
+			ifMatch:  `"0"`,
+			wantCode: http.StatusPreconditionFailed,
+		},
+		{
+			name:     "should fail when the resource is not found",
+			method:   http.MethodPatch,
+			ifMatch:  `"1"`,
+			etagErr:  errors.NotFound("not found"),
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:     "should fail to delete when If-Match doesn't match",
+			method:   http.MethodDelete,
+			ifMatch:  `"0"`,
+			wantCode: http.StatusPreconditionFailed,
+		},
+		{
+			name:       "should delete when If-Match matches the current entity tag",
+			method:     http.MethodDelete,
+			ifMatch:    `"1"`,
+			wantCode:   http.StatusNoContent,
+			wantAction: true,
+		},
+		{
+			name:       "should return the entity tag of the resource",
+			method:     http.MethodGet,
+			wantCode:   http.StatusOK,
+			wantAction: true,
+			wantETag:   `"1"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			version := int64(1)
+			action := false
+			cfg := &HandlerConfig{
+				Action: func() (interface{}, *errors.ServiceError) {
+					action = true
+					if tt.method != http.MethodGet {
+						version++
+					}
+					return nil, nil
+				},
+				ETag: func() (string, *errors.ServiceError) {
+					if tt.etagErr != nil {
+						return "", tt.etagErr
+					}
+					return ETagFromVersion(version), nil
+				},
+			}
+
+			req := httptest.NewRequest(tt.method, "http://example.com/kafkas/1", nil)
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+			recorder := httptest.NewRecorder()
+			switch tt.method {
+			case http.MethodGet:
+				HandleGet(recorder, req, cfg)
+			case http.MethodDelete:
+				HandleDelete(recorder, req, cfg, http.StatusNoContent)
+			default:
+				Handle(recorder, req, cfg, http.StatusOK)
+			}
+
+			gomega.Expect(recorder.Result().StatusCode).To(gomega.Equal(tt.wantCode))
+			gomega.Expect(action).To(gomega.Equal(tt.wantAction))
+			gomega.Expect(recorder.Result().Header.Get("ETag")).To(gomega.Equal(tt.wantETag))
+		})
+	}
+}
@@ -20,11 +20,15 @@ import (
 //   Validate is a list of Validation function that run in order, returning fast on the first error.
 //   Action is the specific logic a handler must take (e.g, find an object, save an object)
 //   ErrorHandler is the way errors are returned to the client
+//   ETag returns the entity tag of the current state of the resource. When set, Handle and HandleDelete check the
+//   If-Match request header against it before running Validate and Action, and Handle and HandleGet return it in
+//   the ETag response header.
 type HandlerConfig struct {
 	MarshalInto  interface{}
 	Validate     []Validate
 	Action       HttpAction
 	ErrorHandler ErrorHandlerFunc
+	ETag         func() (string, *errors.ServiceError)
 }
 
 type EventStream struct {
@@ -58,6 +62,17 @@ func Handle(w http.ResponseWriter, r *http.Request, cfg *HandlerConfig, httpStat
 		cfg.ErrorHandler = shared.HandleError
 	}
 
+	// requests sent with an Idempotency-Key header are only executed once, retries get the stored response
+	idempotentRequest, handled := startIdempotentRequest(w, r, cfg)
+	if handled {
+		return
+	}
+	if idempotentRequest != nil {
+		recorder := &idempotencyResponseRecorder{ResponseWriter: w}
+		w = recorder
+		defer idempotentRequest.finish(r, recorder)
+	}
+
 	if cfg.MarshalInto != nil {
 
 		err := json.NewDecoder(r.Body).Decode(&cfg.MarshalInto)
@@ -77,6 +92,11 @@ func Handle(w http.ResponseWriter, r *http.Request, cfg *HandlerConfig, httpStat
 		}
 	}
 
+	if err := checkIfMatch(r, cfg); err != nil {
+		errorHandler(r, w, cfg, err)
+		return
+	}
+
 	for _, v := range cfg.Validate {
 		err := v()
 		if err != nil {
@@ -91,6 +111,7 @@ func Handle(w http.ResponseWriter, r *http.Request, cfg *HandlerConfig, httpStat
 	case serviceErr != nil:
 		errorHandler(r, w, cfg, serviceErr)
 	default:
+		setETag(w, cfg)
 		shared.WriteJSONResponse(w, httpStatus, result)
 		success(r)
 	}
@@ -101,6 +122,23 @@ func HandleDelete(w http.ResponseWriter, r *http.Request, cfg *HandlerConfig, ht
 	if cfg.ErrorHandler == nil {
 		cfg.ErrorHandler = shared.HandleError
 	}
+
+	// retried deletions sent with an Idempotency-Key header get the response of the first deletion
+	idempotentRequest, handled := startIdempotentRequest(w, r, cfg)
+	if handled {
+		return
+	}
+	if idempotentRequest != nil {
+		recorder := &idempotencyResponseRecorder{ResponseWriter: w}
+		w = recorder
+		defer idempotentRequest.finish(r, recorder)
+	}
+
+	if err := checkIfMatch(r, cfg); err != nil {
+		errorHandler(r, w, cfg, err)
+		return
+	}
+
 	for _, v := range cfg.Validate {
 		err := v()
 		if err != nil {
@@ -137,6 +175,7 @@ func HandleGet(w http.ResponseWriter, r *http.Request, cfg *HandlerConfig) {
 	result, serviceErr := cfg.Action()
 	switch {
 	case serviceErr == nil:
+		setETag(w, cfg)
 		shared.WriteJSONResponse(w, http.StatusOK, result)
 		success(r)
 	default:
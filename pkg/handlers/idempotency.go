@@ -0,0 +1,307 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/spf13/pflag"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// IdempotencyKeyHeader is the request header clients set to safely retry requests
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader is set on responses replayed for a retried request
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+)
+
+// idempotentReplayedHeaders are the response headers stored to be replayed with the response, the other headers are
+// set by the middlewares for each request
+var idempotentReplayedHeaders = []string{"Content-Type", "Location", "ETag"}
+
+type idempotencyContextKey int
+
+const idempotencyStoreKey idempotencyContextKey = iota
+
+type IdempotencyConfig struct {
+	Retention time.Duration
+}
+
+func NewIdempotencyConfig() *IdempotencyConfig {
+	return &IdempotencyConfig{
+		Retention: 24 * time.Hour,
+	}
+}
+
+func (c *IdempotencyConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&c.Retention, "idempotency-key-retention", c.Retention, "How long the responses of requests sent with an Idempotency-Key header are kept to be replayed on retry")
+}
+
+func (c *IdempotencyConfig) ReadFiles() error {
+	return nil
+}
+
+// IdempotencyRecord is the response stored for a request sent with an Idempotency-Key header
+type IdempotencyRecord struct {
+	RequestHash string
+	// StatusCode is 0 while the request is being handled
+	StatusCode int
+	Header     http.Header
+	Response   []byte
+}
+
+// IdempotencyStore keeps the responses of requests sent with an Idempotency-Key header, per key and owner, i.e. the
+// organisation and user sending them
+type IdempotencyStore interface {
+	// Reserve reserves the key for the request with the given hash. It returns the record of the previous request
+	// that used the key, or nil if the key was reserved. A key stays reserved until the request that reserved it
+	// completes or releases it.
+	Reserve(key string, owner string, requestHash string) (*IdempotencyRecord, error)
+	// Complete stores the response of the request that reserved the key
+	Complete(key string, owner string, statusCode int, header http.Header, response []byte) error
+	// Release frees the key so that the request can be retried
+	Release(key string, owner string) error
+}
+
+type idempotencyKey struct {
+	Key         string `gorm:"primaryKey"`
+	Owner       string `gorm:"primaryKey"`
+	RequestHash string
+	StatusCode  int
+	Headers     []byte
+	Response    []byte
+	CreatedAt   time.Time
+}
+
+func (idempotencyKey) TableName() string {
+	return "idempotency_keys"
+}
+
+type idempotencyStore struct {
+	idempotencyConfig *IdempotencyConfig
+	connectionFactory *db.ConnectionFactory
+}
+
+var _ IdempotencyStore = &idempotencyStore{}
+
+func NewIdempotencyStore(idempotencyConfig *IdempotencyConfig, connectionFactory *db.ConnectionFactory) IdempotencyStore {
+	return &idempotencyStore{
+		idempotencyConfig: idempotencyConfig,
+		connectionFactory: connectionFactory,
+	}
+}
+
+func (s *idempotencyStore) Reserve(key string, owner string, requestHash string) (*IdempotencyRecord, error) {
+	dbConn := s.connectionFactory.New()
+	now := time.Now()
+
+	if err := dbConn.Where("created_at < ?", now.Add(-s.idempotencyConfig.Retention)).Delete(&idempotencyKey{}).Error; err != nil {
+		return nil, err
+	}
+
+	result := dbConn.Clauses(clause.OnConflict{DoNothing: true}).Create(&idempotencyKey{
+		Key:         key,
+		Owner:       owner,
+		RequestHash: requestHash,
+		CreatedAt:   now,
+	})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 1 {
+		return nil, nil
+	}
+
+	var existing idempotencyKey
+	if err := dbConn.Where("key = ? AND owner = ?", key, owner).First(&existing).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			// removed in the meantime, the client can retry
+			return &IdempotencyRecord{RequestHash: requestHash}, nil
+		}
+		return nil, err
+	}
+
+	record := &IdempotencyRecord{
+		RequestHash: existing.RequestHash,
+		StatusCode:  existing.StatusCode,
+		Response:    existing.Response,
+	}
+	if len(existing.Headers) > 0 {
+		if err := json.Unmarshal(existing.Headers, &record.Header); err != nil {
+			return nil, err
+		}
+	}
+	return record, nil
+}
+
+func (s *idempotencyStore) Complete(key string, owner string, statusCode int, header http.Header, response []byte) error {
+	headers, err := json.Marshal(header)
+	if err != nil {
+		return err
+	}
+	dbConn := s.connectionFactory.New()
+	return dbConn.Model(&idempotencyKey{}).
+		Where("key = ? AND owner = ?", key, owner).
+		Updates(map[string]interface{}{"status_code": statusCode, "headers": headers, "response": response}).Error
+}
+
+func (s *idempotencyStore) Release(key string, owner string) error {
+	dbConn := s.connectionFactory.New()
+	return dbConn.Where("key = ? AND owner = ?", key, owner).Delete(&idempotencyKey{}).Error
+}
+
+type IdempotencyMiddleware struct {
+	store IdempotencyStore
+}
+
+func NewIdempotencyMiddleware(store IdempotencyStore) *IdempotencyMiddleware {
+	return &IdempotencyMiddleware{
+		store: store,
+	}
+}
+
+// Middleware handler that makes the idempotency store available to Handle and HandleDelete, so that the create, update
+// and delete requests sent with an Idempotency-Key header are only executed once. The other requests ignore the header.
+func (m *IdempotencyMiddleware) Idempotency(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*r = *r.WithContext(context.WithValue(r.Context(), idempotencyStoreKey, m.store))
+		next.ServeHTTP(w, r)
+	})
+}
+
+type idempotentRequest struct {
+	store IdempotencyStore
+	key   string
+	owner string
+}
+
+// startIdempotentRequest reserves the Idempotency-Key of the request for the organisation and user sending it. If the
+// key was already used the stored response is replayed, or an error written, and handled is true. A key is only
+// reserved again once the request that reserved it released it, retries of a request still being handled get a 409.
+func startIdempotentRequest(w http.ResponseWriter, r *http.Request, cfg *HandlerConfig) (req *idempotentRequest, handled bool) {
+	key := r.Header.Get(IdempotencyKeyHeader)
+	store, ok := r.Context().Value(idempotencyStoreKey).(IdempotencyStore)
+	if key == "" || !ok {
+		return nil, false
+	}
+	if len(key) > maxIdempotencyKeyLength {
+		errorHandler(r, w, cfg, errors.BadRequest("%s header must not be longer than %d characters", IdempotencyKeyHeader, maxIdempotencyKeyLength))
+		return nil, true
+	}
+
+	claims, err := auth.GetClaimsFromContext(r.Context())
+	if err != nil {
+		return nil, false
+	}
+	owner := auth.GetOrgIdFromClaims(claims) + "/" + auth.GetUsernameFromClaims(claims)
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		errorHandler(r, w, cfg, errors.MalformedRequest("Unable to read request body: %s", err))
+		return nil, true
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	hash := sha256.New()
+	hash.Write([]byte(r.Method + " " + r.URL.Path + "\n"))
+	hash.Write(body)
+	requestHash := hex.EncodeToString(hash.Sum(nil))
+
+	record, err := store.Reserve(key, owner, requestHash)
+	if err != nil {
+		errorHandler(r, w, cfg, errors.GeneralError("unable to check %s %q: %v", IdempotencyKeyHeader, key, err))
+		return nil, true
+	}
+	if record == nil {
+		req := &idempotentRequest{store: store, key: key, owner: owner}
+		// the key is released whatever rolls back the transaction of the request, so that the request can be retried
+		_ = db.AddPostRollbackAction(r.Context(), func() {
+			req.release(r.Context())
+		})
+		return req, false
+	}
+
+	switch {
+	case record.RequestHash != requestHash:
+		errorHandler(r, w, cfg, errors.BadRequest("%s %q was already used for a different request", IdempotencyKeyHeader, key))
+	case record.StatusCode == 0:
+		errorHandler(r, w, cfg, errors.Conflict("a request with %s %q is still being processed", IdempotencyKeyHeader, key))
+	default:
+		w.Header().Set("Content-Type", "application/json")
+		for _, name := range idempotentReplayedHeaders {
+			if value := record.Header.Get(name); value != "" {
+				w.Header().Set(name, value)
+			}
+		}
+		w.Header().Set("Vary", "Authorization")
+		w.Header().Set(IdempotentReplayedHeader, "true")
+		w.WriteHeader(record.StatusCode)
+		_, _ = w.Write(record.Response)
+	}
+	return nil, true
+}
+
+// finish stores the recorded response to be replayed on retry, once the transaction of the request is committed. Keys
+// of requests that failed with a server error are released so that the request can be retried, as are the keys of
+// requests whose transaction is rolled back.
+func (i *idempotentRequest) finish(r *http.Request, recorder *idempotencyResponseRecorder) {
+	ctx := r.Context()
+	if recorder.statusCode == 0 || recorder.statusCode >= http.StatusInternalServerError {
+		i.release(ctx)
+		return
+	}
+
+	statusCode, header, response := recorder.statusCode, http.Header{}, recorder.body.Bytes()
+	for _, name := range idempotentReplayedHeaders {
+		if value := recorder.Header().Get(name); value != "" {
+			header.Set(name, value)
+		}
+	}
+	complete := func() {
+		if err := i.store.Complete(i.key, i.owner, statusCode, header, response); err != nil {
+			logger.NewUHCLogger(ctx).Errorf("unable to store response of request with %s %q: %v", IdempotencyKeyHeader, i.key, err)
+		}
+	}
+	// requests handled outside of a transaction store their response right away
+	if err := db.AddPostCommitAction(ctx, complete); err != nil {
+		complete()
+	}
+}
+
+func (i *idempotentRequest) release(ctx context.Context) {
+	if err := i.store.Release(i.key, i.owner); err != nil {
+		logger.NewUHCLogger(ctx).Errorf("unable to release %s %q: %v", IdempotencyKeyHeader, i.key, err)
+	}
+}
+
+// idempotencyResponseRecorder records the response written by the handler so that it can be replayed
+type idempotencyResponseRecorder struct {
+	http.ResponseWriter
+	statusCode int
+	body       bytes.Buffer
+}
+
+func (w *idempotencyResponseRecorder) WriteHeader(statusCode int) {
+	w.statusCode = statusCode
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *idempotencyResponseRecorder) Write(b []byte) (int, error) {
+	if w.statusCode == 0 {
+		w.statusCode = http.StatusOK
+	}
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
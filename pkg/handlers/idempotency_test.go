@@ -0,0 +1,288 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/onsi/gomega"
+	"github.com/openshift-online/ocm-sdk-go/authentication"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+type memoryIdempotencyStore struct {
+	records map[string]*IdempotencyRecord
+}
+
+func (s *memoryIdempotencyStore) Reserve(key string, owner string, requestHash string) (*IdempotencyRecord, error) {
+	if record, ok := s.records[owner+"/"+key]; ok {
+		return record, nil
+	}
+	s.records[owner+"/"+key] = &IdempotencyRecord{RequestHash: requestHash}
+	return nil, nil
+}
+
+func (s *memoryIdempotencyStore) Complete(key string, owner string, statusCode int, header http.Header, response []byte) error {
+	s.records[owner+"/"+key].StatusCode = statusCode
+	s.records[owner+"/"+key].Header = header
+	s.records[owner+"/"+key].Response = response
+	return nil
+}
+
+func (s *memoryIdempotencyStore) Release(key string, owner string) error {
+	delete(s.records, owner+"/"+key)
+	return nil
+}
+
+func TestHandle_IdempotencyKey(t *testing.T) {
+	type request struct {
+		orgId        string
+		username     string
+		key          string
+		body         string
+		wantCode     int
+		wantReplayed bool
+		wantLocation string
+	}
+
+	tests := []struct {
+		name        string
+		actionError *errors.ServiceError
+		requests    []request
+		wantActions int
+	}{
+		{
+			name: "should execute requests without idempotency key every time",
+			requests: []request{
+				{username: "user-a", body: `{"name":"a"}`, wantCode: http.StatusAccepted},
+				{username: "user-a", body: `{"name":"a"}`, wantCode: http.StatusAccepted},
+			},
+			wantActions: 2,
+		},
+		{
+			name: "should replay the response of a retried request",
+			requests: []request{
+				{username: "user-a", key: "key-1", body: `{"name":"a"}`, wantCode: http.StatusAccepted, wantLocation: "/kafkas/1"},
+				{username: "user-a", key: "key-1", body: `{"name":"a"}`, wantCode: http.StatusAccepted, wantReplayed: true, wantLocation: "/kafkas/1"},
+			},
+			wantActions: 1,
+		},
+		{
+			name: "should keep keys of different users apart",
+			requests: []request{
+				{username: "user-a", key: "key-1", body: `{"name":"a"}`, wantCode: http.StatusAccepted},
+				{username: "user-b", key: "key-1", body: `{"name":"a"}`, wantCode: http.StatusAccepted},
+			},
+			wantActions: 2,
+		},
+		{
+			name: "should keep keys of users with the same name in different organisations apart",
+			requests: []request{
+				{orgId: "org-1", username: "user-a", key: "key-1", body: `{"name":"a"}`, wantCode: http.StatusAccepted},
+				{orgId: "org-2", username: "user-a", key: "key-1", body: `{"name":"a"}`, wantCode: http.StatusAccepted},
+			},
+			wantActions: 2,
+		},
+		{
+			name: "should reject a key reused for a different request",
+			requests: []request{
+				{username: "user-a", key: "key-1", body: `{"name":"a"}`, wantCode: http.StatusAccepted},
+				{username: "user-a", key: "key-1", body: `{"name":"b"}`, wantCode: http.StatusBadRequest},
+			},
+			wantActions: 1,
+		},
+		{
+			name:        "should replay client errors",
+			actionError: errors.Conflict("duplicate"),
+			requests: []request{
+				{username: "user-a", key: "key-1", body: `{"name":"a"}`, wantCode: http.StatusConflict},
+				{username: "user-a", key: "key-1", body: `{"name":"a"}`, wantCode: http.StatusConflict, wantReplayed: true},
+			},
+			wantActions: 1,
+		},
+		{
+			name:        "should execute the request again after a server error",
+			actionError: errors.GeneralError("unavailable"),
+			requests: []request{
+				{username: "user-a", key: "key-1", body: `{"name":"a"}`, wantCode: http.StatusInternalServerError},
+				{username: "user-a", key: "key-1", body: `{"name":"a"}`, wantCode: http.StatusInternalServerError},
+			},
+			wantActions: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			middleware := NewIdempotencyMiddleware(&memoryIdempotencyStore{records: map[string]*IdempotencyRecord{}})
+			actions := 0
+
+			for _, req := range tt.requests {
+				var payload map[string]interface{}
+				handler := middleware.Idempotency(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					Handle(w, r, &HandlerConfig{
+						MarshalInto: &payload,
+						Action: func() (interface{}, *errors.ServiceError) {
+							actions++
+							if tt.actionError != nil {
+								return nil, tt.actionError
+							}
+							w.Header().Set("Location", fmt.Sprintf("/kafkas/%d", actions))
+							return payload, nil
+						},
+					}, http.StatusAccepted)
+				}))
+
+				httpRequest := httptest.NewRequest(http.MethodPost, "http://example.com/kafkas", strings.NewReader(req.body))
+				if req.key != "" {
+					httpRequest.Header.Set(IdempotencyKeyHeader, req.key)
+				}
+				httpRequest = httpRequest.WithContext(authentication.ContextWithToken(httpRequest.Context(), &jwt.Token{
+					Claims: jwt.MapClaims{"username": req.username, "org_id": req.orgId},
+				}))
+				recorder := httptest.NewRecorder()
+				handler.ServeHTTP(recorder, httpRequest)
+
+				gomega.Expect(recorder.Result().StatusCode).To(gomega.Equal(req.wantCode))
+				gomega.Expect(recorder.Result().Header.Get(IdempotentReplayedHeader) == "true").To(gomega.Equal(req.wantReplayed))
+				if req.wantCode == http.StatusAccepted {
+					gomega.Expect(recorder.Body.String()).To(gomega.MatchJSON(req.body))
+				}
+				if req.wantLocation != "" {
+					gomega.Expect(recorder.Result().Header.Get("Location")).To(gomega.Equal(req.wantLocation))
+				}
+			}
+			gomega.Expect(actions).To(gomega.Equal(tt.wantActions))
+		})
+	}
+}
+
+func TestHandle_IdempotencyKey_Transaction(t *testing.T) {
+	tests := []struct {
+		name       string
+		rollback   bool
+		wantStored bool
+	}{
+		{
+			name:       "should store the response once the transaction is committed",
+			wantStored: true,
+		},
+		{
+			name:     "should release the key when the transaction is rolled back",
+			rollback: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			mocket.Catcher.Reset()
+			mocket.Catcher.NewMock().WithQuery("select txid_current()").WithReply([]map[string]interface{}{{"txid_current": 1}})
+			store := &memoryIdempotencyStore{records: map[string]*IdempotencyRecord{}}
+			handler := NewIdempotencyMiddleware(store).Idempotency(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Handle(w, r, &HandlerConfig{
+					Action: func() (interface{}, *errors.ServiceError) {
+						if tt.rollback {
+							db.MarkForRollback(r.Context(), fmt.Errorf("test"))
+						}
+						return map[string]string{"name": "a"}, nil
+					},
+				}, http.StatusAccepted)
+			}))
+
+			httpRequest := httptest.NewRequest(http.MethodPost, "http://example.com/kafkas", strings.NewReader(`{"name":"a"}`))
+			httpRequest.Header.Set(IdempotencyKeyHeader, "key-1")
+			ctx := authentication.ContextWithToken(httpRequest.Context(), &jwt.Token{
+				Claims: jwt.MapClaims{"username": "user-a", "org_id": "org-1"},
+			})
+			ctx, err := db.NewMockConnectionFactory(nil).NewContext(ctx)
+			gomega.Expect(err).To(gomega.BeNil())
+			handler.ServeHTTP(httptest.NewRecorder(), httpRequest.WithContext(ctx))
+
+			// the response is only stored once the transaction of the request is resolved
+			gomega.Expect(store.records).To(gomega.HaveKey("org-1/user-a/key-1"))
+			gomega.Expect(store.records["org-1/user-a/key-1"].StatusCode).To(gomega.BeZero())
+			gomega.Expect(db.Resolve(ctx)).To(gomega.Succeed())
+			if tt.wantStored {
+				gomega.Expect(store.records["org-1/user-a/key-1"].StatusCode).To(gomega.Equal(http.StatusAccepted))
+			} else {
+				gomega.Expect(store.records).NotTo(gomega.HaveKey("org-1/user-a/key-1"))
+			}
+		})
+	}
+}
+
+func TestHandle_IdempotencyKey_InProgress(t *testing.T) {
+	gomega.RegisterTestingT(t)
+	store := &memoryIdempotencyStore{records: map[string]*IdempotencyRecord{}}
+	middleware := NewIdempotencyMiddleware(store)
+	newRequest := func() *http.Request {
+		httpRequest := httptest.NewRequest(http.MethodPost, "http://example.com/kafkas", strings.NewReader(`{"name":"a"}`))
+		httpRequest.Header.Set(IdempotencyKeyHeader, "key-1")
+		return httpRequest.WithContext(authentication.ContextWithToken(httpRequest.Context(), &jwt.Token{
+			Claims: jwt.MapClaims{"username": "user-a", "org_id": "org-1"},
+		}))
+	}
+
+	// the request is retried while the first one is still being handled
+	var retry *httptest.ResponseRecorder
+	handler := middleware.Idempotency(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		Handle(w, r, &HandlerConfig{
+			Action: func() (interface{}, *errors.ServiceError) {
+				if retry == nil {
+					retry = httptest.NewRecorder()
+					middleware.Idempotency(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						Handle(w, r, &HandlerConfig{
+							Action: func() (interface{}, *errors.ServiceError) {
+								return nil, errors.GeneralError("the retry must not be executed")
+							},
+						}, http.StatusAccepted)
+					})).ServeHTTP(retry, newRequest())
+				}
+				return map[string]string{"name": "a"}, nil
+			},
+		}, http.StatusAccepted)
+	}))
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newRequest())
+
+	gomega.Expect(recorder.Result().StatusCode).To(gomega.Equal(http.StatusAccepted))
+	gomega.Expect(retry.Result().StatusCode).To(gomega.Equal(http.StatusConflict))
+	gomega.Expect(store.records["org-1/user-a/key-1"].StatusCode).To(gomega.Equal(http.StatusAccepted))
+}
+
+func TestHandleDelete_IdempotencyKey(t *testing.T) {
+	gomega.RegisterTestingT(t)
+	middleware := NewIdempotencyMiddleware(&memoryIdempotencyStore{records: map[string]*IdempotencyRecord{}})
+	deleted := false
+	handler := middleware.Idempotency(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		HandleDelete(w, r, &HandlerConfig{
+			Action: func() (interface{}, *errors.ServiceError) {
+				if deleted {
+					return nil, errors.NotFound("kafka not found")
+				}
+				deleted = true
+				return nil, nil
+			},
+		}, http.StatusAccepted)
+	}))
+
+	for _, wantReplayed := range []bool{false, true} {
+		httpRequest := httptest.NewRequest(http.MethodDelete, "http://example.com/kafkas/1", nil)
+		httpRequest.Header.Set(IdempotencyKeyHeader, "key-1")
+		httpRequest = httpRequest.WithContext(authentication.ContextWithToken(httpRequest.Context(), &jwt.Token{
+			Claims: jwt.MapClaims{"username": "user-a", "org_id": "org-1"},
+		}))
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httpRequest)
+
+		// the retried deletion gets the response of the first one rather than a 404
+		gomega.Expect(recorder.Result().StatusCode).To(gomega.Equal(http.StatusAccepted))
+		gomega.Expect(recorder.Result().Header.Get(IdempotentReplayedHeader) == "true").To(gomega.Equal(wantReplayed))
+	}
+}
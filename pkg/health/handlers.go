@@ -0,0 +1,31 @@
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/golang/glog"
+)
+
+// LiveHandler serves the report of the liveness checks, the status code is 503 when any of them fails
+func (r *Registry) LiveHandler(w http.ResponseWriter, req *http.Request) {
+	writeReport(w, r.Live(req.Context()))
+}
+
+// ReadyHandler serves the report of all the checks, the status code is 503 when a critical check fails. The fleet
+// manager is still ready when it is degraded.
+func (r *Registry) ReadyHandler(w http.ResponseWriter, req *http.Request) {
+	writeReport(w, r.Ready(req.Context()))
+}
+
+func writeReport(w http.ResponseWriter, report Report) {
+	status := http.StatusOK
+	if report.Status == StatusFailed {
+		status = http.StatusServiceUnavailable
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		glog.Warningf("unable to write the health report: %v", err)
+	}
+}
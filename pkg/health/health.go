@@ -0,0 +1,273 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Status is the outcome of a health check or of a health report
+type Status string
+
+const (
+	StatusOK Status = "ok"
+	// StatusDegraded is the status of the reports with failing non-critical checks, the fleet manager is still ready
+	StatusDegraded Status = "degraded"
+	// StatusFailed is the status of the failing checks and of the reports with failing critical checks
+	StatusFailed Status = "failed"
+)
+
+// Check is the health check of a component of the fleet manager
+type Check struct {
+	// Name identifies the check in the health reports
+	Name string
+	// Critical checks make the fleet manager not ready when they fail, the failure of the others only degrades it
+	Critical bool
+	// Liveness checks are also run by the liveness endpoint, which fails when any of them fails. They must only fail
+	// when restarting the fleet manager fixes them, the failure of a dependency must not fail them.
+	Liveness bool
+	// External checks call a dependency of the fleet manager, e.g. the OCM API. They are never critical nor liveness
+	// checks, so that the outage of a dependency doesn't take every instance of the fleet manager out of service.
+	External bool
+	// Func returns an error when the component is not healthy
+	Func func(ctx context.Context) error
+}
+
+// Checker is implemented by the components registering health checks. The checks are registered by providing the
+// components as a Checker, e.g. di.Provide(NewComponent, di.As(new(health.Checker)))
+type Checker interface {
+	HealthChecks() []Check
+}
+
+// Checks are a Checker returning themselves
+type Checks []Check
+
+func (c Checks) HealthChecks() []Check {
+	return c
+}
+
+// CheckResult is the outcome of a check in a health report
+type CheckResult struct {
+	Status   Status `json:"status"`
+	Critical bool   `json:"critical"`
+	Error    string `json:"error,omitempty"`
+	// DurationMs is how long the check took, in milliseconds
+	DurationMs int64 `json:"duration_ms"`
+	// CheckedAt is when the check ran, the result of a check is reused by the reports until it is older than the
+	// cache TTL of the registry
+	CheckedAt time.Time `json:"checked_at"`
+}
+
+// Report is the outcome of the checks run by a health endpoint
+type Report struct {
+	Status    Status                 `json:"status"`
+	CheckedAt time.Time              `json:"checked_at"`
+	Checks    map[string]CheckResult `json:"checks"`
+}
+
+// Registry holds the health checks of the fleet manager
+type Registry struct {
+	mu       sync.RWMutex
+	checks   []Check
+	results  map[string]*cachedResult
+	timeout  time.Duration
+	cacheTTL time.Duration
+}
+
+// cachedResult is the last result of a check, its lock is held while the check runs so that concurrent probes wait
+// for the same run
+type cachedResult struct {
+	mu     sync.Mutex
+	result CheckResult
+}
+
+// NewRegistry creates a registry running every check with the timeout. The result of a check is reused for the cache
+// TTL, so that frequent probes don't call the dependencies of the fleet manager every time.
+func NewRegistry(timeout time.Duration, cacheTTL time.Duration) *Registry {
+	return &Registry{
+		results:  map[string]*cachedResult{},
+		timeout:  timeout,
+		cacheTTL: cacheTTL,
+	}
+}
+
+// Register adds the checks to the registry, a check replaces the registered check with the same name
+func (r *Registry) Register(checks ...Check) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, check := range checks {
+		if check.External {
+			check.Critical = false
+			check.Liveness = false
+		}
+		delete(r.results, check.Name)
+		replaced := false
+		for i := range r.checks {
+			if r.checks[i].Name == check.Name {
+				r.checks[i] = check
+				replaced = true
+			}
+		}
+		if !replaced {
+			r.checks = append(r.checks, check)
+		}
+	}
+}
+
+// Names returns the sorted names of the registered checks
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := make([]string, 0, len(r.checks))
+	for _, check := range r.checks {
+		names = append(names, check.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Live runs the liveness checks, the report fails when any of them fails
+func (r *Registry) Live(ctx context.Context) Report {
+	return r.run(ctx, func(check Check) bool {
+		return check.Liveness
+	}, true)
+}
+
+// Ready runs all the checks
+func (r *Registry) Ready(ctx context.Context) Report {
+	return r.run(ctx, func(check Check) bool {
+		return true
+	}, false)
+}
+
+// run runs the selected checks concurrently. The report fails when a critical check fails, or any check when
+// allCritical is set, and is degraded when another check fails.
+func (r *Registry) run(ctx context.Context, selected func(check Check) bool, allCritical bool) Report {
+	r.mu.RLock()
+	var checks []Check
+	for _, check := range r.checks {
+		if selected(check) {
+			checks = append(checks, check)
+		}
+	}
+	r.mu.RUnlock()
+
+	results := make([]CheckResult, len(checks))
+	var wg sync.WaitGroup
+	for i := range checks {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = r.cachedCheck(ctx, checks[i])
+		}(i)
+	}
+	wg.Wait()
+
+	report := Report{
+		Status:    StatusOK,
+		CheckedAt: time.Now(),
+		Checks:    map[string]CheckResult{},
+	}
+	for i, result := range results {
+		report.Checks[checks[i].Name] = result
+		if result.Status == StatusOK {
+			continue
+		}
+		if result.Critical || allCritical {
+			report.Status = StatusFailed
+		} else if report.Status == StatusOK {
+			report.Status = StatusDegraded
+		}
+	}
+	return report
+}
+
+// cachedCheck returns the cached result of the check, or runs it when the result is older than the cache TTL. The
+// result isn't cached when the report was cancelled, e.g. by a probe timing out, as the check didn't really fail.
+func (r *Registry) cachedCheck(ctx context.Context, check Check) CheckResult {
+	if r.cacheTTL <= 0 {
+		return r.runCheck(ctx, check)
+	}
+	r.mu.Lock()
+	cached, ok := r.results[check.Name]
+	if !ok {
+		cached = &cachedResult{}
+		r.results[check.Name] = cached
+	}
+	r.mu.Unlock()
+
+	cached.mu.Lock()
+	defer cached.mu.Unlock()
+	if !cached.result.CheckedAt.IsZero() && time.Since(cached.result.CheckedAt) < r.cacheTTL {
+		return cached.result
+	}
+	result := r.runCheck(ctx, check)
+	if ctx.Err() == nil {
+		cached.result = result
+	}
+	return result
+}
+
+func (r *Registry) runCheck(ctx context.Context, check Check) (result CheckResult) {
+	start := time.Now()
+	result = CheckResult{Status: StatusOK, Critical: check.Critical, CheckedAt: start}
+	defer func() {
+		result.DurationMs = time.Since(start).Milliseconds()
+	}()
+
+	if r.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.timeout)
+		defer cancel()
+	}
+	errs := make(chan error, 1)
+	go func() {
+		defer func() {
+			if p := recover(); p != nil {
+				errs <- fmt.Errorf("check panicked: %v", p)
+			}
+		}()
+		errs <- check.Func(ctx)
+	}()
+	var err error
+	select {
+	case err = <-errs:
+	case <-ctx.Done():
+		err = fmt.Errorf("check timed out: %v", ctx.Err())
+	}
+	if err != nil {
+		result.Status = StatusFailed
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// HTTPCheck returns the external check of a dependency failing when the url can't be reached or answers with a server
+// error. Client errors such as 401 still prove the url is reachable, so the client doesn't need to be authenticated.
+func HTTPCheck(name string, url string, client *http.Client) Check {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return Check{
+		Name:     name,
+		External: true,
+		Func: func(ctx context.Context) error {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+			if err != nil {
+				return err
+			}
+			resp, err := client.Do(req)
+			if err != nil {
+				return err
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode >= http.StatusInternalServerError {
+				return fmt.Errorf("%s answered with status %d", url, resp.StatusCode)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,175 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+)
+
+func passing(ctx context.Context) error {
+	return nil
+}
+
+func failing(ctx context.Context) error {
+	return fmt.Errorf("unhealthy")
+}
+
+func TestRegistry_Ready(t *testing.T) {
+	tests := []struct {
+		name   string
+		checks []Check
+		want   Status
+	}{
+		{
+			name:   "should be ok when all the checks pass",
+			checks: []Check{{Name: "db", Critical: true, Func: passing}, {Name: "ocm", Func: passing}},
+			want:   StatusOK,
+		},
+		{
+			name:   "should be degraded when a non-critical check fails",
+			checks: []Check{{Name: "db", Critical: true, Func: passing}, {Name: "ocm", Func: failing}},
+			want:   StatusDegraded,
+		},
+		{
+			name:   "should fail when a critical check fails",
+			checks: []Check{{Name: "db", Critical: true, Func: failing}, {Name: "ocm", Func: failing}},
+			want:   StatusFailed,
+		},
+		{
+			name: "should be degraded when a non-critical check panics",
+			checks: []Check{{Name: "ocm", Func: func(ctx context.Context) error {
+				panic("boom")
+			}}},
+			want: StatusDegraded,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			r := NewRegistry(time.Second, 0)
+			r.Register(tt.checks...)
+			report := r.Ready(context.Background())
+			gomega.Expect(report.Status).To(gomega.Equal(tt.want))
+			gomega.Expect(report.Checks).To(gomega.HaveLen(len(tt.checks)))
+		})
+	}
+}
+
+func TestRegistry_Live(t *testing.T) {
+	gomega.RegisterTestingT(t)
+	r := NewRegistry(time.Second, 0)
+	r.Register(Check{Name: "db", Critical: true, Func: failing}, Check{Name: "workers", Liveness: true, Func: passing})
+	report := r.Live(context.Background())
+	gomega.Expect(report.Status).To(gomega.Equal(StatusOK))
+	gomega.Expect(report.Checks).To(gomega.HaveKey("workers"))
+	gomega.Expect(report.Checks).ToNot(gomega.HaveKey("db"))
+
+	// the liveness checks are not critical for readiness, but any of them failing fails liveness
+	r.Register(Check{Name: "workers", Liveness: true, Func: failing})
+	gomega.Expect(r.Live(context.Background()).Status).To(gomega.Equal(StatusFailed))
+	gomega.Expect(r.Names()).To(gomega.Equal([]string{"db", "workers"}))
+}
+
+func TestRegistry_External(t *testing.T) {
+	gomega.RegisterTestingT(t)
+	r := NewRegistry(time.Second, 0)
+	r.Register(Check{Name: "ocm", Critical: true, Liveness: true, External: true, Func: failing})
+	// the failure of an external check only degrades the fleet manager
+	gomega.Expect(r.Ready(context.Background()).Status).To(gomega.Equal(StatusDegraded))
+	gomega.Expect(r.Live(context.Background()).Checks).To(gomega.BeEmpty())
+}
+
+func TestRegistry_Cache(t *testing.T) {
+	gomega.RegisterTestingT(t)
+	calls := 0
+	r := NewRegistry(time.Second, time.Hour)
+	r.Register(Check{Name: "ocm", External: true, Func: func(ctx context.Context) error {
+		calls++
+		return nil
+	}})
+	first := r.Ready(context.Background())
+	second := r.Ready(context.Background())
+	gomega.Expect(calls).To(gomega.Equal(1))
+	gomega.Expect(second.Checks["ocm"].CheckedAt).To(gomega.Equal(first.Checks["ocm"].CheckedAt))
+
+	// registering the check again drops its cached result
+	r.Register(Check{Name: "ocm", External: true, Func: failing})
+	gomega.Expect(r.Ready(context.Background()).Checks["ocm"].Status).To(gomega.Equal(StatusFailed))
+
+	// the results of cancelled reports are not cached
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r = NewRegistry(time.Second, time.Hour)
+	r.Register(Check{Name: "ocm", External: true, Func: func(ctx context.Context) error {
+		calls++
+		return ctx.Err()
+	}})
+	r.Ready(ctx)
+	gomega.Expect(r.Ready(context.Background()).Checks["ocm"].Status).To(gomega.Equal(StatusOK))
+}
+
+func TestRegistry_Timeout(t *testing.T) {
+	gomega.RegisterTestingT(t)
+	r := NewRegistry(10*time.Millisecond, 0)
+	r.Register(Check{Name: "slow", Critical: true, Func: func(ctx context.Context) error {
+		<-ctx.Done()
+		time.Sleep(time.Second)
+		return nil
+	}})
+	report := r.Ready(context.Background())
+	gomega.Expect(report.Status).To(gomega.Equal(StatusFailed))
+	gomega.Expect(report.Checks["slow"].Error).To(gomega.ContainSubstring("timed out"))
+}
+
+func TestRegistry_Handlers(t *testing.T) {
+	tests := []struct {
+		name       string
+		check      Check
+		wantStatus int
+	}{
+		{
+			name:       "should answer 200 when degraded",
+			check:      Check{Name: "ocm", Func: failing},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "should answer 503 when a critical check fails",
+			check:      Check{Name: "db", Critical: true, Func: failing},
+			wantStatus: http.StatusServiceUnavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			r := NewRegistry(time.Second, 0)
+			r.Register(tt.check)
+			rec := httptest.NewRecorder()
+			r.ReadyHandler(rec, httptest.NewRequest(http.MethodGet, "/healthz/ready", nil))
+			gomega.Expect(rec.Code).To(gomega.Equal(tt.wantStatus))
+			var report Report
+			gomega.Expect(json.Unmarshal(rec.Body.Bytes(), &report)).To(gomega.Succeed())
+			gomega.Expect(report.Checks[tt.check.Name].Error).To(gomega.Equal("unhealthy"))
+		})
+	}
+}
+
+func TestHTTPCheck(t *testing.T) {
+	gomega.RegisterTestingT(t)
+	status := http.StatusUnauthorized
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	check := HTTPCheck("ocm", server.URL, nil)
+	gomega.Expect(check.External).To(gomega.BeTrue())
+	gomega.Expect(check.Func(context.Background())).To(gomega.Succeed())
+	status = http.StatusBadGateway
+	gomega.Expect(check.Func(context.Background())).ToNot(gomega.Succeed())
+}
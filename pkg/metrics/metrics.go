@@ -64,6 +64,21 @@ const (
 	// DatabaseQueryDuration - metric name for database query duration in milliseconds
 	DatabaseQueryDuration = "database_query_duration"
 
+	// RateLimitRequestCount - metric name for the number of API requests checked against a rate limit budget
+	RateLimitRequestCount = "rate_limit_request_count"
+
+	// KafkaAvailability - metric name for the ratio of time a kafka was available over the SLO window
+	KafkaAvailability = "kafka_availability_ratio"
+	// KafkaErrorBudgetRemaining - metric name for the ratio of the error budget of a kafka left over the SLO window
+	KafkaErrorBudgetRemaining = "kafka_error_budget_remaining_ratio"
+	// KafkaErrorBudgetBurnRate - metric name for the rate the error budget of a kafka is consumed at over the burn rate window
+	KafkaErrorBudgetBurnRate = "kafka_error_budget_burn_rate"
+	// ClusterKafkaAvailability - metric name for the ratio of time the kafkas of a cluster were available over the SLO window
+	ClusterKafkaAvailability = "cluster_kafka_availability_ratio"
+
+	// ClusterScalingFlapping - metric name for whether the compute nodes of a cluster keep being scaled up and down
+	ClusterScalingFlapping = "cluster_scaling_flapping"
+
 	// ClusterStatusMaxCapacity - metric name for the maximum kafka instance capacity
 	ClusterStatusCapacityMax = "cluster_status_capacity_max"
 
@@ -82,6 +97,10 @@ const (
 	LabelRegion              = "region"
 	LabelInstanceType        = "instance_type"
 	LabelCloudProvider       = "cloud_provider"
+
+	LabelRateLimitBudget = "budget"
+	LabelRateLimitScope  = "scope"
+	LabelRateLimitResult = "result"
 )
 
 // JobType metric to capture
@@ -151,6 +170,21 @@ var DatabaseMetricsLabels = []string{
 	LabelDatabaseQueryType,
 }
 
+var kafkaAvailabilityMetricsLabels = []string{
+	LabelID,
+	LabelClusterID,
+}
+
+var clusterKafkaAvailabilityMetricsLabels = []string{
+	LabelClusterID,
+}
+
+var rateLimitRequestCountMetricsLabels = []string{
+	LabelRateLimitBudget,
+	LabelRateLimitScope,
+	LabelRateLimitResult,
+}
+
 var clusterStatusCapacityLabels = []string{
 	LabelRegion,
 	LabelInstanceType,
@@ -676,6 +710,113 @@ func UpdateDatabaseQueryDurationMetric(status string, queryType string, elapsed
 
 // #### Metrics for Database - End ####
 
+// #### Metrics for Rate Limiting ####
+
+// register rate limit request count metric
+//	  rate_limit_request_count - Number of API requests checked against a rate limit budget partitioned by budget,
+//	  scope and result
+var rateLimitRequestCountMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: KasFleetManager,
+	Name:      RateLimitRequestCount,
+	Help:      "number of API requests checked against a rate limit budget.",
+}, rateLimitRequestCountMetricsLabels)
+
+// Increase the rate limit request count metric with the following labels:
+// 	- budget: name of the rate limit budget the request was counted against
+// 	- scope: (i.e. "user" or "organisation")
+// 	- result: (i.e. "allowed", "rejected" or "error")
+func IncreaseRateLimitRequestCount(budget string, scope string, result string) {
+	labels := prometheus.Labels{
+		LabelRateLimitBudget: budget,
+		LabelRateLimitScope:  scope,
+		LabelRateLimitResult: result,
+	}
+	rateLimitRequestCountMetric.With(labels).Inc()
+}
+
+// #### Metrics for Rate Limiting - End ####
+
+// #### Metrics for Kafka availability ####
+
+// register kafka availability metric
+//	  kafka_availability_ratio - Ratio of time a kafka was available over the SLO window partitioned by kafka and cluster id
+var kafkaAvailabilityMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: KasFleetManager,
+	Name:      KafkaAvailability,
+	Help:      "ratio of the measured time a kafka was available over the SLO window.",
+}, kafkaAvailabilityMetricsLabels)
+
+// register kafka error budget remaining metric
+//	  kafka_error_budget_remaining_ratio - Ratio of the error budget of a kafka left over the SLO window partitioned by
+//	  kafka and cluster id, negative once the budget is exhausted
+var kafkaErrorBudgetRemainingMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: KasFleetManager,
+	Name:      KafkaErrorBudgetRemaining,
+	Help:      "ratio of the error budget of a kafka left over the SLO window, negative once the budget is exhausted.",
+}, kafkaAvailabilityMetricsLabels)
+
+// register kafka error budget burn rate metric
+//	  kafka_error_budget_burn_rate - Rate the error budget of a kafka is consumed at over the burn rate window
+//	  partitioned by kafka and cluster id
+var kafkaErrorBudgetBurnRateMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: KasFleetManager,
+	Name:      KafkaErrorBudgetBurnRate,
+	Help:      "how many times faster than allowed by the SLO target the error budget of a kafka was consumed over the burn rate window.",
+}, kafkaAvailabilityMetricsLabels)
+
+// register cluster kafka availability metric
+//	  cluster_kafka_availability_ratio - Ratio of time the kafkas of a cluster were available over the SLO window
+//	  partitioned by cluster id
+var clusterKafkaAvailabilityMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: KasFleetManager,
+	Name:      ClusterKafkaAvailability,
+	Help:      "ratio of the measured time the kafkas of a cluster were available over the SLO window.",
+}, clusterKafkaAvailabilityMetricsLabels)
+
+// UpdateKafkaAvailabilityMetrics sets the availability and the error budget of a kafka
+func UpdateKafkaAvailabilityMetrics(kafkaId, clusterId string, availability, errorBudgetRemaining float64) {
+	labels := prometheus.Labels{
+		LabelID:        kafkaId,
+		LabelClusterID: clusterId,
+	}
+	kafkaAvailabilityMetric.With(labels).Set(availability)
+	kafkaErrorBudgetRemainingMetric.With(labels).Set(errorBudgetRemaining)
+}
+
+// UpdateKafkaErrorBudgetBurnRateMetric sets the error budget burn rate of a kafka
+func UpdateKafkaErrorBudgetBurnRateMetric(kafkaId, clusterId string, burnRate float64) {
+	labels := prometheus.Labels{
+		LabelID:        kafkaId,
+		LabelClusterID: clusterId,
+	}
+	kafkaErrorBudgetBurnRateMetric.With(labels).Set(burnRate)
+}
+
+// UpdateClusterKafkaAvailabilityMetric sets the availability of the kafkas of a cluster
+func UpdateClusterKafkaAvailabilityMetric(clusterId string, availability float64) {
+	clusterKafkaAvailabilityMetric.With(prometheus.Labels{LabelClusterID: clusterId}).Set(availability)
+}
+
+// #### Metrics for Kafka availability - End ####
+
+// register cluster scaling flapping metric
+//	  cluster_scaling_flapping - 1 when the scaling of the compute nodes of a cluster changed direction too often over
+//	  the flapping window partitioned by cluster id, 0 otherwise
+var clusterScalingFlappingMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: KasFleetManager,
+	Name:      ClusterScalingFlapping,
+	Help:      "1 when the compute nodes of a cluster keep being scaled up and down, 0 otherwise.",
+}, clusterKafkaAvailabilityMetricsLabels)
+
+// UpdateClusterScalingFlappingMetric sets whether the compute nodes of a cluster keep being scaled up and down
+func UpdateClusterScalingFlappingMetric(clusterId string, flapping bool) {
+	value := 0.0
+	if flapping {
+		value = 1
+	}
+	clusterScalingFlappingMetric.With(prometheus.Labels{LabelClusterID: clusterId}).Set(value)
+}
+
 // register the metric(s)
 func init() {
 	// metrics for data plane clusters
@@ -710,6 +851,18 @@ func init() {
 	// metrics for database
 	prometheus.MustRegister(databaseRequestCountMetric)
 	prometheus.MustRegister(databaseQueryDurationMetric)
+
+	// metrics for rate limiting
+	prometheus.MustRegister(rateLimitRequestCountMetric)
+
+	// metrics for kafka availability
+	prometheus.MustRegister(kafkaAvailabilityMetric)
+	prometheus.MustRegister(kafkaErrorBudgetRemainingMetric)
+	prometheus.MustRegister(kafkaErrorBudgetBurnRateMetric)
+	prometheus.MustRegister(clusterKafkaAvailabilityMetric)
+
+	// metrics for the scaling of the data plane clusters
+	prometheus.MustRegister(clusterScalingFlappingMetric)
 }
 
 // ResetMetricsForKafkaManagers will reset the metrics for the KafkaManager background reconciler
@@ -746,6 +899,15 @@ func ResetMetricsForObservatorium() {
 	observatoriumRequestDurationMetric.Reset()
 }
 
+// ResetMetricsForKafkaAvailability will reset the metrics related to the availability of the kafkas
+// This is needed because the metrics of deleted kafkas would otherwise be scraped, and when the current process is not the leader anymore
+func ResetMetricsForKafkaAvailability() {
+	kafkaAvailabilityMetric.Reset()
+	kafkaErrorBudgetRemainingMetric.Reset()
+	kafkaErrorBudgetBurnRateMetric.Reset()
+	clusterKafkaAvailabilityMetric.Reset()
+}
+
 // Reset the metrics we have defined. It is mainly used for testing.
 func Reset() {
 	requestClusterCreationDurationMetric.Reset()
@@ -774,4 +936,9 @@ func Reset() {
 
 	databaseRequestCountMetric.Reset()
 	databaseQueryDurationMetric.Reset()
+
+	rateLimitRequestCountMetric.Reset()
+
+	ResetMetricsForKafkaAvailability()
+	clusterScalingFlappingMetric.Reset()
 }
@@ -2,6 +2,7 @@ package providers
 
 import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/acl"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/keycloak"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/observatorium"
@@ -11,13 +12,19 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/health"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/quota_management"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/server"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/account"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/audit"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/authorization"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/metering"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/quota"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/rbac"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/sentry"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/tracing"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
 	"github.com/goava/di"
@@ -33,9 +40,12 @@ func CoreConfigProviders() di.Option {
 		di.Provide(server.NewHealthCheckConfig, di.As(new(environments.ConfigModule))),
 		di.Provide(db.NewDatabaseConfig, di.As(new(environments.ConfigModule))),
 		di.Provide(server.NewServerConfig, di.As(new(environments.ConfigModule))),
-		di.Provide(ocm.NewOCMConfig, di.As(new(environments.ConfigModule))),
-		di.Provide(keycloak.NewKeycloakConfig, di.As(new(environments.ConfigModule))),
+		di.Provide(ocm.NewOCMConfig, di.As(new(environments.ConfigModule)), di.As(new(health.Checker))),
+		di.Provide(keycloak.NewKeycloakConfig, di.As(new(environments.ConfigModule)), di.As(new(health.Checker))),
 		di.Provide(acl.NewAccessControlListConfig, di.As(new(environments.ConfigModule))),
+		di.Provide(auth.NewRateLimitConfig, di.As(new(environments.ConfigModule))),
+		di.Provide(auth.NewOIDCConfig, di.As(new(environments.ConfigModule))),
+		di.Provide(handlers.NewIdempotencyConfig, di.As(new(environments.ConfigModule))),
 		di.Provide(quota_management.NewQuotaManagementListConfig, di.As(new(environments.ConfigModule))),
 		di.Provide(server.NewMetricsConfig, di.As(new(environments.ConfigModule))),
 
@@ -45,9 +55,14 @@ func CoreConfigProviders() di.Option {
 
 		// Add other core config providers..
 		sentry.ConfigProviders(),
+		tracing.ConfigProviders(),
 		signalbus.ConfigProviders(),
 		authorization.ConfigProviders(),
+		rbac.ConfigProviders(),
+		audit.ConfigProviders(),
 		account.ConfigProviders(),
+		quota.ConfigProviders(),
+		metering.ConfigProviders(),
 
 		di.Provide(environments.Func(ServiceProviders)),
 	)
@@ -57,7 +72,7 @@ func ServiceProviders() di.Option {
 	return di.Options(
 
 		// provide the service constructors
-		di.Provide(db.NewConnectionFactory),
+		di.Provide(db.NewConnectionFactory, di.As(new(health.Checker))),
 		di.Provide(observatorium.NewObservatoriumClient),
 
 		di.Provide(func(config *ocm.OCMConfig) ocm.ClusterManagementClient {
@@ -79,7 +94,10 @@ func ServiceProviders() di.Option {
 		di.Provide(aws.NewDefaultClientFactory, di.As(new(aws.ClientFactory))),
 
 		di.Provide(acl.NewAccessControlListMiddleware),
+		di.Provide(auth.NewRateLimitMiddleware),
 		di.Provide(handlers.NewErrorsHandler),
+		di.Provide(handlers.NewIdempotencyStore),
+		di.Provide(handlers.NewIdempotencyMiddleware),
 		di.Provide(func(c *keycloak.KeycloakConfig) services.KafkaKeycloakService {
 			return services.NewKeycloakService(c, c.KafkaRealm)
 		}),
@@ -91,6 +109,6 @@ func ServiceProviders() di.Option {
 		di.Provide(server.NewAPIServer, di.As(new(environments.BootService))),
 		di.Provide(server.NewMetricsServer, di.As(new(environments.BootService))),
 		di.Provide(server.NewHealthCheckServer, di.As(new(environments.BootService))),
-		di.Provide(workers.NewLeaderElectionManager, di.As(new(environments.BootService))),
+		di.Provide(workers.NewLeaderElectionManager, di.As(new(environments.BootService)), di.As(new(health.Checker))),
 	)
 }
@@ -12,10 +12,9 @@ import (
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/server/logging"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/sentry"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/tracing"
 	"github.com/goava/di"
 
-	"github.com/openshift-online/ocm-sdk-go/authentication"
-
 	_ "github.com/auth0/go-jwt-middleware"
 	sentryhttp "github.com/getsentry/sentry-go/http"
 	_ "github.com/golang-jwt/jwt/v4"
@@ -24,6 +23,7 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
 )
 
@@ -65,6 +65,9 @@ func NewAPIServer(options ServerOptions) *ApiServer {
 
 	// Top-level middlewares
 
+	// Tracing middleware starts the span of the request, continuing the trace of the caller when it propagated one
+	mainRouter.Use(tracing.Middleware)
+
 	// Sentryhttp middleware performs two operations:
 	// 1) Attaches an instance of *sentry.Hub to the request’s context. Accessit by using the sentry.GetHubFromContext() method on the request
 	//   NOTE this is the only way middleware, handlers, and services should be reporting to sentry, through the hub
@@ -89,11 +92,11 @@ func NewAPIServer(options ServerOptions) *ApiServer {
 
 	// referring to the router as type http.Handler allows us to add middleware via more handlers
 	var mainHandler http.Handler = mainRouter
-	var builder *authentication.HandlerBuilder
+	var builder *auth.AuthenticationBuilder
 	options.Env.MustResolve(&builder)
 
 	var err error
-	mainHandler, err = builder.Next(mainHandler).Build()
+	mainHandler, err = builder.Build(mainHandler)
 	check(err, "Unable to create authentication handler", options.SentryConfig.Timeout)
 
 	mainHandler = gorillahandlers.CORS(
@@ -106,6 +109,10 @@ func NewAPIServer(options ServerOptions) *ApiServer {
 		gorillahandlers.AllowedHeaders([]string{
 			"Authorization",
 			"Content-Type",
+			"If-Match",
+		}),
+		gorillahandlers.ExposedHeaders([]string{
+			"ETag",
 		}),
 		gorillahandlers.MaxAge(int((10 * time.Minute).Seconds())),
 	)(mainHandler)
@@ -1,24 +1,34 @@
 package server
 
 import (
+	"time"
+
 	"github.com/spf13/pflag"
 )
 
 type HealthCheckConfig struct {
 	BindAddress string `json:"bind_address"`
 	EnableHTTPS bool   `json:"enable_https"`
+	// CheckTimeout is how long each check of the /healthz endpoints is given to complete
+	CheckTimeout time.Duration `json:"check_timeout"`
+	// CheckCacheTTL is how long the result of a check is reused by the /healthz endpoints
+	CheckCacheTTL time.Duration `json:"check_cache_ttl"`
 }
 
 func NewHealthCheckConfig() *HealthCheckConfig {
 	return &HealthCheckConfig{
-		BindAddress: "localhost:8083",
-		EnableHTTPS: false,
+		BindAddress:   "localhost:8083",
+		EnableHTTPS:   false,
+		CheckTimeout:  5 * time.Second,
+		CheckCacheTTL: 30 * time.Second,
 	}
 }
 
 func (c *HealthCheckConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.BindAddress, "health-check-server-bindaddress", c.BindAddress, "Health check server bind adddress")
 	fs.BoolVar(&c.EnableHTTPS, "enable-health-check-https", c.EnableHTTPS, "Enable HTTPS for health check server")
+	fs.DurationVar(&c.CheckTimeout, "health-check-timeout", c.CheckTimeout, "How long each check of the /healthz/live and /healthz/ready endpoints is given to complete")
+	fs.DurationVar(&c.CheckCacheTTL, "health-check-cache-ttl", c.CheckCacheTTL, "How long the result of a check is reused by the /healthz/live and /healthz/ready endpoints, so that frequent probes don't call the dependencies every time")
 }
 
 func (c *HealthCheckConfig) ReadFiles() error {
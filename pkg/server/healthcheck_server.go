@@ -3,18 +3,19 @@ package server
 import (
 	"context"
 	"fmt"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/health"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/sentry"
 	"net"
 	"net/http"
 	"time"
 
-	health "github.com/docker/go-healthcheck"
+	dockerhealth "github.com/docker/go-healthcheck"
 	"github.com/golang/glog"
 	"github.com/gorilla/mux"
 )
 
 var (
-	updater = health.NewStatusUpdater()
+	updater = dockerhealth.NewStatusUpdater()
 )
 
 var _ Server = &HealthCheckServer{}
@@ -24,16 +25,34 @@ type HealthCheckServer struct {
 	serverConfig      *ServerConfig
 	sentryTimeout     time.Duration
 	healthCheckConfig *HealthCheckConfig
+	registry          *health.Registry
 }
 
-func NewHealthCheckServer(healthCheckConfig *HealthCheckConfig, serverConfig *ServerConfig, sentryConfig *sentry.Config) *HealthCheckServer {
+// NewHealthCheckServer creates the server of the maintenance toggle and of the /healthz endpoints running the checks
+// of the checkers
+func NewHealthCheckServer(healthCheckConfig *HealthCheckConfig, serverConfig *ServerConfig, sentryConfig *sentry.Config, checkers []health.Checker) *HealthCheckServer {
 	router := mux.NewRouter()
-	health.DefaultRegistry = health.NewRegistry()
-	health.Register("maintenance_status", updater)
-	router.HandleFunc("/healthcheck", health.StatusHandler).Methods(http.MethodGet)
+	dockerhealth.DefaultRegistry = dockerhealth.NewRegistry()
+	dockerhealth.Register("maintenance_status", updater)
+	router.HandleFunc("/healthcheck", dockerhealth.StatusHandler).Methods(http.MethodGet)
 	router.HandleFunc("/healthcheck/down", downHandler).Methods(http.MethodPost)
 	router.HandleFunc("/healthcheck/up", upHandler).Methods(http.MethodPost)
 
+	registry := health.NewRegistry(healthCheckConfig.CheckTimeout, healthCheckConfig.CheckCacheTTL)
+	// the fleet manager is not ready while it is in maintenance mode
+	registry.Register(health.Check{
+		Name:     "maintenance",
+		Critical: true,
+		Func: func(ctx context.Context) error {
+			return updater.Check()
+		},
+	})
+	for _, checker := range checkers {
+		registry.Register(checker.HealthChecks()...)
+	}
+	router.HandleFunc("/healthz/live", registry.LiveHandler).Methods(http.MethodGet)
+	router.HandleFunc("/healthz/ready", registry.ReadyHandler).Methods(http.MethodGet)
+
 	srv := &http.Server{
 		Handler: router,
 		Addr:    healthCheckConfig.BindAddress,
@@ -44,9 +63,15 @@ func NewHealthCheckServer(healthCheckConfig *HealthCheckConfig, serverConfig *Se
 		serverConfig:      serverConfig,
 		healthCheckConfig: healthCheckConfig,
 		sentryTimeout:     sentryConfig.Timeout,
+		registry:          registry,
 	}
 }
 
+// Registry returns the registry of the checks run by the /healthz endpoints
+func (s HealthCheckServer) Registry() *health.Registry {
+	return s.registry
+}
+
 func (s HealthCheckServer) Start() {
 	go s.Run()
 }
@@ -0,0 +1,111 @@
+package audit
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+)
+
+// AuditEventFilter restricts the audit events returned by List, empty fields match all events
+type AuditEventFilter struct {
+	Actor          string
+	OrganisationId string
+	Action         string
+	ResourceType   string
+	ResourceId     string
+	Outcome        string
+	From           time.Time
+	To             time.Time
+}
+
+//go:generate moq -out audit_events_moq.go . AuditService
+type AuditService interface {
+	// Record persists the audit event of a mutation
+	Record(event *api.AuditEvent) *errors.ServiceError
+	// List returns the audit events matching the filter, most recent first
+	List(filter AuditEventFilter, listArgs *services.ListArguments) (api.AuditEventList, *api.PagingMeta, *errors.ServiceError)
+	// DeleteExpired deletes the audit events older than the configured retention and returns how many were deleted
+	DeleteExpired() (int64, *errors.ServiceError)
+}
+
+type auditService struct {
+	auditConfig       *AuditConfig
+	connectionFactory *db.ConnectionFactory
+}
+
+var _ AuditService = &auditService{}
+
+func NewAuditService(auditConfig *AuditConfig, connectionFactory *db.ConnectionFactory) AuditService {
+	return &auditService{
+		auditConfig:       auditConfig,
+		connectionFactory: connectionFactory,
+	}
+}
+
+func (s *auditService) Record(event *api.AuditEvent) *errors.ServiceError {
+	// audit events are not created in the transaction of the request, so that failed mutations are recorded too
+	if err := s.connectionFactory.New().Create(event).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to record audit event of %s %s", event.Method, event.Path)
+	}
+	return nil
+}
+
+func (s *auditService) List(filter AuditEventFilter, listArgs *services.ListArguments) (api.AuditEventList, *api.PagingMeta, *errors.ServiceError) {
+	var events api.AuditEventList
+	dbConn := s.connectionFactory.New().Model(&api.AuditEvent{})
+	pagingMeta := &api.PagingMeta{
+		Page: listArgs.Page,
+		Size: listArgs.Size,
+	}
+
+	for _, f := range []struct{ column, value string }{
+		{"actor", filter.Actor},
+		{"organisation_id", filter.OrganisationId},
+		{"action", filter.Action},
+		{"resource_type", filter.ResourceType},
+		{"resource_id", filter.ResourceId},
+		{"outcome", filter.Outcome},
+	} {
+		if f.value != "" {
+			dbConn = dbConn.Where(f.column+" = ?", f.value)
+		}
+	}
+	if !filter.From.IsZero() {
+		dbConn = dbConn.Where("created_at >= ?", filter.From)
+	}
+	if !filter.To.IsZero() {
+		dbConn = dbConn.Where("created_at < ?", filter.To)
+	}
+
+	var total int64
+	if err := dbConn.Count(&total).Error; err != nil {
+		return nil, nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to count audit events")
+	}
+	pagingMeta.Total = int(total)
+
+	if err := dbConn.Order("created_at DESC").
+		Offset((pagingMeta.Page - 1) * pagingMeta.Size).
+		Limit(pagingMeta.Size).
+		Find(&events).Error; err != nil {
+		return nil, nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list audit events")
+	}
+	pagingMeta.Size = len(events)
+
+	return events, pagingMeta, nil
+}
+
+func (s *auditService) DeleteExpired() (int64, *errors.ServiceError) {
+	if s.auditConfig.Retention <= 0 {
+		return 0, nil
+	}
+	result := s.connectionFactory.New().Unscoped().
+		Where("created_at < ?", time.Now().Add(-s.auditConfig.Retention)).
+		Delete(&api.AuditEvent{})
+	if result.Error != nil {
+		return 0, errors.NewWithCause(errors.ErrorGeneral, result.Error, "unable to delete expired audit events")
+	}
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,62 @@
+package audit
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+)
+
+// expired audit events are deleted at most once per interval, there is no need to check for them at every reconcile
+const deleteExpiredInterval = time.Hour
+
+// AuditEventsManager periodically deletes the audit events older than the configured retention
+type AuditEventsManager struct {
+	workers.BaseWorker
+	auditService AuditService
+	lastRun      time.Time
+}
+
+// NewAuditEventsManager creates a new audit events manager
+func NewAuditEventsManager(auditService AuditService, bus signalbus.SignalBus) *AuditEventsManager {
+	return &AuditEventsManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: "audit_events",
+			Reconciler: workers.Reconciler{
+				SignalBus: bus,
+			},
+		},
+		auditService: auditService,
+	}
+}
+
+// Start initializes the audit events manager to delete expired audit events
+func (m *AuditEventsManager) Start() {
+	m.StartWorker(m)
+}
+
+// Stop causes the process for deleting expired audit events to stop
+func (m *AuditEventsManager) Stop() {
+	m.StopWorker(m)
+}
+
+func (m *AuditEventsManager) Reconcile() []error {
+	now := time.Now()
+	if now.Sub(m.lastRun) < deleteExpiredInterval {
+		return nil
+	}
+	glog.V(5).Infoln("deleting expired audit events")
+
+	deleted, err := m.auditService.DeleteExpired()
+	if err != nil {
+		return []error{err}
+	}
+	m.lastRun = now
+	if deleted > 0 {
+		glog.Infof("deleted %d expired audit events", deleted)
+	}
+	return nil
+}
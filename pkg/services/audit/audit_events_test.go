@@ -0,0 +1,61 @@
+package audit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_auditService_List(t *testing.T) {
+	gomega.RegisterTestingT(t)
+	mocket.Catcher.Reset()
+	mocket.Catcher.NewMock().WithQuery(`SELECT count(1) FROM "audit_events" WHERE (actor = $1) AND resource_type = $2`).
+		WithReply([]map[string]interface{}{{"count": 3}})
+	mocket.Catcher.NewMock().WithQuery(`ORDER BY created_at DESC LIMIT 2`).
+		WithReply([]map[string]interface{}{{"id": "event-2"}, {"id": "event-1"}})
+
+	s := NewAuditService(NewAuditConfig(), db.NewMockConnectionFactory(nil))
+	events, paging, err := s.List(AuditEventFilter{Actor: "test-user", ResourceType: "Kafka"}, &services.ListArguments{Page: 1, Size: 2})
+
+	gomega.Expect(err).To(gomega.BeNil())
+	gomega.Expect(events).To(gomega.HaveLen(2))
+	gomega.Expect(events[0].ID).To(gomega.Equal("event-2"))
+	gomega.Expect(paging.Total).To(gomega.Equal(3))
+	gomega.Expect(paging.Size).To(gomega.Equal(2))
+}
+
+func Test_auditService_DeleteExpired(t *testing.T) {
+	tests := []struct {
+		name        string
+		retention   time.Duration
+		wantDeleted int64
+	}{
+		{
+			name:        "should keep audit events forever without retention",
+			retention:   0,
+			wantDeleted: 0,
+		},
+		{
+			name:        "should delete the audit events older than the retention",
+			retention:   time.Hour,
+			wantDeleted: 5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			mocket.Catcher.Reset().NewMock().WithQuery(`DELETE FROM "audit_events" WHERE created_at < $1`).WithRowsNum(5)
+
+			s := NewAuditService(&AuditConfig{Retention: tt.retention}, db.NewMockConnectionFactory(nil))
+			deleted, err := s.DeleteExpired()
+
+			gomega.Expect(err).To(gomega.BeNil())
+			gomega.Expect(deleted).To(gomega.Equal(tt.wantDeleted))
+		})
+	}
+}
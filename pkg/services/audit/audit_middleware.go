@@ -0,0 +1,294 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/gorilla/mux"
+)
+
+const redactedValue = "***"
+
+// fields which values are redacted from the recorded diffs, matched case insensitively against the field names
+// without their separators, e.g. apikey matches api_key and apiKey
+var sensitiveFields = []string{"secret", "password", "token", "credential", "apikey", "privatekey", "accesskey"}
+
+// fields which changes are not recorded
+var ignoredFields = map[string]bool{"href": true}
+
+type auditContextKey int
+
+const resourceStateKey auditContextKey = iota
+
+// resourceState is the state of the resource before and after the mutation, set by the handler of the request
+type resourceState struct {
+	before map[string]interface{}
+	after  map[string]interface{}
+}
+
+// SetPreviousState records the state of the resource the request is about to mutate, in the representation returned
+// by the API, so that the changes are recorded with the audit event of the request. Handlers call it with the resource
+// they read through the service layer before mutating it. Only the first state set by a request is kept, and nothing
+// is recorded for the requests that are not audited.
+func SetPreviousState(ctx context.Context, object interface{}) {
+	if state, ok := ctx.Value(resourceStateKey).(*resourceState); ok && state.before == nil {
+		state.before = encodeObject(object)
+	}
+}
+
+// SetNewState records the state of the resource after the mutation, for the handlers not returning the resource in
+// their response. The state read from the response is used otherwise.
+func SetNewState(ctx context.Context, object interface{}) {
+	if state, ok := ctx.Value(resourceStateKey).(*resourceState); ok {
+		state.after = encodeObject(object)
+	}
+}
+
+// IsAudited returns whether the request of the context is audited. Handlers reading the resource they mutate only to
+// record its previous state skip the read for the requests not audited.
+func IsAudited(ctx context.Context) bool {
+	_, ok := ctx.Value(resourceStateKey).(*resourceState)
+	return ok
+}
+
+// encodeObject returns the JSON representation of the object, decoded as a generic JSON object
+func encodeObject(object interface{}) map[string]interface{} {
+	b, err := json.Marshal(object)
+	if err != nil {
+		return nil
+	}
+	return decodeObject(b)
+}
+
+type AuditMiddleware struct {
+	auditService AuditService
+}
+
+func NewAuditMiddleware(auditService AuditService) *AuditMiddleware {
+	return &AuditMiddleware{
+		auditService: auditService,
+	}
+}
+
+// Audit is a middleware recording an audit event for every POST, PUT, PATCH and DELETE request. It must be used after
+// the authentication middlewares of the router, so that the actor can be read from the request context. The state of
+// the resource before the mutation is the one set by the handler with SetPreviousState, the state after the mutation
+// is read from the response unless the handler set it with SetNewState.
+func (m *AuditMiddleware) Audit(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !isMutation(r.Method) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		state := &resourceState{}
+		r = r.WithContext(context.WithValue(r.Context(), resourceStateKey, state))
+		recorder := &auditResponseRecorder{ResponseWriter: w}
+		next.ServeHTTP(recorder, r)
+
+		event := newAuditEvent(r, recorder, state)
+		record := func() {
+			if err := m.auditService.Record(event); err != nil {
+				// the response is already returned, just log the error
+				logger.NewUHCLogger(r.Context()).Errorf("unable to record audit event: %v", err)
+			}
+		}
+		// the mutation only happens once the transaction of the request commits, so the event is recorded then.
+		// Mutations rolled back after the response was written are recorded as failures, without changes.
+		if err := db.AddPostCommitAction(r.Context(), record); err != nil {
+			record()
+			return
+		}
+		_ = db.AddPostRollbackAction(r.Context(), func() {
+			event.Outcome = api.AuditOutcomeFailure
+			event.Diff = nil
+			record()
+		})
+	})
+}
+
+func isMutation(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func newAuditEvent(r *http.Request, recorder *auditResponseRecorder, state *resourceState) *api.AuditEvent {
+	statusCode := recorder.statusCode
+	if statusCode == 0 {
+		statusCode = http.StatusOK
+	}
+	event := &api.AuditEvent{
+		Method:     r.Method,
+		Path:       r.URL.Path,
+		RemoteAddr: r.RemoteAddr,
+		StatusCode: statusCode,
+		Outcome:    api.AuditOutcomeSuccess,
+	}
+	if statusCode >= http.StatusBadRequest {
+		event.Outcome = api.AuditOutcomeFailure
+	}
+
+	if claims, err := auth.GetClaimsFromContext(r.Context()); err == nil {
+		event.Actor = auth.GetUsernameFromClaims(claims)
+		event.OrganisationId = auth.GetOrgIdFromClaims(claims)
+	}
+
+	var pathTemplate string
+	if route := mux.CurrentRoute(r); route != nil {
+		pathTemplate, _ = route.GetPathTemplate()
+		if name := route.GetName(); name != "" {
+			event.Action = logger.NewLogEventFromString(name).Type
+		}
+	}
+	if event.Action == "" {
+		event.Action = fmt.Sprintf("%s %s", r.Method, pathTemplate)
+	}
+	event.ResourceType, event.ResourceId = resourceFromPath(pathTemplate, r.URL.Path, mux.Vars(r))
+
+	before := state.before
+	var after map[string]interface{}
+	if event.Outcome == api.AuditOutcomeSuccess {
+		after = state.after
+		if after == nil {
+			after = decodeObject(recorder.body.Bytes())
+		}
+	}
+	for _, object := range []map[string]interface{}{before, after} {
+		if kind, ok := object["kind"].(string); ok && kind != "" {
+			event.ResourceType = kind
+		}
+		if id, ok := object["id"].(string); ok && id != "" {
+			event.ResourceId = id
+		}
+	}
+
+	if changes := diff(before, after); len(changes) > 0 {
+		if b, err := json.Marshal(changes); err == nil {
+			event.Diff = b
+		}
+	}
+	return event
+}
+
+// resourceFromPath returns the last collection of the path template and the id of the resource in the collection,
+// e.g. kafkas and the value of the id variable for /api/kafkas_mgmt/v1/kafkas/{id}. Variables named _ match
+// alternative spellings of a collection, e.g. {_:kafka[-_]connectors}, the collection is read from the request path.
+func resourceFromPath(pathTemplate string, path string, vars map[string]string) (resourceType string, resourceId string) {
+	pathSegments := strings.Split(path, "/")
+	for i, segment := range strings.Split(pathTemplate, "/") {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			name := strings.TrimSuffix(strings.TrimPrefix(segment, "{"), "}")
+			if j := strings.Index(name, ":"); j >= 0 {
+				name = name[:j]
+			}
+			if name != "_" {
+				resourceId = vars[name]
+				continue
+			}
+			if i >= len(pathSegments) {
+				break
+			}
+			segment = pathSegments[i]
+		}
+		if segment != "" {
+			resourceType = segment
+			resourceId = ""
+		}
+	}
+	return resourceType, resourceId
+}
+
+func decodeObject(body []byte) map[string]interface{} {
+	var object map[string]interface{}
+	if err := json.Unmarshal(body, &object); err != nil {
+		return nil
+	}
+	return object
+}
+
+type change struct {
+	Before interface{} `json:"before,omitempty"`
+	After  interface{} `json:"after,omitempty"`
+}
+
+// diff returns the top level fields which values differ between before and after, with secrets redacted
+func diff(before map[string]interface{}, after map[string]interface{}) map[string]change {
+	changes := map[string]change{}
+	for _, object := range []map[string]interface{}{before, after} {
+		for field := range object {
+			if ignoredFields[field] {
+				continue
+			}
+			if _, done := changes[field]; done {
+				continue
+			}
+			// values are compared before being redacted, so that changed secrets are recorded too
+			if !reflect.DeepEqual(before[field], after[field]) {
+				changes[field] = change{Before: redact(field, before[field]), After: redact(field, after[field])}
+			}
+		}
+	}
+	return changes
+}
+
+// redact replaces the values of the sensitive fields nested in value
+func redact(field string, value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	name := strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(field))
+	for _, sensitive := range sensitiveFields {
+		if strings.Contains(name, sensitive) {
+			return redactedValue
+		}
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		redacted := make(map[string]interface{}, len(v))
+		for key, nested := range v {
+			redacted[key] = redact(key, nested)
+		}
+		return redacted
+	case []interface{}:
+		redacted := make([]interface{}, len(v))
+		for i, nested := range v {
+			redacted[i] = redact("", nested)
+		}
+		return redacted
+	}
+	return value
+}
+
+// auditResponseRecorder records the response written by the handler
+type auditResponseRecorder struct {
+	http.ResponseWriter
+	statusCode int
+	body       bytes.Buffer
+}
+
+func (w *auditResponseRecorder) WriteHeader(statusCode int) {
+	if w.statusCode == 0 {
+		w.statusCode = statusCode
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *auditResponseRecorder) Write(b []byte) (int, error) {
+	if w.statusCode == 0 {
+		w.statusCode = http.StatusOK
+	}
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
@@ -0,0 +1,290 @@
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func TestAuditMiddleware_Audit(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		wantEvent  bool
+		wantAction string
+		wantType   string
+		wantId     string
+		wantCode   int
+		wantResult string
+		wantDiff   map[string]change
+	}{
+		{
+			name:      "should not record read requests",
+			method:    http.MethodGet,
+			path:      "/api/v1/widgets/widget-1",
+			wantEvent: false,
+		},
+		{
+			name:       "should record the changed fields of updated resources with secrets redacted",
+			method:     http.MethodPatch,
+			path:       "/api/v1/widgets/widget-1",
+			wantEvent:  true,
+			wantAction: "update-widget",
+			wantType:   "Widget",
+			wantId:     "widget-1",
+			wantCode:   http.StatusOK,
+			wantResult: api.AuditOutcomeSuccess,
+			wantDiff: map[string]change{
+				"size":   {Before: "small", After: "large"},
+				"config": {Before: map[string]interface{}{"client_secret": redactedValue, "url": "a"}, After: map[string]interface{}{"client_secret": redactedValue, "url": "b"}},
+			},
+		},
+		{
+			name:       "should record the changes set by handlers not returning the resource",
+			method:     http.MethodPut,
+			path:       "/api/v1/widgets/widget-1",
+			wantEvent:  true,
+			wantAction: "PUT /api/v1/widgets/{id}",
+			wantType:   "Widget",
+			wantId:     "widget-1",
+			wantCode:   http.StatusNoContent,
+			wantResult: api.AuditOutcomeSuccess,
+			wantDiff: map[string]change{
+				"size": {Before: "small", After: "large"},
+			},
+		},
+		{
+			name:       "should record failed mutations",
+			method:     http.MethodPost,
+			path:       "/api/v1/widgets",
+			wantEvent:  true,
+			wantAction: "POST /api/v1/widgets",
+			wantType:   "widgets",
+			wantCode:   http.StatusBadRequest,
+			wantResult: api.AuditOutcomeFailure,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			var recorded []*api.AuditEvent
+			auditService := &AuditServiceMock{
+				RecordFunc: func(event *api.AuditEvent) *errors.ServiceError {
+					recorded = append(recorded, event)
+					return nil
+				},
+			}
+
+			widget := func(id string, size string, url string, secret string) map[string]interface{} {
+				return map[string]interface{}{
+					"id": id, "kind": "Widget", "href": "/api/v1/widgets/" + id, "size": size,
+					"config": map[string]interface{}{"url": url, "client_secret": secret},
+				}
+			}
+			getCalls := 0
+			router := mux.NewRouter()
+			widgetsRouter := router.PathPrefix("/api/v1/widgets").Subrouter()
+			widgetsRouter.HandleFunc("/{id}", func(w http.ResponseWriter, r *http.Request) {
+				getCalls++
+				shared.WriteJSONResponse(w, http.StatusOK, widget(mux.Vars(r)["id"], "small", "a", "s3cr3t"))
+			}).Methods(http.MethodGet)
+			widgetsRouter.HandleFunc("/{id}", func(w http.ResponseWriter, r *http.Request) {
+				SetPreviousState(r.Context(), widget(mux.Vars(r)["id"], "small", "a", "s3cr3t"))
+				// only the state before the mutation is kept
+				SetPreviousState(r.Context(), widget(mux.Vars(r)["id"], "medium", "a", "s3cr3t"))
+				shared.WriteJSONResponse(w, http.StatusOK, widget(mux.Vars(r)["id"], "large", "b", "n3w-s3cr3t"))
+			}).Name(logger.NewLogEvent("update-widget", "update a widget").ToString()).Methods(http.MethodPatch)
+			widgetsRouter.HandleFunc("/{id}", func(w http.ResponseWriter, r *http.Request) {
+				SetPreviousState(r.Context(), widget(mux.Vars(r)["id"], "small", "a", "s3cr3t"))
+				SetNewState(r.Context(), widget(mux.Vars(r)["id"], "large", "a", "s3cr3t"))
+				w.WriteHeader(http.StatusNoContent)
+			}).Methods(http.MethodPut)
+			widgetsRouter.HandleFunc("", func(w http.ResponseWriter, r *http.Request) {
+				shared.HandleError(r, w, errors.BadRequest("invalid widget"))
+			}).Methods(http.MethodPost)
+			widgetsRouter.Use(func(next http.Handler) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					ctx := auth.SetTokenInContext(r.Context(), &jwt.Token{Claims: jwt.MapClaims{"username": "test-user", "org_id": "test-org"}})
+					next.ServeHTTP(w, r.WithContext(ctx))
+				})
+			})
+			widgetsRouter.Use(NewAuditMiddleware(auditService).Audit)
+
+			req := httptest.NewRequest(tt.method, "http://example.com"+tt.path, strings.NewReader("{}"))
+			router.ServeHTTP(httptest.NewRecorder(), req)
+
+			if !tt.wantEvent {
+				gomega.Expect(recorded).To(gomega.BeEmpty())
+				return
+			}
+			gomega.Expect(recorded).To(gomega.HaveLen(1))
+			gomega.Expect(getCalls).To(gomega.BeZero())
+			event := recorded[0]
+			gomega.Expect(event.Actor).To(gomega.Equal("test-user"))
+			gomega.Expect(event.OrganisationId).To(gomega.Equal("test-org"))
+			gomega.Expect(event.Action).To(gomega.Equal(tt.wantAction))
+			gomega.Expect(event.ResourceType).To(gomega.Equal(tt.wantType))
+			gomega.Expect(event.ResourceId).To(gomega.Equal(tt.wantId))
+			gomega.Expect(event.Path).To(gomega.Equal(tt.path))
+			gomega.Expect(event.StatusCode).To(gomega.Equal(tt.wantCode))
+			gomega.Expect(event.Outcome).To(gomega.Equal(tt.wantResult))
+			if tt.wantDiff == nil {
+				gomega.Expect(event.Diff).To(gomega.BeNil())
+				return
+			}
+			want, err := json.Marshal(tt.wantDiff)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(string(event.Diff)).To(gomega.MatchJSON(want))
+		})
+	}
+}
+
+func Test_resourceFromPath(t *testing.T) {
+	tests := []struct {
+		name         string
+		pathTemplate string
+		path         string
+		vars         map[string]string
+		wantType     string
+		wantId       string
+	}{
+		{
+			name:         "should return the collection of created resources",
+			pathTemplate: "/api/kafkas_mgmt/v1/kafkas",
+			path:         "/api/kafkas_mgmt/v1/kafkas",
+			wantType:     "kafkas",
+		},
+		{
+			name:         "should return the collection and id of the resource",
+			pathTemplate: "/api/kafkas_mgmt/v1/kafkas/{id}",
+			path:         "/api/kafkas_mgmt/v1/kafkas/kafka-1",
+			vars:         map[string]string{"id": "kafka-1"},
+			wantType:     "kafkas",
+			wantId:       "kafka-1",
+		},
+		{
+			name:         "should return the innermost resource",
+			pathTemplate: "/api/kafkas_mgmt/v1/kafkas/{id}/role_bindings/{binding_id:[a-z0-9]+}",
+			path:         "/api/kafkas_mgmt/v1/kafkas/kafka-1/role_bindings/binding-1",
+			vars:         map[string]string{"id": "kafka-1", "binding_id": "binding-1"},
+			wantType:     "role_bindings",
+			wantId:       "binding-1",
+		},
+		{
+			name:         "should read the collection matched by _ variables from the path",
+			pathTemplate: "/api/connector_mgmt/v1/{_:kafka[-_]connectors}/{connector_id}",
+			path:         "/api/connector_mgmt/v1/kafka-connectors/connector-1",
+			vars:         map[string]string{"_": "kafka-connectors", "connector_id": "connector-1"},
+			wantType:     "kafka-connectors",
+			wantId:       "connector-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			resourceType, resourceId := resourceFromPath(tt.pathTemplate, tt.path, tt.vars)
+			gomega.Expect(resourceType).To(gomega.Equal(tt.wantType))
+			gomega.Expect(resourceId).To(gomega.Equal(tt.wantId))
+		})
+	}
+}
+
+func TestAuditMiddleware_Audit_Transaction(t *testing.T) {
+	tests := []struct {
+		name        string
+		rollback    bool
+		wantOutcome string
+		wantDiff    bool
+	}{
+		{
+			name:        "should record the mutation once the transaction commits",
+			wantOutcome: api.AuditOutcomeSuccess,
+			wantDiff:    true,
+		},
+		{
+			name:        "should record a rolled back mutation as a failure without changes",
+			rollback:    true,
+			wantOutcome: api.AuditOutcomeFailure,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			var recorded []*api.AuditEvent
+			auditService := &AuditServiceMock{
+				RecordFunc: func(event *api.AuditEvent) *errors.ServiceError {
+					recorded = append(recorded, event)
+					return nil
+				},
+			}
+
+			mocket.Catcher.Reset().NewMock().WithQuery(`select txid_current()`).WithReply([]map[string]interface{}{{"txid_current": 1}})
+			connectionFactory := db.NewMockConnectionFactory(nil)
+			router := mux.NewRouter()
+			router.Use(func(next http.Handler) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					ctx, err := connectionFactory.NewContext(r.Context())
+					gomega.Expect(err).ToNot(gomega.HaveOccurred())
+					next.ServeHTTP(w, r.WithContext(ctx))
+					// nothing is recorded until the transaction is resolved
+					gomega.Expect(recorded).To(gomega.BeEmpty())
+					gomega.Expect(db.Resolve(ctx)).To(gomega.Succeed())
+				})
+			})
+			router.HandleFunc("/api/v1/widgets", func(w http.ResponseWriter, r *http.Request) {
+				if tt.rollback {
+					db.MarkForRollback(r.Context(), fmt.Errorf("commit failed"))
+				}
+				shared.WriteJSONResponse(w, http.StatusCreated, map[string]interface{}{"id": "widget-1", "kind": "Widget", "size": "small"})
+			}).Methods(http.MethodPost)
+			router.Use(NewAuditMiddleware(auditService).Audit)
+
+			router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "http://example.com/api/v1/widgets", strings.NewReader("{}")))
+
+			gomega.Expect(recorded).To(gomega.HaveLen(1))
+			gomega.Expect(recorded[0].StatusCode).To(gomega.Equal(http.StatusCreated))
+			gomega.Expect(recorded[0].Outcome).To(gomega.Equal(tt.wantOutcome))
+			gomega.Expect(recorded[0].Diff != nil).To(gomega.Equal(tt.wantDiff))
+		})
+	}
+}
+
+func Test_redact(t *testing.T) {
+	tests := []struct {
+		field string
+		want  interface{}
+	}{
+		{field: "client_secret", want: redactedValue},
+		{field: "api_key", want: redactedValue},
+		{field: "apiKey", want: redactedValue},
+		{field: "private-key", want: redactedValue},
+		{field: "aws_access_key", want: redactedValue},
+		{field: "bootstrap_server_host", want: "value"},
+		{field: "key", want: "value"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			gomega.Expect(redact(tt.field, "value")).To(gomega.Equal(tt.want))
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package audit
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+type AuditConfig struct {
+	Retention time.Duration
+}
+
+func NewAuditConfig() *AuditConfig {
+	return &AuditConfig{
+		Retention: 90 * 24 * time.Hour,
+	}
+}
+
+func (c *AuditConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&c.Retention, "audit-event-retention", c.Retention, "How long audit events are kept before being deleted, 0 keeps them forever")
+}
+
+func (c *AuditConfig) ReadFiles() error {
+	return nil
+}
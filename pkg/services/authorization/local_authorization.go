@@ -0,0 +1,50 @@
+package authorization
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+)
+
+// localAuthorization validates users against the users registered with the admin API
+type localAuthorization struct {
+	userService LocalUserService
+}
+
+var _ Authorization = &localAuthorization{}
+var _ auth.TermsAcceptanceClient = &localAuthorization{}
+
+func NewLocalAuthorization(userService LocalUserService) Authorization {
+	return &localAuthorization{
+		userService: userService,
+	}
+}
+
+// NewLocalTermsAcceptanceClient returns a client checking the terms acceptance of the users registered with the admin API
+func NewLocalTermsAcceptanceClient(userService LocalUserService) auth.TermsAcceptanceClient {
+	return &localAuthorization{
+		userService: userService,
+	}
+}
+
+func (a localAuthorization) CheckUserValid(username string, orgId string) (bool, error) {
+	user, err := a.userService.Get(username)
+	if err != nil {
+		if err.Is404() {
+			return false, nil
+		}
+		return false, err
+	}
+	return !user.Banned && user.OrganisationId == orgId, nil
+}
+
+// GetRequiresTermsAcceptance returns true for the users who aren't registered or haven't accepted the terms. There is
+// no page to accept the terms, they are accepted by the admins registering the users.
+func (a localAuthorization) GetRequiresTermsAcceptance(username string) (termsRequired bool, redirectUrl string, err error) {
+	user, serviceErr := a.userService.Get(username)
+	if serviceErr != nil {
+		if serviceErr.Is404() {
+			return true, "", nil
+		}
+		return false, "", serviceErr
+	}
+	return !user.TermsAccepted, "", nil
+}
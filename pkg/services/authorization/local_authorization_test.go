@@ -0,0 +1,75 @@
+package authorization
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+)
+
+func Test_localAuthorization(t *testing.T) {
+	tests := []struct {
+		name              string
+		user              *api.LocalUser
+		err               *errors.ServiceError
+		orgId             string
+		wantValid         bool
+		wantTermsRequired bool
+		wantErr           bool
+	}{
+		{
+			name:              "registered users are valid members of their organisation",
+			user:              &api.LocalUser{Username: "test-user", OrganisationId: "test-org", TermsAccepted: true},
+			orgId:             "test-org",
+			wantValid:         true,
+			wantTermsRequired: false,
+		},
+		{
+			name:              "registered users are not members of other organisations",
+			user:              &api.LocalUser{Username: "test-user", OrganisationId: "test-org"},
+			orgId:             "other-org",
+			wantValid:         false,
+			wantTermsRequired: true,
+		},
+		{
+			name:              "banned users are not valid",
+			user:              &api.LocalUser{Username: "test-user", OrganisationId: "test-org", Banned: true, TermsAccepted: true},
+			orgId:             "test-org",
+			wantValid:         false,
+			wantTermsRequired: false,
+		},
+		{
+			name:              "users who are not registered are not valid and have not accepted the terms",
+			err:               errors.NotFound("LocalUser not found"),
+			orgId:             "test-org",
+			wantValid:         false,
+			wantTermsRequired: true,
+		},
+		{
+			name:    "should return an error when the user can't be read",
+			err:     errors.GeneralError("db error"),
+			orgId:   "test-org",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			userService := &LocalUserServiceMock{
+				GetFunc: func(username string) (*api.LocalUser, *errors.ServiceError) {
+					return tt.user, tt.err
+				},
+			}
+
+			valid, err := NewLocalAuthorization(userService).CheckUserValid("test-user", tt.orgId)
+			gomega.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			gomega.Expect(valid).To(gomega.Equal(tt.wantValid))
+
+			termsRequired, _, err := NewLocalTermsAcceptanceClient(userService).GetRequiresTermsAcceptance("test-user")
+			gomega.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			gomega.Expect(termsRequired).To(gomega.Equal(tt.wantTermsRequired))
+		})
+	}
+}
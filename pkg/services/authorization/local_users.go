@@ -0,0 +1,99 @@
+package authorization
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"gorm.io/gorm"
+)
+
+// LocalUserService manages the users validated by the local authorization, for installations without access to OCM
+//go:generate moq -out local_users_moq.go . LocalUserService
+type LocalUserService interface {
+	List(listArgs *services.ListArguments) (api.LocalUserList, *api.PagingMeta, *errors.ServiceError)
+	// Get returns the user with the given username, or a not found error if the user isn't registered
+	Get(username string) (*api.LocalUser, *errors.ServiceError)
+	// Save registers the user, replacing the registration of the user with the same username if any
+	Save(user *api.LocalUser) (*api.LocalUser, *errors.ServiceError)
+	Delete(username string) *errors.ServiceError
+}
+
+var _ LocalUserService = &localUserService{}
+
+type localUserService struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+func NewLocalUserService(connectionFactory *db.ConnectionFactory) LocalUserService {
+	return &localUserService{
+		connectionFactory: connectionFactory,
+	}
+}
+
+func (s *localUserService) List(listArgs *services.ListArguments) (api.LocalUserList, *api.PagingMeta, *errors.ServiceError) {
+	var users api.LocalUserList
+	dbConn := s.connectionFactory.New().Model(&api.LocalUser{})
+	pagingMeta := &api.PagingMeta{
+		Page: listArgs.Page,
+		Size: listArgs.Size,
+	}
+
+	var total int64
+	if err := dbConn.Count(&total).Error; err != nil {
+		return nil, nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to count local users")
+	}
+	pagingMeta.Total = int(total)
+
+	if err := dbConn.Order("username").
+		Offset((pagingMeta.Page - 1) * pagingMeta.Size).
+		Limit(pagingMeta.Size).
+		Find(&users).Error; err != nil {
+		return nil, nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list local users")
+	}
+	pagingMeta.Size = len(users)
+
+	return users, pagingMeta, nil
+}
+
+func (s *localUserService) Get(username string) (*api.LocalUser, *errors.ServiceError) {
+	var user api.LocalUser
+	if err := s.connectionFactory.New().Where("username = ?", username).First(&user).Error; err != nil {
+		return nil, services.HandleGetError("LocalUser", "username", username, err)
+	}
+	return &user, nil
+}
+
+func (s *localUserService) Save(user *api.LocalUser) (*api.LocalUser, *errors.ServiceError) {
+	if user.Username == "" {
+		return nil, errors.Validation("username is undefined")
+	}
+	if user.OrganisationId == "" {
+		return nil, errors.Validation("organisation_id is undefined")
+	}
+
+	dbConn := s.connectionFactory.New()
+	var users api.LocalUserList
+	if err := dbConn.Where("username = ?", user.Username).Find(&users).Error; err != nil {
+		return nil, services.HandleGetError("LocalUser", "username", user.Username, err)
+	}
+	if len(users) > 0 {
+		user.ID = users[0].ID
+		user.CreatedAt = users[0].CreatedAt
+	}
+	if err := dbConn.Save(user).Error; err != nil {
+		return nil, services.HandleCreateError("LocalUser", err)
+	}
+	return user, nil
+}
+
+func (s *localUserService) Delete(username string) *errors.ServiceError {
+	result := s.connectionFactory.New().Where("username = ?", username).Delete(&api.LocalUser{})
+	if result.Error != nil {
+		return services.HandleDeleteError("LocalUser", "username", username, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return services.HandleGetError("LocalUser", "username", username, gorm.ErrRecordNotFound)
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package authorization
 
 import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/ocm"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
@@ -15,12 +16,18 @@ func ConfigProviders() di.Option {
 
 func ServiceProviders() di.Option {
 	return di.Options(
+		di.Provide(NewLocalUserService),
 		di.Provide(NewAuthorization),
+		di.Provide(NewTermsAcceptanceClient),
 	)
 }
 
-func NewAuthorization(ocmConfig *ocm.OCMConfig) Authorization {
-	if ocmConfig.EnableMock {
+func NewAuthorization(ocmConfig *ocm.OCMConfig, oidcConfig *auth.OIDCConfig, localUserService LocalUserService) Authorization {
+	if oidcConfig.UserValidation == auth.UserValidationLocal {
+		return NewLocalAuthorization(localUserService)
+	}
+	// identity providers other than OCM can't be queried for the users of an organisation
+	if ocmConfig.EnableMock || oidcConfig.UserValidation == auth.UserValidationNone {
 		return NewMockAuthorization()
 	} else {
 		connection, _, err := ocm.NewOCMConnection(ocmConfig, ocmConfig.AmsUrl)
@@ -30,3 +37,10 @@ func NewAuthorization(ocmConfig *ocm.OCMConfig) Authorization {
 		return NewOCMAuthorization(connection)
 	}
 }
+
+func NewTermsAcceptanceClient(amsClient ocm.AMSClient, oidcConfig *auth.OIDCConfig, localUserService LocalUserService) auth.TermsAcceptanceClient {
+	if oidcConfig.UserValidation == auth.UserValidationLocal {
+		return NewLocalTermsAcceptanceClient(localUserService)
+	}
+	return amsClient
+}
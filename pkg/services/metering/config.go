@@ -0,0 +1,30 @@
+package metering
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+type MeteringConfig struct {
+	Interval time.Duration
+	// MaxSamplingGap caps the hours billed for a resource since its previous sample, so that an outage of the
+	// metering isn't billed as usage
+	MaxSamplingGap time.Duration
+}
+
+func NewMeteringConfig() *MeteringConfig {
+	return &MeteringConfig{
+		Interval:       time.Hour,
+		MaxSamplingGap: 2 * time.Hour,
+	}
+}
+
+func (c *MeteringConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&c.Interval, "usage-metering-interval", c.Interval, "How often the running kafkas and connectors are sampled into usage records, 0 disables usage metering")
+	fs.DurationVar(&c.MaxSamplingGap, "usage-metering-max-sampling-gap", c.MaxSamplingGap, "The longest time since the previous sample of a resource billed in a usage record, it is never less than the usage metering interval")
+}
+
+func (c *MeteringConfig) ReadFiles() error {
+	return nil
+}
@@ -0,0 +1,110 @@
+package metering
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/goava/di"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+)
+
+// UsageMeteringManager samples the running resources into usage records once per metering interval. Every record
+// accounts for the hours since the previous record of its resource, capped by the max sampling gap so that the time the
+// metering wasn't running isn't billed. A resource missing from the previous sampling is billed the time since that
+// sampling, capped by the interval, as it started at some point in between.
+type UsageMeteringManager struct {
+	workers.BaseWorker
+	meteringConfig  *MeteringConfig
+	meteringService MeteringService
+	samplers        []UsageSampler
+}
+
+type UsageMeteringManagerOptions struct {
+	di.Inject
+	MeteringConfig  *MeteringConfig
+	MeteringService MeteringService
+	SignalBus       signalbus.SignalBus
+	Samplers        []UsageSampler `optional:"true"`
+}
+
+// NewUsageMeteringManager creates a new usage metering manager
+func NewUsageMeteringManager(o UsageMeteringManagerOptions) *UsageMeteringManager {
+	return &UsageMeteringManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: "usage_metering",
+			Reconciler: workers.Reconciler{
+				SignalBus: o.SignalBus,
+			},
+		},
+		meteringConfig:  o.MeteringConfig,
+		meteringService: o.MeteringService,
+		samplers:        o.Samplers,
+	}
+}
+
+// Start initializes the usage metering manager to sample the running resources
+func (m *UsageMeteringManager) Start() {
+	m.StartWorker(m)
+}
+
+// Stop causes the process for sampling the running resources to stop
+func (m *UsageMeteringManager) Stop() {
+	m.StopWorker(m)
+}
+
+func (m *UsageMeteringManager) Reconcile() []error {
+	interval := m.meteringConfig.Interval
+	if interval <= 0 {
+		return nil
+	}
+
+	lastSampledAt, err := m.meteringService.LastSampledAt()
+	if err != nil {
+		return []error{err}
+	}
+	now := time.Now()
+	if now.Sub(lastSampledAt) < interval {
+		return nil
+	}
+	glog.V(5).Infoln("sampling usage of running resources")
+
+	var errs []error
+	var records api.UsageRecordList
+	for _, sampler := range m.samplers {
+		sampled, err := sampler.Sample()
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		records = append(records, sampled...)
+	}
+	maxGap := m.meteringConfig.MaxSamplingGap
+	if maxGap < interval {
+		maxGap = interval
+	}
+	resourcesSampledAt, err := m.meteringService.ResourcesSampledAt(lastSampledAt)
+	if err != nil {
+		return append(errs, err)
+	}
+	for _, record := range records {
+		elapsed := now.Sub(lastSampledAt)
+		gap := interval
+		if sampledAt, found := resourcesSampledAt[UsageResource{ResourceType: record.ResourceType, ResourceId: record.ResourceId}]; found {
+			elapsed = now.Sub(sampledAt)
+			gap = maxGap
+		}
+		if elapsed > gap {
+			elapsed = gap
+		}
+		record.SampledAt = now
+		record.Hours = elapsed.Hours()
+	}
+	if err := m.meteringService.Record(records); err != nil {
+		errs = append(errs, err)
+	}
+	return errs
+}
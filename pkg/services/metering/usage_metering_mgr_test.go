@@ -0,0 +1,108 @@
+package metering
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+)
+
+func Test_UsageMeteringManager_Reconcile(t *testing.T) {
+	tests := []struct {
+		name          string
+		interval      time.Duration
+		lastSampledAt time.Time
+		// resourceSampledAt is the time of the previous record of the sampled kafka, none if zero
+		resourceSampledAt time.Time
+		wantSampled       bool
+		wantHours         float64
+	}{
+		{
+			name:          "should not sample when metering is disabled",
+			interval:      0,
+			lastSampledAt: time.Time{},
+			wantSampled:   false,
+		},
+		{
+			name:          "should not sample before the end of the interval",
+			interval:      time.Hour,
+			lastSampledAt: time.Now().Add(-30 * time.Minute),
+			wantSampled:   false,
+		},
+		{
+			name:              "should sample at the end of the interval",
+			interval:          time.Hour,
+			lastSampledAt:     time.Now().Add(-time.Hour),
+			resourceSampledAt: time.Now().Add(-time.Hour),
+			wantSampled:       true,
+			wantHours:         1,
+		},
+		{
+			name:              "should bill the time since the previous record of the resource",
+			interval:          time.Hour,
+			lastSampledAt:     time.Now().Add(-90 * time.Minute),
+			resourceSampledAt: time.Now().Add(-90 * time.Minute),
+			wantSampled:       true,
+			wantHours:         1.5,
+		},
+		{
+			name:              "should cap the time since the previous record of the resource",
+			interval:          time.Hour,
+			lastSampledAt:     time.Now().Add(-5 * time.Hour),
+			resourceSampledAt: time.Now().Add(-5 * time.Hour),
+			wantSampled:       true,
+			wantHours:         2,
+		},
+		{
+			name:          "should bill at most the interval for a new resource",
+			interval:      time.Hour,
+			lastSampledAt: time.Now().Add(-90 * time.Minute),
+			wantSampled:   true,
+			wantHours:     1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			meteringService := &MeteringServiceMock{
+				LastSampledAtFunc: func() (time.Time, *errors.ServiceError) {
+					return tt.lastSampledAt, nil
+				},
+				RecordFunc: func(records api.UsageRecordList) *errors.ServiceError {
+					return nil
+				},
+				ResourcesSampledAtFunc: func(since time.Time) (map[UsageResource]time.Time, *errors.ServiceError) {
+					sampledAt := map[UsageResource]time.Time{}
+					if !tt.resourceSampledAt.IsZero() && !tt.resourceSampledAt.Before(since) {
+						sampledAt[UsageResource{ResourceType: api.UsageResourceTypeKafka, ResourceId: "kafka-id"}] = tt.resourceSampledAt
+					}
+					return sampledAt, nil
+				},
+			}
+			sampler := &UsageSamplerMock{
+				SampleFunc: func() (api.UsageRecordList, *errors.ServiceError) {
+					return api.UsageRecordList{{OrganisationId: "test-org", ResourceType: api.UsageResourceTypeKafka, ResourceId: "kafka-id"}}, nil
+				},
+			}
+			manager := NewUsageMeteringManager(UsageMeteringManagerOptions{
+				MeteringConfig:  &MeteringConfig{Interval: tt.interval, MaxSamplingGap: 2 * time.Hour},
+				MeteringService: meteringService,
+				Samplers:        []UsageSampler{sampler},
+			})
+
+			gomega.Expect(manager.Reconcile()).To(gomega.BeEmpty())
+			if !tt.wantSampled {
+				gomega.Expect(meteringService.RecordCalls()).To(gomega.BeEmpty())
+				return
+			}
+			gomega.Expect(meteringService.RecordCalls()).To(gomega.HaveLen(1))
+			records := meteringService.RecordCalls()[0].Records
+			gomega.Expect(records).To(gomega.HaveLen(1))
+			gomega.Expect(records[0].Hours).To(gomega.BeNumerically("~", tt.wantHours, 0.01))
+			gomega.Expect(records[0].SampledAt.IsZero()).To(gomega.BeFalse())
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package metering
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+)
+
+// UsageSampler returns the usage records of the running resources of a module. Modules register their samplers with
+// di.As(new(metering.UsageSampler)) to have their resources metered.
+//go:generate moq -out usage_sampler_moq.go . UsageSampler
+type UsageSampler interface {
+	// Sample returns a usage record for each running resource, the sampling time and hours are set by the caller
+	Sample() (api.UsageRecordList, *errors.ServiceError)
+}
+
+// UsageFilter restricts the usage records aggregated by Aggregate, the records are sampled in [From, To)
+type UsageFilter struct {
+	OrganisationId string
+	From           time.Time
+	To             time.Time
+}
+
+// UsageAggregate is the usage of the resources of an organisation sharing the same characteristics over a period
+type UsageAggregate struct {
+	OrganisationId string
+	ResourceType   string
+	InstanceType   string
+	CloudProvider  string
+	Region         string
+	MultiAZ        bool
+	StorageSize    string
+	// Instances is the number of distinct resources sampled
+	Instances int
+	Hours     float64
+}
+
+// UsageResource identifies a metered resource
+type UsageResource struct {
+	ResourceType string
+	ResourceId   string
+}
+
+//go:generate moq -out usage_records_moq.go . MeteringService
+type MeteringService interface {
+	// Record persists the usage records of a sampling
+	Record(records api.UsageRecordList) *errors.ServiceError
+	// LastSampledAt returns the time of the most recent usage records, the zero time if there are none
+	LastSampledAt() (time.Time, *errors.ServiceError)
+	// ResourcesSampledAt returns the time of the most recent usage record of each resource sampled since the given time
+	ResourcesSampledAt(since time.Time) (map[UsageResource]time.Time, *errors.ServiceError)
+	// Aggregate returns the hours of usage matching the filter per organisation and resource characteristics
+	Aggregate(filter UsageFilter) ([]UsageAggregate, *errors.ServiceError)
+}
+
+type meteringService struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+var _ MeteringService = &meteringService{}
+
+func NewMeteringService(connectionFactory *db.ConnectionFactory) MeteringService {
+	return &meteringService{
+		connectionFactory: connectionFactory,
+	}
+}
+
+func (s *meteringService) Record(records api.UsageRecordList) *errors.ServiceError {
+	if len(records) == 0 {
+		return nil
+	}
+	if err := s.connectionFactory.New().Create(&records).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to record usage")
+	}
+	return nil
+}
+
+func (s *meteringService) LastSampledAt() (time.Time, *errors.ServiceError) {
+	var records api.UsageRecordList
+	if err := s.connectionFactory.New().
+		Order("sampled_at desc").
+		Limit(1).
+		Find(&records).Error; err != nil {
+		return time.Time{}, errors.NewWithCause(errors.ErrorGeneral, err, "unable to get the last usage records")
+	}
+	if len(records) == 0 {
+		return time.Time{}, nil
+	}
+	return records[0].SampledAt, nil
+}
+
+func (s *meteringService) ResourcesSampledAt(since time.Time) (map[UsageResource]time.Time, *errors.ServiceError) {
+	var rows []struct {
+		UsageResource
+		SampledAt time.Time
+	}
+	if err := s.connectionFactory.New().
+		Model(&api.UsageRecord{}).
+		Select("resource_type, resource_id, max(sampled_at) as sampled_at").
+		Where("sampled_at >= ?", since).
+		Group("resource_type, resource_id").
+		Scan(&rows).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to get the last usage records of the resources")
+	}
+	sampledAt := make(map[UsageResource]time.Time, len(rows))
+	for _, row := range rows {
+		sampledAt[row.UsageResource] = row.SampledAt
+	}
+	return sampledAt, nil
+}
+
+func (s *meteringService) Aggregate(filter UsageFilter) ([]UsageAggregate, *errors.ServiceError) {
+	columns := "organisation_id, resource_type, instance_type, cloud_provider, region, multi_az, storage_size"
+	dbConn := s.connectionFactory.New().
+		Model(&api.UsageRecord{}).
+		Select(columns+", count(distinct resource_id) as instances, sum(hours) as hours").
+		Where("sampled_at >= ? AND sampled_at < ?", filter.From, filter.To)
+	if filter.OrganisationId != "" {
+		dbConn = dbConn.Where("organisation_id = ?", filter.OrganisationId)
+	}
+
+	aggregates := []UsageAggregate{}
+	if err := dbConn.Group(columns).Order(columns).Scan(&aggregates).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to aggregate usage records")
+	}
+	return aggregates, nil
+}
@@ -0,0 +1,225 @@
+package quota
+
+import (
+	"sort"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/goava/di"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// QuotaConsumer reports the capacity units of its resources consumed by the organisations. Modules register their
+// consumers with di.As(new(quota.QuotaConsumer)) to have their resources budgeted by the organisation quotas.
+//go:generate moq -out quota_consumer_moq.go . QuotaConsumer
+type QuotaConsumer interface {
+	// Resources returns the names of the resources the consumer reports on, e.g. the kafka instance types
+	Resources() []string
+	// Consumed returns the capacity units of the resource consumed by the organisation
+	Consumed(orgId string, resource string) (int, *errors.ServiceError)
+}
+
+// QuotaUsage is the consumption of a resource by an organisation against its quota. The allowed and remaining units
+// of unlimited resources are 0.
+type QuotaUsage struct {
+	Resource  string
+	Unlimited bool
+	Allowed   int
+	Consumed  int
+	Remaining int
+}
+
+// OrganisationQuotaService manages the budgets of capacity units of the organisations per resource. The resources of
+// an organisation without a quota for them are not limited by the organisation quotas, a quota of 0 denies them.
+//go:generate moq -out organisation_quotas_moq.go . OrganisationQuotaService
+type OrganisationQuotaService interface {
+	// List returns the quotas of the organisation
+	List(orgId string) (api.OrganisationQuotaList, *errors.ServiceError)
+	// Get returns the quota of the organisation for the resource, or nil if none is defined
+	Get(orgId string, resource string) (*api.OrganisationQuota, *errors.ServiceError)
+	// Save sets the quota of the organisation for the resource, replacing the previous quota if any
+	Save(quota *api.OrganisationQuota) (*api.OrganisationQuota, *errors.ServiceError)
+	Delete(orgId string, resource string) *errors.ServiceError
+	// Usage returns the allowed, consumed and remaining units of the organisation for every known resource
+	Usage(orgId string) ([]QuotaUsage, *errors.ServiceError)
+	// Reserve checks the organisation has the given units of the resource left. An organisation without a quota for
+	// the resource can consume any number of units. The quota row is locked while the consumed units are counted, so
+	// that the concurrent reservations of the organisation are checked one after the other.
+	Reserve(orgId string, resource string, units int) *errors.ServiceError
+}
+
+var _ OrganisationQuotaService = &organisationQuotaService{}
+
+type organisationQuotaService struct {
+	connectionFactory *db.ConnectionFactory
+	consumers         map[string]QuotaConsumer
+}
+
+type OrganisationQuotaServiceOptions struct {
+	di.Inject
+	ConnectionFactory *db.ConnectionFactory
+	Consumers         []QuotaConsumer `optional:"true"`
+}
+
+func NewOrganisationQuotaService(options OrganisationQuotaServiceOptions) OrganisationQuotaService {
+	consumers := map[string]QuotaConsumer{}
+	for _, consumer := range options.Consumers {
+		for _, resource := range consumer.Resources() {
+			consumers[resource] = consumer
+		}
+	}
+	return &organisationQuotaService{
+		connectionFactory: options.ConnectionFactory,
+		consumers:         consumers,
+	}
+}
+
+func (s *organisationQuotaService) List(orgId string) (api.OrganisationQuotaList, *errors.ServiceError) {
+	var quotas api.OrganisationQuotaList
+	if err := s.connectionFactory.New().
+		Where("organisation_id = ?", orgId).
+		Order("resource").
+		Find(&quotas).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list quotas of organisation %s", orgId)
+	}
+	return quotas, nil
+}
+
+func (s *organisationQuotaService) Get(orgId string, resource string) (*api.OrganisationQuota, *errors.ServiceError) {
+	var quotas api.OrganisationQuotaList
+	if err := s.connectionFactory.New().
+		Where("organisation_id = ? AND resource = ?", orgId, resource).
+		Find(&quotas).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to get quota of organisation %s", orgId)
+	}
+	if len(quotas) == 0 {
+		return nil, nil
+	}
+	return quotas[0], nil
+}
+
+func (s *organisationQuotaService) Save(quota *api.OrganisationQuota) (*api.OrganisationQuota, *errors.ServiceError) {
+	if quota.OrganisationId == "" {
+		return nil, errors.Validation("organisation_id is undefined")
+	}
+	if _, ok := s.consumers[quota.Resource]; !ok {
+		return nil, errors.Validation("resource %q is not valid, must be one of %v", quota.Resource, s.resources())
+	}
+	if quota.Allowed < 0 {
+		return nil, errors.Validation("allowed must not be negative")
+	}
+
+	existing, err := s.Get(quota.OrganisationId, quota.Resource)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		quota.ID = existing.ID
+		quota.CreatedAt = existing.CreatedAt
+	}
+	if err := s.connectionFactory.New().Save(quota).Error; err != nil {
+		return nil, services.HandleCreateError("OrganisationQuota", err)
+	}
+	return quota, nil
+}
+
+func (s *organisationQuotaService) Delete(orgId string, resource string) *errors.ServiceError {
+	result := s.connectionFactory.New().
+		Where("organisation_id = ? AND resource = ?", orgId, resource).
+		Delete(&api.OrganisationQuota{})
+	if result.Error != nil {
+		return services.HandleDeleteError("OrganisationQuota", "resource", resource, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return services.HandleGetError("OrganisationQuota", "resource", resource, gorm.ErrRecordNotFound)
+	}
+	return nil
+}
+
+func (s *organisationQuotaService) Usage(orgId string) ([]QuotaUsage, *errors.ServiceError) {
+	quotas, err := s.List(orgId)
+	if err != nil {
+		return nil, err
+	}
+	allowed := map[string]int{}
+	for _, quota := range quotas {
+		allowed[quota.Resource] = quota.Allowed
+	}
+
+	usage := []QuotaUsage{}
+	for _, resource := range s.resources() {
+		consumed, err := s.consumers[resource].Consumed(orgId, resource)
+		if err != nil {
+			return nil, err
+		}
+		quota, limited := allowed[resource]
+		if !limited {
+			usage = append(usage, QuotaUsage{Resource: resource, Unlimited: true, Consumed: consumed})
+			continue
+		}
+		remaining := quota - consumed
+		if remaining < 0 {
+			remaining = 0
+		}
+		usage = append(usage, QuotaUsage{
+			Resource:  resource,
+			Allowed:   quota,
+			Consumed:  consumed,
+			Remaining: remaining,
+		})
+	}
+	return usage, nil
+}
+
+func (s *organisationQuotaService) Reserve(orgId string, resource string, units int) *errors.ServiceError {
+	consumer, ok := s.consumers[resource]
+	if !ok {
+		return errors.GeneralError("no consumer is registered for resource %q", resource)
+	}
+
+	// we must ensure we commit or rollback this transaction to release the lock of the quota row
+	tx := s.connectionFactory.New().Begin()
+	if tx.Error != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, tx.Error, "unable to reserve quota of organisation %s", orgId)
+	}
+	defer tx.Rollback()
+
+	var quotas api.OrganisationQuotaList
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("organisation_id = ? AND resource = ?", orgId, resource).
+		Find(&quotas).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to lock quota of organisation %s", orgId)
+	}
+	if len(quotas) == 0 {
+		return nil
+	}
+	quota := quotas[0]
+	if quota.Allowed <= 0 {
+		return errors.InsufficientQuotaError("Insufficient Quota")
+	}
+
+	consumed, err := consumer.Consumed(orgId, resource)
+	if err != nil {
+		return err
+	}
+	if consumed+units > quota.Allowed {
+		return errors.MaximumAllowedInstanceReached("Organization '%s' has %d of %d allowed %s units left, %d are required.", orgId, quota.Allowed-consumed, quota.Allowed, resource, units)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to reserve quota of organisation %s", orgId)
+	}
+	return nil
+}
+
+// resources returns the sorted names of the resources reported by the registered consumers
+func (s *organisationQuotaService) resources() []string {
+	resources := make([]string, 0, len(s.consumers))
+	for resource := range s.consumers {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+	return resources
+}
@@ -0,0 +1,136 @@
+package quota
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+const testOrgId = "test-org"
+
+func newTestOrganisationQuotaService(consumed map[string]int) OrganisationQuotaService {
+	consumer := &QuotaConsumerMock{
+		ResourcesFunc: func() []string {
+			return []string{"standard", "connectors"}
+		},
+		ConsumedFunc: func(orgId string, resource string) (int, *errors.ServiceError) {
+			return consumed[resource], nil
+		},
+	}
+	return NewOrganisationQuotaService(OrganisationQuotaServiceOptions{
+		ConnectionFactory: db.NewMockConnectionFactory(nil),
+		Consumers:         []QuotaConsumer{consumer},
+	})
+}
+
+func Test_organisationQuotaService_Reserve(t *testing.T) {
+	tests := []struct {
+		name        string
+		quotas      []map[string]interface{}
+		consumed    int
+		units       int
+		wantErrCode errors.ServiceErrorCode
+	}{
+		{
+			name:     "should reserve units of organisations without quota",
+			consumed: 100,
+			units:    1,
+		},
+		{
+			name:        "should not reserve units of organisations with a zero quota",
+			quotas:      []map[string]interface{}{{"organisation_id": testOrgId, "resource": "standard", "allowed": 0}},
+			units:       1,
+			wantErrCode: errors.ErrorInsufficientQuota,
+		},
+		{
+			name:     "should reserve units when the organisation has enough units left",
+			quotas:   []map[string]interface{}{{"organisation_id": testOrgId, "resource": "standard", "allowed": 6}},
+			consumed: 3,
+			units:    3,
+		},
+		{
+			name:        "should not reserve units when the organisation doesn't have enough units left",
+			quotas:      []map[string]interface{}{{"organisation_id": testOrgId, "resource": "standard", "allowed": 6}},
+			consumed:    4,
+			units:       3,
+			wantErrCode: errors.ErrorMaxAllowedInstanceReached,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			mocket.Catcher.Reset()
+			// the quota row must be locked while the consumed units are counted
+			lockQuery := mocket.Catcher.NewMock().WithQuery(`FOR UPDATE`).WithReply(tt.quotas)
+			service := newTestOrganisationQuotaService(map[string]int{"standard": tt.consumed})
+
+			err := service.Reserve(testOrgId, "standard", tt.units)
+			gomega.Expect(lockQuery.Triggered).To(gomega.BeTrue())
+			if tt.wantErrCode == 0 {
+				gomega.Expect(err).To(gomega.BeNil())
+				return
+			}
+			gomega.Expect(err).ToNot(gomega.BeNil())
+			gomega.Expect(err.Code).To(gomega.Equal(tt.wantErrCode))
+		})
+	}
+}
+
+func Test_organisationQuotaService_Usage(t *testing.T) {
+	gomega.RegisterTestingT(t)
+	mocket.Catcher.Reset()
+	mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "organisation_quota"`).
+		WithReply([]map[string]interface{}{{"organisation_id": testOrgId, "resource": "standard", "allowed": 6}})
+	service := newTestOrganisationQuotaService(map[string]int{"standard": 2, "connectors": 4})
+
+	usage, err := service.Usage(testOrgId)
+	gomega.Expect(err).To(gomega.BeNil())
+	gomega.Expect(usage).To(gomega.Equal([]QuotaUsage{
+		{Resource: "connectors", Unlimited: true, Consumed: 4},
+		{Resource: "standard", Allowed: 6, Consumed: 2, Remaining: 4},
+	}))
+}
+
+func Test_organisationQuotaService_Save(t *testing.T) {
+	tests := []struct {
+		name    string
+		quota   *api.OrganisationQuota
+		wantErr bool
+	}{
+		{
+			name:    "should reject quotas without organisation",
+			quota:   &api.OrganisationQuota{Resource: "standard", Allowed: 1},
+			wantErr: true,
+		},
+		{
+			name:    "should reject quotas of unknown resources",
+			quota:   &api.OrganisationQuota{OrganisationId: testOrgId, Resource: "developer", Allowed: 1},
+			wantErr: true,
+		},
+		{
+			name:    "should reject negative quotas",
+			quota:   &api.OrganisationQuota{OrganisationId: testOrgId, Resource: "standard", Allowed: -1},
+			wantErr: true,
+		},
+		{
+			name:  "should save the quota",
+			quota: &api.OrganisationQuota{OrganisationId: testOrgId, Resource: "connectors", Allowed: 10},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			mocket.Catcher.Reset()
+			service := newTestOrganisationQuotaService(nil)
+
+			_, err := service.Save(tt.quota)
+			gomega.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
@@ -0,0 +1,317 @@
+package rbac
+
+import (
+	"context"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/authorization"
+	"github.com/golang-jwt/jwt/v4"
+	"gorm.io/gorm/clause"
+)
+
+type Role string
+
+const (
+	// RoleViewer can read a resource
+	RoleViewer Role = "viewer"
+	// RoleEditor can read and update a resource
+	RoleEditor Role = "editor"
+	// RoleAdmin can read, update and delete a resource and manage who has access to it
+	RoleAdmin Role = "admin"
+)
+
+var roleRanks = map[Role]int{
+	RoleViewer: 1,
+	RoleEditor: 2,
+	RoleAdmin:  3,
+}
+
+func (r Role) IsValid() bool {
+	_, ok := roleRanks[r]
+	return ok
+}
+
+// Includes returns true if the role grants at least the permissions of the given role
+func (r Role) Includes(role Role) bool {
+	return r.IsValid() && role.IsValid() && roleRanks[r] >= roleRanks[role]
+}
+
+type ResourceType string
+
+const (
+	ResourceTypeKafka     ResourceType = "kafka"
+	ResourceTypeConnector ResourceType = "connector"
+	// ResourceTypeOrganisation bindings grant a role on all the kafkas and connectors of an organisation
+	ResourceTypeOrganisation ResourceType = "organisation"
+)
+
+// orgMemberRoles are the roles members of an organisation have on its resources when resources are filtered by
+// organisation, without any role binding
+var orgMemberRoles = map[ResourceType]Role{
+	ResourceTypeKafka:        RoleViewer,
+	ResourceTypeConnector:    RoleAdmin,
+	ResourceTypeOrganisation: RoleViewer,
+}
+
+// Resource is the resource a role is checked or granted on
+type Resource struct {
+	Type           ResourceType
+	ID             string
+	Owner          string
+	OrganisationId string
+}
+
+// OrganisationResource returns the resource of the org-wide role bindings of an organisation
+func OrganisationResource(orgId string) Resource {
+	return Resource{
+		Type:           ResourceTypeOrganisation,
+		ID:             orgId,
+		OrganisationId: orgId,
+	}
+}
+
+//go:generate moq -out role_bindings_moq.go . RoleBindingService
+type RoleBindingService interface {
+	// CheckRole returns a forbidden error if the user in the context doesn't have at least the given role on the
+	// resource. Owners and organisation admins are admins of their resources.
+	CheckRole(ctx context.Context, resource Resource, role Role) *errors.ServiceError
+	// Filter returns the condition selecting the resources of the given type the user in the context was granted at
+	// least the given role on through role bindings. The query is empty if the user wasn't granted any resource.
+	Filter(ctx context.Context, resourceType ResourceType, role Role) (string, []interface{}, *errors.ServiceError)
+	// List returns the role bindings of the resource
+	List(ctx context.Context, resource Resource) (api.RoleBindingList, *errors.ServiceError)
+	// Grant grants the subject the role on the resource, replacing any role the subject was granted before. Only
+	// organisation admins can grant roles on the organisation resource.
+	Grant(ctx context.Context, resource Resource, subject string, role Role) (*api.RoleBinding, *errors.ServiceError)
+	// Revoke deletes the role binding with the given id from the resource
+	Revoke(ctx context.Context, resource Resource, id string) *errors.ServiceError
+	// DeleteResourceBindings deletes all the role bindings of a deleted resource
+	DeleteResourceBindings(resourceType ResourceType, resourceId string) *errors.ServiceError
+}
+
+var _ RoleBindingService = &roleBindingService{}
+
+type roleBindingService struct {
+	connectionFactory *db.ConnectionFactory
+	authService       authorization.Authorization
+}
+
+func NewRoleBindingService(connectionFactory *db.ConnectionFactory, authService authorization.Authorization) RoleBindingService {
+	return &roleBindingService{
+		connectionFactory: connectionFactory,
+		authService:       authService,
+	}
+}
+
+func (s *roleBindingService) CheckRole(ctx context.Context, resource Resource, role Role) *errors.ServiceError {
+	if auth.GetIsAdminFromContext(ctx) {
+		return nil
+	}
+
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		return errors.NewWithCause(errors.ErrorUnauthenticated, err, "user not authenticated")
+	}
+	user := auth.GetUsernameFromClaims(claims)
+	if user == "" {
+		return errors.Unauthenticated("user not authenticated")
+	}
+
+	granted, serviceErr := s.roleOf(ctx, claims, user, resource)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	if !granted.Includes(role) {
+		return errors.Forbidden("user %s is not allowed to perform this action, %s role is required", user, role)
+	}
+	return nil
+}
+
+// roleOf returns the highest role the user has on the resource, or an empty role if the user has no access to it
+func (s *roleBindingService) roleOf(ctx context.Context, claims jwt.MapClaims, user string, resource Resource) (Role, *errors.ServiceError) {
+	// resources can only be accessed by members of the organisation owning them
+	orgId := auth.GetOrgIdFromClaims(claims)
+	if orgId != resource.OrganisationId {
+		return "", nil
+	}
+	if resource.Type != ResourceTypeOrganisation && resource.Owner == user {
+		return RoleAdmin, nil
+	}
+	if orgId == "" {
+		return "", nil
+	}
+	if auth.GetIsOrgAdminFromClaims(claims) {
+		return RoleAdmin, nil
+	}
+
+	var granted Role
+	if auth.GetFilterByOrganisationFromContext(ctx) {
+		granted = orgMemberRoles[resource.Type]
+	}
+
+	var bindings api.RoleBindingList
+	if err := s.connectionFactory.New().
+		Where("subject = ? AND organisation_id = ?", user, orgId).
+		Where("(resource_type = ? AND resource_id = ?) OR (resource_type = ? AND resource_id = ?)", ResourceTypeOrganisation, orgId, resource.Type, resource.ID).
+		Find(&bindings).Error; err != nil {
+		return "", errors.NewWithCause(errors.ErrorGeneral, err, "unable to get role bindings of user %s", user)
+	}
+	for _, binding := range bindings {
+		if bound := Role(binding.Role); bound.IsValid() && !granted.Includes(bound) {
+			granted = bound
+		}
+	}
+	return granted, nil
+}
+
+func (s *roleBindingService) Filter(ctx context.Context, resourceType ResourceType, role Role) (string, []interface{}, *errors.ServiceError) {
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		return "", nil, errors.NewWithCause(errors.ErrorUnauthenticated, err, "user not authenticated")
+	}
+	user := auth.GetUsernameFromClaims(claims)
+	orgId := auth.GetOrgIdFromClaims(claims)
+	// resources can only be shared with members of the organisation owning them
+	if user == "" || orgId == "" {
+		return "", nil, nil
+	}
+
+	var bindings api.RoleBindingList
+	if err := s.connectionFactory.New().
+		Where("subject = ? AND organisation_id = ?", user, orgId).
+		Where("resource_type IN ?", []string{string(resourceType), string(ResourceTypeOrganisation)}).
+		Find(&bindings).Error; err != nil {
+		return "", nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to get role bindings of user %s", user)
+	}
+
+	var ids []string
+	for _, binding := range bindings {
+		if !Role(binding.Role).Includes(role) {
+			continue
+		}
+		if binding.ResourceType == string(ResourceTypeOrganisation) {
+			return "organisation_id = ?", []interface{}{orgId}, nil
+		}
+		ids = append(ids, binding.ResourceId)
+	}
+	if len(ids) == 0 {
+		return "", nil, nil
+	}
+	return "id IN ?", []interface{}{ids}, nil
+}
+
+func (s *roleBindingService) List(ctx context.Context, resource Resource) (api.RoleBindingList, *errors.ServiceError) {
+	if err := s.CheckRole(ctx, resource, RoleViewer); err != nil {
+		return nil, err
+	}
+
+	var bindings api.RoleBindingList
+	if err := s.connectionFactory.New().
+		Where("resource_type = ? AND resource_id = ?", resource.Type, resource.ID).
+		Order("created_at").
+		Find(&bindings).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list role bindings of %s %s", resource.Type, resource.ID)
+	}
+	return bindings, nil
+}
+
+func (s *roleBindingService) Grant(ctx context.Context, resource Resource, subject string, role Role) (*api.RoleBinding, *errors.ServiceError) {
+	if !role.IsValid() {
+		return nil, errors.Validation("role %q is not valid, must be one of %s, %s or %s", role, RoleViewer, RoleEditor, RoleAdmin)
+	}
+	if subject == "" {
+		return nil, errors.Validation("subject is undefined")
+	}
+	if resource.OrganisationId == "" {
+		return nil, errors.BadRequest("%s %s is not owned by an organisation and can't be shared", resource.Type, resource.ID)
+	}
+	if err := s.checkManage(ctx, resource); err != nil {
+		return nil, err
+	}
+
+	valid, err := s.authService.CheckUserValid(subject, resource.OrganisationId)
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to check user %s", subject)
+	}
+	if !valid {
+		return nil, errors.BadRequest("user %s is not a member of organisation %s", subject, resource.OrganisationId)
+	}
+
+	var createdBy string
+	if claims, err := auth.GetClaimsFromContext(ctx); err == nil {
+		createdBy = auth.GetUsernameFromClaims(claims)
+	}
+
+	// a subject has a single role binding per resource, granting a new role only replaces the role of the
+	// existing binding so that it keeps who created it
+	dbConn := s.connectionFactory.New()
+	binding := &api.RoleBinding{
+		ResourceType:   string(resource.Type),
+		ResourceId:     resource.ID,
+		OrganisationId: resource.OrganisationId,
+		Subject:        subject,
+		Role:           string(role),
+		CreatedBy:      createdBy,
+	}
+	if err := dbConn.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "resource_type"}, {Name: "resource_id"}, {Name: "subject"}},
+		DoUpdates: clause.AssignmentColumns([]string{"role", "updated_at"}),
+	}).Create(binding).Error; err != nil {
+		return nil, services.HandleCreateError("RoleBinding", err)
+	}
+
+	var granted api.RoleBinding
+	if err := dbConn.Where("resource_type = ? AND resource_id = ? AND subject = ?", resource.Type, resource.ID, subject).
+		First(&granted).Error; err != nil {
+		return nil, services.HandleGetError("RoleBinding", "subject", subject, err)
+	}
+	return &granted, nil
+}
+
+// checkManage returns a forbidden error if the user in the context isn't allowed to manage the role bindings of
+// the resource. Only organisation admins can manage the organisation wide role bindings, as they grant a role on
+// all the resources of the organisation.
+func (s *roleBindingService) checkManage(ctx context.Context, resource Resource) *errors.ServiceError {
+	if resource.Type == ResourceTypeOrganisation && !auth.GetIsAdminFromContext(ctx) {
+		claims, err := auth.GetClaimsFromContext(ctx)
+		if err != nil {
+			return errors.NewWithCause(errors.ErrorUnauthenticated, err, "user not authenticated")
+		}
+		if !auth.GetIsOrgAdminFromClaims(claims) || auth.GetOrgIdFromClaims(claims) != resource.OrganisationId {
+			return errors.Forbidden("user %s is not allowed to manage the role bindings of organisation %s", auth.GetUsernameFromClaims(claims), resource.OrganisationId)
+		}
+		return nil
+	}
+	return s.CheckRole(ctx, resource, RoleAdmin)
+}
+
+func (s *roleBindingService) Revoke(ctx context.Context, resource Resource, id string) *errors.ServiceError {
+	if err := s.checkManage(ctx, resource); err != nil {
+		return err
+	}
+
+	// role bindings are deleted for good so that the subject can be granted a role on the resource again
+	result := s.connectionFactory.New().Unscoped().
+		Where("id = ? AND resource_type = ? AND resource_id = ?", id, resource.Type, resource.ID).
+		Delete(&api.RoleBinding{})
+	if result.Error != nil {
+		return services.HandleDeleteError("RoleBinding", "id", id, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errors.NotFound("RoleBinding with id='%s' not found", id)
+	}
+	return nil
+}
+
+func (s *roleBindingService) DeleteResourceBindings(resourceType ResourceType, resourceId string) *errors.ServiceError {
+	if err := s.connectionFactory.New().Unscoped().
+		Where("resource_type = ? AND resource_id = ?", resourceType, resourceId).
+		Delete(&api.RoleBinding{}).Error; err != nil {
+		return services.HandleDeleteError("RoleBinding", "resource_id", resourceId, err)
+	}
+	return nil
+}
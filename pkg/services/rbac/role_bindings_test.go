@@ -0,0 +1,291 @@
+package rbac
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/authorization"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+const (
+	testUser  = "test-user"
+	testOrgId = "test-org"
+)
+
+func Test_Role_Includes(t *testing.T) {
+	tests := []struct {
+		name string
+		role Role
+		arg  Role
+		want bool
+	}{
+		{
+			name: "admin includes viewer",
+			role: RoleAdmin,
+			arg:  RoleViewer,
+			want: true,
+		},
+		{
+			name: "editor includes editor",
+			role: RoleEditor,
+			arg:  RoleEditor,
+			want: true,
+		},
+		{
+			name: "viewer does not include editor",
+			role: RoleViewer,
+			arg:  RoleEditor,
+			want: false,
+		},
+		{
+			name: "no role does not include viewer",
+			role: "",
+			arg:  RoleViewer,
+			want: false,
+		},
+		{
+			name: "invalid role does not include anything",
+			role: "owner",
+			arg:  RoleViewer,
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			gomega.Expect(tt.role.Includes(tt.arg)).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func Test_roleBindingService_CheckRole(t *testing.T) {
+	kafka := Resource{
+		Type:           ResourceTypeKafka,
+		ID:             "kafka-id",
+		Owner:          "other-user",
+		OrganisationId: testOrgId,
+	}
+	type args struct {
+		claims               jwt.MapClaims
+		filterByOrganisation bool
+		resource             Resource
+		role                 Role
+	}
+	tests := []struct {
+		name     string
+		args     args
+		bindings []map[string]interface{}
+		wantErr  bool
+	}{
+		{
+			name: "owner is admin of the resource",
+			args: args{
+				claims:   jwt.MapClaims{"username": "other-user", "org_id": testOrgId},
+				resource: kafka,
+				role:     RoleAdmin,
+			},
+		},
+		{
+			name: "organisation admin is admin of the resource",
+			args: args{
+				claims:   jwt.MapClaims{"username": testUser, "org_id": testOrgId, "is_org_admin": true},
+				resource: kafka,
+				role:     RoleAdmin,
+			},
+		},
+		{
+			name: "members of another organisation have no access",
+			args: args{
+				claims:   jwt.MapClaims{"username": testUser, "org_id": "other-org", "is_org_admin": true},
+				resource: kafka,
+				role:     RoleViewer,
+			},
+			wantErr: true,
+		},
+		{
+			name: "organisation members can view kafkas when filtering by organisation",
+			args: args{
+				claims:               jwt.MapClaims{"username": testUser, "org_id": testOrgId},
+				filterByOrganisation: true,
+				resource:             kafka,
+				role:                 RoleViewer,
+			},
+		},
+		{
+			name: "organisation members can't edit kafkas without role binding",
+			args: args{
+				claims:               jwt.MapClaims{"username": testUser, "org_id": testOrgId},
+				filterByOrganisation: true,
+				resource:             kafka,
+				role:                 RoleEditor,
+			},
+			wantErr: true,
+		},
+		{
+			name: "users granted the editor role can edit",
+			args: args{
+				claims:   jwt.MapClaims{"username": testUser, "org_id": testOrgId},
+				resource: kafka,
+				role:     RoleEditor,
+			},
+			bindings: []map[string]interface{}{{"role": "viewer"}, {"role": "editor"}},
+		},
+		{
+			name: "users granted the editor role can't delete",
+			args: args{
+				claims:   jwt.MapClaims{"username": testUser, "org_id": testOrgId},
+				resource: kafka,
+				role:     RoleAdmin,
+			},
+			bindings: []map[string]interface{}{{"role": "editor"}},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "role_bindings"`).WithReply(tt.bindings)
+			s := NewRoleBindingService(db.NewMockConnectionFactory(nil), authorization.NewMockAuthorization())
+			ctx := auth.SetTokenInContext(context.TODO(), &jwt.Token{Claims: tt.args.claims})
+			ctx = auth.SetFilterByOrganisationContext(ctx, tt.args.filterByOrganisation)
+
+			err := s.CheckRole(ctx, tt.args.resource, tt.args.role)
+			gomega.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				gomega.Expect(err.IsForbidden()).To(gomega.BeTrue())
+			}
+		})
+	}
+}
+
+func Test_roleBindingService_Filter(t *testing.T) {
+	tests := []struct {
+		name      string
+		role      Role
+		bindings  []map[string]interface{}
+		wantQuery string
+		wantArgs  []interface{}
+	}{
+		{
+			name: "should return an empty query without role binding",
+		},
+		{
+			name: "should select the resources the user was granted the role on",
+			role: RoleViewer,
+			bindings: []map[string]interface{}{
+				{"resource_type": "kafka", "resource_id": "kafka-1", "role": "viewer"},
+				{"resource_type": "kafka", "resource_id": "kafka-2", "role": "admin"},
+			},
+			wantQuery: "id IN ?",
+			wantArgs:  []interface{}{[]string{"kafka-1", "kafka-2"}},
+		},
+		{
+			name: "should ignore the resources the user was granted a lower role on",
+			role: RoleEditor,
+			bindings: []map[string]interface{}{
+				{"resource_type": "kafka", "resource_id": "kafka-1", "role": "viewer"},
+				{"resource_type": "kafka", "resource_id": "kafka-2", "role": "editor"},
+			},
+			wantQuery: "id IN ?",
+			wantArgs:  []interface{}{[]string{"kafka-2"}},
+		},
+		{
+			name: "should select all the resources of the organisation with an organisation wide role",
+			role: RoleEditor,
+			bindings: []map[string]interface{}{
+				{"resource_type": "kafka", "resource_id": "kafka-1", "role": "viewer"},
+				{"resource_type": "organisation", "resource_id": testOrgId, "role": "admin"},
+			},
+			wantQuery: "organisation_id = ?",
+			wantArgs:  []interface{}{testOrgId},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "role_bindings"`).WithReply(tt.bindings)
+			s := NewRoleBindingService(db.NewMockConnectionFactory(nil), authorization.NewMockAuthorization())
+			ctx := auth.SetTokenInContext(context.TODO(), &jwt.Token{Claims: jwt.MapClaims{"username": testUser, "org_id": testOrgId}})
+
+			query, args, err := s.Filter(ctx, ResourceTypeKafka, tt.role)
+			gomega.Expect(err).To(gomega.BeNil())
+			gomega.Expect(query).To(gomega.Equal(tt.wantQuery))
+			gomega.Expect(args).To(gomega.Equal(tt.wantArgs))
+		})
+	}
+}
+
+func Test_roleBindingService_Grant(t *testing.T) {
+	kafka := Resource{
+		Type:           ResourceTypeKafka,
+		ID:             "kafka-id",
+		Owner:          testUser,
+		OrganisationId: testOrgId,
+	}
+	tests := []struct {
+		name      string
+		claims    jwt.MapClaims
+		resource  Resource
+		bindings  []map[string]interface{}
+		wantErr   bool
+		wantGrant bool
+	}{
+		{
+			name:      "owner can grant roles on the resource",
+			claims:    jwt.MapClaims{"username": testUser, "org_id": testOrgId},
+			resource:  kafka,
+			wantGrant: true,
+		},
+		{
+			name:      "organisation admin can grant organisation wide roles",
+			claims:    jwt.MapClaims{"username": testUser, "org_id": testOrgId, "is_org_admin": true},
+			resource:  OrganisationResource(testOrgId),
+			wantGrant: true,
+		},
+		{
+			name:     "organisation members granted the admin role can't grant organisation wide roles",
+			claims:   jwt.MapClaims{"username": testUser, "org_id": testOrgId},
+			resource: OrganisationResource(testOrgId),
+			bindings: []map[string]interface{}{{"resource_type": "organisation", "resource_id": testOrgId, "role": "admin"}},
+			wantErr:  true,
+		},
+		{
+			name:     "organisation admins can't grant roles on other organisations",
+			claims:   jwt.MapClaims{"username": testUser, "org_id": "other-org", "is_org_admin": true},
+			resource: OrganisationResource(testOrgId),
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gomega.RegisterTestingT(t)
+			mocket.Catcher.Reset()
+			// the existing binding keeps who created it when the subject is granted another role
+			mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "role_bindings" WHERE (resource_type = $1 AND resource_id = $2 AND subject = $3)`).
+				WithReply([]map[string]interface{}{{"id": "binding-id", "subject": "other-user", "role": "editor", "created_by": "creator"}})
+			mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "role_bindings"`).WithReply(tt.bindings)
+			upsert := mocket.Catcher.NewMock().WithQuery(`ON CONFLICT ("resource_type","resource_id","subject") DO UPDATE SET "role"="excluded"."role","updated_at"="excluded"."updated_at"`)
+			s := NewRoleBindingService(db.NewMockConnectionFactory(nil), authorization.NewMockAuthorization())
+			ctx := auth.SetTokenInContext(context.TODO(), &jwt.Token{Claims: tt.claims})
+
+			binding, err := s.Grant(ctx, tt.resource, "other-user", RoleEditor)
+			gomega.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			gomega.Expect(upsert.Triggered).To(gomega.Equal(tt.wantGrant))
+			if tt.wantErr {
+				gomega.Expect(err.IsForbidden()).To(gomega.BeTrue())
+				return
+			}
+			gomega.Expect(binding.ID).To(gomega.Equal("binding-id"))
+			gomega.Expect(binding.CreatedBy).To(gomega.Equal("creator"))
+		})
+	}
+}
@@ -1,8 +1,10 @@
 package signalbus
 
 import (
+	"context"
 	"fmt"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/health"
 	"github.com/golang/glog"
 	"github.com/lib/pq"
 	"sync"
@@ -11,10 +13,12 @@ import (
 )
 
 var _ SignalBus = &PgSignalBus{} // type check the interface is implemented.
+var _ health.Checker = &PgSignalBus{}
 
 // PgSignalBus implements a signalbus.SignalBus that is clustered using postgresql notify events.
 type PgSignalBus struct {
 	isRunning         int32
+	isListening       int32
 	stopChan          chan struct{}
 	syncGroup         sync.WaitGroup
 	connectionFactory *db.ConnectionFactory
@@ -107,6 +111,8 @@ func (sbw *PgSignalBus) run() (exit bool) {
 		glog.V(1).Info("error listening to events:", err.Error())
 		return false
 	}
+	atomic.StoreInt32(&sbw.isListening, 1)
+	defer atomic.StoreInt32(&sbw.isListening, 0)
 	for {
 		// Now lets pull events sent to the listener
 		exit, err := sbw.waitForNotification(listener)
@@ -148,3 +154,17 @@ func (sbw *PgSignalBus) waitForNotification(l *pq.Listener) (exit bool, err erro
 		}
 	}
 }
+
+// HealthChecks checks the bus is listening to the events of the database once started. The workers still reconcile
+// on their repeat interval when it is not.
+func (sbw *PgSignalBus) HealthChecks() []health.Check {
+	return []health.Check{{
+		Name: "signal_bus",
+		Func: func(ctx context.Context) error {
+			if atomic.LoadInt32(&sbw.isRunning) == 1 && atomic.LoadInt32(&sbw.isListening) == 0 {
+				return fmt.Errorf("not listening to the events of the database")
+			}
+			return nil
+		},
+	}}
+}
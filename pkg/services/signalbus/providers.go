@@ -3,6 +3,7 @@ package signalbus
 import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/health"
 	"github.com/goava/di"
 )
 
@@ -13,5 +14,5 @@ func ConfigProviders() di.Option {
 func ServiceProviders() di.Option {
 	return di.Provide(func(dbFactory *db.ConnectionFactory) *PgSignalBus {
 		return NewPgSignalBus(NewSignalBus(), dbFactory)
-	}, di.As(new(SignalBus)), di.As(new(environments.BootService)))
+	}, di.As(new(SignalBus)), di.As(new(environments.BootService)), di.As(new(health.Checker)))
 }
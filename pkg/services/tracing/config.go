@@ -0,0 +1,42 @@
+package tracing
+
+import (
+	"github.com/spf13/pflag"
+)
+
+type Config struct {
+	Enabled bool `json:"enabled"`
+	// Endpoint is the host and port of the OTLP/HTTP collector the spans are exported to
+	Endpoint string `json:"endpoint"`
+	// URLPath is the path the spans are posted to on the collector
+	URLPath  string `json:"url_path"`
+	Insecure bool   `json:"insecure"`
+	// SampleRatio is the ratio of the traces started by the fleet manager that are sampled. The traces started by
+	// the callers of the API are sampled when the callers sampled them.
+	SampleRatio float64 `json:"sample_ratio"`
+	ServiceName string  `json:"service_name"`
+}
+
+func NewConfig() *Config {
+	return &Config{
+		Enabled:     false,
+		Endpoint:    "localhost:4318",
+		URLPath:     "/v1/traces",
+		Insecure:    false,
+		SampleRatio: 0.1,
+		ServiceName: "kas-fleet-manager",
+	}
+}
+
+func (c *Config) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&c.Enabled, "enable-tracing", c.Enabled, "Enable the export of OpenTelemetry traces")
+	fs.StringVar(&c.Endpoint, "tracing-otlp-endpoint", c.Endpoint, "Host and port of the OTLP/HTTP collector the traces are exported to")
+	fs.StringVar(&c.URLPath, "tracing-otlp-url-path", c.URLPath, "Path the traces are posted to on the OTLP/HTTP collector")
+	fs.BoolVar(&c.Insecure, "tracing-otlp-insecure", c.Insecure, "Export the traces over plain HTTP")
+	fs.Float64Var(&c.SampleRatio, "tracing-sample-ratio", c.SampleRatio, "Ratio of the traces started by the fleet manager that are sampled, between 0 and 1")
+	fs.StringVar(&c.ServiceName, "tracing-service-name", c.ServiceName, "Service name the traces are reported with")
+}
+
+func (c *Config) ReadFiles() error {
+	return nil
+}
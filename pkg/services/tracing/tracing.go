@@ -0,0 +1,151 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/golang/glog"
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager"
+
+// The attributes identifying the resources the spans are about, the spans of a kafka request can be found with the
+// kafka ID across the API requests and the reconciles of the workers
+const (
+	KafkaIDKey            = attribute.Key("kas.kafka.id")
+	ClusterIDKey          = attribute.Key("kas.cluster.id")
+	ConnectorIDKey        = attribute.Key("kas.connector.id")
+	ConnectorClusterIDKey = attribute.Key("kas.connector_cluster.id")
+	WorkerTypeKey         = attribute.Key("kas.worker.type")
+	WorkerIDKey           = attribute.Key("kas.worker.id")
+)
+
+// NewTracerProvider creates the tracer provider exporting the spans to the OTLP collector, it is a no-op provider
+// when tracing is disabled. The cleanup func exports the spans still buffered.
+func NewTracerProvider(envName environments.EnvName, c *Config) (trace.TracerProvider, func(), error) {
+	if !c.Enabled {
+		glog.Infof("Disabling tracing")
+		return trace.NewNoopTracerProvider(), func() {}, nil
+	}
+	if c.SampleRatio < 0 || c.SampleRatio > 1 {
+		return nil, nil, fmt.Errorf("tracing sample ratio %v is not between 0 and 1", c.SampleRatio)
+	}
+
+	options := []otlptracehttp.Option{
+		otlptracehttp.WithEndpoint(c.Endpoint),
+		otlptracehttp.WithURLPath(c.URLPath),
+	}
+	if c.Insecure {
+		options = append(options, otlptracehttp.WithInsecure())
+	}
+	exporter, err := otlptracehttp.New(context.Background(), options...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to create the OTLP trace exporter: %w", err)
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceNameKey.String(c.ServiceName),
+		semconv.DeploymentEnvironmentKey.String(string(envName)),
+	))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	glog.Infof("Tracing enabled, exporting to %s%s", c.Endpoint, c.URLPath)
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(c.SampleRatio))),
+	)
+	return provider, func() {
+		if err := provider.Shutdown(context.Background()); err != nil {
+			glog.Errorf("Unable to export the remaining spans: %v", err)
+		}
+	}, nil
+}
+
+// Initialize sets the tracer provider used by the fleet manager and propagates the trace context of the API requests
+// to the outbound requests
+func Initialize(provider trace.TracerProvider) {
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+}
+
+// Start starts a span as a child of the span in the context, if any
+func Start(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// End ends the span, recording the error when there is one
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// SetAttributes sets the attributes on the span in the context
+func SetAttributes(ctx context.Context, attributes ...attribute.KeyValue) {
+	trace.SpanFromContext(ctx).SetAttributes(attributes...)
+}
+
+// Middleware starts a span for each API request, named after the template of the route it matched
+func Middleware(next http.Handler) http.Handler {
+	return otelhttp.NewHandler(next, "api", otelhttp.WithSpanNameFormatter(func(operation string, r *http.Request) string {
+		if route := mux.CurrentRoute(r); route != nil {
+			if template, err := route.GetPathTemplate(); err == nil {
+				return r.Method + " " + template
+			}
+		}
+		return r.Method + " " + operation
+	}))
+}
+
+// VarsMiddleware sets the values of the path variables of the request as attributes of its span, e.g.
+// {"id": KafkaIDKey} on the kafka routes
+func VarsMiddleware(keys map[string]attribute.Key) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			span := trace.SpanFromContext(r.Context())
+			for name, value := range mux.Vars(r) {
+				if key, ok := keys[name]; ok {
+					span.SetAttributes(key.String(value))
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// Transport starts a span for each outbound request and propagates the trace context to the server
+func Transport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return otelhttp.NewTransport(base, otelhttp.WithSpanNameFormatter(func(operation string, r *http.Request) string {
+		return r.Method + " " + r.URL.Host
+	}))
+}
+
+// HTTPClient returns a copy of the client tracing its requests, for the SDKs accepting an *http.Client
+func HTTPClient(client *http.Client) *http.Client {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	traced := *client
+	traced.Transport = Transport(client.Transport)
+	return &traced
+}
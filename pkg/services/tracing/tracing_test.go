@@ -0,0 +1,90 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func newRecorder() *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	Initialize(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	return recorder
+}
+
+func TestNewTracerProvider(t *testing.T) {
+	RegisterTestingT(t)
+
+	provider, cleanup, err := NewTracerProvider("testing", NewConfig())
+	Expect(err).ToNot(HaveOccurred())
+	Expect(provider).ToNot(BeNil())
+	cleanup()
+
+	config := NewConfig()
+	config.Enabled = true
+	config.SampleRatio = 2
+	_, _, err = NewTracerProvider("testing", config)
+	Expect(err).To(HaveOccurred())
+}
+
+func TestMiddleware(t *testing.T) {
+	RegisterTestingT(t)
+	recorder := newRecorder()
+	defer otel.SetTracerProvider(sdktrace.NewTracerProvider())
+
+	router := mux.NewRouter()
+	router.Use(Middleware)
+	kafkasRouter := router.PathPrefix("/kafkas").Subrouter()
+	kafkasRouter.Use(VarsMiddleware(map[string]attribute.Key{"id": KafkaIDKey}))
+	kafkasRouter.HandleFunc("/{id}", func(w http.ResponseWriter, r *http.Request) {
+		_, span := Start(r.Context(), "get kafka")
+		End(span, errors.New("not found"))
+		w.WriteHeader(http.StatusNotFound)
+	}).Methods(http.MethodGet)
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/kafkas/123", nil))
+
+	spans := recorder.Ended()
+	Expect(spans).To(HaveLen(2))
+	child, parent := spans[0], spans[1]
+	Expect(child.Name()).To(Equal("get kafka"))
+	Expect(child.Status().Code).To(Equal(codes.Error))
+	Expect(child.Parent().SpanID()).To(Equal(parent.SpanContext().SpanID()))
+	Expect(parent.Name()).To(Equal("GET /kafkas/{id}"))
+	Expect(parent.Attributes()).To(ContainElement(KafkaIDKey.String("123")))
+}
+
+func TestTransport(t *testing.T) {
+	RegisterTestingT(t)
+	recorder := newRecorder()
+	defer otel.SetTracerProvider(sdktrace.NewTracerProvider())
+
+	var traceparent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+	}))
+	defer server.Close()
+
+	ctx, span := Start(context.Background(), "reconcile")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	Expect(err).ToNot(HaveOccurred())
+	resp, err := HTTPClient(nil).Do(req)
+	Expect(err).ToNot(HaveOccurred())
+	_ = resp.Body.Close()
+	End(span, nil)
+
+	// the trace context is propagated to the server
+	Expect(traceparent).To(ContainSubstring(span.SpanContext().TraceID().String()))
+	Expect(recorder.Ended()).To(HaveLen(2))
+	Expect(recorder.Ended()[0].Name()).To(HavePrefix("GET 127.0.0.1:"))
+}
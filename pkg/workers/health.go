@@ -0,0 +1,106 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/health"
+)
+
+const (
+	// staleReconcileIntervals is how many repeat intervals a running worker may go without a successful reconcile
+	staleReconcileIntervals = 10
+	// hungReconcileTimeout is how long a reconcile may run before the worker is considered hung. Reconciles waiting
+	// for external systems, e.g. for certificates to be issued, can legitimately take long.
+	hungReconcileTimeout = 3 * time.Hour
+)
+
+var _ health.Checker = &LeaderElectionManager{}
+
+// HealthChecks checks the leases of the workers are held, that the workers running on this instance reconcile
+// successfully and that none of their reconciles is hung
+func (s *LeaderElectionManager) HealthChecks() []health.Check {
+	checks := []health.Check{
+		{
+			Name: "leader_leases",
+			Func: s.checkLeaderLeases,
+		},
+		{
+			Name:     "worker_reconciles",
+			Liveness: true,
+			Func: func(ctx context.Context) error {
+				var hung []string
+				for _, worker := range s.workers {
+					since := worker.GetReconcileStatus().ReconcilingSince
+					if worker.IsRunning() && !since.IsZero() && time.Since(since) > hungReconcileTimeout {
+						hung = append(hung, fmt.Sprintf("%s since %s", worker.GetWorkerType(), since.Format(time.RFC3339)))
+					}
+				}
+				if len(hung) > 0 {
+					return fmt.Errorf("reconciles are hung: %s", strings.Join(hung, ", "))
+				}
+				return nil
+			},
+		},
+	}
+	for _, worker := range s.workers {
+		checks = append(checks, health.Check{
+			Name: "worker_" + worker.GetWorkerType(),
+			Func: workerReconcileCheck(worker),
+		})
+	}
+	return checks
+}
+
+// workerReconcileCheck fails when the worker runs on this instance and its reconciles have not succeeded for a while
+func workerReconcileCheck(worker Worker) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		if !worker.IsRunning() {
+			return nil
+		}
+		status := worker.GetReconcileStatus()
+		if status.LastSucceeded.IsZero() {
+			if status.LastReconciled.IsZero() {
+				return nil
+			}
+			return fmt.Errorf("no reconcile succeeded since the worker started, the last one ended at %s", status.LastReconciled.Format(time.RFC3339))
+		}
+		if time.Since(status.LastSucceeded) > staleReconcileIntervals*RepeatInterval {
+			return fmt.Errorf("the last successful reconcile ended at %s", status.LastSucceeded.Format(time.RFC3339))
+		}
+		return nil
+	}
+}
+
+// checkLeaderLeases fails when the lease of a worker expired and no instance acquired it, which means the worker is
+// not running anywhere
+func (s *LeaderElectionManager) checkLeaderLeases(ctx context.Context) error {
+	if len(s.workers) == 0 {
+		return nil
+	}
+	workerTypes := make([]string, 0, len(s.workers))
+	for _, worker := range s.workers {
+		workerTypes = append(workerTypes, worker.GetWorkerType())
+	}
+	var leases api.LeaderLeaseList
+	if err := s.connectionFactory.New().WithContext(ctx).Where("lease_type IN (?)", workerTypes).Find(&leases).Error; err != nil {
+		return fmt.Errorf("failed to retrieve leader leases: %v", err)
+	}
+	// the leases are acquired on the next iteration of the managers once expired
+	staleBefore := time.Now().Add(-s.leaseRenewTime - 2*s.mgrRepeatInterval)
+	var stale []string
+	for _, lease := range leases {
+		if lease.Expires == nil || lease.Expires.Before(staleBefore) {
+			stale = append(stale, lease.LeaseType)
+		}
+	}
+	if len(stale) > 0 {
+		sort.Strings(stale)
+		return fmt.Errorf("the leases of the workers are not held: %s", strings.Join(stale, ", "))
+	}
+	return nil
+}
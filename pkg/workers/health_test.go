@@ -0,0 +1,99 @@
+package workers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	. "github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func newHealthTestWorker(running bool, status ReconcileStatus) *WorkerMock {
+	return &WorkerMock{
+		GetWorkerTypeFunc: func() string {
+			return "cluster"
+		},
+		IsRunningFunc: func() bool {
+			return running
+		},
+		GetReconcileStatusFunc: func() ReconcileStatus {
+			return status
+		},
+	}
+}
+
+func Test_workerReconcileCheck(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name    string
+		running bool
+		status  ReconcileStatus
+		wantErr bool
+	}{
+		{
+			name:   "should pass when the worker doesn't run on this instance",
+			status: ReconcileStatus{LastReconciled: now.Add(-time.Hour)},
+		},
+		{
+			name:    "should pass when the worker didn't complete a reconcile yet",
+			running: true,
+		},
+		{
+			name:    "should pass when the last reconcile succeeded recently",
+			running: true,
+			status:  ReconcileStatus{LastReconciled: now, LastSucceeded: now.Add(-RepeatInterval)},
+		},
+		{
+			name:    "should fail when no reconcile succeeded since the worker started",
+			running: true,
+			status:  ReconcileStatus{LastReconciled: now},
+			wantErr: true,
+		},
+		{
+			name:    "should fail when the last successful reconcile is stale",
+			running: true,
+			status:  ReconcileStatus{LastReconciled: now, LastSucceeded: now.Add(-(staleReconcileIntervals + 1) * RepeatInterval)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			err := workerReconcileCheck(newHealthTestWorker(tt.running, tt.status))(context.Background())
+			Expect(err != nil).To(Equal(tt.wantErr))
+		})
+	}
+}
+
+func TestLeaderElectionManager_HealthChecks(t *testing.T) {
+	RegisterTestingT(t)
+	hung := newHealthTestWorker(true, ReconcileStatus{ReconcilingSince: time.Now().Add(-hungReconcileTimeout - time.Minute)})
+	s := &LeaderElectionManager{
+		workers:           []Worker{hung},
+		connectionFactory: db.NewMockConnectionFactory(nil),
+		leaseRenewTime:    1 * time.Minute,
+		mgrRepeatInterval: 15 * time.Second,
+	}
+	checks := map[string]func(ctx context.Context) error{}
+	for _, check := range s.HealthChecks() {
+		Expect(check.Critical).To(BeFalse())
+		checks[check.Name] = check.Func
+	}
+	Expect(checks).To(HaveLen(3))
+	Expect(checks).To(HaveKey("worker_cluster"))
+	Expect(checks["worker_reconciles"](context.Background())).To(MatchError(ContainSubstring("cluster since")))
+
+	mocket.Catcher.Reset().
+		NewMock().
+		WithQuery(`SELECT * FROM "leader_leases" WHERE lease_type IN ($1)`).
+		WithReply([]map[string]interface{}{{"lease_type": "cluster", "leader": "000-000", "expires": time.Now().Add(-time.Hour)}})
+	Expect(checks["leader_leases"](context.Background())).To(MatchError(ContainSubstring("cluster")))
+
+	mocket.Catcher.Reset().
+		NewMock().
+		WithQuery(`SELECT * FROM "leader_leases" WHERE lease_type IN ($1)`).
+		WithReply([]map[string]interface{}{{"lease_type": "cluster", "leader": "000-000", "expires": time.Now().Add(time.Minute)}})
+	Expect(checks["leader_leases"](context.Background())).To(Succeed())
+}
@@ -1,14 +1,17 @@
 package workers
 
 import (
+	"context"
 	"fmt"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
 	"github.com/goava/di"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/tracing"
 
 	"github.com/golang/glog"
 )
@@ -19,6 +22,37 @@ type Reconciler struct {
 	di.Inject
 	wakeup    chan *sync.WaitGroup
 	SignalBus signalbus.SignalBus
+	// the times of the reconciles in unix nanoseconds, they are accessed atomically as the reconciler is copied into
+	// the workers
+	reconcilingSince int64
+	lastReconciled   int64
+	lastSucceeded    int64
+}
+
+// ReconcileStatus tracks the reconciles of a worker
+type ReconcileStatus struct {
+	// ReconcilingSince is when the reconcile in progress started, it is zero when the worker is not reconciling
+	ReconcilingSince time.Time
+	// LastReconciled is when the last reconcile ended
+	LastReconciled time.Time
+	// LastSucceeded is when the last reconcile without errors ended
+	LastSucceeded time.Time
+}
+
+// Status returns the status of the reconciles of the worker
+func (r *Reconciler) Status() ReconcileStatus {
+	return ReconcileStatus{
+		ReconcilingSince: unixNanoTime(atomic.LoadInt64(&r.reconcilingSince)),
+		LastReconciled:   unixNanoTime(atomic.LoadInt64(&r.lastReconciled)),
+		LastSucceeded:    unixNanoTime(atomic.LoadInt64(&r.lastSucceeded)),
+	}
+}
+
+func unixNanoTime(nanos int64) time.Time {
+	if nanos == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, nanos)
 }
 
 // Wakeup causes the worker reconcile to be performed as soon as possible.  If wait is true, the this
@@ -78,7 +112,23 @@ func (r *Reconciler) Start(worker Worker) {
 
 func (r *Reconciler) runReconcile(worker Worker) {
 	start := time.Now()
-	errors := worker.Reconcile()
+	ctx, span := tracing.Start(context.Background(), "reconcile "+worker.GetWorkerType(),
+		tracing.WorkerTypeKey.String(worker.GetWorkerType()),
+		tracing.WorkerIDKey.String(worker.GetID()),
+	)
+	atomic.StoreInt64(&r.reconcilingSince, start.UnixNano())
+	var errors []error
+	if contextReconciler, ok := worker.(ContextReconciler); ok {
+		errors = contextReconciler.ReconcileWithContext(ctx)
+	} else {
+		errors = worker.Reconcile()
+	}
+	end := time.Now().UnixNano()
+	atomic.StoreInt64(&r.reconcilingSince, 0)
+	atomic.StoreInt64(&r.lastReconciled, end)
+	if len(errors) == 0 {
+		atomic.StoreInt64(&r.lastSucceeded, end)
+	}
 	if len(errors) == 0 {
 		metrics.IncreaseReconcilerSuccessCount(worker.GetWorkerType())
 	} else {
@@ -86,6 +136,11 @@ func (r *Reconciler) runReconcile(worker Worker) {
 		metrics.IncreaseReconcilerErrorsCount(worker.GetWorkerType(), len(errors))
 	}
 	metrics.UpdateReconcilerDurationMetric(worker.GetWorkerType(), time.Since(start))
+	var spanErr error
+	if len(errors) > 0 {
+		spanErr = fmt.Errorf("%d errors reconciling, the first one is: %v", len(errors), errors[0])
+	}
+	tracing.End(span, spanErr)
 	for _, e := range errors {
 		logger.Logger.Error(e)
 	}
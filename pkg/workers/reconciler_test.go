@@ -3,11 +3,15 @@ package workers
 import (
 	"context"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/tracing"
 	"sync"
 	"testing"
 	"time"
 
 	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 func TestReconciler_Wakeup(t *testing.T) {
@@ -77,3 +81,45 @@ func TestReconciler_Wakeup(t *testing.T) {
 	// We can use a 0 timeout here because Wakeup will wait for the reconcile to occur first.
 	Expect(waitForReconcile(0)).Should(Equal(false))
 }
+
+type contextReconcilerMock struct {
+	WorkerMock
+	reconcileWithContext func(ctx context.Context) []error
+}
+
+func (c *contextReconcilerMock) ReconcileWithContext(ctx context.Context) []error {
+	return c.reconcileWithContext(ctx)
+}
+
+func TestReconciler_ReconcileWithContext(t *testing.T) {
+	RegisterTestingT(t)
+	recorder := tracetest.NewSpanRecorder()
+	tracing.Initialize(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(sdktrace.NewTracerProvider())
+
+	worker := &contextReconcilerMock{
+		WorkerMock: WorkerMock{
+			GetIDFunc: func() string {
+				return "test"
+			},
+			GetWorkerTypeFunc: func() string {
+				return "test"
+			},
+		},
+		reconcileWithContext: func(ctx context.Context) []error {
+			_, span := tracing.Start(ctx, "reconcile kafka")
+			tracing.End(span, nil)
+			return nil
+		},
+	}
+
+	r := Reconciler{}
+	r.runReconcile(worker)
+
+	Expect(worker.ReconcileCalls()).To(BeEmpty())
+	spans := recorder.Ended()
+	Expect(spans).To(HaveLen(2))
+	child, parent := spans[0], spans[1]
+	Expect(parent.Name()).To(Equal("reconcile test"))
+	Expect(child.Parent().SpanID()).To(Equal(parent.SpanContext().SpanID()))
+}
@@ -20,6 +20,9 @@ var _ Worker = &WorkerMock{}
 // 			GetIDFunc: func() string {
 // 				panic("mock out the GetID method")
 // 			},
+// 			GetReconcileStatusFunc: func() ReconcileStatus {
+// 				panic("mock out the GetReconcileStatus method")
+// 			},
 // 			GetStopChanFunc: func() *chan struct{} {
 // 				panic("mock out the GetStopChan method")
 // 			},
@@ -54,6 +57,9 @@ type WorkerMock struct {
 	// GetIDFunc mocks the GetID method.
 	GetIDFunc func() string
 
+	// GetReconcileStatusFunc mocks the GetReconcileStatus method.
+	GetReconcileStatusFunc func() ReconcileStatus
+
 	// GetStopChanFunc mocks the GetStopChan method.
 	GetStopChanFunc func() *chan struct{}
 
@@ -83,6 +89,9 @@ type WorkerMock struct {
 		// GetID holds details about calls to the GetID method.
 		GetID []struct {
 		}
+		// GetReconcileStatus holds details about calls to the GetReconcileStatus method.
+		GetReconcileStatus []struct {
+		}
 		// GetStopChan holds details about calls to the GetStopChan method.
 		GetStopChan []struct {
 		}
@@ -110,15 +119,16 @@ type WorkerMock struct {
 		Stop []struct {
 		}
 	}
-	lockGetID         sync.RWMutex
-	lockGetStopChan   sync.RWMutex
-	lockGetSyncGroup  sync.RWMutex
-	lockGetWorkerType sync.RWMutex
-	lockIsRunning     sync.RWMutex
-	lockReconcile     sync.RWMutex
-	lockSetIsRunning  sync.RWMutex
-	lockStart         sync.RWMutex
-	lockStop          sync.RWMutex
+	lockGetID              sync.RWMutex
+	lockGetReconcileStatus sync.RWMutex
+	lockGetStopChan        sync.RWMutex
+	lockGetSyncGroup       sync.RWMutex
+	lockGetWorkerType      sync.RWMutex
+	lockIsRunning          sync.RWMutex
+	lockReconcile          sync.RWMutex
+	lockSetIsRunning       sync.RWMutex
+	lockStart              sync.RWMutex
+	lockStop               sync.RWMutex
 }
 
 // GetID calls GetIDFunc.
@@ -147,6 +157,32 @@ func (mock *WorkerMock) GetIDCalls() []struct {
 	return calls
 }
 
+// GetReconcileStatus calls GetReconcileStatusFunc.
+func (mock *WorkerMock) GetReconcileStatus() ReconcileStatus {
+	if mock.GetReconcileStatusFunc == nil {
+		panic("WorkerMock.GetReconcileStatusFunc: method is nil but Worker.GetReconcileStatus was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetReconcileStatus.Lock()
+	mock.calls.GetReconcileStatus = append(mock.calls.GetReconcileStatus, callInfo)
+	mock.lockGetReconcileStatus.Unlock()
+	return mock.GetReconcileStatusFunc()
+}
+
+// GetReconcileStatusCalls gets all the calls that were made to GetReconcileStatus.
+// Check the length with:
+//     len(mockedWorker.GetReconcileStatusCalls())
+func (mock *WorkerMock) GetReconcileStatusCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetReconcileStatus.RLock()
+	calls = mock.calls.GetReconcileStatus
+	mock.lockGetReconcileStatus.RUnlock()
+	return calls
+}
+
 // GetStopChan calls GetStopChanFunc.
 func (mock *WorkerMock) GetStopChan() *chan struct{} {
 	if mock.GetStopChanFunc == nil {
@@ -1,8 +1,10 @@
 package workers
 
 import (
-	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
+	"context"
 	"sync"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
 )
 
 //go:generate moq -out woker_interface_moq.go . Worker
@@ -16,6 +18,13 @@ type Worker interface {
 	GetSyncGroup() *sync.WaitGroup
 	IsRunning() bool
 	SetIsRunning(val bool)
+	GetReconcileStatus() ReconcileStatus
+}
+
+// ContextReconciler is implemented by the workers that pass the context of the reconcile, which carries its trace span,
+// to the operations they perform, the reconciler calls ReconcileWithContext instead of Reconcile for them
+type ContextReconciler interface {
+	ReconcileWithContext(ctx context.Context) []error
 }
 
 type BaseWorker struct {
@@ -51,6 +60,10 @@ func (b *BaseWorker) SetIsRunning(val bool) {
 	b.isRunning = val
 }
 
+func (b *BaseWorker) GetReconcileStatus() ReconcileStatus {
+	return b.Reconciler.Status()
+}
+
 func (b *BaseWorker) StartWorker(w Worker) {
 	metrics.SetLeaderWorkerMetric(b.WorkerType, true)
 	b.Reconciler.Start(w)
@@ -0,0 +1,21 @@
+#!/bin/bash
+
+# This bash script is meant to be run only in a development environment.
+# It runs a Pebble ACME test server to issue the per instance kafka certificates locally. The DNS-01 challenges are
+# always considered valid by Pebble, so the challenge records don't need to be resolvable.
+
+set -e
+
+docker run \
+  --name=kas-fleet-manager-acme \
+  -e PEBBLE_VA_ALWAYS_VALID=1 \
+  -p 14000:14000 \
+  -d ghcr.io/letsencrypt/pebble:latest
+
+# the certificate of the Pebble directory, to be passed with --kafka-acme-ca-bundle-file
+docker cp kas-fleet-manager-acme:/test/certs/pebble.minica.pem secrets/kafka-acme-ca.pem
+
+# the key the private keys of the kafka certificates are encrypted with in the database
+if [ ! -s secrets/kafka-certificate-encryption.key ]; then
+  head -c 32 /dev/urandom | base64 > secrets/kafka-certificate-encryption.key
+fi
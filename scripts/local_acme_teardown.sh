@@ -0,0 +1,9 @@
+#!/bin/bash
+
+# This bash script is meant to be run only in a development environment.
+
+set -e
+
+docker stop kas-fleet-manager-acme
+
+docker rm kas-fleet-manager-acme